@@ -23,7 +23,7 @@ func TestInjections(t *testing.T) {
 
 	var bootList []environments.BootService
 	env.MustResolve(&bootList)
-	Expect(len(bootList)).To(Equal(5))
+	Expect(len(bootList)).To(Equal(6))
 
 	_, ok := bootList[0].(signalbus.SignalBus)
 	Expect(ok).To(Equal(true))
@@ -35,9 +35,11 @@ func TestInjections(t *testing.T) {
 	Expect(ok).To(Equal(true))
 	_, ok = bootList[4].(*workers.LeaderElectionManager)
 	Expect(ok).To(Equal(true))
+	_, ok = bootList[5].(*environments.ConfigReloader)
+	Expect(ok).To(Equal(true))
 
 	var workerList []workers.Worker
 	env.MustResolve(&workerList)
-	Expect(workerList).To(HaveLen(8))
+	Expect(workerList).To(HaveLen(12))
 
 }
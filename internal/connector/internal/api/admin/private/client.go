@@ -48,9 +48,13 @@ type APIClient struct {
 
 	// API Services
 
+	ConfigsAdminApi *ConfigsAdminApiService
+
 	ConnectorClustersAdminApi *ConnectorClustersAdminApiService
 
 	ConnectorNamespacesAdminApi *ConnectorNamespacesAdminApiService
+
+	WorkersAdminApi *WorkersAdminApiService
 }
 
 type service struct {
@@ -69,8 +73,10 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.common.client = c
 
 	// API Services
+	c.ConfigsAdminApi = (*ConfigsAdminApiService)(&c.common)
 	c.ConnectorClustersAdminApi = (*ConnectorClustersAdminApiService)(&c.common)
 	c.ConnectorNamespacesAdminApi = (*ConnectorNamespacesAdminApiService)(&c.common)
+	c.WorkersAdminApi = (*WorkersAdminApiService)(&c.common)
 
 	return c
 }
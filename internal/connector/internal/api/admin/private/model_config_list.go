@@ -0,0 +1,17 @@
+/*
+ * Connector Service Fleet Manager Admin APIs
+ *
+ * Connector Service Fleet Manager Admin is a Rest API to manage connector clusters.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ConfigList struct for ConfigList
+type ConfigList struct {
+	Kind  string   `json:"kind"`
+	Total int32    `json:"total"`
+	Items []Config `json:"items"`
+}
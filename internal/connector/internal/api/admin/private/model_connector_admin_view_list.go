@@ -11,9 +11,10 @@ package private
 
 // ConnectorAdminViewList struct for ConnectorAdminViewList
 type ConnectorAdminViewList struct {
-	Kind  string               `json:"kind"`
-	Page  int32                `json:"page"`
-	Size  int32                `json:"size"`
-	Total int32                `json:"total"`
-	Items []ConnectorAdminView `json:"items"`
+	Kind       string               `json:"kind"`
+	Page       int32                `json:"page"`
+	Size       int32                `json:"size"`
+	Total      int32                `json:"total"`
+	Items      []ConnectorAdminView `json:"items"`
+	NextCursor string               `json:"next_cursor,omitempty"`
 }
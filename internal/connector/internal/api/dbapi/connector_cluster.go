@@ -4,6 +4,8 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"time"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 )
 
@@ -30,6 +32,8 @@ type ConnectorCluster struct {
 	ClientId       string
 	ClientSecret   string
 	Status         ConnectorClusterStatus `gorm:"embedded;embeddedPrefix:status_"`
+	// StatusReportedAt is the time of the last status report of the agent, it is refreshed once per minute at most
+	StatusReportedAt *time.Time
 }
 
 type ConnectorClusterStatus struct {
@@ -140,6 +140,7 @@ func (a *ConnectorClustersAgentApiService) GetClusterAsignedConnectorDeploymentB
 type GetClusterAsignedConnectorDeploymentsOpts struct {
 	Page      optional.String
 	Size      optional.String
+	Cursor    optional.String
 	GtVersion optional.Int64
 	Watch     optional.String
 }
@@ -152,6 +153,7 @@ Returns a list of connector deployments assigned to the cluster.
  * @param optional nil or *GetClusterAsignedConnectorDeploymentsOpts - Optional Parameters:
  * @param "Page" (optional.String) -  Page index
  * @param "Size" (optional.String) -  Number of items in each page
+ * @param "Cursor" (optional.String) -  Opaque position of the page to return. Send an empty value to return the first page, then the `next_cursor` of the previous page to return the next one. When set, `page` is ignored and at most one `orderBy` field can be used.
  * @param "GtVersion" (optional.Int64) -  filters the connectors to those with a version greater than the given value
  * @param "Watch" (optional.String) -  watch for changes to the resources and return them as a stream of watch events. Specify gt_version to specify the starting point.
 @return ConnectorDeploymentList
@@ -180,6 +182,9 @@ func (a *ConnectorClustersAgentApiService) GetClusterAsignedConnectorDeployments
 	if localVarOptionals != nil && localVarOptionals.Size.IsSet() {
 		localVarQueryParams.Add("size", parameterToString(localVarOptionals.Size.Value(), ""))
 	}
+	if localVarOptionals != nil && localVarOptionals.Cursor.IsSet() {
+		localVarQueryParams.Add("cursor", parameterToString(localVarOptionals.Cursor.Value(), ""))
+	}
 	if localVarOptionals != nil && localVarOptionals.GtVersion.IsSet() {
 		localVarQueryParams.Add("gt_version", parameterToString(localVarOptionals.GtVersion.Value(), ""))
 	}
@@ -372,6 +377,7 @@ func (a *ConnectorClustersAgentApiService) GetClusterAsignedConnectorNamespaceBy
 type GetClusterAsignedConnectorNamespacesOpts struct {
 	Page      optional.String
 	Size      optional.String
+	Cursor    optional.String
 	GtVersion optional.Int64
 }
 
@@ -383,6 +389,7 @@ Returns all connector namespaces assigned to the cluster.
  * @param optional nil or *GetClusterAsignedConnectorNamespacesOpts - Optional Parameters:
  * @param "Page" (optional.String) -  Page index
  * @param "Size" (optional.String) -  Number of items in each page
+ * @param "Cursor" (optional.String) -  Opaque position of the page to return. Send an empty value to return the first page, then the `next_cursor` of the previous page to return the next one. When set, `page` is ignored and at most one `orderBy` field can be used.
  * @param "GtVersion" (optional.Int64) -  filters the connectors to those with a version greater than the given value
 @return ConnectorNamespaceList
 */
@@ -410,6 +417,9 @@ func (a *ConnectorClustersAgentApiService) GetClusterAsignedConnectorNamespaces(
 	if localVarOptionals != nil && localVarOptionals.Size.IsSet() {
 		localVarQueryParams.Add("size", parameterToString(localVarOptionals.Size.Value(), ""))
 	}
+	if localVarOptionals != nil && localVarOptionals.Cursor.IsSet() {
+		localVarQueryParams.Add("cursor", parameterToString(localVarOptionals.Cursor.Value(), ""))
+	}
 	if localVarOptionals != nil && localVarOptionals.GtVersion.IsSet() {
 		localVarQueryParams.Add("gt_version", parameterToString(localVarOptionals.GtVersion.Value(), ""))
 	}
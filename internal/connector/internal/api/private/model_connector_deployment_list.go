@@ -11,9 +11,10 @@ package private
 
 // ConnectorDeploymentList struct for ConnectorDeploymentList
 type ConnectorDeploymentList struct {
-	Kind  string                `json:"kind"`
-	Page  int32                 `json:"page"`
-	Size  int32                 `json:"size"`
-	Total int32                 `json:"total"`
-	Items []ConnectorDeployment `json:"items"`
+	Kind       string                `json:"kind"`
+	Page       int32                 `json:"page"`
+	Size       int32                 `json:"size"`
+	Total      int32                 `json:"total"`
+	Items      []ConnectorDeployment `json:"items"`
+	NextCursor string                `json:"next_cursor,omitempty"`
 }
@@ -57,6 +57,8 @@ type APIClient struct {
 	ConnectorTypesApi *ConnectorTypesApiService
 
 	ConnectorsApi *ConnectorsApiService
+
+	RoleBindingsApi *RoleBindingsApiService
 }
 
 type service struct {
@@ -80,6 +82,7 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.ConnectorServiceApi = (*ConnectorServiceApiService)(&c.common)
 	c.ConnectorTypesApi = (*ConnectorTypesApiService)(&c.common)
 	c.ConnectorsApi = (*ConnectorsApiService)(&c.common)
+	c.RoleBindingsApi = (*RoleBindingsApiService)(&c.common)
 
 	return c
 }
@@ -11,9 +11,10 @@ package public
 
 // ConnectorList struct for ConnectorList
 type ConnectorList struct {
-	Kind  string      `json:"kind"`
-	Page  int32       `json:"page"`
-	Size  int32       `json:"size"`
-	Total int32       `json:"total"`
-	Items []Connector `json:"items"`
+	Kind       string      `json:"kind"`
+	Page       int32       `json:"page"`
+	Size       int32       `json:"size"`
+	Total      int32       `json:"total"`
+	Items      []Connector `json:"items"`
+	NextCursor string      `json:"next_cursor,omitempty"`
 }
@@ -0,0 +1,19 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// PermissionGrant A permission granted on a type of resource
+type PermissionGrant struct {
+	ResourceType string `json:"resource_type"`
+	// Values: [read, create, update, delete]
+	Permission string `json:"permission"`
+	// Values: [organisation, owned]. Permissions with the owned scope are only granted on the resources owned by the user
+	Scope string `json:"scope"`
+}
@@ -0,0 +1,19 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// Permissions The role of the user in its organisation and the permissions granted to it
+type Permissions struct {
+	Kind           string            `json:"kind"`
+	Subject        string            `json:"subject"`
+	OrganisationId string            `json:"organisation_id,omitempty"`
+	Role           string            `json:"role"`
+	Grants         []PermissionGrant `json:"grants"`
+}
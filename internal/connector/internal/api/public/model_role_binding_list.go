@@ -0,0 +1,17 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// RoleBindingList struct for RoleBindingList
+type RoleBindingList struct {
+	Kind  string        `json:"kind"`
+	Total int32         `json:"total"`
+	Items []RoleBinding `json:"items"`
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/golang/glog"
 	"github.com/spf13/pflag"
+	"github.com/xeipuuv/gojsonschema"
 	"time"
 )
 
@@ -25,9 +26,13 @@ type ConnectorsConfig struct {
 	ConnectorCatalogDirs                []string                `json:"connector_types"`
 	CatalogEntries                      []ConnectorCatalogEntry `json:"connector_type_urls"`
 	CatalogChecksums                    map[string]string       `json:"connector_catalog_checksums"`
+	// ConnectorClusterHeartbeatThreshold is the time after which a ready connector cluster whose agent stopped reporting
+	// the cluster status is disconnected. Zero disables the detection of the unresponsive connector clusters.
+	ConnectorClusterHeartbeatThreshold time.Duration `json:"connector_cluster_heartbeat_threshold"`
 }
 
 var _ environments.ConfigModule = &ConnectorsConfig{}
+var _ environments.ConfigValidator = &ConnectorsConfig{}
 
 type ConnectorChannelConfig struct {
 	Revision      int64                  `json:"revision,omitempty"`
@@ -41,7 +46,8 @@ type ConnectorCatalogEntry struct {
 
 func NewConnectorsConfig() *ConnectorsConfig {
 	return &ConnectorsConfig{
-		CatalogChecksums: make(map[string]string),
+		CatalogChecksums:                   make(map[string]string),
+		ConnectorClusterHeartbeatThreshold: 5 * time.Minute,
 	}
 }
 
@@ -51,6 +57,7 @@ func (c *ConnectorsConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringArrayVar(&c.ConnectorEvalOrganizations, "connector-eval-organizations", c.ConnectorEvalOrganizations, "Connector eval organization IDs")
 	fs.BoolVar(&c.ConnectorNamespaceLifecycleAPI, "connector-namespace-lifecycle-api", c.ConnectorNamespaceLifecycleAPI, "Enable APIs to create, update, delete non-eval Namespaces")
 	fs.BoolVar(&c.ConnectorEnableUnassignedConnectors, "connector-enable-unassigned-connectors", c.ConnectorEnableUnassignedConnectors, "Enable support for 'unassigned' state for Connectors")
+	fs.DurationVar(&c.ConnectorClusterHeartbeatThreshold, "connector-cluster-heartbeat-threshold", c.ConnectorClusterHeartbeatThreshold, "Time after which a ready connector cluster whose agent stopped reporting its status is disconnected. Zero disables the detection of unresponsive connector clusters")
 }
 
 func (c *ConnectorsConfig) ReadFiles() error {
@@ -106,6 +113,23 @@ func (c *ConnectorsConfig) ReadFiles() error {
 	return nil
 }
 
+// Validate ensures that the json schema of each connector type of the catalog compiles, so that connectors can be validated against it
+func (c *ConnectorsConfig) Validate(env *environments.Env) error {
+	for _, entry := range c.CatalogEntries {
+		schema := entry.ConnectorType.Schema
+		if schema == nil {
+			schema = entry.ConnectorType.JsonSchema
+		}
+		if schema == nil {
+			return fmt.Errorf("connector type '%s' has no json schema", entry.ConnectorType.Id)
+		}
+		if _, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(schema)); err != nil {
+			return fmt.Errorf("invalid json schema of connector type '%s': %v", entry.ConnectorType.Id, err)
+		}
+	}
+	return nil
+}
+
 func checksum(spec interface{}) (string, error) {
 	h := sha1.New()
 	err := json.NewEncoder(h).Encode(spec)
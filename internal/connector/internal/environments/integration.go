@@ -34,6 +34,8 @@ func (b IntegrationEnvLoader) Defaults() map[string]string {
 		"mas-sso-base-url":              "http://127.0.0.1:8180",
 		"mas-sso-realm":                 "rhoas",
 		"connector-eval-duration":       "48h",
+		// the integration tests do not run agents reporting the status of their connector clusters
+		"connector-cluster-heartbeat-threshold": "0",
 	}
 }
 
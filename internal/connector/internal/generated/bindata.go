@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _connector_mgmtYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3d\xfd\x73\xdb\x36\x96\xbf\xfb\xaf\xc0\x29\xbb\x93\x76\x1b\xc9\x92\x2c\x7f\x69\xae\x7b\xe3\xda\x4e\xea\x36\x71\x52\xdb\x69\x9a\xed\xf4\x64\x88\x84\x24\xc4\x24\x40\x03\xa0\x12\x65\xf7\xfe\xf7\x1b\x00\xfc\x00\x49\x90\xa2\x64\xc5\x76\x5a\x72\x66\xb7\x31\x09\x3c\x3c\x3c\x3c\xbc\x6f\x40\x34\x40\x04\x06\x78\x08\x76\x3a\xdd\x4e\x17\x3c\x01\x04\x21\x17\x88\x19\xe6\x00\x72\x30\xc1\x8c\x0b\xe0\x61\x82\x80\xa0\x00\x7a\x1e\xfd\x08\x38\xf5\x11\x38\x3b\x39\xe5\xf2\xd5\x0d\xa1\x1f\x75\x6b\xd9\x81\x80\x08\x1c\x70\xa9\x13\xfa\x88\x88\xce\xd6\x13\x70\xe4\x79\x00\x11\x37\xa0\x98\x08\x0e\x5c\x34\xc1\x04\xb9\x60\x86\x18\x02\x1f\xb1\xe7\x81\x31\x02\x2e\xe6\x0e\x9d\x23\x06\xc7\x1e\x02\xe3\x85\x1c\x09\x84\x1c\x31\xde\x01\x67\x13\x20\x54\x5b\x39\x40\x84\x1d\x05\x37\x08\x05\x1a\x93\x04\xf2\xd6\x13\xd0\x0a\x18\x9e\x43\x81\x5a\xcf\x00\x74\xe5\x2c\x90\x2f\x1b\x8b\x19\x02\x2d\x87\x12\x82\x1c\x41\xd9\xc8\x9f\xfa\xa2\x1d\xb5\xec\x2c\xa0\xef\xb5\xc0\x04\x7b\x68\x0b\x93\x09\x1d\x6e\x01\x20\xb0\xf0\xd0\x10\x1c\xc7\x1d\xc0\x25\x62\x73\xec\x20\xf0\xdc\x43\x48\x80\x57\x90\xc0\x29\x62\x5b\x00\xcc\x11\xe3\x98\x92\x21\xe8\x76\x7a\x9d\xee\x16\x00\x2e\xe2\x0e\xc3\x81\x50\x2f\x97\xf4\xd7\xf3\xb9\x40\x5c\x80\xa3\x37\x67\x12\x4d\x5f\x7d\x00\x09\xa2\xbc\xb3\xc5\x11\x93\x83\x48\xac\xda\x20\x64\xde\x10\xcc\x84\x08\xf8\x70\x7b\x1b\x06\xb8\x23\x89\xcd\x67\x78\x22\x3a\x0e\xf5\xb7\x00\xc8\x21\xf0\x0a\x62\x02\xbe\x09\x18\x75\x43\x47\xbe\xf9\x16\x68\x70\x76\x60\x5c\xc0\x29\x5a\x06\xf2\x52\xc0\x29\x26\x53\x2b\xa0\xe1\xf6\xb6\x47\x1d\xe8\xcd\x28\x17\xc3\x83\x6e\xb7\x5b\xec\x9e\x7c\x4f\x7b\x6e\x17\x5b\x39\x21\x63\x88\x08\xe0\x52\x1f\x62\xb2\x25\xe0\x34\x22\x00\x81\x7e\x66\x5d\xae\x16\x01\xe2\xc5\xfe\xad\x96\xad\x75\xed\x86\xe0\xd8\x0b\xb9\x40\x2b\x74\x88\xd6\xd7\xda\x7e\x2b\x80\x62\xa6\xf0\x7f\x22\xff\x07\xac\xdd\x9e\x6c\x6d\x01\xd0\x92\xcb\xb0\x9d\x65\xd3\xed\x79\xaf\x35\x54\x70\xa7\x48\xe8\x7f\x00\x10\x13\x44\x3f\xed\x12\x44\x80\xdc\x8b\x0c\x4a\x44\xce\xdc\xa1\xec\xff\xab\x66\xd7\x57\x48\x40\x17\x0a\x18\xb5\xe2\xa1\xef\x43\xb6\x18\x82\x0b\x24\x42\x46\xb8\xda\x2d\x11\x67\x03\x3f\xdb\x36\x33\xb9\x1a\xed\x19\xe2\x01\x25\x1c\x19\xe8\xb6\xfa\xdd\x6e\x2b\xfd\x13\x48\x76\x17\x88\x08\xf3\x15\x00\x30\x08\x3c\xec\x28\xe4\xb7\x3f\x70\x4a\xb2\x5f\x01\xe0\xce\x0c\xf9\x30\xff\x16\x80\xbf\x31\x34\x19\x82\xa7\x4f\xb6\x1d\xea\x07\x94\x20\x22\xf8\xb6\x6e\xcb\xb7\x73\xd3\x7f\x6a\x74\xce\xcc\xeb\xd7\xfc\x5c\x92\xb5\x2b\x72\x5e\xd5\xc2\x6d\xdf\xc0\xc9\x0d\x1c\xa5\xef\x85\xec\xb4\xfd\xef\xec\x8b\x11\x76\xff\x2f\xa2\x47\x00\x19\xf4\x91\x88\xf6\xbb\x5e\x5b\xcd\x6a\x85\x2e\x5b\x56\xcc\xaf\x66\x08\x60\x17\x50\x25\x31\xd3\x4e\x40\x76\xda\x2a\x27\x9d\xfc\x3c\x04\x5c\x30\x4c\xa6\xc9\x6b\x4c\x86\x40\xb2\x6e\xf2\x82\xa1\xdb\x10\x33\xe4\x0e\x81\x60\x21\xaa\xcf\x93\xe9\x26\x05\x80\x23\x27\x64\x58\x2c\xcc\x96\x3f\x20\xc8\x10\x1b\x82\xdf\xc1\x1f\x25\x7c\x9b\xc0\x92\xa0\x7e\x58\x9c\x9d\xe4\x39\xf7\x05\x12\x00\xe6\xe6\x2b\xb5\x48\x42\xa7\x0c\x95\x96\xb6\x7e\x20\xae\x6d\x59\xb9\x36\x33\xf9\x56\xae\x2b\xfa\x04\xfd\xc0\x33\x11\x8d\x9f\x4c\xb7\x53\xdd\xac\xd8\xca\x3e\x74\x0c\x75\xdb\x06\xa4\x55\xb6\x6d\xae\x0a\x2c\x07\x7c\x28\x9c\x99\x54\x17\x92\x1d\x25\xff\x20\x25\xf9\x23\x92\x0e\xba\xbd\x87\x21\xe9\x29\x63\x94\xd5\x27\xe5\xa0\xdb\x5b\x97\x80\x69\xd7\x52\xb2\x1d\x85\x62\x06\x04\xbd\x41\x44\x1a\x04\x98\xcc\xa1\x67\x6c\xef\xd6\xa0\x3b\xf8\x4a\x88\x34\x58\x9f\x48\x83\x65\x44\x3a\xa7\x29\x2f\xe5\x78\x0c\x7d\xc2\x5c\xf0\x94\x60\xbb\x0f\xb5\x51\x57\x24\xd8\x6e\xb7\xbb\x2e\xc1\xd2\xae\xa5\x04\x7b\x4b\xd0\xa7\x00\x39\x02\xb9\x00\x49\xbc\x00\x75\x94\x55\xe5\xae\xac\xaf\x56\x31\x3f\x36\x2c\xea\x79\x99\x85\x02\x81\x87\xb9\x90\x7a\x2e\xcb\x0c\xbc\xca\x4c\x59\xd6\xa9\xa8\x7d\x25\xca\xb6\x85\x48\x5b\x6e\x07\x70\x6a\x2c\xc2\xd2\xe6\x1c\x7f\x5e\xa5\x39\x65\x2e\x62\x3f\x2c\x56\x19\x00\x41\xe6\xcc\x5a\x8f\x5e\x91\xbd\xc4\x5c\x94\x8b\xc4\x25\x2b\xd5\xe8\x8e\x7a\xba\xa3\x11\x85\x4b\x45\x61\xce\xae\x5f\xd1\xa2\x8f\x85\x63\x20\x3d\xde\x65\xd2\xf1\x0e\x82\xd1\x61\x08\x0a\x64\x62\x09\x4c\xb1\x78\xac\x3e\xab\xe0\xc8\xc7\x74\xcb\xd8\x64\x61\x65\x4b\xbb\x00\x94\x7e\xc0\x6d\x88\xd8\xc2\xa0\xaf\x76\x4a\x20\x5f\x10\xa7\x8c\xea\x6f\x10\x9b\x50\xe6\x2b\xcb\x0f\xaa\xe8\x03\xc0\x04\x40\xa2\x7b\xcd\x18\x25\x34\xe4\xc0\x87\x84\x20\xb6\x55\xcd\x6d\xda\x3d\x19\x53\xea\x21\x48\x8c\x2f\x16\x87\x04\xc4\x56\xe6\x0f\xd4\x35\x08\x5c\x12\x96\x31\x1c\x55\xeb\xe6\xa8\xde\x1a\xf6\x8d\x51\x4b\x02\x5e\x68\x24\xb3\x3b\xa4\x6c\x7f\x24\xbd\xf4\xe2\x95\xee\x94\x7a\x96\x7c\x06\x48\xab\xca\xb9\x2b\x53\x1f\xfd\x07\x56\x1f\xe5\xd2\xd0\x71\x50\x20\x50\xc6\x78\xfe\x3a\x04\xe0\xa0\xdb\x55\xeb\x82\x29\x59\x5f\x5b\xe4\x41\x94\xd2\xe9\x57\xa9\x25\x54\x4b\x2d\x10\x79\x2a\x11\x0d\xca\x35\xfa\xb5\xf1\xcd\x6a\xf9\x66\x57\xa9\x6f\x8f\x5c\x29\x33\x68\xc8\x1c\x04\x5c\x8a\x38\x79\x2a\xb4\x7f\xd6\xd8\x24\x39\xc6\x22\x20\x2c\x33\x4b\xb4\xb6\x8f\xa3\x26\x59\x25\x5d\xc7\x0b\xbb\x83\x9d\x21\xcd\xee\x22\x9c\xc6\xfb\xaa\x3d\xc0\x57\xe0\x7d\xad\xea\x79\x35\x4e\x57\xe3\x74\x3d\x4c\xfc\x89\x6f\xff\xbb\x3a\x37\xb2\x64\x37\x62\xb7\x75\x1f\x42\xd3\x8c\x5a\xe5\x45\x66\x2e\xd5\x60\x13\x90\xf6\x26\x8f\x53\x76\xd4\x8c\xfd\x37\x61\xff\xc6\xb4\xac\x43\xa4\x26\xec\xbf\x12\xc1\xee\x24\x76\x75\x53\x0f\x09\xf4\x25\x65\xa1\x1e\xa1\x54\x1c\x9e\xa8\xcf\xcb\x24\x62\x69\x2b\xbb\x50\x7c\x2c\x1b\xc5\x32\x87\xc6\xa1\xfe\xd3\x4a\x3d\xbd\xc0\x77\x90\x7d\x19\x00\x55\x12\x50\x59\x45\xb1\x1a\x05\x1f\xb1\x98\x01\x1e\x20\x07\x4f\x30\x72\xc1\xd9\xc9\xd7\x2c\x09\xef\x46\xc4\x3c\x80\x35\xa5\x62\x20\x35\xcc\x97\x14\x8a\x6a\x80\x52\x99\xf8\x46\x7e\x5d\x26\x12\xcb\x1a\x2d\x8f\x76\x9f\x40\x01\x81\xa0\x1a\x89\x5c\x59\x90\xe4\xa5\xad\x0a\x36\x31\x99\xc4\x47\x6c\x8a\xda\x0a\xca\x77\x75\x63\xe1\x3a\x70\x4f\xc7\x1f\x90\x23\x4a\xc0\x4a\x50\x2b\x42\xcd\x39\xac\x3f\x5d\xbe\x3e\xd7\xf4\x79\x06\x2e\x9e\x1f\x83\xbd\xc3\x6e\x1f\xb4\x93\xca\x46\x41\xa9\xc7\x3b\x18\x89\x49\x87\xb2\xe9\xf6\x4c\xf8\xde\x36\x9b\x38\xb2\xd5\x7a\xd8\x6e\x3e\x09\x90\x74\xfe\x33\x04\xe1\x1b\x4f\xe0\xaf\xab\x13\x1b\x4f\xe0\x6b\xf0\x04\x2c\xd5\xac\x51\xc1\xf3\xaa\xf5\xac\x4e\x54\x27\xbd\x4a\x16\x3c\x5b\x5c\x5d\x9d\xe7\x4e\xd1\x02\xb5\x35\xef\x92\xa4\x38\x70\x32\x30\x6b\x24\xc7\x73\x3d\xfe\x72\x49\xf2\x68\xfa\x0f\x97\x2c\x8f\xb8\x60\xcd\x9c\xb9\xee\xbc\x99\xd4\xb9\x05\xd6\x57\x99\x41\x8f\x26\xd2\x24\xd2\x9b\x44\xba\x41\xb9\xc6\xc6\xa9\x41\xa4\x26\x91\xfe\xb8\xcc\x9c\x35\x12\xe9\x19\x85\x5e\xab\xac\x39\x67\xb2\xdc\x35\xb1\x9e\x07\x57\x27\xbf\xee\x64\xfb\xd4\x4e\xb1\xe7\xfa\xdd\x77\x96\xfd\x71\xa6\xb1\xa2\x05\x58\xb9\x06\x39\x47\xcc\x46\xba\x37\x19\xf1\x7b\x3e\x91\x11\x73\xa0\x79\x88\x30\x7a\xb7\xe2\x39\xc2\xb4\x97\xdd\x01\x28\x3b\x4a\x98\xf5\x86\xee\xff\x34\xe1\xdd\x65\xb1\x99\xaf\xcf\x79\x98\x65\xe7\x09\x2b\x9c\xc6\xea\xa6\x8f\x5a\xfe\xd5\x8c\xe1\xc5\x0e\x60\x13\xcb\x6b\xec\xdc\x3a\x44\x5a\x33\x96\x17\xb3\x59\x13\xd3\x5b\x37\x8f\x15\xde\x8b\xf8\x0c\x03\xd7\x12\xa3\xfb\x61\x71\xe6\xe6\xa5\x68\xe8\x06\x30\x9b\xc7\xaf\x12\xa4\x4b\x5b\xd7\xcf\x75\x69\x14\xdd\x35\x33\x5d\xf7\x12\xbc\x5a\x21\x5a\x94\x15\x19\xd9\x28\x5d\xb4\x67\xb8\x80\x22\x54\x37\xb0\x44\x53\x6f\xe4\x72\x23\x97\x37\x2c\x97\x1b\x91\x6c\xa3\xd9\x46\x0a\xae\x36\x20\x95\x73\x85\x57\x25\x76\x6d\xb1\xb2\xaa\x4a\x22\x2f\x6d\xdd\xd4\x63\x35\x72\xf1\x91\x10\xe9\x1e\xeb\xb1\x92\xc0\x6c\x53\x8a\xb5\xc9\x52\xac\xcd\x45\x41\xb6\xa1\xeb\x52\x32\x4a\xa3\x20\x4d\x58\x64\xbd\xb0\xc8\x91\xa4\xe3\x9b\x84\x6a\x79\x6d\x52\x12\xfa\x78\xca\x81\x5a\x00\x83\xde\x36\xed\xb2\x72\xef\x47\x15\x4b\xc9\x92\xa6\x32\x92\x2c\x59\x26\x9d\x0c\x10\x33\x28\x00\x9f\xd1\xd0\x73\xc1\x18\x81\x90\xeb\xfb\x0c\x1d\x4a\x26\x78\x1a\x32\xa4\x18\x4b\xdf\x04\x68\x7a\x30\x9a\x28\x94\x68\xbe\xd3\xb4\xea\x34\xea\xec\xcf\xaa\xce\x9a\xf0\xcb\xd7\x64\xeb\x6f\x50\x77\x49\x85\xc4\x03\xe8\xa0\xaf\x5c\x6b\xad\x98\x57\x5c\x29\xab\xb8\xea\xc9\xdd\x95\xce\xed\x3e\x9c\xba\x3d\x4f\x96\xbe\xbe\xa6\x25\xf9\x3e\x35\x75\x6c\xa1\xdf\xa3\xd2\xae\x09\x65\x12\x92\x2c\xd5\xb0\xe9\x84\xc0\x1c\x73\x3c\xf6\xd4\x85\xc5\x21\x47\x0c\xe0\x46\x69\x36\x4a\xb3\x51\x9a\x8f\x50\x69\xe6\xeb\x90\x33\x12\x70\xa5\x52\xe4\xa2\xda\xac\x55\x8c\x5c\x10\xb9\x55\xe5\xc8\x49\xe3\x95\xa4\xfe\xb2\x82\x64\x92\x83\x5a\xa7\x24\x39\xdf\x67\x95\x7a\xde\xa4\xef\xc3\x55\xf4\x26\x84\x5c\xaf\xa6\x37\xe9\xbe\x91\xaa\x5e\x3b\xb4\xaf\xb2\xae\x37\x99\x4a\x53\xd9\xdb\x54\xf6\x1a\x94\x6b\xac\x87\x1a\x44\x6a\x2a\x7b\x1f\x97\xe1\xb0\x4e\x65\x6f\x56\x2f\xd6\xf2\xe4\x8a\x4e\xd7\x1d\xab\x7b\xcb\xbd\xb8\xaa\x3a\xdd\x6a\x3f\x6e\xa5\x9e\xcd\x4d\x5a\xe9\xf3\x08\x9c\x53\x5b\x21\x71\x61\xcd\x1a\x75\xd2\x94\x12\xdf\x73\x20\x32\xe5\x41\x33\x14\x99\xbc\x5d\xb1\x9c\xd8\xec\x67\xf7\x40\xca\x62\x90\x79\x5f\xe6\xfe\x73\x67\x9b\x50\x01\x66\x38\xaf\xe0\x27\x96\x85\xf1\x2a\x5d\xbf\x65\x8d\x1f\xb9\x4c\xac\x59\x5c\x9c\x7a\xa3\x4d\x79\x71\x63\x6c\xd7\x21\xd2\x9a\xa1\xba\x94\xd1\x9a\x60\xdd\x17\xbd\x28\x67\x23\xe2\x34\x57\x64\x9c\x80\xac\x59\x66\x5c\x29\x58\x6b\xb4\x5f\xb5\xd4\xd8\xe0\xae\x07\xab\x34\x4e\x68\xa4\xee\xc2\xf9\x42\x05\xc7\xc9\x20\x4d\xc9\x71\x23\xab\xef\x41\x56\x37\x62\xda\x46\xb5\xcd\x14\x1d\x6f\x42\x4e\xe7\xca\x8e\x4b\x2d\x5f\x4b\x29\x71\xa5\x8c\xae\xd1\xbe\x29\x3e\x6e\x24\xe4\x23\x21\x52\x53\x7c\xfc\x17\x2a\x3e\x36\xe2\x26\x68\x0e\xbd\x8d\xa7\x9b\x4f\xe7\xd0\x0b\xd5\xbb\x4d\xe6\x9b\xf9\x8c\x32\x01\x3c\x3c\x97\x73\x4f\x46\x58\x2b\x0d\x5d\xab\xfb\xd7\x9a\x91\x96\xd4\xbf\x63\x56\x5a\x82\xd8\x6c\x66\xba\x00\xb1\xc9\x4e\x7f\x69\xac\x9b\xec\xf4\xbd\x51\xae\x31\x31\x6a\x10\xa9\xc9\x4e\x3f\x2e\x17\xec\x6e\xd9\xe9\xad\x74\x54\x89\x5c\x34\xc3\xa1\xbe\x09\xf8\x89\xfe\x7f\x70\x4c\x7d\x9f\x92\xe8\x95\xfa\xcf\x4b\x9c\x1a\x19\x89\xe0\x37\x8c\x81\x1b\x4c\x5c\xe3\xcf\x00\x4e\x91\xf1\x27\xc7\x9f\xcd\x3f\x05\x15\xd0\x33\xfe\xc6\x02\xf9\xb1\x59\x62\xb9\x0a\x39\x60\xd2\x56\x11\xd8\x24\xb5\x1c\x6f\x69\x9a\x46\x62\x51\x6c\x84\x89\x40\x53\xb3\xf2\x1c\x7f\xae\xd1\x4a\xe1\x5c\xde\x4c\x7d\x50\x6c\x12\xb7\x81\x9e\xf7\x7a\x62\x92\xa8\x8a\xc1\x5e\xab\xf9\x5e\xa0\x09\x62\x88\x38\x99\xfc\x76\xc9\xdd\xd0\x36\xa2\x00\xb5\x27\xdc\x02\xd7\x59\x89\x03\xd4\x4a\x42\xcb\x0e\x29\x6d\x9e\x98\x8c\x23\xec\x56\x76\x52\xdf\x72\x73\x1a\xae\xb6\xc0\x78\xf9\xf2\xd6\xe2\x81\x99\xa4\x7a\x59\x23\x03\xcf\x57\x48\xc0\x15\x51\xa4\x1f\x09\x62\x4b\x11\xd0\xa6\xb5\x3b\x82\x19\x39\x35\xa1\xcc\x87\x62\x28\xcd\x4e\xd4\x16\xd8\x47\xcb\xc0\xf8\xd4\x55\xee\xd6\xba\x70\xd4\xfb\x4b\xc4\xe6\xd8\x89\x83\x26\x98\x92\x4b\x24\xa4\xb4\xe0\x55\x5b\x1b\x9b\x1b\x3b\x64\xde\xdd\x16\x2d\x64\x96\x5d\x64\xc1\xf1\xc8\x71\x68\x48\x2a\x65\x8e\xe3\x61\x44\xc4\x28\x83\x5f\xf4\x8e\x23\x87\xa1\xaa\xb5\x4b\xfa\x2e\x5f\x3f\x13\x62\x35\xea\xbf\x22\xc6\x31\x25\x92\x95\xa4\x3b\x71\x4f\x92\x00\xd9\x54\x8d\xda\x1b\xa0\x75\xf4\xe6\x2c\x42\x2a\xab\xbd\xb0\xfc\x38\xef\x65\x5f\xce\x34\x5a\x76\x67\xb4\x95\x93\x32\x9e\xa7\x39\xa8\xa0\xfe\xda\x1a\xb8\xf2\x5d\x79\x5e\x67\x2e\x19\xa4\xf8\x23\xc7\x85\xfe\xd1\xc4\x4a\x7f\x53\xae\x5c\x2e\x96\x62\xac\xe9\x0a\x19\x83\x8b\xdc\x17\xa5\x98\x8a\x3a\x3c\xb7\xa0\xe6\xdc\x57\x5a\xda\x8c\xce\x8d\xf8\x9e\x9b\x5a\xf7\x67\x49\x8e\xf2\xdd\x9a\x31\x0b\x7e\xa4\x9e\xcb\x63\xb5\xaf\xce\x73\x6a\x33\x5d\x1f\xf0\x94\x10\xe4\x3f\xa1\x86\x09\xce\x08\x17\x90\x38\xa8\xb3\x0e\x8f\x96\x8a\x91\x74\x21\x9e\x44\xbf\x1d\x12\x85\x89\x1c\x63\x5d\xd2\x36\x25\x2c\xfd\x24\xbb\x8a\x5a\x2a\xa8\xa1\x2f\xd0\x14\x73\xc1\x16\x1b\x26\x89\x02\x0e\x62\xe0\xf7\x40\x1b\xdd\x18\xb0\x78\xc4\x4d\x51\x29\xe6\x25\x75\x44\x38\xc3\x49\xd9\x43\xc3\x56\x6a\xb5\x8e\xf2\xc7\x9f\x5b\x1b\x57\xd9\x73\xe8\x85\x16\x63\xcb\x14\xa2\xc5\xe3\xcd\x65\xd8\xc6\xc5\x6d\xf9\x43\xdb\x59\xb4\xcd\x7d\x9d\xdb\xcf\xf5\x4f\x59\xb7\xf2\xf6\x71\xf1\xfa\xfa\x84\xd4\xf9\xb3\x75\x97\x02\x8a\x9c\xf5\x93\xa1\x0a\x22\xa1\x6f\x72\x97\x8b\x79\xc4\x9d\xc8\xd4\x6c\x0c\x41\x77\x61\x36\x43\x1e\x12\x09\xd5\x4a\x6e\x7d\x32\xad\x1a\xdb\x92\xa9\x5a\xaa\xca\xe5\x28\x01\x6c\x5f\x13\xbd\x4b\xa5\x51\x62\x96\xd2\x18\xc9\x6a\xa8\xe2\x6c\x20\xf0\x20\x41\xb9\x23\x81\xad\x75\x76\x5b\xc5\xb4\x5b\x76\xfc\x4d\x8a\xac\xa1\x98\x35\xe4\x2f\x85\xdc\xa5\xca\x6c\x57\x2d\x18\xcf\xb4\x28\xd9\x9c\x65\x9d\x63\x00\x05\x07\x61\x95\x59\x28\x76\xce\x05\x28\x4d\xbf\xa7\x3e\x2b\x6d\xda\x3e\x5a\x65\x16\x77\x59\x47\xbd\x4a\x25\x4b\x68\x0a\xac\x95\x26\x96\x35\x64\x56\xf6\xfb\xac\xa6\xca\xca\x96\xcd\x6a\x77\x78\xda\x65\xa2\xf1\xf6\x78\x06\x09\x41\x5e\x85\xf0\x73\xd1\x04\x86\x9e\x90\x6f\xe1\xd8\x43\x25\x22\x31\xfa\x98\x25\xf8\x09\xe2\xd2\x23\x58\x55\xbc\x86\x04\x72\x8e\xa7\xa4\x52\xb8\x72\x41\x83\x20\xd3\xc2\x8d\x12\xa9\x59\x1c\x56\x1d\x5c\x0f\x6d\x6a\xc4\xf8\x5d\x66\x30\x25\x2d\xb3\xad\x96\x63\x38\x81\xd8\x2b\xa2\x9c\x85\xe2\xe6\xd2\xc1\x6d\xc9\x4f\x73\x2c\x1d\x84\x7c\xc3\xcc\x87\x1c\xab\x9b\xd6\x54\x65\x54\x48\xda\x80\x26\xd2\xda\x38\x1a\x41\xed\xdc\x19\x5f\xf2\xbf\x2e\x66\x8d\xf9\x48\x68\x26\xcf\x56\x71\x6b\x89\xe9\x9c\xee\xb0\x1c\x2e\x45\xb8\x4f\xab\xec\xbb\xc8\x3d\x7d\x9a\x82\x53\xdf\x47\xb1\x49\x57\x17\xcd\x65\x76\x6d\x8a\x6f\x42\x21\x13\xf4\x13\x23\xc6\x57\x65\x44\xca\x96\x4a\xf3\xf2\x19\x0c\x50\xe6\x75\xc0\xa8\x83\x38\x37\x7f\x6d\x5b\xbe\xd6\x71\xc5\x19\x24\xae\x97\x0d\x03\x65\xe4\x52\x96\x2f\x2c\x46\x87\x8d\x2b\xa4\xb6\xb7\x2d\xfd\x48\x82\xce\xba\xf3\xae\xde\xe3\x23\xa5\xb5\xd6\xb5\x63\x0a\x14\x8c\x07\x5a\xda\xc3\x2c\x2d\x5f\x0e\x3e\x2b\xec\x96\xad\x7c\x24\x1b\xd3\x05\xce\xcc\xb5\x36\x14\x9b\x34\xcc\xab\xa6\x9c\xcd\xb6\x9e\x85\x95\xb1\x5e\x56\xec\x9b\x11\x18\x79\xec\x1e\xc2\x22\x2b\x99\xcc\x8a\x3a\x37\xce\x57\x8c\xe6\x3a\xc6\x62\x57\xbf\xf9\x48\xb2\x7e\xe2\xc0\x1d\x26\x62\x6f\x60\xd1\x2a\x8f\xd6\x0c\xdc\x80\xfd\xf7\x20\x86\xdf\x26\x18\x77\xc5\xde\x76\x43\xf1\x2f\x60\x21\xb6\xec\x96\x21\xb8\x5a\x04\xd9\x00\x57\xf2\x49\x7e\xb1\xfa\x94\xff\x6c\x27\x28\x5c\xa0\x80\x21\x2e\x47\xcc\x54\xe7\xa9\xa2\x7a\x1e\x06\x01\x65\x02\xb9\x60\xbc\x50\xbe\xe7\xd1\x9b\xb3\xa8\x23\x25\x28\x4b\xe3\xa2\x4e\x02\x45\xbd\xa4\x5f\x45\x1b\x3b\xf7\x56\xcf\x77\x93\x10\x3f\x70\x4a\x46\x19\xb0\x0f\x94\x3e\xca\x2b\xd2\xc2\x7a\x9c\x43\x1f\x15\x4f\x52\xc9\x51\x3a\x55\x02\xc0\xfc\x50\x22\x2d\xb3\x75\x06\xba\xcd\x1d\x47\x8a\x54\x72\x81\x8d\xb3\xd5\x40\x51\xa3\x55\xc6\xda\xd4\x86\xc9\xdb\x00\x79\xe4\xaa\xf0\x3e\x32\xff\x2c\x20\x5f\x9b\x46\xd8\xa1\x64\x94\xcf\x92\x15\x06\x7b\x7b\xf1\x52\xc5\x4a\x89\x6a\xbf\xfe\x68\x1e\x1c\x2f\x5b\x8f\x97\xaa\x49\x7a\x3b\x21\x14\x68\x4a\x19\xfe\x8c\xb2\x43\xde\x75\x5d\xca\x99\x06\x06\x70\x8c\x3d\x5c\xdc\x1c\xb6\xe3\x64\x46\xe3\xa2\x10\x72\xe4\x7a\x7f\x51\x64\xed\xd5\x0c\x65\x12\x34\x7e\x8e\x94\xc0\x89\xc3\xd0\xea\x5a\x48\x07\x12\xf3\x4e\xc8\xb9\xae\xf3\x41\x00\x16\xcc\xc8\x02\xb4\x74\xc3\x4c\x30\xf2\x5c\x3b\x2f\x14\x24\x10\x30\x85\xde\xd7\x33\x81\xa2\xda\xfa\x0b\xe8\x73\x39\xcd\xd2\x10\x78\xae\xb2\xf4\x49\x96\x42\xf9\x0b\x77\xd6\xc8\xb3\xd7\x72\xee\x20\x21\x54\xc0\x42\x7e\xcf\x4e\x0f\x0b\x2d\x4a\xb9\xb4\x8c\xfc\x00\xdc\xa0\xc5\x0a\x3b\xd5\x92\xfc\x58\xd2\xc3\x6e\x55\xa8\xb8\x0a\xca\xaf\x6e\x5b\x43\xdf\x2a\x21\xfe\x2f\x21\x5d\x99\xec\x69\x16\xb6\x48\xd0\xa2\x4f\x63\xf8\x33\x3b\xe9\xcf\x9b\xfb\xc8\xa7\x6c\x31\x8a\x12\x01\xbc\xae\x57\xfb\x4a\x75\x53\x48\xb7\xf2\xb0\x3c\xec\xe3\x3b\x42\x72\x82\x70\x65\x94\x8e\x83\xd0\x02\x65\x35\x64\x52\x18\x25\xcb\xf4\x10\xae\xb0\x6d\x83\x3e\x0a\x9f\xd8\xfc\x72\x6b\xf2\x6f\x2d\x52\xdb\xb7\x40\x29\xe5\xaf\x10\x81\x44\xfc\x6c\x54\x14\xd5\x09\x25\x73\x63\x0a\x6d\x40\xd9\x14\x12\xcc\x95\x10\xaa\x1e\xa7\x62\x27\xd6\x28\xae\x4b\x22\x64\x75\x0a\xe3\x56\x23\x52\x4a\x86\x94\x05\xb2\xa1\xaf\x8c\x66\x3e\xc5\x62\x86\x98\xbe\xea\x92\xb2\x0c\x01\x00\x76\x81\x8b\x02\x44\x5c\x4c\xa6\xf1\xe5\xd1\x4a\x46\x49\xe3\x31\x33\xa3\xca\x58\x41\x9e\x3d\xad\x4e\xe2\x91\xf5\x04\x9f\xae\xbc\xca\x1d\xe8\xaf\x13\x90\x2c\x5e\x69\x9b\x59\x83\xf5\xe2\x67\x1b\xde\x67\x29\x92\x95\x81\x17\xf3\x43\x9e\x35\xee\xc6\x1e\x25\xcb\x64\x1e\x01\x5e\x61\xad\xd4\x01\xef\xdc\x52\xdd\x03\x9d\x4b\x26\x61\x1c\xc5\xb0\xcf\x81\x2c\x39\x8a\x62\xe7\xbd\x4d\x4e\xa8\x04\xf3\xbf\x80\x31\x6a\x1c\xe6\x28\x21\xc2\xfd\x56\x5c\x64\x86\x4d\x83\xb2\x35\x65\xbb\x99\xd9\xc8\x64\x43\xf8\xc8\x45\x81\x47\x17\xa8\x4a\xda\xaf\x97\x2b\xc8\x12\x2a\x5d\x75\x8b\xca\xae\x4e\xa4\xa4\x38\xae\x6f\x22\x16\x82\xc7\x75\x94\x41\x7d\xc9\xb2\xbe\x35\xb5\x7e\xa8\x39\x63\xcf\x6d\x38\x18\x57\x1e\xb6\x58\x5d\x21\xa0\x4f\x01\xce\x26\x71\xe3\xa7\xe4\xe6\x85\xb4\x03\x10\xd8\x47\x5c\x40\x3f\x00\x98\x80\x8b\xe7\xc7\x60\x67\x67\xe7\x30\x5a\xe2\x1c\xb0\x27\x55\x45\xca\x95\x08\x8a\x8c\xb5\x14\x3f\xeb\xe8\xac\x6c\x88\xad\x98\x3e\x59\x1d\x6e\x1c\xdc\x4f\xfb\x97\x45\x81\xb1\x9b\x7b\x61\x09\x0b\xe7\xcd\xe6\xdc\x67\x8b\x49\xa2\x3f\x68\x0a\xe5\x5e\xea\xe9\xe9\xbd\x63\x38\x40\xd6\x4d\xa3\xbf\x6b\x03\x5b\x15\x6e\x69\x97\x46\x9a\x73\xb1\x8b\x54\x2e\x48\x03\x28\x04\x62\x64\x08\x5a\xff\xfb\xcd\xef\xdf\xb5\xff\xf8\x9f\xdf\xbb\xed\xc3\xce\x1f\xdf\x7d\xfb\xcd\xef\xe8\x14\x93\xd0\xbf\xf9\xf9\xd5\x8b\xab\x37\x7f\xfc\xe3\xf7\xf6\x77\xfa\xe3\x1f\xff\xf8\xf6\x6f\x9a\x66\xb1\x33\x64\xc5\xea\xf8\xcd\xdb\x7b\x46\x69\xab\x78\xd9\x58\xba\x93\xf4\x95\x63\x09\xf1\x0b\x51\xc0\xb3\x13\x69\xd4\x32\xe4\x50\x96\xdc\x11\x93\x8b\x6b\x59\x50\xcd\xdd\x22\x66\x39\x4f\x69\x1e\x60\xd1\x38\x18\x07\x6b\x64\xf7\xdb\x10\xb1\x85\x0d\xab\x37\x70\x8a\x00\x26\x2e\xfa\x54\x80\x3e\x81\x1e\x47\xf5\xb1\x2c\x1e\x73\xca\x1f\xab\xd1\x71\x0d\xd0\x8a\x6a\xc4\xcd\xf3\x34\x1a\x69\xe3\xf8\x4f\x25\xd2\xe7\xa1\x3f\x96\x9e\xc4\x44\xdb\x07\x52\xb2\x20\xe8\xcc\xcc\x49\x6f\x70\x1a\xf9\x73\x3f\xc9\x34\xba\x5d\x3d\x91\xe8\xea\x48\x2b\x83\xfe\x27\x8d\x49\x5e\x46\x27\xe1\x75\xa5\xb1\xea\x04\xc6\x0b\xe0\x30\x2c\x10\xc3\xb0\xa3\x38\x84\x2f\x88\x80\x9f\x74\xdc\x1c\xf3\x94\xd5\x00\xe6\x06\x42\x3e\xf6\x20\x03\x82\x2a\x48\x66\x17\x04\xae\x63\xc0\xd7\xc0\xf1\x60\xc8\x95\x1b\x05\x09\xb8\xfc\xe5\xa5\xb6\x02\x7c\x44\x44\x1a\xb9\x3c\x95\x74\x53\x84\x8e\x03\xa3\xaa\xbf\x0e\x4d\x43\xb2\x48\xc0\x66\x62\x7c\xd7\x3a\x00\xca\x53\x38\xcf\x29\x8b\x49\xf7\x4c\x22\xc6\xd4\x75\xa0\x52\x9d\x1a\x11\x40\x49\x6e\x6e\x0e\x20\x66\x08\x6b\x1d\xfc\x4c\x3a\x87\x6a\xa4\x09\xf5\x3c\xfa\x51\x3a\x83\x7a\x62\x51\xc9\xb2\x7c\xae\xaf\xaf\xf9\x6d\x7a\x20\x4c\xf6\x03\x90\x3b\xe6\xf7\xb4\xf1\xd5\xea\x48\x80\x11\x24\xee\x28\x36\x6f\xee\x82\xd2\xb3\x18\x48\x39\x7e\x67\x9a\xb0\xe6\x0a\x93\xa7\x42\xd7\x59\xb9\xc8\x7d\x26\xc5\x18\x9e\x18\xee\x30\xe6\x00\xf9\x81\x58\x3c\x93\xef\x52\xc1\xaf\x0b\x68\x79\xe8\x09\x0e\x20\xcb\xac\x9f\xc4\xa6\x93\xf0\x75\xe0\x51\x17\x65\x0e\x61\x17\x79\x3d\xc7\xca\x26\xbb\xc7\x53\x6b\x95\xec\x50\xbd\x85\x23\x00\x77\xdd\x85\x5c\x2c\x3c\x34\x54\x66\x82\x96\x15\xea\xae\x55\xfb\x0e\x4b\x37\x98\x6a\x94\x6e\x28\x83\x17\xaa\x77\xd6\x92\x1d\xf5\x71\x86\x18\xca\x6c\xa7\x74\xc8\xcc\xae\x02\x47\x92\x4f\x90\x1b\xed\x8e\xf8\x57\x3d\x34\xf2\x6a\x71\xae\x25\x95\xae\x9f\x81\x6b\x63\x0a\xf2\xcf\x88\x5b\xe4\x3f\x55\xe6\xeb\xfa\x19\x80\xc4\x05\xd7\x51\x62\xf2\x3a\xdd\x68\xf1\x10\xfa\x8c\x1d\x65\x7a\xd1\xaf\xff\xfb\x9f\xb2\xef\xf7\xd7\x8a\x6d\xae\x5f\x9e\xfd\x7c\x6a\xe9\xe3\x50\xf2\x21\x24\x8e\xc0\x73\x94\xef\x7f\x74\x7e\x72\xad\x87\x7c\x7d\x71\xdd\x01\x3f\xd2\x8f\x68\x8e\xd8\x33\xb0\xa0\xa1\x12\x0c\x72\xe6\x10\xf8\xf0\x13\xf6\x43\x5f\xd2\xa0\xd7\x4d\xc1\x51\xa2\xe6\x0a\xe3\x99\x2a\xb6\x30\xc8\x7f\x9a\xf0\x99\x6d\x77\xe6\xf2\xfe\xfa\xda\x10\x11\xfd\x5e\x0a\xb8\x86\x1f\x79\x9b\xdf\xf2\xb6\xb6\x7b\x34\x92\x2a\x67\xa6\x49\x03\xae\x75\xd1\xe7\x75\xdd\xed\x9a\xdd\xab\xdf\x83\x2c\x7c\x05\x3e\x06\xfd\x7d\xb6\xda\x54\x75\xff\x3d\x68\xff\x61\x9f\x86\x3e\x30\x83\xa3\x43\x21\x7a\x1a\x50\x8f\xa2\x7f\x50\x4d\x40\x26\xb8\x7e\x2f\x67\xb5\x26\xc6\x1e\xbe\x41\x12\xe9\xbf\xf7\x77\xbf\x88\x60\x51\xe2\x52\x7e\xcc\x2e\x8b\x21\x6f\xa0\x50\xdf\x55\x34\x6f\x06\x39\x08\x10\xf3\x31\xe7\xd1\x89\x19\x8e\x90\x62\x29\x4d\x17\xe4\x1a\x7c\x70\x4e\x05\xea\xc4\xf8\x69\xa5\x93\x1e\x79\x97\x1c\x1f\x55\x13\x62\x6e\xf4\x2e\x17\x5f\x91\xd1\xa0\x78\xae\x44\x28\xd9\x05\x90\x45\xc7\x67\xe4\x0b\xc8\x8b\xbd\x5a\x5c\xd2\x5a\x4f\xbc\x6d\xa5\xb7\xa6\xa8\x22\xcf\x18\xad\xe8\xda\x14\x13\x28\x1a\x82\xb1\x7a\x1b\xbd\xd4\x7f\x3c\x8f\xbc\xa6\x9f\xde\x5d\x65\xcc\xdd\x99\x10\x81\x84\x9e\x9d\x6d\xbe\x3a\xdb\x7a\x0d\x48\x2e\x03\xa5\x09\xdd\x7a\xb5\x48\x2a\xba\x41\x3e\xb9\x59\x0d\x00\xbb\x43\xe0\xd1\xe9\x88\x63\x72\x33\xea\x76\x7a\xc9\x07\x7d\x4a\x2f\x03\x29\xf9\xb6\xd2\x09\x40\x55\xaa\xc9\xb7\xcd\x41\x5a\x39\xfc\x5f\xd2\x29\xb8\xc4\xe4\x26\x79\x1d\x87\x31\x40\x2b\xd3\xda\x56\x0c\xd2\xce\x4b\x82\x6c\x25\x42\x1e\x72\x5a\x2b\xb1\x26\xfe\x9d\x80\x4c\x53\x8c\x8a\xc5\x10\x6d\xc0\xcd\xf1\xca\x4a\x11\xda\xaa\x98\x77\x94\x2f\xe6\x6d\xdb\x8a\x79\x8b\x09\xf6\xf2\x23\x92\xbe\x5f\x0c\x05\xa4\x5b\x2d\xbd\xe8\x27\x7e\x04\x16\x9e\x5e\x81\xfc\x87\xb2\x6c\x6a\x55\x3e\x15\x00\x3f\xf4\x04\x1e\x79\x98\x58\x2f\x4e\x48\xce\x0a\x98\x7b\x3e\xdb\xc0\x74\x6c\x25\x2c\xf0\x12\x13\x5b\xcb\x08\xf1\xea\x36\x6a\x0e\x63\x4a\x3d\x04\x89\xe5\xfb\xa7\xf6\x94\xd1\x30\x18\x82\x16\x22\x6e\x40\x71\x3e\xc8\x20\x1f\x3e\xa3\x1f\x47\xd0\xf3\xee\x3e\x9d\xcb\x19\xfd\x28\x15\x7e\xf9\x64\xaa\x5a\xdc\x71\x2a\x82\x06\xd8\x59\x52\x45\x45\x7d\x5f\x1a\x0a\x52\x3d\x09\xe4\x26\x87\xf3\xb4\xf6\x54\x00\x74\x50\xce\xce\x42\x57\xe5\x0d\xca\x13\xea\x29\xda\x6a\xd7\xe5\x63\x3c\x28\xb8\x7b\x44\x3a\x57\x3c\x98\x3e\xed\x4a\x46\x8e\x60\x12\x8e\x98\x18\x29\xab\xb1\xac\x4d\xb9\x5f\x59\x7c\x8e\x5c\x57\x95\x3e\x86\x5c\x50\x5f\x1b\xa3\xb1\x39\xe2\x50\x65\x9f\x88\x48\xf5\x47\x06\xaf\x8f\x38\xd7\x81\x00\x20\x18\x24\x1c\x8b\x7c\x6d\x4b\xfa\x2c\x9f\x8e\x7c\x96\xcc\xa5\x30\x9f\xf8\xf7\xf1\x62\xa3\x5b\x23\x2d\xa8\xf4\x48\xa1\xeb\x22\xb7\x12\x54\xc4\x1c\xcf\x65\xa7\xea\x86\xe5\x4c\x62\x3e\x25\x15\x18\x95\xd8\x27\x39\xcb\x04\xfd\x3a\x28\xff\xaa\xaa\x31\xee\x8c\x72\x59\x09\x88\xf9\xb4\x97\x62\x15\x17\x87\x2c\xc1\xf9\x4c\xb1\xab\xa6\x36\x38\x52\xf6\x7f\x79\x97\x72\x01\x5f\x0f\xf3\x76\x66\x77\x58\x1b\x2d\x19\xa3\xce\x0e\x44\x9f\x04\x83\xce\x6a\x5b\xf0\x54\xf7\x01\x30\x62\xd6\x09\xa3\xbe\x5a\xfc\x31\x75\xf3\x52\x23\x7d\xfe\xfc\xdb\x67\x13\xbc\x18\x61\x14\x93\xf8\xbe\x58\x2d\xc3\x06\x5f\x8a\xd7\x66\x90\x8f\x66\x08\xba\x88\x8d\x26\xd8\x13\xa8\x70\x22\x22\x7d\x32\x6b\xfc\x5c\x35\x06\x63\xc8\xa5\xfb\xaf\x43\x0b\xba\xd0\xdd\x51\xeb\x4e\x09\x02\x1a\xee\x1d\x99\xcf\x96\x4e\xaa\xc0\x4b\xf2\x9e\x1e\x37\x72\x76\x69\x9c\xf4\xae\x16\x6c\xf1\x7d\x24\x51\xe7\xf3\x7c\xb2\x23\xff\x44\x3c\xf1\xa3\x1e\x6a\x79\xf3\xcd\xf1\x6a\x21\x0f\x63\x43\x0b\xf2\x18\xb5\x68\xa1\xbe\x3c\xbb\x16\x38\xa9\x1e\xcb\xa6\x2e\x60\x6d\xdf\xef\xd5\xe2\x25\x9d\x9a\x59\xda\xcc\x89\x37\xd0\x3a\x1c\xf3\x79\x97\xef\x0b\x82\xf6\xa7\xdd\xfe\x74\xb6\x3b\x1d\x18\xfe\x4b\xe1\x40\xa6\xd1\x67\x6f\xcc\x26\xac\xdb\xed\x07\x13\x72\x33\xeb\x9a\xa6\x59\x7a\xf5\x0e\x68\x71\x36\x77\xda\xd0\x71\x44\xbb\xb7\xd7\x47\x93\xbe\x7b\xd0\xee\xf6\xbb\x87\xed\x41\xaf\xb7\xdf\x3e\x18\xec\xf5\xdb\xee\x64\x6f\xc7\xe9\x77\xfb\xbb\x4e\x7f\xcf\x02\x25\xba\x96\x07\xb4\xc6\xbd\xc1\xc0\x3d\x3c\xec\xb5\xbb\x07\x68\xdc\x1e\x0c\xf6\xfb\xed\x03\xe4\xf4\xda\x68\xdc\xdd\x19\x38\x7b\x87\xfd\x9d\xde\xd8\xec\x1f\x32\x6f\x08\x5a\x13\x4a\xdb\x36\x7c\x3b\x37\x90\x77\xa0\xe3\xa3\x8e\x43\xfd\xe1\x60\xb0\xd3\xca\xf9\x53\xd6\x83\x9e\xc6\xf4\xbb\x37\x07\x1e\x99\x76\x77\x7a\x1c\x1d\xde\xd6\x98\x3e\xea\xf6\x77\xfb\x7b\xbb\xa8\x0d\x0f\x0e\x60\x7b\x30\x98\x8c\xdb\x07\x83\xdd\x6e\x1b\xb9\xdd\x5e\x17\x8d\xf7\xc6\xce\xae\x53\x35\x7d\xd7\xd9\x85\x07\xfd\xc3\x83\xf6\x18\xb9\xfb\xed\x41\xbf\x8f\xda\x07\x87\x83\xfd\xf6\x64\x6f\xe2\xc2\xbd\xc3\xfe\x61\x7f\x32\x29\x4e\x7f\x0c\x59\x34\xfd\xbe\x3f\x71\x60\xb7\xdb\x17\x87\xb7\xfb\x7c\xda\xe1\xac\x6c\xfa\xf1\x29\xc7\xbc\xe3\x5c\x3c\x5c\x09\x5a\x76\xaf\xdd\x7a\x90\xd5\xe6\x7b\x26\xce\x93\x19\x1c\xd2\x4f\xea\x28\xf2\xc2\xd7\xc8\x59\x51\x8b\xfb\x6c\x0c\xb3\x77\x63\x27\x6e\x73\x76\x28\x4b\x29\x6f\x9c\xb4\x6e\x5d\x5e\x5d\x9c\x9d\xbf\xc8\x3a\x17\x56\x43\x32\xe9\xf1\xd3\xe5\xeb\xf3\xdc\x9d\x44\x91\x57\x5e\x48\x0d\x57\x7a\x08\x51\x7c\x46\x7d\x3d\x37\xae\xc8\xc8\xe3\x11\x35\x51\x36\x67\xd9\x79\xd2\x5c\x35\x8b\x0a\xc8\x8d\xe2\xe3\xbd\xd9\x02\x3f\xe8\x8e\x3c\x24\x04\x62\xa3\xdb\x10\xe5\xa7\xa9\xa8\x2b\x19\xce\xbb\xcd\x85\x8b\xaa\x7f\x1b\xb5\x2c\xf4\x64\xb9\x9a\xd5\xa8\x64\x58\x26\x81\x4a\xaa\xbe\x55\x85\xf4\x10\xb4\xb2\xe1\x99\xce\x78\xd2\xef\x50\x36\xdd\x0e\x18\x9d\x60\x0f\xd9\x96\x14\xb4\x22\xb7\xbc\x9d\x69\xb4\xc2\x55\xbb\x65\x13\x95\x1d\x2c\x93\xfd\x02\x33\x48\x4b\xd3\xb2\x93\x28\xbf\x29\xd6\x12\xd6\x6b\xf5\xba\xc6\xae\x8f\x6e\xdd\xca\xdd\x83\x59\x1d\x09\xd3\xf7\xc3\x6e\x67\xe0\xa8\xdb\x09\x41\xeb\xf8\xf5\xf9\xf9\xe9\xf1\xd5\xeb\x8b\xf6\xab\x17\xaf\xae\xda\x99\x26\xd1\x9d\x84\xa0\x75\xb9\x20\xce\x8c\x51\x42\x43\x0e\xa0\xa3\x6b\x4a\x39\x20\x54\xa4\xe7\x6c\x74\xa4\x1d\xf2\x05\x71\xbe\x97\x52\xa0\x78\x75\x51\xee\xd2\x42\xd0\xea\xe1\x77\x67\xd8\xbf\x7d\xe1\xb0\x93\xf0\xe5\x5e\x0f\xbe\xfd\x74\xf6\xaf\xdb\x1f\xae\x6e\xcf\x2f\x60\x42\xa5\x33\x1d\xb9\xfe\x25\x44\x6c\x51\x83\x52\xfd\x0d\x51\xaa\xbf\x94\x50\x7d\x0b\x9d\xfe\x63\xf0\xc0\x73\x75\x05\x84\xb4\xd4\x02\xc8\x38\xca\xe4\x6d\x86\xe0\x2d\x81\xd1\x6f\xd3\xab\xe0\x8c\x8e\xcc\xc4\x15\x15\xaa\xd0\x02\x06\x78\xa4\x03\x98\xd1\xed\x08\x43\x50\xc0\x60\xb8\xc2\x78\xe9\x81\x28\x87\x7a\xa1\x4f\xb4\x21\x29\x47\x8a\x02\xf3\xe0\x29\x76\x9f\x76\xc0\xa5\xad\x9d\xca\x60\x99\xa3\x49\x95\x4b\xc9\xb3\x28\xaf\xec\x78\x34\x74\x47\x51\xf6\x83\xc5\x6f\x75\xe9\x4b\x07\xfc\xa2\xb3\x10\x7a\x21\x87\x00\xbb\xe0\x7b\xd0\xeb\xef\x94\x72\x85\xf7\xee\xe4\x45\xb8\x18\x9f\xb1\x53\xf2\x89\x1d\x21\x7f\xbf\x3f\x98\xde\xde\xdc\xe0\x93\x79\xcc\x15\xf9\x6b\x70\x6d\x9c\x30\xe8\x0e\x36\xc2\x09\xfb\xcb\x18\x61\xdf\xb2\x5f\xea\xdc\xa5\x9b\x4c\xc6\x7a\xed\xbd\x6d\x4a\xfb\x0f\x37\xa1\x34\x51\xa5\x82\x5c\xd8\xfd\xfe\x69\x0f\xff\xbc\xe3\x86\xbf\xbe\x3f\x9b\xcf\x77\xdf\xcf\x5f\x7a\x8b\xcf\x3d\xff\xc5\xc5\xce\x4f\x8b\xdb\xf3\xa7\x4a\x34\x4c\x68\x68\xd6\xae\x17\x36\xff\xfb\xd7\xfb\xd3\xfe\x74\xef\xc7\x2b\xf7\xed\xcf\x6f\x61\xff\x86\xff\x78\xd0\xbf\xf9\xe5\x64\x67\x11\x53\x26\x7f\x25\xb4\x55\x34\xf6\x36\x23\x19\x7b\x4b\x05\x63\xcf\x42\x96\x74\x1b\xcf\x11\xc3\x93\x05\xf8\xe9\xdd\x95\xbe\x68\x7a\x08\x2e\x22\xdf\x02\xc0\x50\xcc\x28\xc3\x9f\xe3\x0b\xef\x6e\x10\xa9\x47\x9f\x9d\xb7\xb3\xd3\xd9\x47\xff\xb7\x1f\x82\x77\x6f\x26\x67\x7d\xef\x1c\xdd\x04\xee\xe0\x5f\x27\x31\x7d\x0e\xa5\x0e\x3b\xa6\x64\xe2\x61\x47\xd4\xa0\xd5\xce\xde\x46\x68\x65\x82\xb1\xd3\xca\x6c\x61\xb2\x90\x3e\x80\xa9\x25\x0f\xe6\x00\x7a\xca\x10\x52\xe7\x04\x4b\xe9\xb0\x77\xf3\xbe\xfb\x16\x9f\xde\x7c\xbe\xf9\xed\xf8\xf3\xbb\x37\xe8\xac\x4f\xdf\xa3\x99\xbb\x73\x1a\x91\xa1\x78\xc1\xb3\x6d\xea\x87\x1b\x99\xf9\xe1\xb2\x89\x1f\x5a\x79\x24\xfd\x7d\x0e\x94\x1d\xb4\xb0\xe4\xe8\xf4\xe5\xfc\xf9\xe1\x87\x57\xbf\xbc\xdf\x7b\x3f\x9d\x4d\x5e\x1d\x4e\x5f\x5c\xf0\x1f\xe7\xa7\xef\x92\xb9\xd6\x16\x16\x0f\x37\x63\x53\x0b\xaa\x31\x93\x6a\x6d\x20\xad\x03\x2e\x9d\xa4\xd7\xc7\xaf\xda\xa7\xbf\xb5\x0f\x87\xd1\x55\x49\x72\x0b\xe9\x0b\x91\xd2\x36\xe8\x93\x68\x47\xba\x0f\x06\xb8\xdd\xc3\x9f\xba\x3b\x1e\x71\x3d\xff\xb6\x7b\x3b\x71\xf6\x39\x16\x70\x97\x7b\x1f\xe6\x07\xa6\xc7\x21\x15\x5a\x64\xb4\x2a\x3a\xf4\xa6\xbb\xee\xc1\xc1\x6d\xd7\x63\x8e\x3b\x1f\x4c\xf7\xa1\x37\xde\xe7\xde\x64\x4a\x3e\xec\xb8\xb3\x31\xff\xf0\xf7\xff\xfa\xe6\xf4\xb7\xab\x8b\x23\xf0\x0f\x3d\xe3\x8e\xc2\xf8\x7b\xec\x22\x22\xe4\x9a\x99\xfe\x3e\xe6\xe0\xe9\xa0\x3b\x78\xfa\x4c\xd1\x42\xfd\x79\xfc\xf2\xed\xe5\xd5\xe9\xc5\xa5\x26\x86\xfc\xa8\xf2\xd6\xc9\xc2\x82\x14\x90\x6a\xdf\x9b\xee\x52\xb6\xdb\x9d\xe3\xb0\xbb\x4f\x91\x5c\xb6\x19\xbb\x71\xfa\x7b\xee\x74\x22\x3e\xf4\xa0\xf3\xd4\x54\xb2\x51\x2a\x58\xf5\xaa\x9c\x84\x21\x6f\xbf\xad\x90\x27\x57\xfc\x1d\x5b\xec\x11\x7e\x3b\xee\xf3\x73\xff\xf9\x87\xdd\xf1\x6f\xc1\xc9\xfe\x31\x6c\x6d\xfd\x7f\x00\x00\x00\xff\xff\xd8\xc3\x58\xbf\xfe\xdf\x00\x00")
+var _connector_mgmtYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x3d\x69\x77\xdb\x38\x92\xdf\xfd\x2b\xb0\xca\xcc\x73\x1f\x96\x2c\xc9\x8e\xaf\xb7\x99\x7d\x8e\xed\xa4\xdd\x1d\x3b\x69\xdb\xe9\x74\x26\x2f\x2b\x43\x24\x24\x31\xa6\x48\x99\xa0\x14\xab\x67\xf6\xbf\x2f\x2e\x92\x00\x08\x5e\x92\x7c\x64\xc2\xbc\x3e\x22\x12\x47\xa1\x50\x77\x15\x40\x7f\x82\x3c\x38\x71\x0e\xc0\x56\xab\xdd\x6a\x83\x67\xc0\x43\xc8\x06\xe1\xc8\xc1\x00\x62\x30\x70\x02\x1c\x02\xd7\xf1\x10\x08\x7d\x00\x5d\xd7\xff\x0a\xb0\x3f\x46\xe0\xf4\xf8\x04\xd3\x47\x37\x1e\x79\xc2\x5a\xd3\x0e\x1e\xf0\xf9\x70\xc0\xf6\xad\xe9\x18\x79\x61\x6b\xed\x19\x38\x74\x5d\x80\x3c\x7b\xe2\x3b\x5e\x88\x81\x8d\x06\x64\x38\x1b\x8c\x50\x80\xc0\x57\x87\xbc\xeb\x23\x60\x3b\xd8\xf2\x67\x28\x80\x7d\x17\x81\xfe\x9c\xce\x04\xa6\x18\x05\xb8\x05\x4e\x07\x64\x7c\xda\x96\x4e\x20\xa0\x23\xf3\x22\x34\xe1\x90\xc4\x23\x93\x99\x1a\x93\xc0\x99\xc1\x10\x35\x36\x00\xb4\xe9\x2a\xd0\x98\x36\x26\xff\x07\x0d\xcb\xf7\x3c\x64\x85\x7e\xd0\x1b\x0f\xc7\x61\x53\xb4\x6c\xcd\xe1\xd8\x6d\x90\x75\xba\x68\xcd\xf1\x06\xfe\xc1\x1a\x00\xa1\x13\xba\xe8\x00\x1c\x45\x1d\xc0\x25\x0a\x66\x8e\x85\xc0\x2b\x17\xa1\x10\x9c\x41\x0f\x0e\x51\x40\x1a\x12\x80\xb1\xe3\x7b\x07\xa0\xdd\xea\xb4\xda\xe4\x81\x8d\xb0\x15\x38\x93\x90\x3d\x2c\xe8\xcf\xd7\x73\x81\x08\x7e\x0f\xdf\x9d\x52\x30\xc7\xec\x05\x88\x01\xc5\xad\x35\x82\x02\x3a\x09\x85\xaa\x09\xa6\x81\x7b\x00\x46\x61\x38\xc1\x07\x9b\x9b\x04\xc9\x2d\x8a\x6c\x3c\x72\x06\x61\xcb\xf2\xc7\xa4\x89\x06\xc0\x19\x74\x3c\xf0\xc3\x24\xf0\xed\xa9\x45\x9f\xfc\x08\xf8\x70\xe6\xc1\x70\x48\x26\x2f\x1a\xf2\x92\x34\x72\xbc\xa1\x71\x20\x32\x8e\xeb\x5b\xd0\x1d\xf9\x38\x3c\xd8\x6b\xb7\xdb\xe9\xee\xf1\xfb\xa4\xe7\x66\xba\x95\x35\x0d\x02\x42\x3a\x84\x86\xc6\x64\x05\x6b\x64\x4a\x81\x00\x0f\x8e\x95\x7d\xb9\x9a\x4f\x10\x4e\xf7\x6f\x34\x4c\xad\x4b\x37\x04\x47\xee\x14\x87\xa8\x42\x07\xb1\xbf\x05\xed\x2f\x7c\x42\xdb\x2f\x1d\xcf\x26\x08\x34\x8f\xbd\x36\x81\xe1\x88\xad\xf5\x19\xfd\x17\x18\xa7\x78\xb6\x46\xfe\xd3\xa0\x5b\xb6\xa9\x92\xf4\xe6\xac\xd3\x38\x60\xe3\x0e\x51\xc8\xff\x42\x68\x59\x20\x8f\xff\x69\x66\x00\x0d\x28\xdf\x06\x90\x02\x72\x6a\x1f\xd0\xfe\x7f\x70\xd2\x3e\x43\x21\xb4\x61\x08\x45\x2b\x3c\x1d\x8f\x61\x30\x27\x6b\x41\xe1\x34\xf0\x30\xe3\x2c\xc1\x05\x60\xac\xb6\x55\x16\x57\xa2\x7d\x80\xf0\xc4\xf7\x30\x92\xc0\x6d\x74\xdb\xed\x46\xf2\x13\x50\xd6\x08\x09\x65\xc8\x8f\x00\x80\x93\x89\xeb\x58\x0c\xf8\xcd\x2f\x98\xcc\xa6\xbc\x25\x40\x5b\x44\x0c\x40\xfd\x29\x00\x7f\x0b\xd0\xe0\x00\xac\x3f\x23\x68\x1c\x93\x99\xc9\xb8\x78\x93\xb7\xc5\x9b\xda\xf2\xd7\xa5\xce\xca\xba\xfe\xd0\xd7\x12\xef\x5d\x9a\x4a\xf3\x36\x6e\xf3\x06\x0e\x6e\x60\x2f\x79\x1e\xd2\x4e\x9b\xff\x52\x1f\xf4\x1c\xfb\xff\x04\x3e\x26\x30\x20\x44\x15\x0a\xd9\xc0\xf7\x96\x93\x59\xaa\xcb\x9a\x11\xf2\x2b\xb2\x13\x8e\x0d\x7c\x26\x5d\x93\x4e\x80\x76\x5a\xcb\x46\x1d\x7d\x7d\x00\x70\x18\x10\x22\x8e\x1f\x3b\x64\x3c\x4a\xba\xf1\x83\x00\xdd\x4e\x9d\x00\x11\x52\x0a\x83\x29\x2a\x4f\x93\x09\x43\x93\xb9\x11\x91\x03\x4e\x38\x97\x5b\xbe\x44\x30\x40\xc1\x01\xf8\x04\x3e\x67\xd0\x6d\x3c\x16\x1d\xea\xe5\xfc\xf4\x58\xa7\xdc\xd7\x44\x02\x43\x6d\xbd\x54\xe3\xc4\x78\x52\xb0\x54\xd8\xfa\x91\xa8\xb6\x61\xa4\x5a\x65\xf1\x0d\xad\x2b\xba\x83\xe3\x89\x2b\x03\x1a\xfd\x51\xba\x9d\xf0\x66\xe9\x56\xe6\xa9\xa3\x51\x37\x4d\x83\x34\xb2\xd8\xe6\x2a\x45\x72\x44\xf9\x85\xd6\x88\xaa\x16\x4a\x8e\x94\x7e\x10\xd3\x12\x02\xa5\xdb\xed\xce\xe3\xa0\xf4\x24\x08\xfc\xa0\x3c\x2a\x09\x9c\x8b\x22\x30\xe9\x9a\x89\xb6\xc3\x69\x38\x22\x86\xc2\x0d\xf2\xa8\xf1\xe0\x78\x33\xe8\x4a\xec\x4d\x90\xb4\xfd\x8d\x20\x69\x7b\x71\x24\x6d\x17\x21\xe9\xdc\x4f\x68\x49\xa3\x31\x74\xe7\xe0\x10\x27\x08\x7b\xfe\x58\x8c\x5a\x11\x61\x04\xce\x45\x11\x96\x74\xcd\x44\xd8\x7b\x0f\xdd\x4d\x08\x96\x88\x61\x8d\x28\x5c\xc0\xb7\x98\x05\x66\x57\xd6\x57\x55\xcc\x8f\x15\x8b\x7a\x9c\x65\xa1\x40\xe2\xbd\x10\x13\x9b\xe8\x39\x95\x18\x70\x9e\x99\x52\xd4\x29\xad\x7d\x29\xc8\xa6\x8d\x48\x5a\x92\xbf\x0e\xa5\x4d\x28\x6c\x8e\x9d\xbf\xaa\x34\xf7\x03\x1b\x05\x2f\xe7\x55\x26\x20\x18\xb6\x46\x8d\x27\xaf\xc8\xde\x90\xad\xc8\x16\x89\x05\x3b\x55\xeb\x8e\x72\xba\xa3\x16\x85\x85\xa2\x50\xb3\xeb\x2b\x5a\xf4\x91\x70\x9c\x50\xef\xb8\x48\x3a\x2e\x21\x18\xad\x00\xc1\x10\xc9\x50\x2a\x62\xf1\x88\xbd\x66\x81\x94\xaf\x09\xcb\x98\x64\x61\x6e\x4b\xb3\x00\xa4\x7e\x00\x31\xdc\x82\xb9\x84\x5f\xee\x94\x40\x3c\xf7\xac\x2c\xac\xbf\x43\xc1\xc0\x0f\xc6\xcc\xf2\x83\x2c\x52\x41\x46\xa2\xc1\x24\xd6\x6b\x14\xf8\x9e\x3f\xc5\x34\x3a\xe2\xa1\x60\x2d\x9f\xda\xb8\x7b\xd2\xf7\x89\xab\x0d\x3d\xe9\x8d\xc1\x21\x01\x91\x95\xf9\xd2\xb7\x25\x04\x67\x84\x70\x24\x47\xd5\xc8\x1c\xf9\xac\x61\x66\x8c\x52\x12\xf0\x82\x03\xa9\x72\x48\x16\x7f\xc4\xbd\xf8\xe6\x65\x72\x4a\x39\x4b\x5e\x19\xa4\xb1\x56\x80\x4b\x93\xfa\xe8\x3e\xb2\xfa\xc8\x96\x86\x96\x85\x26\x84\xcd\x65\x2d\xd1\xfe\x46\xb4\x44\x9b\xed\x0b\x01\x61\x71\x6d\xa1\x0f\x91\x89\xa7\x3f\xa8\x96\x60\x2d\xb9\x40\xc4\x89\x44\xac\xf5\x6b\xed\x9b\x55\xf5\xcd\xae\x12\xdf\x9e\xa8\x58\x22\x33\xfc\x69\x60\x21\x60\xfb\x08\x7b\xeb\x21\xf7\xcf\x6a\x9b\x44\x23\x2c\x0f\x4c\xb3\xcc\x12\xae\xed\xa3\xa8\x89\xaa\xa4\xcb\x78\x61\x4b\xd8\x19\xd4\xec\x4e\x8f\xf3\xbd\x7a\x5f\x04\x7d\x58\x26\x99\xef\xd4\x5d\xab\xea\xaa\xd5\x5e\x5a\xed\xa5\x3d\x4e\xc0\x0a\x6f\xfe\x2b\x3f\x99\x52\xc0\x8d\x8e\xdd\x78\x08\x29\x2b\x87\xb9\x0a\x32\x19\x25\xd2\x17\x4f\x5a\x76\x94\x4c\x16\xd4\x79\x82\xda\x16\xad\xf3\x04\x4f\x4b\xec\xf2\xa6\x2e\x91\x8c\xf7\x29\x0b\xf9\x0c\x99\xe2\xf0\x98\xbd\x2e\x92\x88\x99\xad\xcc\x42\xf1\xa9\x30\x8a\x61\x0d\xb5\x07\xfe\x1f\x2b\xf5\xf8\x06\x2f\x21\xfb\x94\x01\xf2\x24\x20\xb3\x8a\x22\x35\x0a\xbe\x3a\x04\x83\x98\xf0\xb8\x33\x70\x08\x97\x9f\x1e\x7f\xcb\x92\x70\x39\x24\xea\x03\x2c\x28\x15\x27\x54\xc3\xdc\xa7\x50\x64\x13\x64\xca\xc4\x77\xf4\x6d\x91\x48\xcc\x6a\x54\x1c\x1e\x3f\x86\x21\xa4\x85\x8b\x0c\x08\xad\x8e\x88\xd2\x52\xd9\x80\xf9\x18\x05\x43\xd4\x64\xa3\xfc\x5c\x36\x78\xce\x23\xfd\x7e\xff\x0b\x99\x2e\x27\x0e\x5f\x71\x54\xcd\x61\xfd\xf5\xf2\xed\x39\xc7\xcf\x06\xb8\x78\x75\x04\x76\xf6\xdb\x5d\xb2\x27\x51\xd9\x64\xe8\xfb\x2e\x6e\x39\x28\x1c\xb4\xfc\x60\xb8\x39\x0a\xc7\xee\x66\x30\xb0\x68\x2b\x23\xb4\x30\x08\xe0\x5c\x7b\xe3\x84\x68\x6c\x20\xe0\x92\xcb\x5b\x74\x4d\x67\x14\xe3\xf2\xca\x76\xb7\xf6\x77\x8a\x57\x46\x5b\xe5\xed\xc3\x7f\x54\x8e\xa0\xf6\x3b\x6a\xbf\xa3\xf6\x3b\xbe\xad\xa4\x7c\x54\xbb\x5d\xb5\xdc\xd6\x12\x25\xdf\x55\x92\xf4\x6a\x9d\x78\x7e\x1a\x3e\x01\xab\xbc\x9e\x2f\xc8\xd9\x03\x4b\x19\xb3\x44\xee\x5e\xeb\xf1\xdd\xe5\xf0\xc5\xf2\x1f\x2f\x97\x2f\xa8\x60\xc1\x94\x3e\xef\xbc\x9a\xcc\xbe\x61\xac\x6f\x32\xc1\x2f\x16\x52\xe7\xf9\xeb\x3c\x7f\x6d\xe3\xd4\x79\xfe\xef\x2c\xcf\xaf\x28\xf4\x52\x55\xd7\x9a\xc9\xb2\x6c\xde\x5f\x1f\xae\x4c\xfa\xdf\x52\xfb\x94\xae\x00\xd0\xfa\x3d\x74\x11\xc0\xd3\x4c\x9a\x89\x0d\xa8\x5c\x22\xad\x21\xb3\x96\xee\x75\xfe\xfd\x81\x0f\x8c\x44\x14\x28\x9f\x71\x14\xcf\x2a\x1e\x73\x4c\x7a\x55\x3b\xe9\xa8\x7a\x43\x0f\x7f\xd8\x71\x79\x59\x2c\x57\x07\x68\x1e\x66\xd6\x71\xc7\x1c\xa7\x31\xbf\xe9\x93\x96\x7f\x25\x63\x78\x91\x03\x58\xc7\xf2\x6a\x3b\xf7\x1e\x63\x79\x11\x99\xd5\x31\xbd\x45\xb3\x66\xd3\x07\x11\x9f\xd3\x89\x6d\x88\xd1\xbd\x9c\x9f\xda\xba\x14\x9d\xda\x13\xa8\x56\x0d\xe4\x09\xd2\xc2\xd6\xe5\x33\x6b\x1c\x44\x7b\xc1\xbc\xda\x83\x04\xaf\x2a\x44\x8b\x54\x91\xa1\x46\xe9\x04\xcf\xe0\x10\x86\x53\x76\x99\x8c\x58\x7a\x2d\x97\x6b\xb9\xbc\x62\xb9\x5c\x8b\xe4\x7b\x2b\xef\x5a\x81\x54\xd6\xca\xbc\x32\xec\xda\x74\x1d\x57\x9e\x44\x2e\x6c\x5d\x57\x7f\xd5\x72\xf1\xfb\xab\xfe\x8a\x03\xb3\x75\xe1\xd7\x2a\x0b\xbf\x56\x17\x05\xd9\x84\xb6\xed\x7b\xbd\x24\x0a\x52\x87\x45\x16\x0b\x8b\x1c\x52\x3c\xbe\x8b\xb1\x56\x32\x4a\xb2\x8e\x01\xdb\x00\x09\xdf\x15\x02\x27\xd9\xbd\x9f\x54\x2c\x45\x45\x4d\x6e\x24\x99\x92\x4c\xb2\x18\x42\x37\x30\x04\x78\xe4\x4f\x5d\x9b\xde\xe1\x38\xc5\xfc\x6a\x46\x02\xf9\xc0\x19\x4e\x03\xc4\x08\x8b\x5f\x6a\x28\x7b\x30\x1c\x29\xe4\x1f\x46\x77\x1c\x57\xad\x5a\x9d\xd5\x66\x7e\x1d\x7e\xf9\x4f\x8a\xe0\x6f\x52\x85\x84\x27\xd0\x42\xdf\xb8\xd6\xaa\x98\x57\xac\x94\x55\xac\x78\xb0\xb8\xea\xb1\xe2\x4a\x87\x8a\x1f\x4f\x3b\x9f\xc7\x94\x52\x5e\x31\x7b\x7a\x9f\x92\x2a\x39\xd5\xef\x69\x26\x36\x62\x94\x14\x2a\xe4\x64\x41\x60\xe6\x60\x87\x5e\xa0\x4c\x03\x87\x98\xde\x30\x5c\xeb\xd8\x5a\xc7\xd6\x3a\xf6\x1b\x28\x5b\x56\x24\x60\xa5\xca\xe5\xb4\x96\x2d\x55\xbb\x9c\x12\xb9\x79\xd5\xcb\x71\xe3\x95\xd6\x2f\x7b\xda\xa8\x65\x2a\x98\xf5\x3e\x55\xca\x7f\xe3\xbe\x8f\x57\x00\x1c\x23\x72\xb1\x12\xe0\xb8\xfb\x4a\x8a\x80\xcd\xa3\x7d\x93\x65\xc0\xf1\x52\xea\x42\xe0\xba\x10\xb8\xb6\x1e\xea\x42\xe0\xef\xad\x10\x58\xd5\x8b\xa5\x3c\xb9\xb4\xd3\xb5\x64\x31\x70\xb6\x17\x97\x57\xd6\x9b\xef\xc7\x55\xea\x59\xdf\x0b\xf6\x6d\x7b\xb3\x87\x65\x36\xb9\xd6\x3f\x75\xa9\xf2\x03\x07\x3a\x13\x1a\x94\x43\x9d\xf1\xd3\x8a\xe5\xca\x72\xbf\x6a\x31\x4e\xdd\xf9\x79\xf8\xdc\xdc\x2a\x74\x86\x1c\xff\x4b\x39\x96\x59\x71\xbf\x5c\x5f\xb1\xa8\xf1\x13\x97\x89\x25\x8b\x97\x13\xf7\xb5\x2e\x5f\xae\xad\xf3\x7b\x8c\xed\x25\x84\x56\x47\xf7\xee\xf5\xda\x9f\x95\x88\x53\xad\x88\x39\x1e\xb2\x64\x19\x73\xae\x60\x2d\xd1\xbe\x6a\x29\xb3\x44\x5d\x8f\x56\xc9\x1c\xe3\x88\xdd\x7f\x73\x4f\x05\xcd\xf1\x24\x75\x49\x73\x2d\xab\x1f\x40\x56\xd7\x62\xfa\xfe\x8a\x9a\x57\x21\xa7\xb5\xb2\xe6\x4c\xcb\xd7\x50\xaa\x9c\x2b\xa3\x4b\xb4\xaf\x8b\x9b\x6b\x09\x59\x17\x37\xd7\xc5\xcd\x0f\x5d\xdc\x2c\xc5\x4d\x10\xa1\xd8\x95\xe7\xa7\x4f\xc8\xa0\x53\xf6\x6c\x95\x09\x6a\x3c\xf2\x03\xfa\xc9\xf3\x19\x5d\x7b\x3c\xc3\x42\x79\xeb\x52\xdd\xbf\xd5\x14\x36\xc5\xfe\x92\x69\x6c\x3a\xc4\x6a\x53\xd9\xa9\x11\xeb\x74\x76\x9d\xce\xae\xd3\xd9\xb5\x13\x56\xa7\xb3\xbf\xcd\x74\x76\x8e\xb9\x11\xf8\x2e\xea\xf5\x1d\xcf\x26\xfd\x0d\x9f\x1e\x7e\x52\x51\xff\x0b\x02\xeb\x4b\x0e\x6a\x6e\x0a\x94\xe7\xbb\x09\x62\xa2\x4c\x28\x0b\xf1\x93\xce\x20\x5a\x68\xf4\xd0\x0f\x86\xd0\x73\x30\x03\xb3\x96\x65\x15\x65\xd9\xd6\x53\xf4\x7d\xdf\xd3\x92\x6d\xcf\x0f\x01\x24\xe0\xfb\x81\xf3\x17\x3f\x4e\x05\x89\xaa\xc7\x98\xed\x39\x69\x30\x73\xa4\xf4\x5f\x2d\x61\x4a\x04\x79\xca\x7a\x03\xba\x2f\x42\x59\x16\x08\x9e\xc5\x19\x89\x4c\x89\xad\x33\x8b\x5e\xca\x73\xb0\xec\x19\x15\x7b\x05\x17\xd2\x98\xa9\x2c\xe0\x03\x78\x04\xd2\xda\x97\x09\xa1\x77\x1e\x5d\x20\x67\x0b\x63\x19\xc3\xdc\xe5\x7c\x92\x06\x77\x6d\xf1\xd6\x5a\x82\x1f\xb3\xad\x6e\x2e\xec\x3f\xc5\x35\x52\x33\x1b\x4f\xd9\x27\x25\xe8\xd6\x40\x97\xf0\x9e\x3d\x07\x7d\x7f\xea\x71\x95\xc8\xd6\x58\xab\xc1\x27\xa1\x06\xb9\x60\x94\xe4\xa9\xae\x08\xe9\x63\x2c\xf6\xcc\x44\x8e\x7c\x47\xd9\x81\x35\x7a\x3a\x3c\xb2\x72\xa8\xe1\x43\x36\x3c\x2c\xeb\x01\xc8\x1f\x74\x54\x13\x3b\x0b\xd5\x85\x46\x1f\x78\x5c\x20\xf5\x5b\x27\x31\x6a\x79\x9b\x25\x6f\x9f\x64\xd6\x8d\x66\x4b\x64\x5b\x47\xcb\x98\x38\x76\x9d\x5d\x7e\x4a\x12\x97\x4b\x37\x49\xe2\x9a\x6a\x7d\xb8\x10\x8a\xe5\x6e\xb4\xb5\xfd\x39\x38\x3d\xce\x11\xa9\x64\x9e\xb1\x83\x31\x99\xe9\xa9\x87\x54\xde\x49\x90\x16\x86\x53\x62\x3e\x85\x1e\xff\x21\xad\x33\x62\x59\xaa\x81\x6a\x99\x5d\x17\x99\x7f\x43\xf1\x07\x89\x07\x0a\xc3\x0f\x12\x95\xd3\x8b\x01\x9c\x10\xab\x56\x98\x89\x33\x86\x01\xf4\x42\xae\xf0\x9c\x70\x6d\x2d\xc1\x0f\x05\x54\xec\xc5\x01\xff\xea\xdb\x33\xfe\x5f\x70\xe4\x8f\xc7\x42\xe5\x3d\xe3\x6f\x68\xc0\x53\x0e\x6b\xb0\xc0\x80\xb4\xd0\x1b\xb2\x46\xe9\x27\x3d\x90\x23\xfd\xa4\x07\x6e\xa4\x9f\xa1\x1f\x42\x57\xfe\x5c\x11\xfd\x7c\xde\x9a\x5c\xfb\xae\x7c\x33\x6f\x12\x50\x94\x85\x8e\x4c\x14\x74\xbe\xc2\x92\x79\x0a\x45\xba\x91\x43\xb0\x31\x94\x6f\x19\x21\xc0\x15\xb7\x62\x30\x67\x37\x63\x2f\x18\x41\x47\x6d\xa0\xeb\xbe\x1d\x14\xd9\xaa\x11\x2b\xbc\x65\xeb\xbd\x40\x03\x14\x20\xcf\x52\x0e\x27\x65\x7c\x44\xd0\x84\x14\xce\xbd\x36\x32\x7f\x66\x51\x43\x0e\xdf\x49\x68\xe0\xe5\xcc\xe6\x31\xe5\xf6\x1c\x3b\xb7\x13\x7b\xa7\xad\xe9\xa0\xda\x06\x3b\xc5\xdb\x5b\x8a\x06\x46\x14\xeb\x6b\xc5\x70\x9e\xa1\x10\x56\x04\xd1\xff\xea\xa1\xa0\x10\x00\x11\x73\xea\x41\x45\xa2\xd2\xef\x6c\x91\x27\xb4\x04\x00\x35\x43\x67\x8c\x8a\x86\x19\xfb\x36\x33\xe4\x16\x1d\x87\x3d\xbf\xe4\x5e\x99\xc8\x51\x93\x8d\xbc\x44\x21\xcd\xdc\xe0\x3c\xd6\x76\x64\xc6\x9e\x06\xee\x72\x9b\x46\x06\x38\x28\x03\xe3\x21\x77\x1c\xf3\x00\xb3\x5c\x87\x30\x51\x4f\x81\x4f\x3c\x23\xd2\x3a\x40\x79\x7b\x17\xf7\x2d\xde\x3f\x79\xc4\x7c\xd0\xff\x20\x3e\x27\x41\x2a\x25\x25\x5a\xda\xf1\x40\x92\x00\x99\x94\x22\xe3\x0d\xd0\x38\x7c\x77\x2a\x80\x52\xf5\xac\x43\x5f\xce\x3a\xea\xc3\x11\x07\xcb\x6c\x54\x36\x34\x29\xe3\xba\x9c\x82\x52\x8a\xba\xc9\x07\x67\x75\x44\xb8\x91\xd2\xd4\xb9\x93\xe8\xd5\x47\x86\xfe\x62\x61\x71\x99\x85\x9e\x89\xcb\x96\x8b\x99\x10\x57\xfe\xae\xab\xb6\xa1\xf2\xda\x2b\x6d\xad\xa2\x73\x05\xdd\x63\x59\xeb\xfe\x46\xd1\x91\xcd\xad\x8a\x01\xf3\x8b\xef\xda\x38\x4a\xc1\xb2\xbb\xfb\xe2\xc0\x8c\xc0\x27\x8f\xd1\xb0\x31\xc1\xa9\x87\x43\x48\x80\x68\x2d\x42\xa3\x99\x62\x24\xd9\x88\x67\xe2\xab\xd4\xc2\x01\xb5\xa4\x7d\x49\xda\x64\x90\xf4\x33\x75\x17\xb9\x54\x60\x53\x5f\xa0\x21\xd9\xee\x60\xbe\x62\x94\xb0\xc1\x41\x34\xf8\x03\xe0\x86\x37\x26\x42\x4d\xcc\xb8\x2a\x2c\x45\xb4\xc4\xae\x83\x54\x28\x49\xbd\x20\xd2\x88\xad\xc6\xa1\x7e\xd5\x65\x63\xe5\x2a\x9b\xd6\xd2\xa1\x7c\x21\x9a\xbe\xca\x32\x0b\xda\x28\xbd\xae\x5f\xd0\xa9\x82\x2d\xf3\xb5\xc6\xcf\xe5\x6f\xd4\x6c\xe8\xf6\x71\xfa\x53\xa5\x31\xaa\xf5\x8b\xd1\x2e\x43\x18\x6a\xd6\x8f\x82\x15\xe4\x4d\xc7\x32\x75\xd9\x0e\x16\xd4\x89\x64\xcd\xc6\xc2\xe7\x72\x33\x1a\x1b\x88\xb1\x96\x71\xc3\xbf\x6c\xd5\x98\xb6\x8c\x9d\x6b\xcd\xdd\x8e\x8c\x81\xcd\x7b\xc2\xb9\x94\x1a\x25\xf2\xb1\x46\xe9\xe0\x10\x64\x35\x8f\x60\xe2\x42\x0f\x69\xf7\xb9\x35\x16\xe1\xb6\x9c\x65\x37\xcc\xf0\xcb\x18\x59\x40\x31\xf3\x91\xef\x0b\xb8\x4b\x76\xca\x28\x6f\xc3\xb0\xd2\x22\x83\x39\xf3\xf4\x20\x96\xa9\xb1\x52\x41\xa3\x4c\xce\x5a\xb1\xa8\xec\xf7\x94\x27\xa5\x55\xdb\x47\x55\x56\xb1\xcc\x3e\xf2\x5d\xca\xd8\x42\x59\x60\x55\x5a\x98\x6a\xc8\x54\xf6\xfb\x8c\xa6\x4a\x65\xcb\xa6\xda\xf7\x9a\xcc\x32\x51\x7a\x7a\x34\xa2\xdf\x0a\x76\x73\x84\x9f\x8d\x06\x70\xea\x86\xf4\x29\xec\xc7\xe9\x40\x5d\x24\x8a\x97\x2a\xc2\x8f\x11\xa6\x1e\x41\x55\xf1\x3a\xf5\x20\xc6\xce\xd0\xcb\x15\xae\x38\xf4\x27\x13\xa5\x85\x2d\xf2\x41\x2a\x0c\x55\x27\xe7\x53\xcb\x1a\x31\x7a\xa6\x4c\xc6\xa4\xa5\xda\xaa\x18\xc2\x01\x74\xdc\x34\xc8\xea\x28\xb6\x96\xd5\x6a\x52\x7a\xa2\x37\x4e\xfa\x9e\xde\x50\x79\xa1\x91\xba\x6c\x4d\xe5\x46\x85\xa8\x0d\x28\x03\xcd\x8d\xa3\x5e\x92\x15\x8c\xfd\x36\x85\x7a\x32\x62\x3e\x74\x34\x99\x66\xf3\xa8\x35\xc3\x74\x4e\x38\x4c\x83\x25\x3d\xee\x7a\x9e\x7d\x27\xdc\xd3\x75\xed\x16\x87\x5e\x64\xd2\x95\x05\xb3\xc8\xae\x6d\xc8\xd5\x40\x1c\x43\xf2\xd0\xcf\xa4\x7a\xcb\x3c\x23\x92\xb6\x64\x9a\x17\x8f\xe0\x04\x29\x8f\x49\x6b\x5a\x9b\xe6\x07\x6a\x6b\x1e\x04\x25\xfc\x6b\xbb\x6a\x18\x48\x91\x4b\x2a\x5d\x18\x8c\x0e\x13\x55\x50\x6d\x6f\xda\xfa\x1e\x1d\x5a\x75\xe7\x6d\xce\xe3\x3d\xa6\xb5\x16\xb5\x63\x52\x18\x8c\x26\x2a\xec\x21\x5f\xf3\x51\x3c\xbc\x2a\xec\x0a\xc5\x29\x6f\xde\x90\x0b\xc3\x92\xb5\x96\x1e\xc5\x24\x0d\x1b\xe6\x7d\x39\x58\xca\xc2\x52\xac\x97\xaa\x8a\x53\x16\x18\x3a\x74\x8f\x61\x91\x65\x2c\xa6\xa2\xce\x8d\x6a\xc7\x7b\x33\x1e\x63\x31\xab\x5f\x3d\x92\xac\x06\xee\xc8\xdb\x9d\x6d\x83\x56\x79\xb2\x66\xe0\x0a\xec\xbf\x47\x31\xfc\x56\x41\xb8\x15\x7b\x9b\x0d\xc5\xef\xc0\x42\x54\xc9\xc3\x43\x77\x61\x8f\x5f\xa2\xa6\x8f\x97\xbe\x2a\x87\x35\x8b\xf2\x5c\xb4\x27\xcb\xe2\x80\xaf\x23\xe4\x31\x87\x3f\x2e\x6d\x80\xa2\x6d\x0b\x9c\xb2\x02\x33\x5a\xde\x81\x51\x18\x7d\x93\xc2\x85\x98\x77\x6d\x15\x65\x37\xd2\x4e\xfd\x15\x69\x82\x8d\x2e\x3d\x7d\x63\x74\x7d\xff\xd1\x8c\xa7\xb9\x20\xa6\x13\xc2\x14\x31\xca\x81\x6e\x76\x0f\x0b\x9e\x4e\x26\x7e\x40\xb3\x71\xfd\x39\x03\xf3\xf0\xdd\x69\x94\x16\xf4\x90\x4a\x0a\x69\xd5\x69\x50\x9f\xfc\x91\x90\x3f\xda\x53\xbe\x2d\xab\x1c\x91\x66\x7e\x7b\xca\xb0\x8f\x94\xe5\xd2\xf5\x7d\xba\x1a\x86\x34\x48\x5f\xbe\x45\x67\x69\x95\xcd\x76\x65\x08\x75\xb5\x50\x97\xb7\x59\x72\x26\x61\x39\xe0\xdc\xa9\x84\xbd\x80\xab\xcc\xb5\x2a\xbe\xd6\x4d\x15\x1d\xb8\x3c\xb8\x0f\xe5\x9f\x29\xe0\x4b\xe3\xc8\x21\x5d\x7a\x7a\x32\x2f\x9d\xc5\xbf\x78\xc3\x42\xba\x1e\x6b\xbf\xf8\x6c\x2e\xec\x17\xed\xc7\x1b\xd6\x24\xf9\x60\x0e\xd1\x8a\x43\x56\x5a\xa6\x4e\xb9\xec\xbe\x64\x13\x0d\x9c\xc0\xbe\xe3\x3a\x69\xe6\x30\x89\x55\xa9\x71\x5a\x08\x59\x74\xbf\xef\x15\x58\x73\x79\x48\x96\x04\x8d\xfe\x1c\x32\x81\x13\x45\xcb\xd9\x97\x8a\x2c\xb2\xb5\xd2\x67\x8a\x66\xbc\x50\x9e\x06\x16\x75\xdb\x2e\x35\x5a\xc2\x30\x03\x07\xb9\xb6\x99\x16\x52\x12\x08\xc8\x42\xef\xdb\x59\x40\x5a\x6d\x7d\x07\x66\x07\x5d\x66\x66\xa4\x5e\xbb\x8c\xe0\x99\x8a\x21\xfd\x52\xf7\x05\xca\x01\x4a\xf9\xa0\x44\x90\xfa\x21\x4c\xa5\x21\xcd\xf8\x30\xe0\x22\x93\x4a\xb3\xd0\x0f\xc0\x0d\x9a\x57\xe0\x54\x43\x8e\xa6\xb0\x88\xc4\x64\x55\xb0\xf0\x0f\x9a\xa7\x9e\xb1\xd1\xd7\x32\x90\xff\xfb\xd4\xaf\x8c\xf6\x24\x59\x5c\x5c\xc4\xa3\xb8\x5d\x5b\xdd\xa4\xb8\x02\x8d\xfd\x60\xde\x13\xf9\x0a\x5c\xd6\xf9\x3e\x63\xdd\x18\xd0\x0d\x7d\x2c\xd7\x19\x3b\x4b\x8e\x64\x4d\xa6\x95\x41\x3a\x9a\x4c\x0d\xa3\x54\x03\x26\x19\x23\x63\x9b\x1e\xc3\x63\x37\x31\xe8\x93\x70\xdd\xe5\x37\xb7\x32\xfd\x56\x12\x5d\x2a\x0b\x64\x62\xfe\x0a\x79\xd0\x0b\x7f\x93\x0a\x9f\xca\x44\xbc\xe5\x9a\xd8\xa6\x5a\xd5\x9e\x3b\x4f\x0e\x27\x96\xa8\x01\x8c\x03\x79\x65\xea\xf7\xaa\x21\x29\x41\x43\x23\x23\xfb\xac\x68\xe6\x13\xe2\x2f\xa2\x20\x3e\x9d\xa2\x54\x4c\x3a\x36\x8d\x75\x23\x5e\x58\x2d\x7c\x47\x26\xa3\xa8\xf1\xa8\xac\x28\x37\xa4\xa1\x93\xa7\xd1\x49\x3c\x34\x5e\xfa\xc6\x0b\xc4\xb4\xd3\x9f\x65\xe2\xa6\xe9\xaf\xac\x29\x7b\xb0\x58\x98\x6f\xc5\x7c\x96\x00\x59\xba\xca\x50\x27\x8d\xe5\xc8\x23\x63\x9b\xe4\x5b\x23\x2b\xec\x15\xbb\x13\x54\xdb\xaa\x07\xc0\x73\xc6\x22\xa4\xdb\x7b\xcc\x6b\xf0\x0a\x6e\x2f\x32\xd3\xde\x2a\x17\x94\x01\xf9\x77\x60\x8c\x1a\xef\xff\x79\xd2\xc1\xb0\x8c\xbd\x7a\xd8\xfa\x15\x65\xda\x24\xc4\x5d\x52\x05\xc9\x79\x22\x25\xb7\x84\x7b\x44\xca\xbb\xfe\x1c\xd9\x05\x51\x75\xb4\xb8\x56\xd2\x22\xe3\x06\xcb\x22\x3f\x2d\x95\xc0\xb8\xb8\x25\x9b\x0a\xc5\x97\xd9\xe1\xf2\x02\xf0\x31\x02\xf7\x8a\xd9\xb9\xe2\x98\x61\x76\x74\xa5\xba\xde\x42\x77\x13\x47\x4d\x89\x67\x46\x26\xa3\xdb\x5e\xa3\x0e\x80\x56\x6e\x13\xea\x1b\x4f\xe8\xa9\x8e\x8b\x57\x47\x60\x6b\x6b\x6b\x5f\x6c\xb1\x36\xd8\xb3\xbc\x92\xef\x5c\x00\x43\xc5\xa8\x5b\x46\xb5\x36\x52\xb9\xa4\x29\x5e\x6e\xdc\x28\x55\x52\xe4\x56\x2a\xe5\xe8\x99\xd1\x6b\xdd\xba\xd7\x5e\x1b\x2c\x27\x41\x51\x6c\x75\x7a\x18\x9d\x81\xc6\x79\x47\xf2\xd3\x8c\x4c\xc3\xdf\x73\x3f\x80\x95\xc1\x71\xcf\x8b\x5a\x9d\x91\x27\x97\x2d\x48\x89\x71\x41\xa0\xa2\x75\x74\xff\xfb\xc3\xa7\x9f\x9b\x9f\xff\xe7\x53\xbb\xb9\xdf\xfa\xfc\xf3\x8f\x3f\x7c\x42\x27\x0e\x91\xb2\x37\xbf\x9d\xbd\xbe\x7a\xf7\xf9\xa7\x4f\xcd\x9f\xf9\xcb\xcf\x3f\xfd\xf8\x37\x8e\xb3\xc8\x67\x33\x42\x75\xf4\xee\xfd\x03\x83\xa4\xc4\x60\xd2\x87\xa0\x44\x5a\x25\x7d\xfb\x88\xb9\xac\x70\xc9\xc3\xe7\x15\xcb\x58\xc5\xa5\x05\x99\xde\x44\x83\x4e\xe4\x49\xf9\x85\xd8\xaf\x18\xc8\x17\xfc\xe8\xb3\x67\xb2\xa6\x98\x8f\x15\x25\x14\x2a\x0b\xa1\x6e\xc1\x27\x3a\xe9\x86\x5e\xc4\xf2\x39\xb9\xbc\xc5\x77\x2b\x0c\x36\x73\xd0\x57\x3a\x1c\xb2\x1d\xc2\x94\x1b\x00\xda\x63\xc7\xfb\x9c\xa7\x67\xa7\xaa\xd4\x6d\x2a\xab\x90\xb5\x7e\x74\xc7\x83\xb4\xd7\x59\xf5\xbc\x99\xdb\x2b\xdd\x19\x51\xb8\xc9\x8f\x94\x90\x92\xe1\xad\xa2\x34\x0c\xc4\x56\xa6\x7d\x8a\x58\xf2\x23\x74\xbe\x5b\x41\xf3\x89\xf3\x4a\xfd\x79\xe5\x2e\x30\xb5\x8e\xca\xba\x4a\xbb\x63\xed\xe0\x1e\x4e\x05\x16\x9c\xe4\xcb\xb4\xfd\x4b\xc7\x68\x2b\x5e\x59\x54\x22\x94\x92\x7d\x60\x32\x39\x3c\xfa\x3a\x90\x94\xbb\xce\x5a\xc9\x79\xd0\xf8\x38\x28\x3d\x2d\xca\x93\xd2\x84\xe5\x22\x85\x59\x51\x54\xc6\x7a\xb6\x94\xec\x4a\x80\xc8\x96\xad\x7f\xd0\xd0\x0b\x26\x22\x89\xba\x0b\x1b\x82\xb2\x36\x44\xf9\xf8\x86\xa8\x5f\xfc\x5c\x2c\x53\x2d\x5f\x05\x28\x63\x16\x99\x6d\x37\xd8\x81\x3e\xfb\x73\x4b\x42\x2a\xe6\xee\x15\x93\x48\xf4\x25\x1f\x98\x10\x01\xf9\xed\xb9\x73\x19\x99\xbc\xe0\x9d\xe3\x03\x8b\xd6\x22\xbf\x46\x85\x56\x01\xc8\x26\x22\x50\xb0\x2b\x97\x6c\xc6\xe0\xc9\xf2\x97\x02\xa6\x91\x84\xf9\x54\x4c\xe3\x6a\x65\xa7\x89\x1b\xf7\xc0\x9e\x06\x89\x68\x6c\x97\x23\x71\x8d\xed\x4b\x69\x44\xb6\xba\xd5\x73\xbd\xc6\xa5\x15\x38\x3f\xad\x68\x95\x5b\x93\x9a\x02\x62\x6a\x4f\xe9\x37\xe4\x24\x38\xe1\x5f\x2f\x8b\xad\xdd\x54\x9c\xe1\xf4\x98\xcb\x00\xcb\x0f\xe2\xe3\xee\x5a\xbe\xd3\x80\x2d\xed\x83\x64\x86\xeb\xe2\xe4\xf3\xd7\x1c\x06\xe9\x5c\x38\xed\x4e\x6c\xbe\x60\x6e\x82\xea\x1d\x0d\x73\x10\x4c\xa0\xbb\xd4\xe8\x03\xe8\x62\x54\x1e\xca\xf4\x7d\x02\xfa\xa9\x70\x9e\xef\x02\x0d\x71\xc4\x51\x3e\x0e\xce\x81\x96\x4e\xaf\xe7\x02\x7d\x3e\x1d\xf7\x11\x33\x03\x19\x79\x50\x96\x42\xd0\x1a\xc9\x8b\x5e\xe1\x32\xf4\x63\xeb\xf1\x32\xda\x6d\xbe\x10\x35\xc8\x24\xbe\x60\xc7\x9e\x95\x59\xcc\xbf\x93\x6c\xf6\xdb\x09\x24\xcd\xe8\xcd\x86\x8e\x5c\xc3\xc1\x42\x51\x21\xbd\xa3\x9f\x5e\x52\xd0\x02\x97\x88\x88\x0b\x48\xd6\x3c\x9e\x84\x73\x11\x43\x8f\x5f\xb3\x1e\x03\x27\x10\x71\xa8\x0d\xfa\x9b\x3d\x8c\x67\xb9\x96\x22\x63\xd7\xf1\x1c\x01\x9a\x39\xfe\x14\x6b\x93\x25\xf1\x30\xc2\x68\x2d\xf0\x81\x8e\x85\x51\xb8\x01\xae\x69\xbb\x6b\x76\xcd\xc5\xd0\xf3\xe9\xe5\xb4\x54\x86\xc1\x10\x8c\x7d\x29\xa1\x4e\x3a\x81\x6b\xf1\x91\xce\x6b\x9e\x4c\x97\xf3\xf2\xad\xe5\x36\x4b\x0c\x7c\x50\x80\xd5\x4b\x71\x35\x0f\x16\xf6\x2e\xe9\x44\xf5\x05\x69\x4b\x58\xd8\x81\x2d\x7e\x81\xda\xdc\x0b\xe1\x1d\xc7\x86\x83\x13\x16\x27\x2b\x94\x08\x61\xec\xb8\x30\xa0\xd8\x09\xb5\x2e\xd1\x32\xc9\xc0\xd7\xc4\xeb\x85\x64\x75\xec\xc0\x9c\x07\x2e\x7f\x7f\xc3\xc3\x5d\x63\x22\xa8\x92\xa8\xe0\x09\xa5\x57\x46\x13\x11\x42\x58\x7f\xae\xca\xa0\x37\x8f\x87\x55\x72\xee\x02\x87\x38\x19\xe7\x15\x31\xd1\x05\xc9\x6e\x48\xdb\x46\x8c\x73\x29\x23\x4f\x31\x87\xe5\x09\xc8\xc8\x0e\x0f\x36\x6d\xd0\x8d\xe0\x34\xe3\xbb\xae\xff\x95\x06\x3a\xf9\xc2\xc4\x49\x47\x46\x31\xd7\xd7\xf8\xd6\x55\xf2\xef\x00\x62\x4b\x7e\x9f\x34\xbe\xaa\x0e\x04\xe8\x11\xe2\xe9\x45\x71\xbc\x65\x40\xda\x88\x06\xc9\x86\xef\x34\x62\xaa\x64\x87\xe9\x6d\xd4\xec\x14\x86\x8d\x88\x29\x44\x60\x75\x06\x52\x7a\x8a\x90\x03\xe3\x34\xce\x4a\x09\x39\x72\x33\x64\xea\xd2\xf2\xc3\x40\xd9\x3f\x0a\x4d\x2b\x96\x27\x13\x97\xde\x14\x21\xdf\xed\x99\x96\x31\x1a\x29\xcb\x62\x26\x5a\x5a\x23\x43\x98\x70\x79\x23\x06\x58\x56\xfa\xe1\x70\x4e\x94\x37\xf3\x26\xb8\x8c\x66\x9f\xcb\x35\x73\x58\xc2\x60\xac\x51\xc2\x50\x12\x2d\xe4\x73\x56\x01\x47\x7d\x1d\x11\x84\x2a\xec\x94\x4c\xa9\x70\x15\x38\xa4\x74\x42\x70\xcf\xb9\x83\xea\x03\x1e\x1f\x60\x70\xd1\xcd\xb9\xa6\x58\xba\x26\x62\x4b\x5a\x02\xfd\x29\xa8\x85\xfe\x95\x55\xa2\x91\xbf\x50\x39\x76\x2d\x0a\x05\xaf\x13\x46\x8b\xa6\xe0\x57\x73\xd0\x9b\x42\xd9\xb8\xff\xfd\x0f\xda\xf7\xc5\x35\x23\x9b\xeb\x37\xa7\xbf\x9d\x18\xfa\x58\xbe\xf7\x65\xea\x59\xa1\x33\x43\x7a\xff\xc3\xf3\xe3\x6b\x3e\xe5\xdb\x8b\xeb\x16\xf8\x85\xb4\x9f\xd1\x00\xc1\xdc\x9f\x32\xc1\x40\x57\x0e\xc1\x18\xde\x39\xe3\xe9\x98\xe2\xa0\xd3\x4e\x86\xa3\x66\xa2\xe3\x31\x17\x9d\xad\x94\x91\x85\x84\xfe\x93\x98\xce\x4c\xdc\xa9\xd5\xe1\x26\x06\x38\xa3\xb8\x6b\xf8\x15\x37\xf1\x2d\xf9\x97\x99\xc6\xd7\xb1\x8d\x2a\x50\x03\xae\xf9\x59\xb1\xeb\xb2\xec\xaa\xf2\xea\x0b\xa0\x8e\xcf\x86\x8f\x86\x7e\xa1\x1e\x52\x63\xdd\x3f\x4d\x9a\x9f\xcd\xcb\xe0\xe7\xec\x1d\x71\x96\x3c\x4a\xd3\xb0\x59\x58\x21\x18\x79\x1e\x84\xc2\xbf\xa0\xab\x5a\x10\x62\xd7\xb9\x41\x14\xe8\xbf\x77\x9f\xdf\x8b\x60\x61\xe2\x92\xbe\x54\xb7\x45\x92\x37\x64\x2d\xb1\x17\x31\x82\x58\xf6\x35\x09\x03\x61\xc4\xdd\xa5\x40\xdc\xe2\x25\xd1\xc1\xb9\x1f\x12\xe5\x2d\xe0\xe3\x4a\x27\xb9\x9f\x6a\x83\x99\x12\xec\x10\x12\x79\x98\xf4\xce\x16\x5f\xc2\x58\x63\x34\x97\x21\x94\xcc\x02\xc8\x60\x5b\x29\xf2\x25\x25\xf6\x4a\x51\x49\x63\x31\xf1\xb6\x96\x5c\x35\xc5\xce\x86\x45\x60\x89\xbb\xa6\xe4\x41\x49\x97\x3e\x7b\x2a\x1e\xf2\x1f\xaf\x44\xc8\xe5\xd7\x0f\x57\x8a\x77\x36\x0a\xc3\x09\x1d\x5d\x5d\xad\x7e\xa8\xd3\xf8\x25\x17\xad\x22\x8c\x23\xba\x71\x36\x8f\x0f\x82\xa6\x8a\x0d\xf3\x07\xa0\x17\x84\xb8\xfe\xb0\x87\x1d\xef\xa6\xd7\x6e\x75\x54\x07\x51\x1d\x69\x6d\xa1\x8b\x43\x98\xcb\x8c\x37\xe5\x49\x1a\x1a\xfc\x6f\xfc\x21\xb8\x24\xef\x52\xf9\x3a\xd0\x50\x5a\x9b\x8a\xb3\x9b\xba\x24\x50\x2b\x83\xf5\x91\x93\xda\xe5\x05\xe1\x6f\x4d\xe4\x1b\xae\xd3\xc5\xc9\xf4\x86\x2d\x69\xbe\xac\xd2\xe0\x26\x3b\x03\xd8\xd3\xcf\x00\x36\x4d\x67\x00\xd3\x05\xaf\xd9\x37\xab\xd0\xcb\xc2\xf4\xc8\x5f\xc2\x6a\xc9\xed\x68\x31\x0b\x38\xa1\xcb\x77\xa0\x6c\x0d\x6e\x5e\x7d\x23\x00\x63\x62\xed\x38\x3d\xd7\xf1\x8c\x37\xc3\xc5\x47\x8c\x65\x9e\xcf\x4c\xcf\x9d\xd1\xb1\xc0\x1b\x32\x96\xa1\xa5\x00\x3c\xbf\x0d\x5b\x43\xdf\x27\x6e\x3a\xf4\x0c\xef\xef\x9a\xc3\xc0\x9f\x4e\x08\x29\x10\x7f\x69\xe2\x3b\x7a\x36\x8d\x21\x7f\xe4\x7f\xed\x11\xc1\xbb\xfc\x72\x2e\xc9\x48\x54\xe1\x67\x2f\x26\xaf\xc5\x92\x4b\x09\xfd\x89\x63\x15\x9c\x6a\x20\xc4\x43\x0d\x05\xaa\x9e\x42\xe9\x93\x19\x5c\x7b\xb2\x01\x78\xf6\xd9\x4c\x42\x57\xd9\x0d\xb2\x0b\x5c\x13\xb0\x19\xd7\xe9\xc9\x4c\x34\x59\xbe\x42\x44\x3b\xcc\xa3\xf1\x5a\x26\x21\x47\x5a\x8b\xa8\xd2\xb0\xc7\xac\xc6\xac\x36\xd9\x7e\x65\xfa\xcf\xa1\xcd\xb2\x67\x16\x11\xd6\xfe\x58\xb8\xbb\x51\xd5\x88\xcf\xec\x93\x30\x71\xd7\xa9\x2e\x26\xd8\xc4\x3c\x00\x43\xf4\x2b\xf4\x88\xdb\xdf\xca\x1c\xbe\x78\x39\x2c\x0f\x90\xbf\x16\x63\x54\x4a\x4e\xb1\x71\xa0\x09\x78\xc4\x23\x85\xb6\x8d\xec\xdc\xa1\x04\x71\xbc\xa2\x9d\xf2\x1b\x66\x13\x49\x89\x8a\xe8\x5c\xe8\xe3\x1a\xc2\x18\xfc\x32\x20\xb3\xc0\xf4\xf2\x20\x67\x95\x64\xab\x94\x58\x04\x55\x54\xac\x5d\x00\xf3\x29\x23\x57\x8e\x6d\x70\x68\x85\x7a\xbe\xbd\xa4\x80\x2f\x07\x79\x53\xe1\x8e\xb5\x05\xe6\x28\xc3\x81\xe8\x8e\xd0\xbd\x55\x8d\x05\x4f\x78\x1f\xc2\x54\x9c\x58\x07\x01\x61\x36\xba\xf9\x7d\xdf\x9e\x7f\xc7\xec\xb3\x0a\x5a\x14\x10\x45\x28\x7e\x28\x52\x53\xc8\xe0\xbe\x68\x8d\xb8\x4c\xbd\x11\x82\x36\x0a\xc8\x3c\x6e\x88\x82\x92\xf4\xf6\x8a\x35\x06\x7d\x88\x93\xfc\x13\x3f\x78\x6a\xb1\x7d\xa7\x41\x4e\x3e\xee\x92\xc4\x67\xaa\x9b\x2a\xa0\x3d\x3e\xaf\x70\x76\xfd\xa8\x08\x35\x5f\xb0\x45\xd7\x18\x8a\xce\xe7\x70\x8c\xca\x50\xe9\x2f\x7c\xaa\xe2\xe6\xab\xa3\x55\x2f\x6f\xae\x08\x2c\xe2\x08\x0b\xd0\xc4\x46\xdd\x3f\xb9\xa6\x28\xa9\x1c\xc9\x26\x2e\x60\x69\xdf\xef\x6c\x4e\x6c\x77\x39\x59\xae\x5c\x94\x01\x1a\xfb\x7d\x3c\x6b\xe3\xdd\xd0\x43\xbb\xc3\x76\x77\x38\x7a\x3e\xdc\x96\xfc\x97\xd4\x3d\x2e\x52\x9f\x9d\x7e\x30\x08\xda\xed\xee\x64\xe0\xdd\x8c\xda\xb2\x69\x96\xdc\xd8\x09\x1a\x38\x98\x59\x4d\x68\x59\x61\xb3\xb3\xd3\x45\x83\xae\xbd\xd7\x6c\x77\xdb\xfb\xcd\xed\x4e\x67\xb7\xb9\xb7\xbd\xd3\x6d\xda\x83\x9d\x2d\xab\xdb\xee\x3e\xb7\xba\x3b\x86\x51\xc4\x6d\x9e\xa0\xd1\xef\x6c\x6f\xdb\xfb\xfb\x9d\x66\x7b\x0f\xf5\x9b\xdb\xdb\xbb\xdd\xe6\x1e\xb2\x3a\x4d\xd4\x6f\x6f\x6d\x5b\x3b\xfb\xdd\xad\x4e\x5f\xee\x4f\xaf\x2f\x05\x8d\x81\xef\x37\x4d\xf0\xb6\x6e\x20\x6e\x41\x6b\x8c\x5a\xc4\x29\x3a\xd8\xde\xde\x6a\x94\xb9\x1f\x46\x5a\x7e\xfb\x66\xcf\xf5\x86\xed\xad\x0e\x46\xfb\xb7\x25\x96\x8f\xc8\x0a\xbb\x3b\xcf\x51\x13\xee\xed\x41\x02\xfe\xa0\x4f\x96\xff\xbc\xdd\x44\x76\xbb\xd3\x46\xfd\x9d\xbe\xf5\xdc\xca\x5b\xbe\x6d\x3d\x87\x7b\xdd\xfd\xbd\x66\x1f\xd9\xbb\xcd\xed\x6e\x17\x35\xf7\xf6\xb7\x77\x9b\x83\x9d\x81\x0d\xc9\xea\xf7\xbb\x83\x41\x7a\xf9\x7d\x18\x88\xe5\x77\xc7\x03\x0b\x92\xe5\x87\xfb\xb7\xbb\x78\xd8\xc2\x41\xd6\xf2\xa3\xcb\x51\x74\xc7\x39\x7d\x27\x0b\x68\x98\xbd\x76\xe3\xfd\x37\x26\xdf\x33\x76\x9e\xe4\xe0\x90\xee\x28\xe2\xd4\x5b\xe1\xac\xb0\xcd\xdd\x20\x2b\x54\x6e\x0a\x8f\xdd\x66\xed\x96\xd5\xf4\xd1\xba\xa8\xe2\xa5\x71\x79\x75\x71\x7a\xfe\x5a\x75\x2e\x8c\x86\x64\xdc\xe3\xd7\xcb\xb7\xe7\xda\x55\xa6\xc2\x2b\x4f\xd5\x40\xe6\x7a\x08\x22\x3e\xc3\xde\x9e\x4b\x37\xeb\xa5\xa3\x59\xac\x09\xb3\x39\xb3\xae\xa1\xd1\xca\xb6\x59\x40\xae\x17\xdd\x0a\xa4\x16\x58\x40\xbb\xe7\x22\x5a\x2e\xd8\xbb\x9d\x22\x7d\x99\x0c\xbb\x94\xe0\xdc\xdb\x46\x46\x15\x72\xa5\xd0\x93\xa1\xba\x5e\x2a\xd9\x2d\x92\x40\x19\xa7\x30\xd9\x89\x45\xd2\x59\x0d\xcf\xb4\xfa\x83\x6e\xcb\x0f\x86\x9b\x64\x3f\x88\x5c\x45\xa6\x2d\x25\x0b\xe3\x6e\x79\x53\x69\x54\xe1\x6b\xc9\x59\x0b\xa5\x1d\x0c\x8b\xbd\x87\x15\x24\x47\x45\xd4\x45\x64\x7f\xec\xd7\x10\xd6\x6b\x74\xda\x12\xd7\x8b\xcb\x7a\xb5\x8b\xfe\xf3\x23\x61\xfc\x83\x67\x9b\xca\x38\xec\x52\x73\xd0\x38\x7a\x7b\x7e\x7e\x72\x74\xf5\xf6\xa2\x79\xf6\xfa\xec\xaa\xa9\x34\x11\x57\x99\x13\xbe\x9b\x7b\xd6\x28\xf0\x3d\x9a\x35\x86\x16\x3f\xe3\x25\x0e\x44\x44\xe7\xde\x79\xa4\x1d\x62\xd2\xf2\x05\x95\x02\xe9\x1b\x4f\xb5\xbb\xce\xc9\xb2\x9c\x0f\xa7\xce\xf8\xf6\xb5\x15\x1c\x4f\xdf\xec\x74\xe0\xfb\xbb\xd3\x7f\xde\xbe\xbc\xba\x3d\xbf\x80\x31\x96\x4e\x79\xe4\xfa\x77\x1a\x70\x2e\x81\xa9\xee\x8a\x30\xd5\x2d\x44\x54\xd7\x80\xa7\x7f\x4b\x34\xf0\x8a\xdd\x1c\x47\x2d\x35\x82\x08\x8c\x94\xbc\x0d\xfd\x32\x02\x95\xd8\xf4\x2d\x0b\xce\xf0\xc8\x4c\x54\x3a\xcc\x2a\x8a\x09\x78\x3d\x1e\xc0\x14\x45\x99\x07\x20\x05\xc1\x41\x85\xf9\x92\x0b\x0a\x2c\xdf\x9d\x8e\x3d\x6e\x48\xd2\x99\x44\x60\x1e\xac\x3b\xf6\x7a\x0b\x5c\x9a\xda\xb1\x0c\xd6\x81\x52\x48\x3e\x64\xe9\x5b\x9e\x57\xb6\x5c\x7f\x6a\xf7\x44\xf6\x23\x88\x9e\xf2\x1a\xef\x16\xf8\x9d\x67\x21\xf8\x46\xd2\x92\x19\xf0\x02\x74\xba\x5b\x99\x54\xe1\x7e\x38\x7e\x3d\x9d\xf7\x4f\x83\x13\xef\x2e\x38\x44\xe3\xdd\xee\xf6\xf0\xf6\xe6\xc6\x39\x9e\x45\x54\xb1\x5d\x82\x12\xe8\x17\x85\x56\x41\x09\xbb\x45\x84\xb0\x6b\xe0\x97\x32\x9f\x43\x8e\x17\xc3\x3f\x48\x53\x62\x49\xbb\x8f\xb7\xa0\x24\x51\xc5\x82\x5c\x8e\xfd\x62\xbd\xe3\xfc\xb6\x65\x4f\xff\xf8\x78\x3a\x9b\x3d\xff\x38\x7b\xe3\xce\xff\xea\x8c\x5f\x5f\x6c\xfd\x3a\xbf\x3d\x5f\x67\xa2\x61\x40\x2b\x8c\x73\x98\xff\xe3\xdb\xdd\x61\x77\xb8\xf3\xcb\x95\xfd\xfe\xb7\xf7\xb0\x7b\x83\x7f\xd9\xeb\xde\xfc\x7e\xbc\x35\x8f\x30\xd3\x29\x23\x1a\x3b\xab\x91\x8c\x9d\x42\xc1\xd8\x31\xa0\x25\x61\xe3\x19\x0a\x9c\xc1\x9c\x26\x88\xf8\x57\x61\xe8\x77\x46\xb8\x6f\x11\x7f\x1f\x2b\x2a\xab\xa7\xdf\x8c\x29\x85\x9f\xad\xf7\xa3\x93\xd1\xd7\xf1\x9f\x2f\x27\x1f\xde\x0d\x4e\xbb\xee\x39\xba\x99\xd8\xdb\xff\x3c\x8e\xf0\xb3\x4f\x75\x18\xbd\x4d\xcb\x75\xac\xb0\x04\xae\xb6\x76\x56\x82\x2b\x79\x18\x33\xae\xe4\x16\x32\x09\xf1\x0b\x51\xb8\xe4\x91\x3e\x5f\x48\xeb\x83\x32\xf1\xb0\x73\xf3\xb1\xfd\xde\x39\xb9\xf9\xeb\xe6\xcf\xa3\xbf\x3e\xbc\x43\xa7\x5d\xff\x23\x1a\xd9\x5b\x27\x02\x0d\xe9\x2f\xd8\x98\x96\xbe\xbf\x92\x95\xef\x17\x2d\x7c\xdf\x48\x23\xc9\x07\xc3\x90\x3a\x69\x6a\xcb\xd1\xc9\x9b\xd9\xab\xfd\x2f\x67\xbf\x7f\xdc\xf9\x38\x1c\x0d\xce\xf6\x87\xaf\x2f\xf0\x2f\xb3\x93\x0f\xf1\x5a\x4b\x0b\x8b\xc7\x5b\xb1\xac\x05\xd9\x9c\xf1\xb1\x44\x9a\x7a\xb7\x30\x75\x92\xde\x1e\x9d\x35\x4f\xfe\x6c\xee\x1f\x88\x1b\x56\x29\x0b\xf1\x3a\xe4\xa4\x0d\xba\x0b\x9b\x42\xf7\x11\x18\x9b\x1d\xe7\xae\xbd\xe5\x12\x23\x79\x7c\xdb\xbe\x1d\x58\xbb\xd8\x09\xe1\x73\xec\x7e\x99\xed\x21\xf5\x04\x5f\x64\xb4\x32\x3c\x74\x86\xcf\xed\xbd\xbd\xdb\xb6\x1b\x58\xf6\x6c\x7b\xb8\x0b\xdd\xfe\x2e\x76\x07\x43\xef\xcb\x96\x3d\xea\xe3\x2f\x7f\xff\xaf\x1f\x4e\xfe\xbc\xba\x38\x04\x3f\xf1\x15\xb7\x18\xc4\x2f\x88\x1e\xf3\x42\xba\x67\xb2\xbf\x4f\x48\x76\x9d\xc8\xeb\xf5\x0d\x86\x0b\xf6\xf3\xe8\xcd\xfb\xcb\xab\x93\x8b\x4b\x8e\x0c\xfa\x92\xe5\xad\xe3\x8d\x05\xc9\x40\xac\x3d\x01\xc7\x0f\x9e\xb7\x67\xce\xb4\xbd\xeb\x23\xba\x6d\xa3\xe0\x86\xb8\xd3\xf6\x70\x10\x7e\xe9\x40\x6b\x5d\x56\xb2\x22\x15\xcc\x7a\xe5\x2e\x42\x92\xb7\x3f\xe6\xc8\x93\x2b\xfc\x21\x98\xef\x78\xf8\xb6\xdf\xc5\xe7\xe3\x57\x5f\x9e\xf7\xff\x9c\x1c\xef\x1e\x11\x63\xeb\xff\x01\xab\xe7\x7c\x7e\xec\x02\x01\x00")
 
 func connector_mgmtYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -93,7 +93,7 @@ func connector_mgmtYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "connector_mgmt.yaml", size: 57342, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "connector_mgmt.yaml", size: 66284, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -193,10 +193,11 @@ func (h *ConnectorAdminHandler) GetClusterNamespaces(writer http.ResponseWriter,
 			}
 
 			result := private.ConnectorNamespaceList{
-				Kind:  "ConnectorNamespaceList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
+				Kind:       "ConnectorNamespaceList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				NextCursor: paging.NextCursor,
 			}
 
 			result.Items = make([]private.ConnectorNamespace, len(namespaces))
@@ -222,10 +223,11 @@ func (h *ConnectorAdminHandler) GetConnectorNamespaces(writer http.ResponseWrite
 			}
 
 			result := private.ConnectorNamespaceList{
-				Kind:  "ConnectorNamespaceList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
+				Kind:       "ConnectorNamespaceList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				NextCursor: paging.NextCursor,
 			}
 
 			result.Items = make([]private.ConnectorNamespace, len(namespaces))
@@ -323,10 +325,11 @@ func (h *ConnectorAdminHandler) GetNamespaceConnectors(writer http.ResponseWrite
 			}
 
 			result := private.ConnectorAdminViewList{
-				Kind:  "ConnectorAdminViewList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
+				Kind:       "ConnectorAdminViewList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				NextCursor: paging.NextCursor,
 			}
 
 			result.Items = make([]private.ConnectorAdminView, len(connectors))
@@ -80,10 +80,11 @@ func (h *ConnectorClusterHandler) ListDeployments(w http.ResponseWriter, r *http
 				}
 
 				list = private.ConnectorDeploymentList{
-					Kind:  "ConnectorDeploymentList",
-					Page:  int32(paging.Page),
-					Size:  int32(paging.Size),
-					Total: int32(paging.Total),
+					Kind:       "ConnectorDeploymentList",
+					Page:       int32(paging.Page),
+					Size:       int32(paging.Size),
+					Total:      int32(paging.Total),
+					NextCursor: paging.NextCursor,
 				}
 
 				for _, resource := range resources {
@@ -280,10 +281,11 @@ func (h *ConnectorClusterHandler) GetAgentNamespaces(writer http.ResponseWriter,
 			}
 
 			resourceList := public.ConnectorNamespaceList{
-				Kind:  "ConnectorNamespaceList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
+				Kind:       "ConnectorNamespaceList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				NextCursor: paging.NextCursor,
 			}
 
 			for _, resource := range resources {
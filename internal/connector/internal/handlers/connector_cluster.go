@@ -19,6 +19,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
 	"github.com/goava/di"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -41,6 +42,7 @@ type ConnectorClusterHandler struct {
 	ServerConfig       *server.ServerConfig
 	AuthZ              authz.AuthZService
 	QuotaConfig        *config.ConnectorsQuotaConfig
+	IdempotencyService idempotency.IdempotencyService
 }
 
 func NewConnectorClusterHandler(handler ConnectorClusterHandler) *ConnectorClusterHandler {
@@ -85,6 +87,7 @@ func (h *ConnectorClusterHandler) Create(w http.ResponseWriter, r *http.Request)
 			}
 			return presenters.PresentConnectorCluster(convResource), nil
 		},
+		IdempotencyService: h.IdempotencyService,
 	}
 
 	// return 202 status accepted
@@ -281,10 +284,11 @@ func (h *ConnectorClusterHandler) GetNamespaces(writer http.ResponseWriter, requ
 			}
 
 			resourceList := public.ConnectorNamespaceList{
-				Kind:  "ConnectorNamespaceList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
+				Kind:       "ConnectorNamespaceList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				NextCursor: paging.NextCursor,
 			}
 
 			for _, resource := range resources {
@@ -8,6 +8,8 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/authz"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 	"github.com/dustinkirkland/golang-petname"
 	"github.com/goava/di"
@@ -30,10 +32,11 @@ func init() {
 
 type ConnectorNamespaceHandler struct {
 	di.Inject
-	Bus          signalbus.SignalBus
-	Service      services.ConnectorNamespaceService
-	AuthZService authz.AuthZService
-	QuotaConfig  *config.ConnectorsQuotaConfig
+	Bus                signalbus.SignalBus
+	Service            services.ConnectorNamespaceService
+	AuthZService       authz.AuthZService
+	QuotaConfig        *config.ConnectorsQuotaConfig
+	IdempotencyService idempotency.IdempotencyService
 }
 
 func NewConnectorNamespaceHandler(handler ConnectorNamespaceHandler) *ConnectorNamespaceHandler {
@@ -49,6 +52,7 @@ func (h *ConnectorNamespaceHandler) Create(w http.ResponseWriter, r *http.Reques
 	cfg := &handlers.HandlerConfig{
 		MarshalInto: &resource,
 		Validate: []handlers.Validate{
+			user.AuthorizedPermission(rbac.ResourceConnectorNamespace, rbac.PermissionCreate),
 			handlers.Validation("name", &resource.Name, handlers.MinLen(1)),
 			handlers.Validation("cluster_id", &resource.ClusterId, handlers.MinLen(1), handlers.MaxLen(maxConnectorClusterIdLength), user.AuthorizedClusterUser()),
 		},
@@ -81,6 +85,7 @@ func (h *ConnectorNamespaceHandler) Create(w http.ResponseWriter, r *http.Reques
 			}
 			return presenters.PresentConnectorNamespace(convResource, h.QuotaConfig), nil
 		},
+		IdempotencyService: h.IdempotencyService,
 	}
 
 	// return 201 status created
@@ -115,6 +120,7 @@ func (h *ConnectorNamespaceHandler) CreateEvaluation(w http.ResponseWriter, r *h
 			}
 			return presenters.PresentConnectorNamespace(convResource, h.QuotaConfig), nil
 		},
+		IdempotencyService: h.IdempotencyService,
 	}
 
 	// return 201 status created
@@ -141,6 +147,7 @@ func (h *ConnectorNamespaceHandler) Get(w http.ResponseWriter, r *http.Request)
 			if err != nil {
 				return nil, err
 			}
+			handlers.SetETag(w, handlers.VersionETag(resource.Version))
 			return presenters.PresentConnectorNamespace(resource, h.QuotaConfig), nil
 		},
 	}
@@ -159,6 +166,13 @@ func (h *ConnectorNamespaceHandler) Update(w http.ResponseWriter, r *http.Reques
 		Validate: []handlers.Validate{
 			handlers.Validation("connector_namespace_id", &connectorNamespaceId,
 				handlers.MinLen(1), handlers.MaxLen(maxConnectorNamespaceIdLength), user.AuthorizedNamespaceAdmin()),
+			handlers.ValidateIfMatch(r, "connector_namespaces/"+connectorNamespaceId, func() (string, *errors.ServiceError) {
+				current, err := h.Service.Get(r.Context(), connectorNamespaceId)
+				if err != nil {
+					return "", err
+				}
+				return handlers.VersionETag(current.Version), nil
+			}),
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
 			existing, err := h.Service.Get(r.Context(), connectorNamespaceId)
@@ -229,11 +243,12 @@ func (h *ConnectorNamespaceHandler) List(w http.ResponseWriter, r *http.Request)
 				items[j] = presenters.PresentConnectorNamespace(resource, h.QuotaConfig)
 			}
 			resourceList := public.ConnectorNamespaceList{
-				Kind:  "ConnectorNamespaceList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
-				Items: items,
+				Kind:       "ConnectorNamespaceList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				Items:      items,
+				NextCursor: paging.NextCursor,
 			}
 
 			return resourceList, nil
@@ -19,6 +19,8 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/secrets"
 	"github.com/spyzhov/ajson"
 
@@ -42,6 +44,7 @@ type ConnectorsHandler struct {
 	vaultService          vault.VaultService
 	authZService          authz.AuthZService
 	connectorsConfig      *config.ConnectorsConfig
+	idempotencyService    idempotency.IdempotencyService
 }
 
 // this is an initial guess at what operation is being performed in update
@@ -53,7 +56,7 @@ var stateToOperationsMap = map[public.ConnectorDesiredState]phase.ConnectorOpera
 
 func NewConnectorsHandler(connectorsService services.ConnectorsService, connectorTypesService services.ConnectorTypesService,
 	namespaceService services.ConnectorNamespaceService, vaultService vault.VaultService, authZService authz.AuthZService,
-	connectorsConfig *config.ConnectorsConfig) *ConnectorsHandler {
+	connectorsConfig *config.ConnectorsConfig, idempotencyService idempotency.IdempotencyService) *ConnectorsHandler {
 	return &ConnectorsHandler{
 		connectorsService:     connectorsService,
 		connectorTypesService: connectorTypesService,
@@ -61,6 +64,7 @@ func NewConnectorsHandler(connectorsService services.ConnectorsService, connecto
 		vaultService:          vaultService,
 		authZService:          authZService,
 		connectorsConfig:      connectorsConfig,
+		idempotencyService:    idempotencyService,
 	}
 }
 
@@ -75,6 +79,7 @@ func (h ConnectorsHandler) Create(w http.ResponseWriter, r *http.Request) {
 		MarshalInto: &resource,
 		Validate: []handlers.Validate{
 			handlers.ValidateAsyncEnabled(r, "creating connector"),
+			user.AuthorizedPermission(rbac.ResourceConnector, rbac.PermissionCreate),
 			handlers.Validation("channel", (*string)(&resource.Channel), handlers.WithDefault("stable"), handlers.MaxLen(40)),
 			handlers.Validation("name", &resource.Name, handlers.WithDefault("New Connector"), handlers.MinLen(1), handlers.MaxLen(100)),
 			handlers.Validation("kafka.id", &resource.Kafka.Id, handlers.MinLen(1), handlers.MaxLen(maxKafkaNameLength)),
@@ -126,6 +131,7 @@ func (h ConnectorsHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 			return presenters.PresentConnector(convResource)
 		},
+		IdempotencyService: h.idempotencyService,
 	}
 
 	// return 202 status accepted
@@ -137,12 +143,21 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 	connectorId := mux.Vars(r)["connector_id"]
 	connectorTypeId := mux.Vars(r)["connector_type_id"]
 	contentType := r.Header.Get("Content-Type")
+	user := h.authZService.GetValidationUser(r.Context())
 
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{
+			user.AuthorizedPermission(rbac.ResourceConnector, rbac.PermissionUpdate),
 			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
 			handlers.Validation("connector_type_id", &connectorTypeId, handlers.MaxLen(maxConnectorTypeIdLength)),
 			handlers.Validation("Content-Type header", &contentType, handlers.IsOneOf("application/json", "application/json-patch+json", "application/merge-patch+json")),
+			handlers.ValidateIfMatch(r, "connectors/"+connectorId, func() (string, *errors.ServiceError) {
+				current, err := h.connectorsService.Get(r.Context(), connectorId, connectorTypeId)
+				if err != nil {
+					return "", err
+				}
+				return handlers.VersionETag(current.Version), nil
+			}),
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 
@@ -154,6 +169,9 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			if serr != nil {
 				return nil, serr
 			}
+			if serr = user.AuthorizedConnectorPermission(&dbresource.Connector, rbac.PermissionUpdate); serr != nil {
+				return nil, serr
+			}
 
 			resource, serr := presenters.PresentConnector(&dbresource.Connector)
 			if serr != nil {
@@ -427,6 +445,7 @@ func (h ConnectorsHandler) Get(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			handlers.SetETag(w, handlers.VersionETag(resource.Version))
 
 			ct, serr := h.connectorTypesService.Get(resource.ConnectorTypeId)
 			if serr != nil {
@@ -449,9 +468,11 @@ func (h ConnectorsHandler) Get(w http.ResponseWriter, r *http.Request) {
 // Delete is the handler for deleting a connector
 func (h ConnectorsHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	connectorId := mux.Vars(r)["connector_id"]
+	user := h.authZService.GetValidationUser(r.Context())
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{
 			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+			user.AuthorizedPermission(rbac.ResourceConnector, rbac.PermissionDelete),
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 
@@ -460,6 +481,9 @@ func (h ConnectorsHandler) Delete(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			if err = user.AuthorizedConnectorPermission(&c.Connector, rbac.PermissionDelete); err != nil {
+				return nil, err
+			}
 
 			// validate delete operation if connector is assigned to a namespace
 			if c.NamespaceId != nil {
@@ -503,10 +527,11 @@ func (h ConnectorsHandler) List(w http.ResponseWriter, r *http.Request) {
 			}
 
 			resourceList := public.ConnectorList{
-				Kind:  "ConnectorList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
+				Kind:       "ConnectorList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				NextCursor: paging.NextCursor,
 			}
 
 			for _, resource := range resources {
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/authz"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+)
+
+// connectorsServiceStub returns the connector it holds and records the updates, the other methods are not implemented
+type connectorsServiceStub struct {
+	services.ConnectorsService
+	connector *dbapi.ConnectorWithConditions
+	updated   bool
+}
+
+func (s *connectorsServiceStub) Get(ctx context.Context, id string, tid string) (*dbapi.ConnectorWithConditions, *errors.ServiceError) {
+	return s.connector, nil
+}
+
+func (s *connectorsServiceStub) Update(ctx context.Context, resource *dbapi.Connector) *errors.ServiceError {
+	s.updated = true
+	return nil
+}
+
+func (s *connectorsServiceStub) SaveStatus(ctx context.Context, resource dbapi.ConnectorStatus) *errors.ServiceError {
+	return nil
+}
+
+type connectorTypesServiceStub struct {
+	services.ConnectorTypesService
+}
+
+func (s *connectorTypesServiceStub) Get(id string) (*dbapi.ConnectorType, *errors.ServiceError) {
+	return &dbapi.ConnectorType{Model: db.Model{ID: id}, JsonSchema: api.JSON(`{"type": "object"}`)}, nil
+}
+
+func buildConnector(owner string) *dbapi.ConnectorWithConditions {
+	return &dbapi.ConnectorWithConditions{
+		Connector: dbapi.Connector{
+			Model:           db.Model{ID: "connector-id"},
+			ConnectorTypeId: "connector-type-id",
+			Owner:           owner,
+			OrganisationId:  "org-id",
+			DesiredState:    dbapi.ConnectorReady,
+			ConnectorSpec:   api.JSON(`{}`),
+		},
+	}
+}
+
+func Test_ConnectorsHandler_OwnerPermissions(t *testing.T) {
+	editor := jwt.MapClaims{"username": "editor", "org_id": "org-id"}
+	orgAdmin := jwt.MapClaims{"username": "org-admin", "org_id": "org-id", "is_org_admin": true}
+
+	tests := []struct {
+		name        string
+		claims      jwt.MapClaims
+		owner       string
+		wantPatched int
+		wantDeleted int
+		wantUpdate  bool
+	}{
+		{
+			name:        "should allow editors to patch and delete the connectors they own",
+			claims:      editor,
+			owner:       "editor",
+			wantPatched: http.StatusAccepted,
+			wantDeleted: http.StatusNoContent,
+			wantUpdate:  true,
+		},
+		{
+			name:        "should deny editors to patch and delete the connectors of other members",
+			claims:      editor,
+			owner:       "other-member",
+			wantPatched: http.StatusForbidden,
+			wantDeleted: http.StatusForbidden,
+		},
+		{
+			name:        "should allow administrators to patch and delete the connectors of other members",
+			claims:      orgAdmin,
+			owner:       "other-member",
+			wantPatched: http.StatusAccepted,
+			wantDeleted: http.StatusNoContent,
+			wantUpdate:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			ctx := auth.SetTokenInContext(context.Background(), &jwt.Token{Claims: tt.claims})
+			ctx = rbac.SetRoleContext(ctx, rbac.DefaultRole(tt.claims))
+
+			connectorsService := &connectorsServiceStub{connector: buildConnector(tt.owner)}
+			h := NewConnectorsHandler(connectorsService, &connectorTypesServiceStub{}, nil, nil,
+				authz.NewAuthZService(nil, nil, nil), &config.ConnectorsConfig{}, nil)
+
+			req := httptest.NewRequest(http.MethodPatch, "/connectors/connector-id", strings.NewReader(`{}`)).WithContext(ctx)
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+			req = mux.SetURLVars(req, map[string]string{"connector_id": "connector-id"})
+			rw := httptest.NewRecorder()
+			h.Patch(rw, req)
+			Expect(rw.Code).To(Equal(tt.wantPatched), rw.Body.String())
+
+			req = httptest.NewRequest(http.MethodDelete, "/connectors/connector-id", nil).WithContext(ctx)
+			req = mux.SetURLVars(req, map[string]string{"connector_id": "connector-id"})
+			rw = httptest.NewRecorder()
+			h.Delete(rw, req)
+			Expect(rw.Code).To(Equal(tt.wantDeleted))
+			Expect(connectorsService.updated).To(Equal(tt.wantUpdate))
+		})
+	}
+}
@@ -10,11 +10,16 @@ const (
 
 	// label for operation name
 	labelOperation = "operation"
+	// label for connector cluster id
+	labelClusterId = "cluster_id"
 
 	VaultServiceTotalCount   = "vault_service_total_count"
 	VaultServiceSuccessCount = "vault_service_success_count"
 	VaultServiceFailureCount = "vault_service_failure_count"
 	VaultServiceErrorsCount  = "vault_service_errors_count"
+
+	ConnectorClusterDisconnectedCount = "connector_cluster_disconnected_count"
+	ConnectorClusterReconnectedCount  = "connector_cluster_reconnected_count"
 )
 
 var VaultServiceMetricsLabels = []string{
@@ -81,6 +86,38 @@ func IncreaseVaultServiceErrorsCount(operationType string) {
 
 // #### Metrics for Vault Service - End ####
 
+// #### Metrics for Connector Clusters ####
+
+var connectorClusterDisconnectedCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: CosFleetManager,
+		Name:      ConnectorClusterDisconnectedCount,
+		Help:      "number of times a ready connector cluster was disconnected because its agent stopped reporting the cluster status",
+	}, []string{labelClusterId})
+
+func IncreaseConnectorClusterDisconnectedCount(clusterId string) {
+	labels := prometheus.Labels{
+		labelClusterId: clusterId,
+	}
+	connectorClusterDisconnectedCountMetric.With(labels).Inc()
+}
+
+var connectorClusterReconnectedCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: CosFleetManager,
+		Name:      ConnectorClusterReconnectedCount,
+		Help:      "number of times a disconnected connector cluster was connected again because its agent reported the cluster status",
+	}, []string{labelClusterId})
+
+func IncreaseConnectorClusterReconnectedCount(clusterId string) {
+	labels := prometheus.Labels{
+		labelClusterId: clusterId,
+	}
+	connectorClusterReconnectedCountMetric.With(labels).Inc()
+}
+
+// #### Metrics for Connector Clusters - End ####
+
 // register the metric(s)
 func init() {
 	// metrics for vault service
@@ -88,6 +125,10 @@ func init() {
 	prometheus.MustRegister(vaultServiceSuccessCountMetric)
 	prometheus.MustRegister(vaultServiceFailureCountMetric)
 	prometheus.MustRegister(vaultServiceErrorsCountMetric)
+
+	// metrics for connector clusters
+	prometheus.MustRegister(connectorClusterDisconnectedCountMetric)
+	prometheus.MustRegister(connectorClusterReconnectedCountMetric)
 }
 
 // ResetMetricsForVaultService will reset the metrics related to Vault Service requests
@@ -102,4 +143,5 @@ func ResetMetricsForVaultService() {
 // Reset the metrics we have defined. It is mainly used for testing.
 func Reset() {
 	ResetMetricsForVaultService()
+	connectorClusterDisconnectedCountMetric.Reset()
 }
@@ -0,0 +1,27 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addConnectorClusterStatusReportedAt(migrationId string) *gormigrate.Migration {
+
+	type ConnectorCluster struct {
+		StatusReportedAt *time.Time
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		// add the time of the last status report of the agent
+		db.AddTableColumnsAction(&ConnectorCluster{}),
+		// the ready clusters are checked from now on, instead of from their last update which may be long gone
+		db.ExecAction(`UPDATE connector_clusters SET status_reported_at = now() WHERE status_reported_at IS NULL AND status_phase = 'ready'`, ``),
+	)
+}
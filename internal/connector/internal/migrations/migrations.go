@@ -40,6 +40,10 @@ var migrations = []*gormigrate.Migration{
 	addConnectorNamespaceVersion("202203240000"),
 	addConnectorClusterClientSecret("202203310000"),
 	addConnectorTypeChecksum("202204050000"),
+	addIdempotencyKeys("202204140000"),
+	addRateLimitBuckets("202204150000"),
+	addRoleBindings("202204200000"),
+	addConnectorClusterStatusReportedAt("202204250000"),
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
@@ -14,6 +14,8 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	kerrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	coreHandlers "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/ratelimit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/goava/di"
@@ -27,7 +29,10 @@ type options struct {
 	ConnectorsConfig          *config.ConnectorsConfig
 	ServerConfig              *server.ServerConfig
 	ErrorsHandler             *coreHandlers.ErrorHandler
+	WorkersHandler            *coreHandlers.WorkersHandler
+	ConfigsHandler            *coreHandlers.ConfigsHandler
 	AuthorizeMiddleware       *acl.AccessControlListMiddleware
+	RateLimitMiddleware       *ratelimit.RateLimitMiddleware
 	KeycloakService           sso.KafkaKeycloakService
 	AuthAgentService          auth.AuthAgentService
 	ConnectorAdminHandler     *handlers.ConnectorAdminHandler
@@ -35,6 +40,8 @@ type options struct {
 	ConnectorsHandler         *handlers.ConnectorsHandler
 	ConnectorClusterHandler   *handlers.ConnectorClusterHandler
 	ConnectorNamespaceHandler *handlers.ConnectorNamespaceHandler
+	RoleMiddleware            *rbac.RoleMiddleware
+	RoleBindingService        rbac.RoleBindingService
 	DB                        *db.ConnectionFactory
 }
 
@@ -44,13 +51,17 @@ func NewRouteLoader(s options) environments.RouteLoader {
 
 func (s *options) AddRoutes(mainRouter *mux.Router) error {
 
-	authorizeMiddleware := s.AuthorizeMiddleware.Authorize
+	authorizeMiddleware := s.AuthorizeMiddleware.Authorize(acl.APIConnectorMgmt)
 	requireOrgID := auth.NewRequireOrgIDMiddleware().RequireOrgID(kerrors.ErrorUnauthenticated)
 
 	openAPIDefinitions, err := shared.LoadOpenAPISpec(generated.Asset, "connector_mgmt.yaml")
 	if err != nil {
 		return errors.Wrap(err, "Can't load OpenAPI specification")
 	}
+	openAPIValidationMiddleware, err := coreHandlers.NewOpenAPIValidationMiddleware(openAPIDefinitions)
+	if err != nil {
+		return errors.Wrap(err, "Can't create OpenAPI validation middleware")
+	}
 
 	//  /api/connector_mgmt
 	apiRouter := mainRouter.PathPrefix("/api/connector_mgmt").Subrouter()
@@ -93,6 +104,7 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Delete).Methods(http.MethodDelete)
 	apiV1ConnectorsRouter.Use(authorizeMiddleware)
 	apiV1ConnectorsRouter.Use(requireOrgID)
+	apiV1ConnectorsRouter.Use(s.RoleMiddleware.ResolveRole)
 
 	//  /api/connector_mgmt/v1/kafka_connector_clusters
 	v1Collections = append(v1Collections, api.CollectionMetadata{
@@ -110,6 +122,7 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	apiV1ConnectorClustersRouter.HandleFunc("/{connector_cluster_id}/namespaces", s.ConnectorClusterHandler.GetNamespaces).Methods(http.MethodGet)
 	apiV1ConnectorClustersRouter.Use(authorizeMiddleware)
 	apiV1ConnectorClustersRouter.Use(requireOrgID)
+	apiV1ConnectorClustersRouter.Use(s.RoleMiddleware.ResolveRole)
 
 	//  /api/connector_mgmt/v1/kafka_connector_namespaces
 	v1Collections = append(v1Collections, api.CollectionMetadata{
@@ -132,6 +145,29 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	}
 	apiV1ConnectorNamespacesRouter.Use(authorizeMiddleware)
 	apiV1ConnectorNamespacesRouter.Use(requireOrgID)
+	apiV1ConnectorNamespacesRouter.Use(s.RoleMiddleware.ResolveRole)
+
+	//  /api/connector_mgmt/v1/role_bindings
+	v1Collections = append(v1Collections, api.CollectionMetadata{
+		ID:   "role_bindings",
+		Kind: "RoleBindingList",
+	})
+
+	roleBindingsHandler := coreHandlers.NewRoleBindingsHandler(s.RoleBindingService, "/api/connector_mgmt/v1")
+	apiV1RoleBindingsRouter := apiV1Router.PathPrefix("/role_bindings").Subrouter()
+	apiV1RoleBindingsRouter.HandleFunc("", roleBindingsHandler.List).Methods(http.MethodGet)
+	apiV1RoleBindingsRouter.HandleFunc("", roleBindingsHandler.Create).Methods(http.MethodPost)
+	apiV1RoleBindingsRouter.HandleFunc("/{id}", roleBindingsHandler.Delete).Methods(http.MethodDelete)
+	apiV1RoleBindingsRouter.Use(authorizeMiddleware)
+	apiV1RoleBindingsRouter.Use(requireOrgID)
+	apiV1RoleBindingsRouter.Use(s.RoleMiddleware.ResolveRole)
+
+	//  /api/connector_mgmt/v1/permissions
+	apiV1PermissionsRouter := apiV1Router.Path("/permissions").Subrouter()
+	apiV1PermissionsRouter.HandleFunc("", roleBindingsHandler.GetPermissions).Methods(http.MethodGet)
+	apiV1PermissionsRouter.Use(authorizeMiddleware)
+	apiV1PermissionsRouter.Use(requireOrgID)
+	apiV1PermissionsRouter.Use(s.RoleMiddleware.ResolveRole)
 
 	// This section adds the API's accessed by the connector agent...
 	{
@@ -169,6 +205,9 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	adminRouter.HandleFunc("/kafka_connector_namespaces/{namespace_id}/connectors", s.ConnectorAdminHandler.GetNamespaceConnectors).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/kafka_connectors/{connector_id}", s.ConnectorAdminHandler.GetConnector).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/kafka_connectors/{connector_id}", s.ConnectorAdminHandler.DeleteConnector).Methods(http.MethodDelete)
+	adminRouter.HandleFunc("/workers", s.WorkersHandler.List).Methods(http.MethodGet)
+	adminRouter.HandleFunc("/workers/{type}/reconcile", s.WorkersHandler.Reconcile).Methods(http.MethodPost)
+	adminRouter.HandleFunc("/configs", s.ConfigsHandler.List).Methods(http.MethodGet)
 
 	v1Metadata := api.VersionMetadata{
 		ID:          "v1",
@@ -185,6 +224,8 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	apiV1Router.HandleFunc("", v1Metadata.ServeHTTP).Methods(http.MethodGet)
 
 	apiRouter.Use(coreHandlers.MetricsMiddleware)
+	apiRouter.Use(s.RateLimitMiddleware.RateLimit)
+	apiRouter.Use(openAPIValidationMiddleware.Validate)
 	apiRouter.Use(db.TransactionMiddleware(s.DB))
 	apiRouter.Use(gorillaHandlers.CompressHandler)
 	return nil
@@ -0,0 +1,92 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/generated"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
+	coreHandlers "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/ratelimit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+)
+
+var (
+	pathParamRegexp = regexp.MustCompile(`{[^}]+}`)
+	httpMethods     = []string{http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete, http.MethodPatch}
+)
+
+func newTestRouter() *mux.Router {
+	connectorsConfig := config.NewConnectorsConfig()
+	connectorsConfig.ConnectorNamespaceLifecycleAPI = true
+	s := &options{
+		ConnectorsConfig: connectorsConfig,
+		ServerConfig:     server.NewServerConfig(),
+		ErrorsHandler:    coreHandlers.NewErrorsHandler(),
+		KeycloakService: &sso.KeycloakServiceMock{
+			GetConfigFunc: keycloak.NewKeycloakConfig,
+		},
+		RateLimitMiddleware:       ratelimit.NewRateLimitMiddleware(ratelimit.NewRateLimitConfig(), ratelimit.NewMemoryLimiter()),
+		ConnectorAdminHandler:     &handlers.ConnectorAdminHandler{},
+		ConnectorTypesHandler:     &handlers.ConnectorTypesHandler{},
+		ConnectorsHandler:         &handlers.ConnectorsHandler{},
+		ConnectorClusterHandler:   &handlers.ConnectorClusterHandler{},
+		ConnectorNamespaceHandler: &handlers.ConnectorNamespaceHandler{},
+	}
+	router := mux.NewRouter()
+	Expect(s.AddRoutes(router)).To(Succeed())
+	return router
+}
+
+// Test_AddRoutes_OpenAPIOperations checks that every operation of the OpenAPI document has a route, so that
+// the requests validated against the document reach the handlers
+func Test_AddRoutes_OpenAPIOperations(t *testing.T) {
+	RegisterTestingT(t)
+	router := newTestRouter()
+
+	openAPIDefinitions, err := shared.LoadOpenAPISpec(generated.Asset, "connector_mgmt.yaml")
+	Expect(err).NotTo(HaveOccurred())
+	var doc struct {
+		Paths map[string]map[string]interface{} `json:"paths"`
+	}
+	Expect(json.Unmarshal(openAPIDefinitions, &doc)).To(Succeed())
+
+	for path, pathItem := range doc.Paths {
+		for method := range pathItem {
+			method, path := strings.ToUpper(method), path
+			if !shared.Contains(httpMethods, method) {
+				continue
+			}
+			t.Run(method+" "+path, func(t *testing.T) {
+				RegisterTestingT(t)
+				req := httptest.NewRequest(method, pathParamRegexp.ReplaceAllString(path, "test-id"), nil)
+				var match mux.RouteMatch
+				Expect(router.Match(req, &match)).To(BeTrue())
+				Expect(match.MatchErr).NotTo(HaveOccurred())
+			})
+		}
+	}
+}
+
+func Test_AddRoutes_OpenAPIValidation(t *testing.T) {
+	RegisterTestingT(t)
+	router := newTestRouter()
+
+	// the request is rejected before reaching the authorization middlewares and the handler
+	req := httptest.NewRequest(http.MethodPost, "/api/connector_mgmt/v1/kafka_connectors?async=true", strings.NewReader(`{"name": 1}`))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	Expect(recorder.Body.String()).To(ContainSubstring("body.name"))
+}
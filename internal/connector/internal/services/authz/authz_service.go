@@ -2,9 +2,11 @@ package authz
 
 import (
 	"context"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
 	"github.com/golang-jwt/jwt/v4"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -89,6 +91,32 @@ func (u *ValidationUser) AuthorizedOrgAdmin() handlers.Validate {
 	}
 }
 
+// AuthorizedPermission validates that the role of the user grants the permission on some resources of the type, the
+// permission on a given connector is checked with AuthorizedConnectorPermission once it has been loaded
+func (u *ValidationUser) AuthorizedPermission(resourceType rbac.ResourceType, permission rbac.Permission) handlers.Validate {
+	return func() (err *errors.ServiceError) {
+		if u.err != nil {
+			err = u.err
+		} else if !rbac.EvaluateAny(u.ctx, resourceType, permission) {
+			err = unauthorizedError
+		}
+		return err
+	}
+}
+
+// AuthorizedConnectorPermission validates that the role of the user grants the permission on the connector, e.g. that
+// editors only update or delete the connectors they own. It is called once the connector has been loaded.
+func (u *ValidationUser) AuthorizedConnectorPermission(connector *dbapi.Connector, permission rbac.Permission) *errors.ServiceError {
+	if u.err != nil {
+		return u.err
+	}
+	resource := rbac.Resource{Type: rbac.ResourceConnector, OrganisationId: connector.OrganisationId, Owner: connector.Owner}
+	if !rbac.Evaluate(u.ctx, permission, resource) {
+		return unauthorizedError
+	}
+	return nil
+}
+
 func (u *ValidationUser) AuthorizedClusterAdmin() handlers.ValidateOption {
 	return func(field string, value *string) (err *errors.ServiceError) {
 		if u.err != nil {
@@ -187,8 +215,9 @@ func (u *ValidationUser) ValidateNamespaceConnectorQuota() handlers.ValidateOpti
 	}
 }
 
+// IsOrgAdmin returns true when the user has the admin role in its organisation
 func (u *User) IsOrgAdmin() bool {
-	return auth.GetIsOrgAdminFromClaims(u.claims)
+	return rbac.GetRoleFromContext(u.ctx) == rbac.RoleAdmin
 }
 
 func (u *User) IsAdmin() bool {
@@ -9,14 +9,17 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
 	"github.com/golang/glog"
 	"reflect"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/secrets"
@@ -24,6 +27,16 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	// connectorClusterStatusReportResolution is the minimum time between two updates of the last status report time of a connector cluster
+	connectorClusterStatusReportResolution = time.Minute
+
+	// ConnectorClusterDisconnectedEvent - event of a ready connector cluster whose agent stopped reporting the cluster status
+	ConnectorClusterDisconnectedEvent = "connector-cluster-disconnected"
+	// ConnectorClusterReconnectedEvent - event of a disconnected connector cluster whose agent reported the cluster status again
+	ConnectorClusterReconnectedEvent = "connector-cluster-reconnected"
+)
+
 type ConnectorClusterService interface {
 	Create(ctx context.Context, resource *dbapi.ConnectorCluster) *errors.ServiceError
 	Get(ctx context.Context, id string) (dbapi.ConnectorCluster, *errors.ServiceError)
@@ -46,6 +59,7 @@ type ConnectorClusterService interface {
 	UpgradeConnectorsByOperator(ctx context.Context, clusterId string, upgrades dbapi.ConnectorDeploymentOperatorUpgradeList) *errors.ServiceError
 	CleanupDeployments() *errors.ServiceError
 	ReconcileDeletingClusters() (int, []*errors.ServiceError)
+	ReconcileUnresponsiveClusters(threshold time.Duration) (int, []*errors.ServiceError)
 	GetClusterOrg(id string) (string, *errors.ServiceError)
 }
 
@@ -318,6 +332,9 @@ func (k *connectorClusterService) UpdateConnectorClusterStatus(ctx context.Conte
 		return services.HandleGetError("Connector cluster status", "id", id, err)
 	}
 
+	// a cluster that already reported its status and is disconnected was disconnected by ReconcileUnresponsiveClusters
+	reconnected := resource.Status.Phase == dbapi.ConnectorClusterPhaseDisconnected && resource.StatusReportedAt != nil
+
 	// compare current and requested cluster phases to validate that agent can connect
 	updated, err := phase.PerformClusterOperation(&resource, phase.ConnectCluster)
 	if err != nil {
@@ -327,9 +344,17 @@ func (k *connectorClusterService) UpdateConnectorClusterStatus(ctx context.Conte
 	// agent doesn't directly modify cluster phase, that's done in PerformClusterOperation()
 	status.Phase = resource.Status.Phase
 
-	if updated || !reflect.DeepEqual(resource.Status, status) {
+	// the time of the report is only refreshed once per connectorClusterStatusReportResolution
+	now := time.Now()
+	reportDue := resource.StatusReportedAt == nil || now.Sub(*resource.StatusReportedAt) >= connectorClusterStatusReportResolution
+
+	if updated || reportDue || !reflect.DeepEqual(resource.Status, status) {
 
 		if updated {
+			if reconnected {
+				metrics.IncreaseConnectorClusterReconnectedCount(id)
+				logger.NewEventLogger(ConnectorClusterReconnectedEvent).Infof("agent of disconnected connector cluster %s reported the cluster status again", id)
+			}
 			// The phase should not be changing that often.. but when it does,
 			// kick off a reconcile to get connectors deployed to the cluster.
 			_ = db.AddPostCommitAction(ctx, func() {
@@ -338,14 +363,18 @@ func (k *connectorClusterService) UpdateConnectorClusterStatus(ctx context.Conte
 			})
 		}
 
-		if err := dbConn.Updates(&dbapi.ConnectorCluster{
+		update := &dbapi.ConnectorCluster{
 			Model: db.Model{ID: id},
 			Status: dbapi.ConnectorClusterStatus{
 				Phase:      resource.Status.Phase,
 				Version:    status.Version,
 				Conditions: status.Conditions,
 				Operators:  status.Operators,
-			}}).Error; err != nil {
+			}}
+		if reportDue {
+			update.StatusReportedAt = &now
+		}
+		if err := dbConn.Updates(update).Error; err != nil {
 			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update status")
 		}
 	}
@@ -415,24 +444,48 @@ func (k *connectorClusterService) ListConnectorDeployments(ctx context.Context,
 		dbConn = dbConn.Where("connector_deployments.version > ?", gtVersion)
 	}
 
-	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
-	total := int64(pagingMeta.Total)
-	dbConn.Model(&resourceList).Count(&total)
-	pagingMeta.Total = int(total)
-
-	if pagingMeta.Size > pagingMeta.Total {
-		pagingMeta.Size = pagingMeta.Total
+	var keyset *services.KeysetPaging
+	if listArgs.CursorPaging {
+		var err error
+		// deployments are always ordered by version
+		keyset, err = services.NewKeysetPaging(&dbapi.ConnectorDeployment{}, "connector_deployments", listArgs, "version")
+		if err != nil {
+			return resourceList, pagingMeta, errors.NewWithCause(errors.ErrorBadRequest, err, "Unable to list connector deployments for cluster %s: %s", id, err)
+		}
 	}
-	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 
-	// default the order by version
-	dbConn = dbConn.Order("connector_deployments.version")
+	if keyset != nil {
+		// the keyset pages are not counted, as the count would scan all the resources for every page
+		dbConn = keyset.Apply(dbConn)
+	} else {
+		// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
+		total := int64(pagingMeta.Total)
+		dbConn.Model(&resourceList).Count(&total)
+		pagingMeta.Total = int(total)
+
+		if pagingMeta.Size > pagingMeta.Total {
+			pagingMeta.Size = pagingMeta.Total
+		}
+		dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
+
+		// default the order by version
+		dbConn = dbConn.Order("connector_deployments.version")
+	}
 
 	// execute query
 	if err := dbConn.Find(&resourceList).Error; err != nil {
 		return resourceList, pagingMeta, errors.GeneralError("Unable to list connector deployments for cluster %s: %s", id, err)
 	}
 
+	if keyset != nil {
+		nextCursor, err := keyset.Page(&resourceList)
+		if err != nil {
+			return resourceList, pagingMeta, errors.GeneralError("Unable to list connector deployments for cluster %s: %s", id, err)
+		}
+		pagingMeta.NextCursor = nextCursor
+		pagingMeta.Size = len(resourceList)
+	}
+
 	return resourceList, pagingMeta, nil
 }
 
@@ -914,6 +967,58 @@ func (k *connectorClusterService) ReconcileDeletingClusters() (int, []*errors.Se
 	return count, errs
 }
 
+// ReconcileUnresponsiveClusters disconnects the ready clusters whose agent did not report the cluster status for longer
+// than the threshold. The clusters that never reported their status since the status report time is recorded are
+// checked against their last update. A disconnected cluster is connected again by the next status report of its agent.
+func (k *connectorClusterService) ReconcileUnresponsiveClusters(threshold time.Duration) (int, []*errors.ServiceError) {
+	dbConn := k.connectionFactory.New()
+	deadline := time.Now().Add(-threshold)
+
+	var clusters []dbapi.ConnectorCluster
+	if err := dbConn.Select("id", "status_phase", "status_reported_at", "updated_at").
+		Where("status_phase = ?", dbapi.ConnectorClusterPhaseReady).
+		Where("status_reported_at < ? OR (status_reported_at IS NULL AND updated_at < ?)", deadline, deadline).
+		Find(&clusters).Error; err != nil {
+		return 0, []*errors.ServiceError{services.HandleGetError("Connector cluster",
+			"status_phase", dbapi.ConnectorClusterPhaseReady, err)}
+	}
+
+	count := 0
+	var errs []*errors.ServiceError
+	for i := range clusters {
+		cluster := &clusters[i]
+		lastReport := cluster.UpdatedAt
+		if cluster.StatusReportedAt != nil {
+			lastReport = *cluster.StatusReportedAt
+		}
+
+		disconnected := false
+		if _, err := phase.PerformClusterOperation(cluster, phase.DisconnectCluster,
+			func(cluster *dbapi.ConnectorCluster) *errors.ServiceError {
+				// the agent may have reported the cluster status in the meantime, only disconnect the cluster if it is still ready
+				result := dbConn.Model(cluster).Where("status_phase = ?", dbapi.ConnectorClusterPhaseReady).
+					Update("status_phase", cluster.Status.Phase)
+				if result.Error != nil {
+					return services.HandleUpdateError("Connector cluster", result.Error)
+				}
+				disconnected = result.RowsAffected > 0
+				return nil
+			}); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if disconnected {
+			count++
+			metrics.IncreaseConnectorClusterDisconnectedCount(cluster.ID)
+			logger.NewEventLogger(ConnectorClusterDisconnectedEvent).Warningf("agent of connector cluster %s did not report the cluster status for %s, the cluster is now disconnected",
+				cluster.ID, time.Since(lastReport).Round(time.Second))
+		}
+	}
+
+	return count, errs
+}
+
 func (k *connectorClusterService) GetClusterOrg(id string) (string, *errors.ServiceError) {
 	dbConn := k.connectionFactory.New()
 	cluster := dbapi.ConnectorCluster{}
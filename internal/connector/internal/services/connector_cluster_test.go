@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+// connectorClusterCount returns the value of the connector cluster counter with the given name for the cluster
+func connectorClusterCount(name string, clusterId string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	Expect(err).To(BeNil())
+	for _, family := range families {
+		if family.GetName() != metrics.CosFleetManager+"_"+name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "cluster_id" && label.GetValue() == clusterId {
+					return metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+// recordUpdates records the arguments of the updates of the connector clusters
+func recordUpdates(mock *mocket.FakeResponse, updates *[][]interface{}) *mocket.FakeResponse {
+	return mock.WithCallback(func(_ string, args []driver.NamedValue) {
+		var values []interface{}
+		for _, arg := range args {
+			values = append(values, arg.Value)
+		}
+		*updates = append(*updates, values)
+	})
+}
+
+func Test_connectorClusterService_ReconcileUnresponsiveClusters(t *testing.T) {
+	const selectClusters = `SELECT "id","status_phase","status_reported_at","updated_at" FROM "connector_clusters" ` +
+		`WHERE status_phase = $1 AND (status_reported_at < $2 OR (status_reported_at IS NULL AND updated_at < $3))`
+	const disconnectCluster = `UPDATE "connector_clusters" SET "status_phase"=$1,"updated_at"=$2 WHERE status_phase = $3 AND "id" = $4`
+
+	tests := []struct {
+		name       string
+		clusterId  string
+		rowsNum    int64
+		selectErr  bool
+		updateErr  bool
+		wantCount  int
+		wantErrs   int
+		wantUpdate bool
+	}{
+		{
+			name:       "should disconnect the ready cluster that did not report its status",
+			clusterId:  "unresponsive-cluster",
+			rowsNum:    1,
+			wantCount:  1,
+			wantUpdate: true,
+		},
+		{
+			name:       "should not disconnect the cluster that reported its status in the meantime",
+			clusterId:  "reporting-cluster",
+			rowsNum:    0,
+			wantUpdate: true,
+		},
+		{
+			name:      "should return an error when the clusters cannot be listed",
+			clusterId: "unlisted-cluster",
+			selectErr: true,
+			wantErrs:  1,
+		},
+		{
+			name:      "should return an error when the cluster cannot be disconnected",
+			clusterId: "failing-cluster",
+			updateErr: true,
+			wantErrs:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			k := NewConnectorClusterService(db.NewMockConnectionFactory(nil), signalbus.NewSignalBus(), nil, nil, nil, nil, nil)
+			mocket.Catcher.Reset()
+
+			var selectArgs []interface{}
+			selectMock := mocket.Catcher.NewMock().WithQuery(selectClusters)
+			if tt.selectErr {
+				selectMock.WithQueryException()
+			} else {
+				selectMock.WithCallback(func(_ string, args []driver.NamedValue) {
+					for _, arg := range args {
+						selectArgs = append(selectArgs, arg.Value)
+					}
+				}).WithReply([]map[string]interface{}{{
+					"id":           tt.clusterId,
+					"status_phase": string(dbapi.ConnectorClusterPhaseReady),
+					"updated_at":   time.Now().Add(-time.Hour),
+				}})
+			}
+			var updates [][]interface{}
+			updateMock := recordUpdates(mocket.Catcher.NewMock().WithQuery(disconnectCluster), &updates)
+			if tt.updateErr {
+				updateMock.WithExecException()
+			} else {
+				updateMock.WithRowsNum(tt.rowsNum)
+			}
+
+			disconnectedCount := connectorClusterCount(metrics.ConnectorClusterDisconnectedCount, tt.clusterId)
+			before := time.Now()
+			count, errs := k.ReconcileUnresponsiveClusters(time.Minute)
+			Expect(count).To(Equal(tt.wantCount))
+			Expect(errs).To(HaveLen(tt.wantErrs))
+			Expect(connectorClusterCount(metrics.ConnectorClusterDisconnectedCount, tt.clusterId)).To(Equal(disconnectedCount + float64(tt.wantCount)))
+
+			if !tt.selectErr {
+				// only the ready clusters that did not report their status since the deadline are disconnected
+				Expect(selectArgs).To(HaveLen(3))
+				Expect(selectArgs[0]).To(Equal(string(dbapi.ConnectorClusterPhaseReady)))
+				Expect(selectArgs[1]).To(BeTemporally("~", before.Add(-time.Minute), time.Second))
+				Expect(selectArgs[2]).To(Equal(selectArgs[1]))
+			}
+			if !tt.wantUpdate {
+				Expect(updates).To(BeEmpty())
+				return
+			}
+			// the cluster is only disconnected when it is still ready
+			Expect(updates).To(HaveLen(1))
+			Expect(updates[0][0]).To(Equal(string(dbapi.ConnectorClusterPhaseDisconnected)))
+			Expect(updates[0][2]).To(Equal(string(dbapi.ConnectorClusterPhaseReady)))
+			Expect(updates[0][3]).To(Equal(tt.clusterId))
+		})
+	}
+}
+
+func Test_connectorClusterService_UpdateConnectorClusterStatus(t *testing.T) {
+	const selectCluster = `SELECT * FROM "connector_clusters" WHERE id = $1`
+	const updateStatus = `UPDATE "connector_clusters" SET`
+	const updateStatusReported = `UPDATE "connector_clusters" SET "updated_at"=$1,"status_phase"=$2,"status_version"=$3,"status_reported_at"=$4 WHERE "id" = $5`
+	const updateStatusOnly = `UPDATE "connector_clusters" SET "updated_at"=$1,"status_phase"=$2,"status_version"=$3 WHERE "id" = $4`
+
+	reportedAt := func(age time.Duration) *time.Time {
+		reportedAt := time.Now().Add(-age)
+		return &reportedAt
+	}
+	tests := []struct {
+		name            string
+		clusterId       string
+		phase           dbapi.ConnectorClusterPhaseEnum
+		reportedAt      *time.Time
+		version         string
+		wantUpdate      string
+		wantReconnected bool
+	}{
+		{
+			name:            "should reconnect the cluster that was disconnected after reporting its status",
+			clusterId:       "disconnected-cluster",
+			phase:           dbapi.ConnectorClusterPhaseDisconnected,
+			reportedAt:      reportedAt(time.Hour),
+			version:         "1.0.0",
+			wantUpdate:      updateStatusReported,
+			wantReconnected: true,
+		},
+		{
+			name:       "should connect the new cluster without a reconnect event",
+			clusterId:  "new-cluster",
+			phase:      dbapi.ConnectorClusterPhaseDisconnected,
+			version:    "1.0.0",
+			wantUpdate: updateStatusReported,
+		},
+		{
+			name:       "should record the time of the report when it is due",
+			clusterId:  "ready-cluster",
+			phase:      dbapi.ConnectorClusterPhaseReady,
+			reportedAt: reportedAt(2 * time.Minute),
+			version:    "1.0.0",
+			wantUpdate: updateStatusReported,
+		},
+		{
+			name:       "should update the changed status without the time of the report when it is not due",
+			clusterId:  "ready-cluster",
+			phase:      dbapi.ConnectorClusterPhaseReady,
+			reportedAt: reportedAt(10 * time.Second),
+			version:    "1.0.1",
+			wantUpdate: updateStatusOnly,
+		},
+		{
+			name:       "should not update the unchanged status when the report is not due",
+			clusterId:  "ready-cluster",
+			phase:      dbapi.ConnectorClusterPhaseReady,
+			reportedAt: reportedAt(10 * time.Second),
+			version:    "1.0.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			k := NewConnectorClusterService(db.NewMockConnectionFactory(nil), signalbus.NewSignalBus(), nil, nil, nil, nil, nil)
+			mocket.Catcher.Reset()
+
+			row := map[string]interface{}{
+				"id":             tt.clusterId,
+				"status_phase":   string(tt.phase),
+				"status_version": "1.0.0",
+			}
+			if tt.reportedAt != nil {
+				row["status_reported_at"] = *tt.reportedAt
+			}
+			mocket.Catcher.NewMock().WithQuery(selectCluster).WithArgs(tt.clusterId).WithReply([]map[string]interface{}{row})
+			var updates [][]interface{}
+			recordUpdates(mocket.Catcher.NewMock().WithQuery(updateStatus), &updates)
+
+			reconnectedCount := connectorClusterCount(metrics.ConnectorClusterReconnectedCount, tt.clusterId)
+			before := time.Now()
+			err := k.UpdateConnectorClusterStatus(context.Background(), tt.clusterId, dbapi.ConnectorClusterStatus{Version: tt.version})
+			Expect(err).To(BeNil())
+
+			wantReconnectedCount := reconnectedCount
+			if tt.wantReconnected {
+				wantReconnectedCount++
+			}
+			Expect(connectorClusterCount(metrics.ConnectorClusterReconnectedCount, tt.clusterId)).To(Equal(wantReconnectedCount))
+
+			if tt.wantUpdate == "" {
+				Expect(updates).To(BeEmpty())
+				return
+			}
+			Expect(updates).To(HaveLen(1))
+			Expect(mocket.Catcher.FindResponse(tt.wantUpdate, nil).Triggered).To(BeTrue())
+			Expect(updates[0][1]).To(Equal(string(dbapi.ConnectorClusterPhaseReady)))
+			Expect(updates[0][2]).To(Equal(tt.version))
+			if tt.wantUpdate == updateStatusReported {
+				Expect(updates[0][3]).To(BeTemporally(">=", before))
+			}
+		})
+	}
+}
+
+func Test_connectorClusterService_UpdateConnectorClusterStatus_NotFound(t *testing.T) {
+	RegisterTestingT(t)
+	k := NewConnectorClusterService(db.NewMockConnectionFactory(nil), signalbus.NewSignalBus(), nil, nil, nil, nil, nil)
+	mocket.Catcher.Reset()
+
+	err := k.UpdateConnectorClusterStatus(context.Background(), "missing-cluster", dbapi.ConnectorClusterStatus{})
+	Expect(err).ToNot(BeNil())
+	Expect(err.Is404()).To(BeTrue())
+}
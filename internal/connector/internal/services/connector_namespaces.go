@@ -154,7 +154,10 @@ func (k *connectorNamespaceService) Get(ctx context.Context, namespaceID string)
 	return result, nil
 }
 
-var validNamespaceColumns = []string{"name", "cluster_id", "owner", "expiration", "tenant_user_id", "tenant_organisation_id"}
+// connectorNamespaceKeysetColumns are the columns, besides name, that namespaces can be ordered by when listing with a cursor
+var connectorNamespaceKeysetColumns = []string{"created_at", "updated_at", "id"}
+
+var validNamespaceColumns = []string{"name", "cluster_id", "owner", "expiration", "tenant_user_id", "tenant_organisation_id", "created_at", "updated_at"}
 
 func (k *connectorNamespaceService) List(ctx context.Context, clusterIDs []string, listArguments *services.ListArguments, gtVersion int64) (dbapi.ConnectorNamespaceList, *api.PagingMeta, *errors.ServiceError) {
 	var resourceList dbapi.ConnectorNamespaceList
@@ -183,23 +186,37 @@ func (k *connectorNamespaceService) List(ctx context.Context, clusterIDs []strin
 		dbConn = dbConn.Where("connector_namespaces.version > ?", gtVersion)
 	}
 
-	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
-	total := int64(pagingMeta.Total)
-	dbConn.Count(&total)
-	pagingMeta.Total = int(total)
-	if pagingMeta.Size > pagingMeta.Total {
-		pagingMeta.Size = pagingMeta.Total
+	var keyset *services.KeysetPaging
+	if listArguments.CursorPaging {
+		var err error
+		keyset, err = services.NewKeysetPaging(&dbapi.ConnectorNamespace{}, "connector_namespaces", listArguments, "name", connectorNamespaceKeysetColumns...)
+		if err != nil {
+			return resourceList, &pagingMeta, errors.NewWithCause(errors.ErrorBadRequest, err, "Unable to list connector namespace requests: %s", err.Error())
+		}
 	}
-	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 
-	if len(listArguments.OrderBy) == 0 {
-		// default orderBy name
-		dbConn = dbConn.Order("name ASC")
-	}
+	if keyset != nil {
+		// the keyset pages are not counted, as the count would scan all the resources for every page
+		dbConn = keyset.Apply(dbConn)
+	} else {
+		// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
+		total := int64(pagingMeta.Total)
+		dbConn.Count(&total)
+		pagingMeta.Total = int(total)
+		if pagingMeta.Size > pagingMeta.Total {
+			pagingMeta.Size = pagingMeta.Total
+		}
+		dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 
-	// Set the order by arguments if any
-	for _, orderByArg := range listArguments.OrderBy {
-		dbConn = dbConn.Order(orderByArg)
+		if len(listArguments.OrderBy) == 0 {
+			// default orderBy name
+			dbConn = dbConn.Order("name ASC")
+		}
+
+		// Set the order by arguments if any
+		for _, orderByArg := range listArguments.OrderBy {
+			dbConn = dbConn.Order(orderByArg)
+		}
 	}
 
 	// execute query
@@ -208,6 +225,15 @@ func (k *connectorNamespaceService) List(ctx context.Context, clusterIDs []strin
 		return nil, nil, errors.GeneralError("failed to get connector namespaces: %v", err)
 	}
 
+	if keyset != nil {
+		nextCursor, err := keyset.Page(&resourceList)
+		if err != nil {
+			return nil, nil, errors.GeneralError("failed to get connector namespaces: %v", err)
+		}
+		pagingMeta.NextCursor = nextCursor
+		pagingMeta.Size = len(resourceList)
+	}
+
 	return resourceList, &pagingMeta, nil
 }
 
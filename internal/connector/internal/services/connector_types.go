@@ -125,7 +125,9 @@ func (cts *connectorTypesService) Get(id string) (*dbapi.ConnectorType, *errors.
 	return &resource, nil
 }
 
-var validColumns = []string{"name", "description", "version", "label", "channel"}
+// validColumns are the columns connector types can be searched by, the timestamps are qualified as they are ambiguous
+// when channels or labels are joined
+var validColumns = []string{"name", "description", "version", "label", "channel", "connector_types.created_at", "connector_types.updated_at"}
 
 // List returns all connector types
 func (cts *connectorTypesService) List(ctx context.Context, listArgs *services.ListArguments) (dbapi.ConnectorTypeList, *api.PagingMeta, *errors.ServiceError) {
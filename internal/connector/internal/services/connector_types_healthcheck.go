@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/healthcheck"
+)
+
+var _ healthcheck.Check = &CatalogHealthCheck{}
+
+// CatalogHealthCheck checks that the connector types of the catalog have been reconciled in the database, so that
+// connectors of any type of the catalog can be created
+type CatalogHealthCheck struct {
+	connectorTypesService ConnectorTypesService
+}
+
+func NewCatalogHealthCheck(connectorTypesService ConnectorTypesService) *CatalogHealthCheck {
+	return &CatalogHealthCheck{
+		connectorTypesService: connectorTypesService,
+	}
+}
+
+func (h *CatalogHealthCheck) Name() string {
+	return "connector_catalog"
+}
+
+func (h *CatalogHealthCheck) Check(ctx context.Context) error {
+	done, err := h.connectorTypesService.CatalogEntriesReconciled()
+	if err != nil {
+		return err
+	}
+	if !done {
+		return fmt.Errorf("connector catalog entries are not reconciled yet")
+	}
+	return nil
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/secrets"
 	goerrors "github.com/pkg/errors"
 	"github.com/spyzhov/ajson"
-	"strings"
 
 	"gorm.io/gorm"
 
@@ -204,7 +203,11 @@ func (k *connectorsService) Delete(ctx context.Context, id string) *errors.Servi
 	return nil
 }
 
-var validConnectorColumns = []string{"name", "owner", "kafka_id", "connector_type_id", "desired_state", "channel", "namespace_id"}
+// connectorKeysetColumns are the columns, besides name, that connectors can be ordered by when listing with a cursor
+var connectorKeysetColumns = []string{"created_at", "updated_at", "id"}
+
+// validConnectorColumns are the columns connectors can be searched by, qualified when they are ambiguous in the joined tables
+var validConnectorColumns = []string{"name", "owner", "kafka_id", "connector_type_id", "desired_state", "channel", "connectors.namespace_id", "connectors.created_at", "connectors.updated_at"}
 
 // List returns all connectors visible to the user within the requested paging window.
 func (k *connectorsService) List(ctx context.Context, kafka_id string, listArgs *services.ListArguments, tid string) (dbapi.ConnectorWithConditionsList, *api.PagingMeta, *errors.ServiceError) {
@@ -241,26 +244,38 @@ func (k *connectorsService) List(ctx context.Context, kafka_id string, listArgs
 		if err != nil {
 			return nil, pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "Unable to list connector requests: %s", err.Error())
 		}
-		// add connectors. prefix to namespace_id
-		searchDbQuery.Query = strings.ReplaceAll(searchDbQuery.Query, "namespace_id", "connectors.namespace_id")
 		dbConn = dbConn.Where(searchDbQuery.Query, searchDbQuery.Values...)
 	}
 
-	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
-	total := int64(pagingMeta.Total)
-	dbConn.Model(&dbapi.ConnectorList{}).Count(&total)
-	pagingMeta.Total = int(total)
-	if pagingMeta.Size > pagingMeta.Total {
-		pagingMeta.Size = pagingMeta.Total
+	var keyset *services.KeysetPaging
+	if listArgs.CursorPaging {
+		var keysetErr error
+		keyset, keysetErr = services.NewKeysetPaging(&dbapi.ConnectorWithConditions{}, "connectors", listArgs, "name", connectorKeysetColumns...)
+		if keysetErr != nil {
+			return nil, pagingMeta, errors.NewWithCause(errors.ErrorBadRequest, keysetErr, "Unable to list connector requests: %s", keysetErr.Error())
+		}
 	}
-	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 
-	// default the order by name
-	dbConn = dbConn.Order("name")
+	if keyset != nil {
+		// the keyset pages are not counted, as the count would scan all the resources for every page
+		dbConn = keyset.Apply(dbConn)
+	} else {
+		// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
+		total := int64(pagingMeta.Total)
+		dbConn.Model(&dbapi.ConnectorList{}).Count(&total)
+		pagingMeta.Total = int(total)
+		if pagingMeta.Size > pagingMeta.Total {
+			pagingMeta.Size = pagingMeta.Total
+		}
+		dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
+
+		// default the order by name
+		dbConn = dbConn.Order("name")
 
-	// Set the order by arguments if any
-	for _, orderByArg := range listArgs.OrderBy {
-		dbConn = dbConn.Order(orderByArg)
+		// Set the order by arguments if any
+		for _, orderByArg := range listArgs.OrderBy {
+			dbConn = dbConn.Order(orderByArg)
+		}
 	}
 
 	var resourcesWithConditions dbapi.ConnectorWithConditionsList
@@ -271,6 +286,15 @@ func (k *connectorsService) List(ctx context.Context, kafka_id string, listArgs
 		return resourcesWithConditions, pagingMeta, errors.GeneralError("Unable to list connectors: %s", err)
 	}
 
+	if keyset != nil {
+		nextCursor, err := keyset.Page(&resourcesWithConditions)
+		if err != nil {
+			return resourcesWithConditions, pagingMeta, errors.GeneralError("Unable to list connectors: %s", err)
+		}
+		pagingMeta.NextCursor = nextCursor
+		pagingMeta.Size = len(resourcesWithConditions)
+	}
+
 	return resourcesWithConditions, pagingMeta, nil
 }
 
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_connectorsService_ListWithCursor(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := auth.SetTokenInContext(context.TODO(), &jwt.Token{Claims: jwt.MapClaims{"username": "test-user"}})
+	ctx = auth.SetIsAdminContext(ctx, true)
+	k := NewConnectorsService(db.NewMockConnectionFactory(nil), nil, nil, nil)
+
+	var selectArgs []interface{}
+	counted := false
+	mockList := func(rows ...map[string]interface{}) {
+		mocket.Catcher.Reset()
+		mocket.Catcher.NewMock().WithQuery(`SELECT count(*) FROM "connectors"`).
+			WithCallback(func(_ string, _ []driver.NamedValue) {
+				counted = true
+			}).
+			WithReply([]map[string]interface{}{{"count": 3}})
+		mocket.Catcher.NewMock().WithQuery(`SELECT connectors.*, connector_deployment_statuses.conditions`).
+			WithCallback(func(_ string, args []driver.NamedValue) {
+				selectArgs = nil
+				for _, arg := range args {
+					selectArgs = append(selectArgs, arg.Value)
+				}
+			}).
+			WithReply(rows)
+	}
+
+	// the first page returns one more row than the page size, so there is a next page
+	mockList(
+		map[string]interface{}{"id": "1", "name": "connector-a"},
+		map[string]interface{}{"id": "2", "name": "connector-b"},
+	)
+	listArgs := &services.ListArguments{Size: 1, CursorPaging: true}
+	connectors, paging, err := k.List(ctx, "", listArgs, "")
+	Expect(err).To(BeNil())
+	Expect(connectors).To(HaveLen(1))
+	Expect(connectors[0].Name).To(Equal("connector-a"))
+	Expect(paging.NextCursor).NotTo(BeEmpty())
+	// the pages are not counted
+	Expect(counted).To(BeFalse())
+	Expect(paging.Total).To(BeZero())
+
+	// the second page starts after the last connector of the first page
+	mockList(
+		map[string]interface{}{"id": "2", "name": "connector-b"},
+		map[string]interface{}{"id": "3", "name": "connector-c"},
+	)
+	listArgs.Cursor = paging.NextCursor
+	connectors, paging, err = k.List(ctx, "", listArgs, "")
+	Expect(err).To(BeNil())
+	Expect(selectArgs).To(Equal([]interface{}{"connector-a", "1"}))
+	Expect(connectors).To(HaveLen(1))
+	Expect(connectors[0].Name).To(Equal("connector-b"))
+	Expect(paging.NextCursor).NotTo(BeEmpty())
+}
@@ -0,0 +1,28 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/healthcheck"
+)
+
+var _ healthcheck.Check = &HealthCheck{}
+
+// HealthCheck checks that the vault backend storing the connector secrets can be reached
+type HealthCheck struct {
+	vaultService VaultService
+}
+
+func NewHealthCheck(vaultService VaultService) *HealthCheck {
+	return &HealthCheck{
+		vaultService: vaultService,
+	}
+}
+
+func (h *HealthCheck) Name() string {
+	return "vault"
+}
+
+func (h *HealthCheck) Check(ctx context.Context) error {
+	return h.vaultService.Ping()
+}
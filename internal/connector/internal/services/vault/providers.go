@@ -2,6 +2,7 @@ package vault
 
 import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/healthcheck"
 	"github.com/goava/di"
 )
 
@@ -15,5 +16,6 @@ func ConfigProviders() di.Option {
 func ServiceProviders() di.Option {
 	return di.Options(
 		di.Provide(NewVaultService),
+		di.Provide(NewHealthCheck, di.As(new(healthcheck.ReadinessCheck))),
 	)
 }
@@ -10,6 +10,8 @@ type VaultService interface {
 	GetSecretString(name string) (string, error)
 	DeleteSecretString(name string) error
 	ForEachSecret(f func(name string, owningResource string) bool) error
+	// Ping returns an error when the vault backend can't be reached
+	Ping() error
 	Kind() string
 }
 
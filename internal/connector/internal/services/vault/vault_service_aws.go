@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-secretsmanager-caching-go/secretcache"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
 )
 
 var OwnerResourceTagKey = "owner-resource"
@@ -32,6 +33,7 @@ func NewAwsVaultService(vaultConfig *Config) (*awsVaultService, error) {
 	if err != nil {
 		return nil, err
 	}
+	tracing.AddAWSHandlers(&sess.Handlers)
 
 	secretClient := secretsmanager.New(sess)
 	secretCache, err := secretcache.New(func(cache *secretcache.Cache) {
@@ -116,6 +118,13 @@ func (k *awsVaultService) ForEachSecret(f func(name string, owningResource strin
 	return nil
 }
 
+func (k *awsVaultService) Ping() error {
+	_, err := k.secretClient.ListSecrets(&secretsmanager.ListSecretsInput{
+		MaxResults: aws.Int64(1),
+	})
+	return err
+}
+
 func getTag(tags []*secretsmanager.Tag, key string) string {
 	for _, tag := range tags {
 		if *tag.Key == key {
@@ -120,6 +120,10 @@ func (k *TmpVaultService) DeleteSecretString(name string) error {
 	return nil
 }
 
+func (k *TmpVaultService) Ping() error {
+	return nil
+}
+
 func (k *TmpVaultService) ForEachSecret(f func(name string, owningResource string) bool) error {
 
 	// Copy the secrets to an array...
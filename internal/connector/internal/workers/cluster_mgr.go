@@ -1,6 +1,7 @@
 package workers
 
 import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 	"github.com/golang/glog"
@@ -11,7 +12,8 @@ var _ workers.Worker = &ClusterManager{}
 
 type ClusterManager struct {
 	workers.BaseWorker
-	clusterService services.ConnectorClusterService
+	clusterService   services.ConnectorClusterService
+	connectorsConfig *config.ConnectorsConfig
 }
 
 func (m *ClusterManager) Start() {
@@ -22,14 +24,15 @@ func (m *ClusterManager) Stop() {
 	m.StopWorker(m)
 }
 
-func NewClusterManager(clusterService services.ConnectorClusterService, reconciler workers.Reconciler) *ClusterManager {
+func NewClusterManager(clusterService services.ConnectorClusterService, connectorsConfig *config.ConnectorsConfig, reconciler workers.Reconciler) *ClusterManager {
 	return &ClusterManager{
 		BaseWorker: workers.BaseWorker{
 			Id:         uuid.New().String(),
 			WorkerType: "connector_cluster",
 			Reconciler: reconciler,
 		},
-		clusterService: clusterService,
+		clusterService:   clusterService,
+		connectorsConfig: connectorsConfig,
 	}
 }
 
@@ -48,5 +51,18 @@ func (m *ClusterManager) Reconcile() []error {
 	} else {
 		glog.V(5).Infof("Removed %d empty deleting clusters", count)
 	}
+
+	if threshold := m.connectorsConfig.ConnectorClusterHeartbeatThreshold; threshold > 0 {
+		glog.V(5).Infof("Disconnecting unresponsive clusters...")
+		count, serrs = m.clusterService.ReconcileUnresponsiveClusters(threshold)
+		for _, serr := range serrs {
+			errs = append(errs, serr)
+		}
+		if count == 0 {
+			glog.V(5).Infof("No unresponsive clusters")
+		} else {
+			glog.V(5).Infof("Disconnected %d unresponsive clusters", count)
+		}
+	}
 	return errs
 }
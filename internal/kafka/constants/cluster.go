@@ -13,6 +13,17 @@ const (
 	// The DNS prefixes used for traffic ingress
 	ManagedKafkaIngressDnsNamePrefix = "kas"
 	DefaultIngressDnsNamePrefix      = "apps"
+
+	// ClusterUnresponsiveEvent - event of a cluster whose kas fleetshard operator stopped reporting the cluster status
+	ClusterUnresponsiveEvent = "cluster-unresponsive"
+	// ClusterRestoredEvent - event of an unresponsive cluster whose kas fleetshard operator reported the cluster status again
+	ClusterRestoredEvent = "cluster-restored"
+	// ClusterUpgradeStartedEvent - event of a cluster whose OpenShift version upgrade was requested to its cluster provider
+	ClusterUpgradeStartedEvent = "cluster-upgrade-started"
+	// ClusterUpgradeCompletedEvent - event of a cluster upgraded to a new OpenShift version
+	ClusterUpgradeCompletedEvent = "cluster-upgrade-completed"
+	// ClusterUpgradeFailedEvent - event of a cluster whose OpenShift version upgrade failed
+	ClusterUpgradeFailedEvent = "cluster-upgrade-failed"
 )
 
 func (c ClusterOperation) String() string {
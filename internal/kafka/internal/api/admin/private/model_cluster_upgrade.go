@@ -0,0 +1,32 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ClusterUpgrade The OpenShift upgrade of a data plane cluster
+type ClusterUpgrade struct {
+	Kind string `json:"kind"`
+	// The id of the data plane cluster
+	Id            string `json:"id"`
+	CloudProvider string `json:"cloud_provider,omitempty"`
+	Region        string `json:"region,omitempty"`
+	// The status of the cluster. Values: [ready, full, upgrading, upgrade_failed]
+	Status string `json:"status,omitempty"`
+	// The OpenShift version the cluster was last upgraded to by the fleet manager
+	OpenshiftVersion string `json:"openshift_version,omitempty"`
+	// The OpenShift version the cluster is being upgraded to
+	UpgradeVersion     string    `json:"upgrade_version,omitempty"`
+	UpgradeScheduledAt time.Time `json:"upgrade_scheduled_at,omitempty"`
+	// The reason of the failure when the upgrade of the cluster failed or could not be started
+	UpgradeStatusDetails string `json:"upgrade_status_details,omitempty"`
+}
@@ -0,0 +1,22 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterUpgradeRequest struct for ClusterUpgradeRequest
+type ClusterUpgradeRequest struct {
+	// The OpenShift version to upgrade the clusters to
+	OpenshiftVersion string `json:"openshift_version"`
+	// The ids of the clusters to upgrade
+	ClusterIds []string `json:"cluster_ids,omitempty"`
+	// The cloud provider of the clusters to upgrade when no cluster id is given
+	CloudProvider string `json:"cloud_provider,omitempty"`
+	// The region of the clusters to upgrade when no cluster id is given
+	Region string `json:"region,omitempty"`
+}
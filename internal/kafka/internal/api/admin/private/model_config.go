@@ -0,0 +1,28 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// Config The configuration loaded from files that can be reloaded without restarting the service
+type Config struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// The configuration files that are watched for changes
+	Files []string `json:"files"`
+	// The sha256 checksum of the content of the configuration files currently loaded
+	Checksum          string    `json:"checksum"`
+	LoadedAt          time.Time `json:"loaded_at"`
+	LastReloadAttempt time.Time `json:"last_reload_attempt,omitempty"`
+	// The error of the last reload, the configuration loaded previously being kept in that case
+	LastReloadError string `json:"last_reload_error,omitempty"`
+}
@@ -52,4 +52,6 @@ type Kafka struct {
 	RoutesCreated          bool               `json:"routes_created,omitempty"`
 	ClusterId              string             `json:"cluster_id,omitempty"`
 	Namespace              string             `json:"namespace,omitempty"`
+	Conditions             []KafkaCondition   `json:"conditions,omitempty"`
+	Capacity               KafkaCapacity      `json:"capacity,omitempty"`
 }
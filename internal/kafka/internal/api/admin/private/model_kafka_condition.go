@@ -0,0 +1,25 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// KafkaCondition A partial state of a Kafka instance
+type KafkaCondition struct {
+	// Values: [RoutesCreated, SsoClientCreated, CanaryServiceAccountCreated, Upgrading, DataPlaneReady]
+	Type string `json:"type"`
+	// Values: [True, False, Unknown]
+	Status             string    `json:"status"`
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"last_transition_time"`
+}
@@ -11,9 +11,10 @@ package private
 
 // KafkaList struct for KafkaList
 type KafkaList struct {
-	Kind  string  `json:"kind"`
-	Page  int32   `json:"page"`
-	Size  int32   `json:"size"`
-	Total int32   `json:"total"`
-	Items []Kafka `json:"items"`
+	Kind       string  `json:"kind"`
+	Page       int32   `json:"page"`
+	Size       int32   `json:"size"`
+	Total      int32   `json:"total"`
+	Items      []Kafka `json:"items"`
+	NextCursor string  `json:"next_cursor,omitempty"`
 }
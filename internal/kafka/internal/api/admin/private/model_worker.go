@@ -0,0 +1,31 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// Worker The leadership and last reconcile state of a worker
+type Worker struct {
+	Kind string `json:"kind"`
+	// The id of the worker registered in the fleet manager instance serving the request
+	Id   string `json:"id"`
+	Type string `json:"type"`
+	// Whether the worker is running in the fleet manager instance serving the request
+	Running bool `json:"running"`
+	// The id of the worker currently holding the leader lease for this worker type
+	Leader          string    `json:"leader,omitempty"`
+	LeaseExpires    time.Time `json:"lease_expires,omitempty"`
+	LastRunStart    time.Time `json:"last_run_start,omitempty"`
+	LastRunEnd      time.Time `json:"last_run_end,omitempty"`
+	LastRunDuration string    `json:"last_run_duration,omitempty"`
+	LastErrors      []string  `json:"last_errors"`
+}
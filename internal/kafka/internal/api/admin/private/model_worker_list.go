@@ -0,0 +1,17 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// WorkerList struct for WorkerList
+type WorkerList struct {
+	Kind  string   `json:"kind"`
+	Total int32    `json:"total"`
+	Items []Worker `json:"items"`
+}
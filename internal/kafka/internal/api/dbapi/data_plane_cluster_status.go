@@ -8,6 +8,8 @@ type DataPlaneClusterStatus struct {
 	ResizeInfo               DataPlaneClusterStatusResizeInfo
 	Remaining                DataPlaneClusterStatusCapacity
 	AvailableStrimziVersions []api.StrimziVersion
+	// AgentVersion the version of the kas fleetshard operator reporting the status
+	AgentVersion string
 }
 
 type DataPlaneClusterStatusCondition struct {
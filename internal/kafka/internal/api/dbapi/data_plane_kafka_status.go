@@ -5,9 +5,9 @@ import (
 )
 
 type DataPlaneKafkaStatus struct {
-	KafkaClusterId string
-	Conditions     []DataPlaneKafkaStatusCondition
-	// Going to ignore the rest of fields (like capacity and versions) for now, until when they are needed
+	KafkaClusterId  string
+	Conditions      []DataPlaneKafkaStatusCondition
+	Capacity        KafkaCapacity
 	Routes          []DataPlaneKafkaRouteRequest
 	KafkaVersion    string
 	StrimziVersion  string
@@ -0,0 +1,73 @@
+package dbapi
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// KafkaCapacity is the capacity of a kafka instance as reported by the kas-fleetshard-operator
+type KafkaCapacity struct {
+	IngressEgressThroughputPerSec string `json:"ingress_egress_throughput_per_sec,omitempty"`
+	TotalMaxConnections           int    `json:"total_max_connections,omitempty"`
+	MaxDataRetentionSize          string `json:"max_data_retention_size,omitempty"`
+	MaxPartitions                 int    `json:"max_partitions,omitempty"`
+	MaxDataRetentionPeriod        string `json:"max_data_retention_period,omitempty"`
+	MaxConnectionAttemptsPerSec   int    `json:"max_connection_attempts_per_sec,omitempty"`
+}
+
+// IsEmpty returns true if no capacity has been reported
+func (c KafkaCapacity) IsEmpty() bool {
+	return c == KafkaCapacity{}
+}
+
+// IngressEgressThroughputBytesPerSec returns the throughput in bytes per second and whether it could be parsed
+func (c KafkaCapacity) IngressEgressThroughputBytesPerSec() (int64, bool) {
+	return parseQuantity(c.IngressEgressThroughputPerSec)
+}
+
+// MaxDataRetentionSizeBytes returns the data retention size in bytes and whether it could be parsed
+func (c KafkaCapacity) MaxDataRetentionSizeBytes() (int64, bool) {
+	return parseQuantity(c.MaxDataRetentionSize)
+}
+
+func parseQuantity(value string) (int64, bool) {
+	if value == "" {
+		return 0, false
+	}
+	q, err := resource.ParseQuantity(value)
+	if err != nil {
+		return 0, false
+	}
+	return q.Value(), true
+}
+
+// GetCapacity returns the capacity last reported for the kafka, it is empty when no capacity has been reported yet
+func (k *KafkaRequest) GetCapacity() (KafkaCapacity, error) {
+	var capacity KafkaCapacity
+	if k.Capacity == nil {
+		return capacity, nil
+	}
+	if err := json.Unmarshal(k.Capacity, &capacity); err != nil {
+		return KafkaCapacity{}, err
+	}
+	return capacity, nil
+}
+
+// SetCapacity stores the reported capacity. It returns true if the capacity was modified.
+func (k *KafkaRequest) SetCapacity(capacity KafkaCapacity) (bool, error) {
+	current, err := k.GetCapacity()
+	if err != nil {
+		return false, err
+	}
+	if k.Capacity != nil && reflect.DeepEqual(current, capacity) {
+		return false, nil
+	}
+	c, err := json.Marshal(capacity)
+	if err != nil {
+		return false, err
+	}
+	k.Capacity = c
+	return true, nil
+}
@@ -0,0 +1,100 @@
+package dbapi
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type KafkaConditionType string
+type KafkaConditionStatus string
+
+const (
+	// KafkaConditionRoutesCreated indicates whether the DNS records of the kafka routes have been created
+	KafkaConditionRoutesCreated KafkaConditionType = "RoutesCreated"
+	// KafkaConditionSsoClientCreated indicates whether the sso client of the kafka has been created
+	KafkaConditionSsoClientCreated KafkaConditionType = "SsoClientCreated"
+	// KafkaConditionCanaryServiceAccountCreated indicates whether the canary service account of the kafka has been created
+	KafkaConditionCanaryServiceAccountCreated KafkaConditionType = "CanaryServiceAccountCreated"
+	// KafkaConditionUpgrading indicates whether a strimzi, kafka or kafka ibp version upgrade is in progress
+	KafkaConditionUpgrading KafkaConditionType = "Upgrading"
+	// KafkaConditionDataPlaneReady is the Ready condition last reported by the kas-fleetshard-operator
+	KafkaConditionDataPlaneReady KafkaConditionType = "DataPlaneReady"
+
+	KafkaConditionStatusTrue    KafkaConditionStatus = "True"
+	KafkaConditionStatusFalse   KafkaConditionStatus = "False"
+	KafkaConditionStatusUnknown KafkaConditionStatus = "Unknown"
+)
+
+// KafkaCondition represents a partial state of a kafka request, modelled after kubernetes status conditions
+type KafkaCondition struct {
+	Type               KafkaConditionType   `json:"type"`
+	Status             KafkaConditionStatus `json:"status"`
+	Reason             string               `json:"reason,omitempty"`
+	Message            string               `json:"message,omitempty"`
+	LastTransitionTime time.Time            `json:"last_transition_time"`
+}
+
+type KafkaConditions []KafkaCondition
+
+// GetCondition returns the condition of the given type and whether it was found
+func (c KafkaConditions) GetCondition(conditionType KafkaConditionType) (KafkaCondition, bool) {
+	for _, condition := range c {
+		if condition.Type == conditionType {
+			return condition, true
+		}
+	}
+	return KafkaCondition{}, false
+}
+
+func (k *KafkaRequest) GetConditions() (KafkaConditions, error) {
+	var conditions KafkaConditions
+	if k.Conditions == nil {
+		return conditions, nil
+	}
+	if err := json.Unmarshal(k.Conditions, &conditions); err != nil {
+		return nil, err
+	}
+	return conditions, nil
+}
+
+// SetCondition adds or replaces the condition of the given type. The LastTransitionTime is only
+// changed when the status of the condition changes. It returns true if the condition was modified.
+func (k *KafkaRequest) SetCondition(conditionType KafkaConditionType, status KafkaConditionStatus, reason string, message string) (bool, error) {
+	conditions, err := k.GetConditions()
+	if err != nil {
+		return false, err
+	}
+
+	newCondition := KafkaCondition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: time.Now(),
+	}
+
+	found := false
+	for i, condition := range conditions {
+		if condition.Type != conditionType {
+			continue
+		}
+		found = true
+		if condition.Status == status && condition.Reason == reason && condition.Message == message {
+			return false, nil
+		}
+		if condition.Status == status {
+			newCondition.LastTransitionTime = condition.LastTransitionTime
+		}
+		conditions[i] = newCondition
+	}
+	if !found {
+		conditions = append(conditions, newCondition)
+	}
+
+	c, err := json.Marshal(conditions)
+	if err != nil {
+		return false, err
+	}
+	k.Conditions = c
+	return true, nil
+}
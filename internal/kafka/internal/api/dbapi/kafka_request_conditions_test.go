@@ -0,0 +1,100 @@
+package dbapi
+
+import (
+	"testing"
+)
+
+func TestKafkaRequest_SetCondition(t *testing.T) {
+	type setConditionArgs struct {
+		status  KafkaConditionStatus
+		reason  string
+		message string
+	}
+	tests := []struct {
+		name                  string
+		initial               *setConditionArgs
+		set                   setConditionArgs
+		wantChanged           bool
+		wantTransitionUpdated bool
+		wantStatus            KafkaConditionStatus
+		wantReason            string
+	}{
+		{
+			name:                  "adds the condition when it does not exist",
+			set:                   setConditionArgs{status: KafkaConditionStatusFalse, reason: "Pending"},
+			wantChanged:           true,
+			wantTransitionUpdated: true,
+			wantStatus:            KafkaConditionStatusFalse,
+			wantReason:            "Pending",
+		},
+		{
+			name:        "does nothing when the condition is unchanged",
+			initial:     &setConditionArgs{status: KafkaConditionStatusTrue, reason: "Created"},
+			set:         setConditionArgs{status: KafkaConditionStatusTrue, reason: "Created"},
+			wantChanged: false,
+			wantStatus:  KafkaConditionStatusTrue,
+			wantReason:  "Created",
+		},
+		{
+			name:                  "keeps the transition time when only the reason changes",
+			initial:               &setConditionArgs{status: KafkaConditionStatusFalse, reason: "Pending"},
+			set:                   setConditionArgs{status: KafkaConditionStatusFalse, reason: "Error", message: "failed"},
+			wantChanged:           true,
+			wantTransitionUpdated: false,
+			wantStatus:            KafkaConditionStatusFalse,
+			wantReason:            "Error",
+		},
+		{
+			name:                  "updates the transition time when the status changes",
+			initial:               &setConditionArgs{status: KafkaConditionStatusFalse, reason: "Pending"},
+			set:                   setConditionArgs{status: KafkaConditionStatusTrue, reason: "Created"},
+			wantChanged:           true,
+			wantTransitionUpdated: true,
+			wantStatus:            KafkaConditionStatusTrue,
+			wantReason:            "Created",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kafka := &KafkaRequest{}
+			var initialCondition KafkaCondition
+			if tt.initial != nil {
+				if _, err := kafka.SetCondition(KafkaConditionRoutesCreated, tt.initial.status, tt.initial.reason, tt.initial.message); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				conditions, _ := kafka.GetConditions()
+				initialCondition, _ = conditions.GetCondition(KafkaConditionRoutesCreated)
+			}
+
+			changed, err := kafka.SetCondition(KafkaConditionRoutesCreated, tt.set.status, tt.set.reason, tt.set.message)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if changed != tt.wantChanged {
+				t.Errorf("changed want: %v got: %v", tt.wantChanged, changed)
+			}
+
+			conditions, err := kafka.GetConditions()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(conditions) != 1 {
+				t.Fatalf("want 1 condition got: %d", len(conditions))
+			}
+			condition, ok := conditions.GetCondition(KafkaConditionRoutesCreated)
+			if !ok {
+				t.Fatalf("condition %s not found", KafkaConditionRoutesCreated)
+			}
+			if condition.Status != tt.wantStatus {
+				t.Errorf("status want: %v got: %v", tt.wantStatus, condition.Status)
+			}
+			if condition.Reason != tt.wantReason {
+				t.Errorf("reason want: %v got: %v", tt.wantReason, condition.Reason)
+			}
+			if tt.initial != nil && !tt.wantTransitionUpdated && !condition.LastTransitionTime.Equal(initialCondition.LastTransitionTime) {
+				t.Errorf("last transition time should not have changed: want %v got: %v", initialCondition.LastTransitionTime, condition.LastTransitionTime)
+			}
+		})
+	}
+}
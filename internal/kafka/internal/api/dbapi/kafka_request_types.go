@@ -2,6 +2,7 @@ package dbapi
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"gorm.io/gorm"
@@ -51,6 +52,13 @@ type KafkaRequest struct {
 	Namespace               string `json:"namespace"`
 	ReauthenticationEnabled bool   `json:"reauthentication_enabled"`
 	RoutesCreationId        string `json:"routes_creation_id"`
+	// Conditions the partial states of the kafka instance that are not reflected by its status, see KafkaCondition
+	Conditions api.JSON `json:"conditions"`
+	// Capacity the capacity of the kafka instance last reported by the kas-fleetshard-operator, see KafkaCapacity
+	Capacity api.JSON `json:"capacity"`
+	// StatusReportedAt the last time the kas-fleetshard-operator reported the status of the kafka instance. It is only
+	// refreshed once per status report resolution to limit the database writes, see dataPlaneKafkaService.
+	StatusReportedAt *time.Time `json:"status_reported_at"`
 }
 
 type KafkaList []*KafkaRequest
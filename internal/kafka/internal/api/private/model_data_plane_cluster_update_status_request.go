@@ -18,4 +18,6 @@ type DataPlaneClusterUpdateStatusRequest struct {
 	NodeInfo   *DatePlaneClusterUpdateStatusRequestNodeInfo    `json:"nodeInfo,omitempty"`
 	ResizeInfo *DatePlaneClusterUpdateStatusRequestResizeInfo  `json:"resizeInfo,omitempty"`
 	Strimzi    []DataPlaneClusterUpdateStatusRequestStrimzi    `json:"strimzi,omitempty"`
+	// The version of the kas-fleetshard operator reporting the status
+	Version string `json:"version,omitempty"`
 }
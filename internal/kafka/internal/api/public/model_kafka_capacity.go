@@ -0,0 +1,23 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.4.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// KafkaCapacity The capacity of a Kafka instance as last reported by the data plane. It is not set until the data plane has reported it.
+type KafkaCapacity struct {
+	// The maximum ingress and egress throughput, e.g. 30Mi
+	IngressEgressThroughputPerSec string `json:"ingress_egress_throughput_per_sec,omitempty"`
+	TotalMaxConnections           int32  `json:"total_max_connections,omitempty"`
+	// The maximum size of the retained data, e.g. 100Gi
+	MaxDataRetentionSize string `json:"max_data_retention_size,omitempty"`
+	MaxPartitions        int32  `json:"max_partitions,omitempty"`
+	// The maximum retention period of the data as an ISO-8601 duration, e.g. P14D
+	MaxDataRetentionPeriod      string `json:"max_data_retention_period,omitempty"`
+	MaxConnectionAttemptsPerSec int32  `json:"max_connection_attempts_per_sec,omitempty"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.4.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// KafkaCondition A partial state of a Kafka instance
+type KafkaCondition struct {
+	// Values: [RoutesCreated, SsoClientCreated, CanaryServiceAccountCreated, Upgrading, DataPlaneReady]
+	Type string `json:"type"`
+	// Values: [True, False, Unknown]
+	Status             string    `json:"status"`
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"last_transition_time"`
+}
@@ -0,0 +1,24 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.4.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// KafkaHealth The health of a Kafka instance, computed from the signals reported by the data plane and from the Kafka metrics
+type KafkaHealth struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	// Values: [healthy, degraded, unavailable]
+	Status    string              `json:"status"`
+	CheckedAt time.Time           `json:"checked_at"`
+	Signals   []KafkaHealthSignal `json:"signals"`
+}
@@ -0,0 +1,21 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.4.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// KafkaHealthSignal A signal contributing to the health of a Kafka instance
+type KafkaHealthSignal struct {
+	// Values: [status, ready_condition, status_report_age, offline_partitions, disk_usage, upgrade]
+	Name string `json:"name"`
+	// Values: [healthy, degraded, unavailable, unknown]. The unknown signals do not contribute to the health of the Kafka instance
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+	// The measured value of the signal, if any
+	Value *float64 `json:"value,omitempty"`
+}
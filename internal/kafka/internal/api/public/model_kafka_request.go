@@ -36,4 +36,7 @@ type KafkaRequest struct {
 	ReauthenticationEnabled bool      `json:"reauthentication_enabled"`
 	KafkaStorageSize        string    `json:"kafka_storage_size,omitempty"`
 	BrowserUrl              string    `json:"browser_url,omitempty"`
+	// The partial states of the Kafka instance that are not reflected by its status
+	Conditions []KafkaCondition `json:"conditions,omitempty"`
+	Capacity   KafkaCapacity    `json:"capacity,omitempty"`
 }
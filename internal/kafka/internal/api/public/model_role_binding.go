@@ -0,0 +1,27 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.4.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// RoleBinding A role of the organisation bound to a user or a service account
+type RoleBinding struct {
+	Id             string    `json:"id,omitempty"`
+	Kind           string    `json:"kind,omitempty"`
+	Href           string    `json:"href,omitempty"`
+	OrganisationId string    `json:"organisation_id,omitempty"`
+	Subject        string    `json:"subject,omitempty"`
+	SubjectType    string    `json:"subject_type,omitempty"`
+	Role           string    `json:"role,omitempty"`
+	CreatedBy      string    `json:"created_by,omitempty"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.4.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// RoleBindingList struct for RoleBindingList
+type RoleBindingList struct {
+	Kind  string        `json:"kind"`
+	Total int32         `json:"total"`
+	Items []RoleBinding `json:"items"`
+}
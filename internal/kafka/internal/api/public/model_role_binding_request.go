@@ -0,0 +1,18 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.4.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// RoleBindingRequest Binds a role of the organisation to a user or a service account
+type RoleBindingRequest struct {
+	// username of the user or of the service account
+	Subject     string `json:"subject"`
+	SubjectType string `json:"subject_type"`
+	Role        string `json:"role"`
+}
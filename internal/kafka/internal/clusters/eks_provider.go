@@ -0,0 +1,510 @@
+package clusters
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
+	"github.com/pkg/errors"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+)
+
+const (
+	eksCloudProviderID          = "aws"
+	eksCloudProviderDisplayName = "Amazon Web Services"
+	// eksNodegroupNameSuffix is appended to the cluster name to name its compute node group
+	eksNodegroupNameSuffix = "-compute"
+)
+
+// EKSProvider creates and manages clusters of the AWS Elastic Kubernetes Service. Each cluster gets a single managed
+// node group of the configured compute machine type, which is resized to scale the cluster. Like the KubernetesProvider,
+// the operators are installed from manifests and the cluster dns is read from the configuration of the ingress
+// controller, which is installed while the cluster is provisioned; the clusters are reached through their EKS endpoint,
+// authenticated with the AWS credentials.
+type EKSProvider struct {
+	clientFactory          aws.EKSClientFactory
+	idGenerator            ocm.IDGenerator
+	awsConfig              *config.AWSConfig
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+	// newDynamicClient returns the clients of the kubernetes API of the clusters, it is replaced in the tests
+	newDynamicClient func(restConfig *rest.Config) (dynamic.Interface, *restmapper.DeferredDiscoveryRESTMapper, error)
+}
+
+// blank assignment to verify that EKSProvider implements Provider
+var _ Provider = &EKSProvider{}
+
+func newEKSProvider(clientFactory aws.EKSClientFactory, awsConfig *config.AWSConfig, dataplaneClusterConfig *config.DataplaneClusterConfig) *EKSProvider {
+	return &EKSProvider{
+		clientFactory:          clientFactory,
+		idGenerator:            ocm.NewIDGenerator(ClusterNamePrefix),
+		awsConfig:              awsConfig,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+		newDynamicClient:       newDynamicClientForConfig,
+	}
+}
+
+// eksClusterInfo is the additional information of the EKS clusters, saved in their cluster spec
+type eksClusterInfo struct {
+	Region        string `json:"region"`
+	NodegroupName string `json:"nodegroup_name"`
+}
+
+func (e *EKSProvider) Create(request *types.ClusterRequest) (*types.ClusterSpec, error) {
+	client, err := e.newClient(request.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetIDs, err := client.ListSubnetIDsByTag(e.awsConfig.EKSSubnetTagKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list subnets of region %s", request.Region)
+	}
+	if len(subnetIDs) == 0 {
+		return nil, errors.Errorf("no subnet tagged with %s found in region %s", e.awsConfig.EKSSubnetTagKey, request.Region)
+	}
+
+	name := e.idGenerator.Generate()
+	input := &eks.CreateClusterInput{
+		Name:    &name,
+		RoleArn: &e.awsConfig.EKSClusterRoleARN,
+		ResourcesVpcConfig: &eks.VpcConfigRequest{
+			SubnetIds: awssdk.StringSlice(subnetIDs),
+		},
+	}
+	if e.awsConfig.EKSKubernetesVersion != "" {
+		input.Version = &e.awsConfig.EKSKubernetesVersion
+	}
+	cluster, err := client.CreateCluster(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create EKS cluster in region %s", request.Region)
+	}
+
+	clusterInfo, err := json.Marshal(eksClusterInfo{
+		Region:        request.Region,
+		NodegroupName: name + eksNodegroupNameSuffix,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.ClusterSpec{
+		InternalID:     name,
+		ExternalID:     awssdk.StringValue(cluster.Arn),
+		Status:         api.ClusterProvisioning,
+		AdditionalInfo: clusterInfo,
+	}, nil
+}
+
+// CheckClusterStatus creates the compute node group once the cluster is active, and installs the ingress controller once
+// the node group is active. The cluster is provisioned when the domain of its ingress controller is available, so that
+// its cluster dns can be read before the operators are installed.
+func (e *EKSProvider) CheckClusterStatus(spec *types.ClusterSpec) (*types.ClusterSpec, error) {
+	info, client, err := e.newClusterClient(spec)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Status == "" {
+		spec.Status = api.ClusterProvisioning
+	}
+
+	cluster, err := client.DescribeCluster(spec.InternalID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get cluster %s", spec.InternalID)
+	}
+	if cluster == nil {
+		return nil, errors.Errorf("EKS cluster %s not found", spec.InternalID)
+	}
+
+	switch awssdk.StringValue(cluster.Status) {
+	case eks.ClusterStatusFailed:
+		spec.Status = api.ClusterFailed
+		spec.StatusDetails = "EKS cluster creation failed"
+		return spec, nil
+	case eks.ClusterStatusActive:
+	default:
+		return spec, nil
+	}
+
+	nodegroup, err := client.DescribeNodegroup(spec.InternalID, info.NodegroupName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get node group of cluster %s", spec.InternalID)
+	}
+	if nodegroup == nil {
+		computeNodes := int64(e.awsConfig.EKSComputeNodes)
+		_, err := client.CreateNodegroup(&eks.CreateNodegroupInput{
+			ClusterName:   &spec.InternalID,
+			NodegroupName: &info.NodegroupName,
+			NodeRole:      &e.awsConfig.EKSNodeRoleARN,
+			Subnets:       cluster.ResourcesVpcConfig.SubnetIds,
+			InstanceTypes: []*string{&e.dataplaneClusterConfig.ComputeMachineType},
+			ScalingConfig: &eks.NodegroupScalingConfig{
+				MinSize:     &computeNodes,
+				DesiredSize: &computeNodes,
+				MaxSize:     &computeNodes,
+			},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create node group of cluster %s", spec.InternalID)
+		}
+		return spec, nil
+	}
+
+	switch awssdk.StringValue(nodegroup.Status) {
+	case eks.NodegroupStatusActive:
+		ready, err := e.reconcileIngressController(spec)
+		if err != nil {
+			return nil, err
+		}
+		if !ready {
+			spec.StatusDetails = "waiting for the domain of the ingress controller"
+			return spec, nil
+		}
+		spec.Status = api.ClusterProvisioned
+		spec.StatusDetails = ""
+	case eks.NodegroupStatusCreateFailed:
+		spec.Status = api.ClusterFailed
+		spec.StatusDetails = nodegroupHealthIssues(nodegroup)
+	}
+	return spec, nil
+}
+
+// reconcileIngressController applies the manifests of the ingress controller, when they are configured, and returns
+// whether the config map of the ingress controller holds its domain
+func (e *EKSProvider) reconcileIngressController(spec *types.ClusterSpec) (bool, error) {
+	dynamicClient, mapper, err := e.newClusterDynamicClient(spec)
+	if err != nil {
+		return false, err
+	}
+
+	ingressConfig := e.dataplaneClusterConfig.KubernetesIngressControllerConfig
+	if ingressConfig.ManifestsDir != "" {
+		resources, err := buildIngressControllerResources(ingressConfig)
+		if err != nil {
+			return false, err
+		}
+		if _, err := applyResourcesWithClient(dynamicClient, mapper, types.ResourceSet{Resources: resources}); err != nil {
+			return false, errors.Wrapf(err, "failed to install ingress controller of cluster %s", spec.InternalID)
+		}
+	}
+
+	configMap, err := getIngressControllerConfigMap(dynamicClient, ingressConfig)
+	if err != nil {
+		if k8sErrors.IsNotFound(errors.Cause(err)) {
+			return false, nil
+		}
+		return false, err
+	}
+	_, err = getIngressControllerDomain(configMap.Object, ingressConfig)
+	return err == nil, nil
+}
+
+func nodegroupHealthIssues(nodegroup *eks.Nodegroup) string {
+	var issues []string
+	if nodegroup.Health != nil {
+		for _, issue := range nodegroup.Health.Issues {
+			issues = append(issues, awssdk.StringValue(issue.Message))
+		}
+	}
+	if len(issues) == 0 {
+		return "EKS node group creation failed"
+	}
+	return strings.Join(issues, ", ")
+}
+
+// Delete deletes the node groups of the cluster first, as the cluster can only be deleted once it has none left. It
+// returns true when the cluster is not found anymore.
+func (e *EKSProvider) Delete(spec *types.ClusterSpec) (bool, error) {
+	_, client, err := e.newClusterClient(spec)
+	if err != nil {
+		return false, err
+	}
+
+	nodegroupNames, err := client.ListNodegroups(spec.InternalID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list node groups of cluster %s", spec.InternalID)
+	}
+	for _, nodegroupName := range nodegroupNames {
+		nodegroup, err := client.DescribeNodegroup(spec.InternalID, nodegroupName)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get node group %s of cluster %s", nodegroupName, spec.InternalID)
+		}
+		if nodegroup == nil || awssdk.StringValue(nodegroup.Status) == eks.NodegroupStatusDeleting {
+			continue
+		}
+		if err := client.DeleteNodegroup(spec.InternalID, nodegroupName); err != nil {
+			return false, errors.Wrapf(err, "failed to delete node group %s of cluster %s", nodegroupName, spec.InternalID)
+		}
+	}
+	if len(nodegroupNames) > 0 {
+		return false, nil
+	}
+
+	cluster, err := client.DescribeCluster(spec.InternalID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get cluster %s", spec.InternalID)
+	}
+	if cluster == nil {
+		return true, nil
+	}
+	if awssdk.StringValue(cluster.Status) != eks.ClusterStatusDeleting {
+		if err := client.DeleteCluster(spec.InternalID); err != nil {
+			return false, errors.Wrapf(err, "failed to delete cluster %s", spec.InternalID)
+		}
+	}
+	return false, nil
+}
+
+func (e *EKSProvider) InstallStrimzi(clusterSpec *types.ClusterSpec) (bool, error) {
+	resources, err := buildStrimziOperatorResources(e.dataplaneClusterConfig)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = e.ApplyResources(clusterSpec, types.ResourceSet{
+		Resources: resources,
+	})
+
+	return true, err
+}
+
+func (e *EKSProvider) InstallKasFleetshard(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error) {
+	resources, err := buildKasFleetshardOperatorResources(e.dataplaneClusterConfig, params)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = e.ApplyResources(clusterSpec, types.ResourceSet{
+		Resources: resources,
+	})
+
+	return true, err
+}
+
+func (e *EKSProvider) InstallClusterLogging(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error) {
+	return true, nil // NOOP for now
+}
+
+// AddIdentityProvider is a NOOP: the authentication of the EKS clusters is bound to the AWS IAM identities
+func (e *EKSProvider) AddIdentityProvider(clusterSpec *types.ClusterSpec, identityProvider types.IdentityProviderInfo) (*types.IdentityProviderInfo, error) {
+	return &identityProvider, nil
+}
+
+func (e *EKSProvider) ApplyResources(clusterSpec *types.ClusterSpec, resources types.ResourceSet) (*types.ResourceSet, error) {
+	dynamicClient, mapper, err := e.newClusterDynamicClient(clusterSpec)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourcesWithClient(dynamicClient, mapper, resources)
+}
+
+// GetClusterDNS returns the domain of the ingress controller of the cluster, read from the configured config map
+func (e *EKSProvider) GetClusterDNS(clusterSpec *types.ClusterSpec) (string, error) {
+	dynamicClient, _, err := e.newClusterDynamicClient(clusterSpec)
+	if err != nil {
+		return "", err
+	}
+	return getClusterIngressControllerDomain(dynamicClient, e.dataplaneClusterConfig.KubernetesIngressControllerConfig)
+}
+
+func (e *EKSProvider) ScaleUp(clusterSpec *types.ClusterSpec, increment int) (*types.ClusterSpec, error) {
+	nodes, err := e.GetComputeNodes(clusterSpec)
+	if err != nil {
+		return nil, err
+	}
+	return e.SetComputeNodes(clusterSpec, nodes.Desired+increment)
+}
+
+func (e *EKSProvider) ScaleDown(clusterSpec *types.ClusterSpec, decrement int) (*types.ClusterSpec, error) {
+	nodes, err := e.GetComputeNodes(clusterSpec)
+	if err != nil {
+		return nil, err
+	}
+	return e.SetComputeNodes(clusterSpec, nodes.Desired-decrement)
+}
+
+// SetComputeNodes sets the desired size of the node group of the cluster, widening its minimum and maximum sizes when
+// they do not allow it
+func (e *EKSProvider) SetComputeNodes(clusterSpec *types.ClusterSpec, numNodes int) (*types.ClusterSpec, error) {
+	info, client, err := e.newClusterClient(clusterSpec)
+	if err != nil {
+		return nil, err
+	}
+	nodegroup, err := e.getNodegroup(client, clusterSpec, info)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredSize := int64(numNodes)
+	scalingConfig := &eks.NodegroupScalingConfig{
+		MinSize:     nodegroup.ScalingConfig.MinSize,
+		DesiredSize: &desiredSize,
+		MaxSize:     nodegroup.ScalingConfig.MaxSize,
+	}
+	if awssdk.Int64Value(scalingConfig.MinSize) > desiredSize {
+		scalingConfig.MinSize = &desiredSize
+	}
+	if awssdk.Int64Value(scalingConfig.MaxSize) < desiredSize {
+		scalingConfig.MaxSize = &desiredSize
+	}
+	if err := client.UpdateNodegroupScaling(clusterSpec.InternalID, info.NodegroupName, scalingConfig); err != nil {
+		return nil, errors.Wrapf(err, "failed to set compute nodes value of cluster %s to %d", clusterSpec.InternalID, numNodes)
+	}
+	return clusterSpec, nil
+}
+
+func (e *EKSProvider) GetComputeNodes(spec *types.ClusterSpec) (*types.ComputeNodesInfo, error) {
+	info, client, err := e.newClusterClient(spec)
+	if err != nil {
+		return nil, err
+	}
+	nodegroup, err := e.getNodegroup(client, spec, info)
+	if err != nil {
+		return nil, err
+	}
+
+	var autoScalingGroupNames []string
+	if nodegroup.Resources != nil {
+		for _, group := range nodegroup.Resources.AutoScalingGroups {
+			autoScalingGroupNames = append(autoScalingGroupNames, awssdk.StringValue(group.Name))
+		}
+	}
+	actual, err := client.CountInServiceInstances(autoScalingGroupNames)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get compute nodes of cluster %s", spec.InternalID)
+	}
+	return &types.ComputeNodesInfo{
+		Actual:  actual,
+		Desired: int(awssdk.Int64Value(nodegroup.ScalingConfig.DesiredSize)),
+	}, nil
+}
+
+// UpgradeCluster is not supported: the EKS clusters do not run OpenShift
+func (e *EKSProvider) UpgradeCluster(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error) {
+	return nil, types.ErrClusterUpgradeNotSupported
+}
+
+func (e *EKSProvider) CheckClusterUpgradeStatus(clusterSpec *types.ClusterSpec, upgrade *types.ClusterUpgrade) (*types.ClusterUpgrade, error) {
+	return nil, types.ErrClusterUpgradeNotSupported
+}
+
+func (e *EKSProvider) GetCloudProviders() (*types.CloudProviderInfoList, error) {
+	return &types.CloudProviderInfoList{
+		Items: []types.CloudProviderInfo{
+			{
+				ID:          eksCloudProviderID,
+				Name:        eksCloudProviderID,
+				DisplayName: eksCloudProviderDisplayName,
+			},
+		},
+	}, nil
+}
+
+func (e *EKSProvider) GetCloudProviderRegions(providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
+	list := types.CloudProviderRegionInfoList{}
+	if providerInf.ID != eksCloudProviderID {
+		return &list, nil
+	}
+
+	client, err := e.newClient(e.awsConfig.EKSRegionsEndpointRegion)
+	if err != nil {
+		return nil, err
+	}
+	regions, err := client.DescribeRegions()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get regions for provider %s", providerInf.Name)
+	}
+	for _, region := range regions {
+		name := awssdk.StringValue(region.RegionName)
+		list.Items = append(list.Items, types.CloudProviderRegionInfo{
+			ID:              name,
+			CloudProviderID: eksCloudProviderID,
+			Name:            name,
+			DisplayName:     name,
+			SupportsMultiAZ: true,
+		})
+	}
+	return &list, nil
+}
+
+func (e *EKSProvider) newClient(region string) (aws.EKSClient, error) {
+	client, err := e.clientFactory.NewEKSClient(aws.Config{
+		AccessKeyID:     e.awsConfig.AccessKey,
+		SecretAccessKey: e.awsConfig.SecretAccessKey,
+	}, region)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create EKS client for region %s", region)
+	}
+	return client, nil
+}
+
+// newClusterClient returns the additional information of the cluster and a client of its region
+func (e *EKSProvider) newClusterClient(spec *types.ClusterSpec) (*eksClusterInfo, aws.EKSClient, error) {
+	info := &eksClusterInfo{}
+	if err := json.Unmarshal(spec.AdditionalInfo, info); err != nil || info.Region == "" {
+		return nil, nil, errors.Errorf("cluster %s has no EKS cluster information", spec.InternalID)
+	}
+	client, err := e.newClient(info.Region)
+	if err != nil {
+		return nil, nil, err
+	}
+	return info, client, nil
+}
+
+func (e *EKSProvider) getNodegroup(client aws.EKSClient, spec *types.ClusterSpec, info *eksClusterInfo) (*eks.Nodegroup, error) {
+	nodegroup, err := client.DescribeNodegroup(spec.InternalID, info.NodegroupName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get node group of cluster %s", spec.InternalID)
+	}
+	if nodegroup == nil || nodegroup.ScalingConfig == nil {
+		return nil, errors.Errorf("cluster %s has no node group %s", spec.InternalID, info.NodegroupName)
+	}
+	return nodegroup, nil
+}
+
+// newClusterRestConfig returns the rest config of the EKS endpoint of the cluster, authenticated with a token of the
+// AWS credentials
+func (e *EKSProvider) newClusterRestConfig(spec *types.ClusterSpec) (*rest.Config, error) {
+	_, client, err := e.newClusterClient(spec)
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := client.DescribeCluster(spec.InternalID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get cluster %s", spec.InternalID)
+	}
+	if cluster == nil || cluster.Endpoint == nil || cluster.CertificateAuthority == nil {
+		return nil, errors.Errorf("EKS cluster %s has no endpoint", spec.InternalID)
+	}
+	caData, err := base64.StdEncoding.DecodeString(awssdk.StringValue(cluster.CertificateAuthority.Data))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode certificate authority of cluster %s", spec.InternalID)
+	}
+	token, err := client.GetClusterToken(spec.InternalID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get token of cluster %s", spec.InternalID)
+	}
+	return &rest.Config{
+		Host:        awssdk.StringValue(cluster.Endpoint),
+		BearerToken: token,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData: caData,
+		},
+	}, nil
+}
+
+func (e *EKSProvider) newClusterDynamicClient(spec *types.ClusterSpec) (dynamic.Interface, *restmapper.DeferredDiscoveryRESTMapper, error) {
+	restConfig, err := e.newClusterRestConfig(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	return e.newDynamicClient(restConfig)
+}
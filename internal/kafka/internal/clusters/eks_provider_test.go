@@ -0,0 +1,407 @@
+package clusters
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+)
+
+const (
+	testEKSClusterName   = "mk-test-cluster"
+	testEKSNodegroupName = "mk-test-cluster-compute"
+)
+
+func newTestEKSProvider(client aws.EKSClient) *EKSProvider {
+	awsConfig := config.NewAWSConfig()
+	awsConfig.EKSClusterRoleARN = "arn:aws:iam::123456789012:role/eks-cluster"
+	awsConfig.EKSNodeRoleARN = "arn:aws:iam::123456789012:role/eks-node"
+	provider := newEKSProvider(aws.NewMockEKSClientFactory(client), awsConfig, config.NewDataplaneClusterConfig())
+	provider.idGenerator = &ocm.IDGeneratorMock{
+		GenerateFunc: func() string {
+			return testEKSClusterName
+		},
+	}
+	return provider
+}
+
+func testEKSClusterSpec(status api.ClusterStatus) *types.ClusterSpec {
+	return &types.ClusterSpec{
+		InternalID:     testEKSClusterName,
+		Status:         status,
+		AdditionalInfo: []byte(`{"region":"us-east-1","nodegroup_name":"mk-test-cluster-compute"}`),
+	}
+}
+
+// testEKSIngressConfigMap returns the config map of the ingress controller holding the given domain
+func testEKSIngressConfigMap(domain string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      "ingress-nginx-controller",
+			"namespace": "ingress-nginx",
+		},
+		"data": map[string]interface{}{
+			"domain": domain,
+		},
+	}}
+}
+
+func testEKSNodegroup(status string, desired int64) *eks.Nodegroup {
+	return &eks.Nodegroup{
+		NodegroupName: awssdk.String(testEKSNodegroupName),
+		Status:        awssdk.String(status),
+		ScalingConfig: &eks.NodegroupScalingConfig{
+			MinSize:     awssdk.Int64(3),
+			DesiredSize: awssdk.Int64(desired),
+			MaxSize:     awssdk.Int64(desired),
+		},
+		Resources: &eks.NodegroupResources{
+			AutoScalingGroups: []*eks.AutoScalingGroup{{Name: awssdk.String("eks-mk-test-cluster-compute")}},
+		},
+	}
+}
+
+func TestEKSProvider_Create(t *testing.T) {
+	tests := []struct {
+		name      string
+		subnetIDs []string
+		createErr error
+		want      *types.ClusterSpec
+		wantErr   bool
+	}{
+		{
+			name:      "should create the cluster in the tagged subnets of the region",
+			subnetIDs: []string{"subnet-a", "subnet-b"},
+			want: &types.ClusterSpec{
+				InternalID:     testEKSClusterName,
+				ExternalID:     "arn:aws:eks:us-east-1:123456789012:cluster/mk-test-cluster",
+				Status:         api.ClusterProvisioning,
+				AdditionalInfo: []byte(`{"region":"us-east-1","nodegroup_name":"mk-test-cluster-compute"}`),
+			},
+		},
+		{
+			name:    "should return an error when no subnet is tagged in the region",
+			wantErr: true,
+		},
+		{
+			name:      "should return an error when the cluster creation fails",
+			subnetIDs: []string{"subnet-a"},
+			createErr: errors.New("failed to create cluster"),
+			wantErr:   true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			client := &aws.EKSClientMock{
+				ListSubnetIDsByTagFunc: func(tagKey string) ([]string, error) {
+					Expect(tagKey).To(Equal(config.NewAWSConfig().EKSSubnetTagKey))
+					return tt.subnetIDs, nil
+				},
+				CreateClusterFunc: func(input *eks.CreateClusterInput) (*eks.Cluster, error) {
+					Expect(awssdk.StringValue(input.Name)).To(Equal(testEKSClusterName))
+					Expect(awssdk.StringValue(input.RoleArn)).To(Equal("arn:aws:iam::123456789012:role/eks-cluster"))
+					Expect(awssdk.StringValueSlice(input.ResourcesVpcConfig.SubnetIds)).To(Equal(tt.subnetIDs))
+					Expect(input.Version).To(BeNil())
+					if tt.createErr != nil {
+						return nil, tt.createErr
+					}
+					return &eks.Cluster{
+						Name: input.Name,
+						Arn:  awssdk.String("arn:aws:eks:us-east-1:123456789012:cluster/mk-test-cluster"),
+					}, nil
+				},
+			}
+
+			spec, err := newTestEKSProvider(client).Create(&types.ClusterRequest{CloudProvider: "aws", Region: "us-east-1", MultiAZ: true})
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(spec).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestEKSProvider_CheckClusterStatus(t *testing.T) {
+	tests := []struct {
+		name                string
+		clusterStatus       string
+		nodegroup           *eks.Nodegroup
+		ingressConfigMap    *unstructured.Unstructured
+		wantStatus          api.ClusterStatus
+		wantNodegroupCreate bool
+	}{
+		{
+			name:          "should be provisioning while the cluster is being created",
+			clusterStatus: eks.ClusterStatusCreating,
+			wantStatus:    api.ClusterProvisioning,
+		},
+		{
+			name:          "should be failed when the cluster creation failed",
+			clusterStatus: eks.ClusterStatusFailed,
+			wantStatus:    api.ClusterFailed,
+		},
+		{
+			name:                "should create the node group once the cluster is active",
+			clusterStatus:       eks.ClusterStatusActive,
+			wantStatus:          api.ClusterProvisioning,
+			wantNodegroupCreate: true,
+		},
+		{
+			name:          "should be provisioning while the node group is being created",
+			clusterStatus: eks.ClusterStatusActive,
+			nodegroup:     testEKSNodegroup(eks.NodegroupStatusCreating, 3),
+			wantStatus:    api.ClusterProvisioning,
+		},
+		{
+			name:          "should be provisioning while the ingress controller config map is not found",
+			clusterStatus: eks.ClusterStatusActive,
+			nodegroup:     testEKSNodegroup(eks.NodegroupStatusActive, 3),
+			wantStatus:    api.ClusterProvisioning,
+		},
+		{
+			name:             "should be provisioning while the ingress controller has no domain",
+			clusterStatus:    eks.ClusterStatusActive,
+			nodegroup:        testEKSNodegroup(eks.NodegroupStatusActive, 3),
+			ingressConfigMap: testEKSIngressConfigMap(""),
+			wantStatus:       api.ClusterProvisioning,
+		},
+		{
+			name:             "should be provisioned once the domain of the ingress controller is available",
+			clusterStatus:    eks.ClusterStatusActive,
+			nodegroup:        testEKSNodegroup(eks.NodegroupStatusActive, 3),
+			ingressConfigMap: testEKSIngressConfigMap("apps.example.com"),
+			wantStatus:       api.ClusterProvisioned,
+		},
+		{
+			name:          "should be failed when the node group creation failed",
+			clusterStatus: eks.ClusterStatusActive,
+			nodegroup:     testEKSNodegroup(eks.NodegroupStatusCreateFailed, 3),
+			wantStatus:    api.ClusterFailed,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			client := &aws.EKSClientMock{
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return &eks.Cluster{
+						Name:                 awssdk.String(clusterName),
+						Status:               awssdk.String(tt.clusterStatus),
+						Endpoint:             awssdk.String("https://mk-test-cluster.eks.amazonaws.com"),
+						CertificateAuthority: &eks.Certificate{Data: awssdk.String("")},
+						ResourcesVpcConfig:   &eks.VpcConfigResponse{SubnetIds: awssdk.StringSlice([]string{"subnet-a"})},
+					}, nil
+				},
+				GetClusterTokenFunc: func(clusterName string) (string, error) {
+					return "token", nil
+				},
+				DescribeNodegroupFunc: func(clusterName string, nodegroupName string) (*eks.Nodegroup, error) {
+					Expect(nodegroupName).To(Equal(testEKSNodegroupName))
+					return tt.nodegroup, nil
+				},
+				CreateNodegroupFunc: func(input *eks.CreateNodegroupInput) (*eks.Nodegroup, error) {
+					Expect(awssdk.StringValue(input.NodeRole)).To(Equal("arn:aws:iam::123456789012:role/eks-node"))
+					Expect(awssdk.StringValueSlice(input.InstanceTypes)).To(Equal([]string{"m5.2xlarge"}))
+					Expect(awssdk.Int64Value(input.ScalingConfig.DesiredSize)).To(Equal(int64(3)))
+					return &eks.Nodegroup{}, nil
+				},
+			}
+
+			provider := newTestEKSProvider(client)
+			provider.newDynamicClient = func(restConfig *rest.Config) (dynamic.Interface, *restmapper.DeferredDiscoveryRESTMapper, error) {
+				Expect(restConfig.Host).To(Equal("https://mk-test-cluster.eks.amazonaws.com"))
+				if tt.ingressConfigMap == nil {
+					return fake.NewSimpleDynamicClient(runtime.NewScheme()), nil, nil
+				}
+				return fake.NewSimpleDynamicClient(runtime.NewScheme(), tt.ingressConfigMap), nil, nil
+			}
+
+			spec, err := provider.CheckClusterStatus(testEKSClusterSpec(""))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spec.Status).To(Equal(tt.wantStatus))
+			Expect(len(client.CreateNodegroupCalls()) == 1).To(Equal(tt.wantNodegroupCreate))
+		})
+	}
+}
+
+func TestEKSProvider_CheckClusterStatus_NoClusterInfo(t *testing.T) {
+	RegisterTestingT(t)
+	_, err := newTestEKSProvider(&aws.EKSClientMock{}).CheckClusterStatus(&types.ClusterSpec{InternalID: testEKSClusterName})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestEKSProvider_Delete(t *testing.T) {
+	tests := []struct {
+		name              string
+		nodegroups        []*eks.Nodegroup
+		cluster           *eks.Cluster
+		want              bool
+		wantNodegroupDels int
+		wantClusterDels   int
+	}{
+		{
+			name:              "should delete the node groups first",
+			nodegroups:        []*eks.Nodegroup{testEKSNodegroup(eks.NodegroupStatusActive, 3)},
+			cluster:           &eks.Cluster{Status: awssdk.String(eks.ClusterStatusActive)},
+			wantNodegroupDels: 1,
+		},
+		{
+			name:       "should wait for the node groups being deleted",
+			nodegroups: []*eks.Nodegroup{testEKSNodegroup(eks.NodegroupStatusDeleting, 3)},
+			cluster:    &eks.Cluster{Status: awssdk.String(eks.ClusterStatusActive)},
+		},
+		{
+			name:            "should delete the cluster once it has no node group",
+			cluster:         &eks.Cluster{Status: awssdk.String(eks.ClusterStatusActive)},
+			wantClusterDels: 1,
+		},
+		{
+			name:    "should wait for the cluster being deleted",
+			cluster: &eks.Cluster{Status: awssdk.String(eks.ClusterStatusDeleting)},
+		},
+		{
+			name: "should be deleted when the cluster is not found",
+			want: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			client := &aws.EKSClientMock{
+				ListNodegroupsFunc: func(clusterName string) ([]string, error) {
+					var names []string
+					for _, nodegroup := range tt.nodegroups {
+						names = append(names, awssdk.StringValue(nodegroup.NodegroupName))
+					}
+					return names, nil
+				},
+				DescribeNodegroupFunc: func(clusterName string, nodegroupName string) (*eks.Nodegroup, error) {
+					return tt.nodegroups[0], nil
+				},
+				DeleteNodegroupFunc: func(clusterName string, nodegroupName string) error {
+					return nil
+				},
+				DescribeClusterFunc: func(clusterName string) (*eks.Cluster, error) {
+					return tt.cluster, nil
+				},
+				DeleteClusterFunc: func(clusterName string) error {
+					return nil
+				},
+			}
+
+			deleted, err := newTestEKSProvider(client).Delete(testEKSClusterSpec(api.ClusterDeprovisioning))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(Equal(tt.want))
+			Expect(client.DeleteNodegroupCalls()).To(HaveLen(tt.wantNodegroupDels))
+			Expect(client.DeleteClusterCalls()).To(HaveLen(tt.wantClusterDels))
+		})
+	}
+}
+
+func TestEKSProvider_ComputeNodes(t *testing.T) {
+	RegisterTestingT(t)
+	nodegroup := testEKSNodegroup(eks.NodegroupStatusActive, 6)
+	client := &aws.EKSClientMock{
+		DescribeNodegroupFunc: func(clusterName string, nodegroupName string) (*eks.Nodegroup, error) {
+			return nodegroup, nil
+		},
+		CountInServiceInstancesFunc: func(autoScalingGroupNames []string) (int, error) {
+			Expect(autoScalingGroupNames).To(Equal([]string{"eks-mk-test-cluster-compute"}))
+			return 5, nil
+		},
+		UpdateNodegroupScalingFunc: func(clusterName string, nodegroupName string, scalingConfig *eks.NodegroupScalingConfig) error {
+			return nil
+		},
+	}
+	provider := newTestEKSProvider(client)
+	spec := testEKSClusterSpec(api.ClusterReady)
+
+	nodes, err := provider.GetComputeNodes(spec)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(*nodes).To(Equal(types.ComputeNodesInfo{Actual: 5, Desired: 6}))
+
+	scalingConfig := func(call int) (int64, int64, int64) {
+		config := client.UpdateNodegroupScalingCalls()[call].ScalingConfig
+		return awssdk.Int64Value(config.MinSize), awssdk.Int64Value(config.DesiredSize), awssdk.Int64Value(config.MaxSize)
+	}
+
+	// the maximum size of the node group is raised to scale up
+	_, err = provider.ScaleUp(spec, 3)
+	Expect(err).ToNot(HaveOccurred())
+	min, desired, max := scalingConfig(0)
+	Expect([]int64{min, desired, max}).To(Equal([]int64{3, 9, 9}))
+
+	_, err = provider.ScaleDown(spec, 3)
+	Expect(err).ToNot(HaveOccurred())
+	min, desired, max = scalingConfig(1)
+	Expect([]int64{min, desired, max}).To(Equal([]int64{3, 3, 6}))
+
+	// the minimum size of the node group is lowered to set fewer nodes
+	_, err = provider.SetComputeNodes(spec, 2)
+	Expect(err).ToNot(HaveOccurred())
+	min, desired, max = scalingConfig(2)
+	Expect([]int64{min, desired, max}).To(Equal([]int64{2, 2, 6}))
+
+	nodegroup = nil
+	_, err = provider.SetComputeNodes(spec, 2)
+	Expect(err).To(HaveOccurred())
+}
+
+func TestEKSProvider_GetCloudProviderRegions(t *testing.T) {
+	RegisterTestingT(t)
+	client := &aws.EKSClientMock{
+		DescribeRegionsFunc: func() ([]*ec2.Region, error) {
+			return []*ec2.Region{
+				{RegionName: awssdk.String("us-east-1")},
+				{RegionName: awssdk.String("eu-west-1")},
+			}, nil
+		},
+	}
+	provider := newTestEKSProvider(client)
+
+	providers, err := provider.GetCloudProviders()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(providers.Items).To(HaveLen(1))
+
+	regions, err := provider.GetCloudProviderRegions(providers.Items[0])
+	Expect(err).ToNot(HaveOccurred())
+	Expect(regions.Items).To(Equal([]types.CloudProviderRegionInfo{
+		{ID: "us-east-1", CloudProviderID: "aws", Name: "us-east-1", DisplayName: "us-east-1", SupportsMultiAZ: true},
+		{ID: "eu-west-1", CloudProviderID: "aws", Name: "eu-west-1", DisplayName: "eu-west-1", SupportsMultiAZ: true},
+	}))
+
+	regions, err = provider.GetCloudProviderRegions(types.CloudProviderInfo{ID: "gcp"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(regions.Items).To(BeEmpty())
+}
+
+func TestDefaultProviderFactory_GetProvider_EKS(t *testing.T) {
+	RegisterTestingT(t)
+	factory := NewDefaultProviderFactory(nil, db.NewMockConnectionFactory(nil), nil, config.NewAWSConfig(), config.NewDataplaneClusterConfig(), aws.NewMockEKSClientFactory(&aws.EKSClientMock{}))
+	provider, err := factory.GetProvider(api.ClusterProviderAwsEKS)
+	Expect(err).ToNot(HaveOccurred())
+	_, ok := provider.(*EKSProvider)
+	Expect(ok).To(BeTrue())
+}
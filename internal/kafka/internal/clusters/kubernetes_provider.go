@@ -0,0 +1,273 @@
+package clusters
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// manifestDocumentSeparator splits the multi-document yaml manifests
+var manifestDocumentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+var configMapsResource = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+// KubernetesProvider manages the operators of existing vanilla kubernetes clusters. Unlike the StandaloneProvider it does
+// not rely on OLM nor on the OpenShift APIs: the operators are installed from manifests rendered from the configured
+// directories, the identity provider setup is left to the cluster administrator and the cluster dns is read from the
+// configuration of the ingress controller.
+type KubernetesProvider struct {
+	connectionFactory      *db.ConnectionFactory
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+}
+
+// blank assignment to verify that KubernetesProvider implements Provider
+var _ Provider = &KubernetesProvider{}
+
+func newKubernetesProvider(connectionFactory *db.ConnectionFactory, dataplaneClusterConfig *config.DataplaneClusterConfig) *KubernetesProvider {
+	return &KubernetesProvider{
+		connectionFactory:      connectionFactory,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+	}
+}
+
+// operatorManifestValues are the values available to the templates of the operator manifests
+type operatorManifestValues struct {
+	// Namespace is the namespace of the operator
+	Namespace string
+	// Parameters are the parameters of the operator, e.g. the kas-fleetshard sync parameters
+	Parameters map[string]string
+}
+
+func (k *KubernetesProvider) Create(request *types.ClusterRequest) (*types.ClusterSpec, error) {
+	return nil, nil
+}
+
+func (k *KubernetesProvider) Delete(spec *types.ClusterSpec) (bool, error) {
+	return true, nil
+}
+
+func (k *KubernetesProvider) CheckClusterStatus(spec *types.ClusterSpec) (*types.ClusterSpec, error) {
+	spec.Status = api.ClusterProvisioned
+	return spec, nil
+}
+
+func (k *KubernetesProvider) InstallStrimzi(clusterSpec *types.ClusterSpec) (bool, error) {
+	resources, err := buildStrimziOperatorResources(k.dataplaneClusterConfig)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = k.ApplyResources(clusterSpec, types.ResourceSet{
+		Resources: resources,
+	})
+
+	return true, err
+}
+
+func (k *KubernetesProvider) InstallKasFleetshard(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error) {
+	resources, err := buildKasFleetshardOperatorResources(k.dataplaneClusterConfig, params)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = k.ApplyResources(clusterSpec, types.ResourceSet{
+		Resources: resources,
+	})
+
+	return true, err
+}
+
+// buildStrimziOperatorResources returns the namespace of the strimzi operator followed by its rendered manifests
+func buildStrimziOperatorResources(dataplaneClusterConfig *config.DataplaneClusterConfig) ([]interface{}, error) {
+	namespace := dataplaneClusterConfig.StrimziOperatorOLMConfig.Namespace
+	resources, err := renderOperatorManifests(dataplaneClusterConfig.StrimziOperatorManifestsDir, operatorManifestValues{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to render strimzi operator manifests")
+	}
+	return append([]interface{}{buildNamespace(namespace)}, resources...), nil
+}
+
+// buildIngressControllerResources returns the namespace of the ingress controller followed by its rendered manifests
+func buildIngressControllerResources(ingressConfig config.IngressControllerConfig) ([]interface{}, error) {
+	resources, err := renderOperatorManifests(ingressConfig.ManifestsDir, operatorManifestValues{
+		Namespace: ingressConfig.Namespace,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to render ingress controller manifests")
+	}
+	return append([]interface{}{buildNamespace(ingressConfig.Namespace)}, resources...), nil
+}
+
+// buildKasFleetshardOperatorResources returns the namespace and the parameters secret of the kas-fleetshard operator
+// followed by its rendered manifests
+func buildKasFleetshardOperatorResources(dataplaneClusterConfig *config.DataplaneClusterConfig, params []types.Parameter) ([]interface{}, error) {
+	namespace := dataplaneClusterConfig.KasFleetshardOperatorOLMConfig.Namespace
+	parameters := map[string]string{}
+	for _, param := range params {
+		parameters[param.Id] = param.Value
+	}
+	resources, err := renderOperatorManifests(dataplaneClusterConfig.KasFleetshardOperatorManifestsDir, operatorManifestValues{
+		Namespace:  namespace,
+		Parameters: parameters,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to render kas-fleetshard operator manifests")
+	}
+	return append([]interface{}{
+		buildNamespace(namespace),
+		buildKASFleetShardSyncSecret(namespace, params),
+	}, resources...), nil
+}
+
+func (k *KubernetesProvider) InstallClusterLogging(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error) {
+	return true, nil // NOOP for now
+}
+
+// AddIdentityProvider is a NOOP: vanilla kubernetes has no identity provider API, the authentication of the cluster is
+// configured by its administrator
+func (k *KubernetesProvider) AddIdentityProvider(clusterSpec *types.ClusterSpec, identityProvider types.IdentityProviderInfo) (*types.IdentityProviderInfo, error) {
+	return &identityProvider, nil
+}
+
+func (k *KubernetesProvider) ApplyResources(clusterSpec *types.ClusterSpec, resources types.ResourceSet) (*types.ResourceSet, error) {
+	return applyResourcesToCluster(k.dataplaneClusterConfig, clusterSpec, resources)
+}
+
+// GetClusterDNS returns the domain of the ingress controller of the cluster, read from the configured config map. It is
+// only called when the cluster dns is not set in the dataplane cluster configuration.
+func (k *KubernetesProvider) GetClusterDNS(clusterSpec *types.ClusterSpec) (string, error) {
+	dynamicClient, _, err := newClusterDynamicClient(k.dataplaneClusterConfig, clusterSpec.InternalID)
+	if err != nil {
+		return "", err
+	}
+	if dynamicClient == nil {
+		return "", nil // no kubeconfig read, do nothing.
+	}
+
+	return getClusterIngressControllerDomain(dynamicClient, k.dataplaneClusterConfig.KubernetesIngressControllerConfig)
+}
+
+func getClusterIngressControllerDomain(dynamicClient dynamic.Interface, ingressConfig config.IngressControllerConfig) (string, error) {
+	configMap, err := getIngressControllerConfigMap(dynamicClient, ingressConfig)
+	if err != nil {
+		return "", err
+	}
+	return getIngressControllerDomain(configMap.Object, ingressConfig)
+}
+
+func getIngressControllerConfigMap(dynamicClient dynamic.Interface, ingressConfig config.IngressControllerConfig) (*unstructured.Unstructured, error) {
+	configMap, err := dynamicClient.Resource(configMapsResource).Namespace(ingressConfig.Namespace).Get(ctx, ingressConfig.ConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get ingress controller config map %s/%s", ingressConfig.Namespace, ingressConfig.ConfigMapName)
+	}
+	return configMap, nil
+}
+
+func getIngressControllerDomain(configMap map[string]interface{}, ingressConfig config.IngressControllerConfig) (string, error) {
+	data, _ := configMap["data"].(map[string]interface{})
+	domain, _ := data[ingressConfig.DomainKey].(string)
+	if domain == "" {
+		return "", errors.Errorf("ingress controller config map %s/%s has no %s key", ingressConfig.Namespace, ingressConfig.ConfigMapName, ingressConfig.DomainKey)
+	}
+	return domain, nil
+}
+
+func (k *KubernetesProvider) ScaleUp(clusterSpec *types.ClusterSpec, increment int) (*types.ClusterSpec, error) {
+	return clusterSpec, nil // NOOP
+}
+
+func (k *KubernetesProvider) ScaleDown(clusterSpec *types.ClusterSpec, decrement int) (*types.ClusterSpec, error) {
+	return clusterSpec, nil // NOOP
+}
+
+func (k *KubernetesProvider) SetComputeNodes(clusterSpec *types.ClusterSpec, numNodes int) (*types.ClusterSpec, error) {
+	return clusterSpec, nil // NOOP
+}
+
+func (k *KubernetesProvider) GetComputeNodes(spec *types.ClusterSpec) (*types.ComputeNodesInfo, error) {
+	return &types.ComputeNodesInfo{}, nil // NOOP
+}
+
+func (k *KubernetesProvider) UpgradeCluster(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error) {
+	return nil, types.ErrClusterUpgradeNotSupported // the clusters are not managed by the fleet manager
+}
+
+func (k *KubernetesProvider) CheckClusterUpgradeStatus(clusterSpec *types.ClusterSpec, upgrade *types.ClusterUpgrade) (*types.ClusterUpgrade, error) {
+	return nil, types.ErrClusterUpgradeNotSupported
+}
+
+func (k *KubernetesProvider) GetCloudProviders() (*types.CloudProviderInfoList, error) {
+	return getCloudProvidersByProviderType(k.connectionFactory, api.ClusterProviderKubernetes)
+}
+
+func (k *KubernetesProvider) GetCloudProviderRegions(providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
+	return getCloudProviderRegionsByProviderType(k.connectionFactory, api.ClusterProviderKubernetes, providerInf)
+}
+
+// renderOperatorManifests renders the .yaml and .yml templates of the directory in lexical order, like a helm chart whose
+// values are the operatorManifestValues. Each template can hold several documents separated by '---'.
+func renderOperatorManifests(dir string, values operatorManifestValues) ([]interface{}, error) {
+	if dir == "" {
+		return nil, errors.New("the manifests directory is not configured")
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, file := range files {
+		if ext := filepath.Ext(file.Name()); !file.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			names = append(names, file.Name())
+		}
+	}
+	sort.Strings(names)
+
+	resources := []interface{}{}
+	for _, name := range names {
+		content, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		tmpl, err := template.New(name).Option("missingkey=error").Parse(string(content))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse manifest %s", name)
+		}
+		var rendered bytes.Buffer
+		if err := tmpl.Execute(&rendered, values); err != nil {
+			return nil, errors.Wrapf(err, "failed to render manifest %s", name)
+		}
+
+		for _, document := range manifestDocumentSeparator.Split(rendered.String(), -1) {
+			if strings.TrimSpace(document) == "" {
+				continue
+			}
+			var resource map[string]interface{}
+			if err := yaml.Unmarshal([]byte(document), &resource); err != nil {
+				return nil, errors.Wrapf(err, "failed to parse manifest %s", name)
+			}
+			// documents holding only comments are empty
+			if len(resource) == 0 {
+				continue
+			}
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
@@ -0,0 +1,186 @@
+package clusters
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	. "github.com/onsi/gomega"
+)
+
+func TestKubernetesProvider_renderOperatorManifests(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		values  operatorManifestValues
+		want    []interface{}
+		wantErr bool
+	}{
+		{
+			name: "should render the manifests of the directory in lexical order",
+			files: map[string]string{
+				"02-deployment.yaml": `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: kas-fleetshard-sync
+  namespace: {{ .Namespace }}
+spec:
+  replicas: 1
+`,
+				"01-rbac.yml": `
+# the service account of the operator
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: kas-fleetshard-operator
+  namespace: {{ .Namespace }}
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: kas-fleetshard-config
+  namespace: {{ .Namespace }}
+data:
+  cluster-id: "{{ index .Parameters "cluster-id" }}"
+`,
+				"README.md": "not a manifest",
+			},
+			values: operatorManifestValues{
+				Namespace:  "kas-fleetshard",
+				Parameters: map[string]string{"cluster-id": "test-cluster"},
+			},
+			want: []interface{}{
+				map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "ServiceAccount",
+					"metadata":   map[string]interface{}{"name": "kas-fleetshard-operator", "namespace": "kas-fleetshard"},
+				},
+				map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+					"metadata":   map[string]interface{}{"name": "kas-fleetshard-config", "namespace": "kas-fleetshard"},
+					"data":       map[string]interface{}{"cluster-id": "test-cluster"},
+				},
+				map[string]interface{}{
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+					"metadata":   map[string]interface{}{"name": "kas-fleetshard-sync", "namespace": "kas-fleetshard"},
+					"spec":       map[string]interface{}{"replicas": float64(1)},
+				},
+			},
+		},
+		{
+			name: "should return an error when a template value is missing",
+			files: map[string]string{
+				"manifest.yaml": `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Name }}
+`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when a manifest is invalid",
+			files: map[string]string{
+				"manifest.yaml": "kind: [ConfigMap",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				Expect(ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600)).To(Succeed())
+			}
+
+			resources, err := renderOperatorManifests(dir, tt.values)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			if !tt.wantErr {
+				Expect(resources).To(Equal(tt.want))
+			}
+		})
+	}
+}
+
+func TestKubernetesProvider_renderOperatorManifests_NotConfigured(t *testing.T) {
+	RegisterTestingT(t)
+	_, err := renderOperatorManifests("", operatorManifestValues{})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestKubernetesProvider_getIngressControllerDomain(t *testing.T) {
+	ingressConfig := config.NewDataplaneClusterConfig().KubernetesIngressControllerConfig
+
+	tests := []struct {
+		name      string
+		configMap map[string]interface{}
+		want      string
+		wantErr   bool
+	}{
+		{
+			name: "should return the domain of the ingress controller",
+			configMap: map[string]interface{}{
+				"data": map[string]interface{}{"domain": "apps.example.com"},
+			},
+			want: "apps.example.com",
+		},
+		{
+			name: "should return an error when the domain is not set",
+			configMap: map[string]interface{}{
+				"data": map[string]interface{}{"use-forwarded-headers": "true"},
+			},
+			wantErr: true,
+		},
+		{
+			name:      "should return an error when the config map has no data",
+			configMap: map[string]interface{}{},
+			wantErr:   true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			domain, err := getIngressControllerDomain(tt.configMap, ingressConfig)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(domain).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestKubernetesProvider_NoOpenShiftResources(t *testing.T) {
+	RegisterTestingT(t)
+	provider := newKubernetesProvider(db.NewMockConnectionFactory(nil), config.NewDataplaneClusterConfig())
+	clusterSpec := &types.ClusterSpec{InternalID: "test-cluster"}
+
+	identityProvider := types.IdentityProviderInfo{OpenID: &types.OpenIDIdentityProviderInfo{Name: "test"}}
+	result, err := provider.AddIdentityProvider(clusterSpec, identityProvider)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(*result).To(Equal(identityProvider))
+
+	spec, err := provider.CheckClusterStatus(clusterSpec)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(spec.Status).To(Equal(api.ClusterProvisioned))
+}
+
+func TestDefaultProviderFactory_GetProvider_Kubernetes(t *testing.T) {
+	RegisterTestingT(t)
+	factory := NewDefaultProviderFactory(nil, db.NewMockConnectionFactory(nil), nil, nil, config.NewDataplaneClusterConfig(), nil)
+	provider, err := factory.GetProvider(api.ClusterProviderKubernetes)
+	Expect(err).ToNot(HaveOccurred())
+	_, ok := provider.(*KubernetesProvider)
+	Expect(ok).To(BeTrue())
+}
@@ -1,9 +1,11 @@
 package clusters
 
 import (
+	"fmt"
 	"net/http"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
@@ -18,6 +20,10 @@ import (
 
 const (
 	ipdAlreadyCreatedErrorToCheck = "already exists"
+	// OCM rejects manual upgrade policies that are not scheduled at least 5 minutes in the future
+	upgradePolicyScheduleDelay = 6 * time.Minute
+	upgradePolicyScheduleType  = "manual"
+	upgradePolicyUpgradeType   = "OSD"
 )
 
 type OCMProvider struct {
@@ -305,6 +311,81 @@ func (o *OCMProvider) GetCloudProviderRegions(providerInfo types.CloudProviderIn
 	return &list, nil
 }
 
+func (o *OCMProvider) UpgradeCluster(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error) {
+	upgradePolicy, err := clustersmgmtv1.NewUpgradePolicy().
+		ClusterID(clusterSpec.InternalID).
+		ScheduleType(upgradePolicyScheduleType).
+		UpgradeType(upgradePolicyUpgradeType).
+		Version(version).
+		NextRun(time.Now().Add(upgradePolicyScheduleDelay)).
+		Build()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build upgrade policy for cluster %s", clusterSpec.InternalID)
+	}
+
+	createdPolicy, err := o.ocmClient.CreateUpgradePolicy(clusterSpec.InternalID, upgradePolicy)
+	if err != nil {
+		// a client error means that OCM refused the upgrade (e.g. the version is not an available upgrade of the cluster),
+		// retrying will not help so the upgrade is reported as failed
+		if svcErr := svcErrors.ToServiceError(err); svcErr.IsClientErrorClass() {
+			return &types.ClusterUpgrade{
+				Version:      version,
+				State:        types.ClusterUpgradeFailed,
+				StateDetails: svcErr.Reason,
+			}, nil
+		}
+		return nil, errors.Wrapf(err, "failed to create upgrade policy for cluster %s", clusterSpec.InternalID)
+	}
+
+	return &types.ClusterUpgrade{
+		ID:      createdPolicy.ID(),
+		Version: version,
+		State:   types.ClusterUpgradePending,
+	}, nil
+}
+
+func (o *OCMProvider) CheckClusterUpgradeStatus(clusterSpec *types.ClusterSpec, upgrade *types.ClusterUpgrade) (*types.ClusterUpgrade, error) {
+	state, err := o.ocmClient.GetUpgradePolicyState(clusterSpec.InternalID, upgrade.ID)
+	if err != nil {
+		// OCM removes the upgrade policies once they are executed, the version of the cluster tells whether the upgrade succeeded
+		if svcErrors.ToServiceError(err).Is404() {
+			return o.checkClusterVersion(clusterSpec, upgrade)
+		}
+		return nil, errors.Wrapf(err, "failed to get state of upgrade policy %s for cluster %s", upgrade.ID, clusterSpec.InternalID)
+	}
+
+	result := *upgrade
+	result.StateDetails = state.Description()
+	switch state.Value() {
+	case clustersmgmtv1.UpgradePolicyStateValueStarted:
+		result.State = types.ClusterUpgradeStarted
+	case clustersmgmtv1.UpgradePolicyStateValueCompleted:
+		result.State = types.ClusterUpgradeCompleted
+	case clustersmgmtv1.UpgradePolicyStateValueFailed, clustersmgmtv1.UpgradePolicyStateValueCancelled:
+		result.State = types.ClusterUpgradeFailed
+	default:
+		result.State = types.ClusterUpgradePending
+	}
+	return &result, nil
+}
+
+func (o *OCMProvider) checkClusterVersion(clusterSpec *types.ClusterSpec, upgrade *types.ClusterUpgrade) (*types.ClusterUpgrade, error) {
+	ocmCluster, err := o.ocmClient.GetCluster(clusterSpec.InternalID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get cluster %s", clusterSpec.InternalID)
+	}
+
+	result := *upgrade
+	if ocmCluster.OpenshiftVersion() == upgrade.Version {
+		result.State = types.ClusterUpgradeCompleted
+		result.StateDetails = ""
+	} else {
+		result.State = types.ClusterUpgradeFailed
+		result.StateDetails = fmt.Sprintf("upgrade policy %s no longer exists and the cluster is running version %s", upgrade.ID, ocmCluster.OpenshiftVersion())
+	}
+	return &result, nil
+}
+
 // ensure OCMProvider implements Provider interface
 var _ Provider = &OCMProvider{}
 
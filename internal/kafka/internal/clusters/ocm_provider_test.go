@@ -1356,3 +1356,180 @@ func sampleOperatorGroup() *v1alpha2.OperatorGroup {
 		},
 	}
 }
+
+func TestOCMProvider_UpgradeCluster(t *testing.T) {
+	type fields struct {
+		ocmClient ocm.Client
+	}
+
+	spec := &types.ClusterSpec{InternalID: "test-internal-id"}
+	version := "4.10.3"
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    *types.ClusterUpgrade
+		wantErr bool
+	}{
+		{
+			name: "should create a manual upgrade policy",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{
+					CreateUpgradePolicyFunc: func(clusterID string, upgradePolicy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error) {
+						Expect(clusterID).To(Equal(spec.InternalID))
+						Expect(upgradePolicy.Version()).To(Equal(version))
+						Expect(upgradePolicy.ScheduleType()).To(Equal(upgradePolicyScheduleType))
+						Expect(upgradePolicy.NextRun()).To(BeTemporally(">", time.Now()))
+						return clustersmgmtv1.NewUpgradePolicy().ID("policy-id").Build()
+					},
+				},
+			},
+			want: &types.ClusterUpgrade{
+				ID:      "policy-id",
+				Version: version,
+				State:   types.ClusterUpgradePending,
+			},
+		},
+		{
+			name: "should report a failed upgrade when OCM refuses the upgrade policy",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{
+					CreateUpgradePolicyFunc: func(clusterID string, upgradePolicy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error) {
+						return nil, apiErrors.BadRequest("version is not an available upgrade")
+					},
+				},
+			},
+			want: &types.ClusterUpgrade{
+				Version:      version,
+				State:        types.ClusterUpgradeFailed,
+				StateDetails: "version is not an available upgrade",
+			},
+		},
+		{
+			name: "should return error when OCM fails",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{
+					CreateUpgradePolicyFunc: func(clusterID string, upgradePolicy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error) {
+						return nil, apiErrors.GeneralError("test")
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			p := newOCMProvider(test.fields.ocmClient, nil, &ocm.OCMConfig{})
+			resp, err := p.UpgradeCluster(spec, version)
+			Expect(err != nil).To(Equal(test.wantErr))
+			Expect(resp).To(Equal(test.want))
+		})
+	}
+}
+
+func TestOCMProvider_CheckClusterUpgradeStatus(t *testing.T) {
+	type fields struct {
+		ocmClient ocm.Client
+	}
+
+	spec := &types.ClusterSpec{InternalID: "test-internal-id"}
+	upgrade := &types.ClusterUpgrade{ID: "policy-id", Version: "4.10.3", State: types.ClusterUpgradePending}
+
+	upgradePolicyState := func(value clustersmgmtv1.UpgradePolicyStateValue) func(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error) {
+		return func(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error) {
+			Expect(upgradePolicyID).To(Equal(upgrade.ID))
+			return clustersmgmtv1.NewUpgradePolicyState().Value(value).Description("test").Build()
+		}
+	}
+	upgradePolicyNotFound := func(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error) {
+		return nil, apiErrors.NotFound("upgrade policy not found")
+	}
+	clusterWithVersion := func(version string) func(clusterID string) (*clustersmgmtv1.Cluster, error) {
+		return func(clusterID string) (*clustersmgmtv1.Cluster, error) {
+			return clustersmgmtv1.NewCluster().ID(clusterID).OpenshiftVersion(version).Build()
+		}
+	}
+
+	tests := []struct {
+		name      string
+		fields    fields
+		wantState types.ClusterUpgradeState
+		wantErr   bool
+	}{
+		{
+			name: "should be pending while the upgrade policy is scheduled",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{GetUpgradePolicyStateFunc: upgradePolicyState(clustersmgmtv1.UpgradePolicyStateValueScheduled)},
+			},
+			wantState: types.ClusterUpgradePending,
+		},
+		{
+			name: "should be started when the upgrade policy is started",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{GetUpgradePolicyStateFunc: upgradePolicyState(clustersmgmtv1.UpgradePolicyStateValueStarted)},
+			},
+			wantState: types.ClusterUpgradeStarted,
+		},
+		{
+			name: "should be completed when the upgrade policy is completed",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{GetUpgradePolicyStateFunc: upgradePolicyState(clustersmgmtv1.UpgradePolicyStateValueCompleted)},
+			},
+			wantState: types.ClusterUpgradeCompleted,
+		},
+		{
+			name: "should be failed when the upgrade policy is cancelled",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{GetUpgradePolicyStateFunc: upgradePolicyState(clustersmgmtv1.UpgradePolicyStateValueCancelled)},
+			},
+			wantState: types.ClusterUpgradeFailed,
+		},
+		{
+			name: "should be completed when the upgrade policy no longer exists and the cluster runs the target version",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{
+					GetUpgradePolicyStateFunc: upgradePolicyNotFound,
+					GetClusterFunc:            clusterWithVersion("4.10.3"),
+				},
+			},
+			wantState: types.ClusterUpgradeCompleted,
+		},
+		{
+			name: "should be failed when the upgrade policy no longer exists and the cluster runs another version",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{
+					GetUpgradePolicyStateFunc: upgradePolicyNotFound,
+					GetClusterFunc:            clusterWithVersion("4.9.24"),
+				},
+			},
+			wantState: types.ClusterUpgradeFailed,
+		},
+		{
+			name: "should return error when OCM fails",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{
+					GetUpgradePolicyStateFunc: func(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error) {
+						return nil, apiErrors.GeneralError("test")
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			p := newOCMProvider(test.fields.ocmClient, nil, &ocm.OCMConfig{})
+			resp, err := p.CheckClusterUpgradeStatus(spec, upgrade)
+			Expect(err != nil).To(Equal(test.wantErr))
+			if !test.wantErr {
+				Expect(resp.ID).To(Equal(upgrade.ID))
+				Expect(resp.Version).To(Equal(upgrade.Version))
+				Expect(resp.State).To(Equal(test.wantState))
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/pkg/errors"
@@ -43,6 +44,11 @@ type Provider interface {
 	InstallClusterLogging(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error)
 	// Install the cluster logging operator for a given cluster
 	InstallKasFleetshard(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error)
+	// UpgradeCluster request the upgrade of the OpenShift version of the cluster to the given version.
+	// It should return types.ErrClusterUpgradeNotSupported if the clusters of the provider cannot be upgraded.
+	UpgradeCluster(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error)
+	// CheckClusterUpgradeStatus check the status of an upgrade requested with UpgradeCluster. This will be called periodically until the upgrade is either completed or failed.
+	CheckClusterUpgradeStatus(clusterSpec *types.ClusterSpec, upgrade *types.ClusterUpgrade) (*types.ClusterUpgrade, error)
 }
 
 // ProviderFactory used to return an instance of Provider implementation
@@ -62,13 +68,20 @@ func NewDefaultProviderFactory(
 	ocmConfig *ocm.OCMConfig,
 	awsConfig *config.AWSConfig,
 	dataplaneClusterConfig *config.DataplaneClusterConfig,
+	eksClientFactory aws.EKSClientFactory,
 ) *DefaultProviderFactory {
 	ocmProvider := newOCMProvider(ocmClient, NewClusterBuilder(awsConfig, dataplaneClusterConfig), ocmConfig)
 	standaloneProvider := newStandaloneProvider(connectionFactory, dataplaneClusterConfig)
+	kubernetesProvider := newKubernetesProvider(connectionFactory, dataplaneClusterConfig)
+	eksProvider := newEKSProvider(eksClientFactory, awsConfig, dataplaneClusterConfig)
+	simulatedProvider := newSimulatedProvider(connectionFactory, dataplaneClusterConfig)
 	return &DefaultProviderFactory{
 		providerContainer: map[api.ClusterProviderType]Provider{
 			api.ClusterProviderStandalone: standaloneProvider,
+			api.ClusterProviderKubernetes: kubernetesProvider,
 			api.ClusterProviderOCM:        ocmProvider,
+			api.ClusterProviderAwsEKS:     eksProvider,
+			api.ClusterProviderSimulated:  simulatedProvider,
 		},
 	}
 }
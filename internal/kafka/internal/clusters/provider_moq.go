@@ -28,6 +28,9 @@ var _ Provider = &ProviderMock{}
 // 			CheckClusterStatusFunc: func(spec *types.ClusterSpec) (*types.ClusterSpec, error) {
 // 				panic("mock out the CheckClusterStatus method")
 // 			},
+// 			CheckClusterUpgradeStatusFunc: func(clusterSpec *types.ClusterSpec, upgrade *types.ClusterUpgrade) (*types.ClusterUpgrade, error) {
+// 				panic("mock out the CheckClusterUpgradeStatus method")
+// 			},
 // 			CreateFunc: func(request *types.ClusterRequest) (*types.ClusterSpec, error) {
 // 				panic("mock out the Create method")
 // 			},
@@ -64,6 +67,9 @@ var _ Provider = &ProviderMock{}
 // 			SetComputeNodesFunc: func(clusterSpec *types.ClusterSpec, numNodes int) (*types.ClusterSpec, error) {
 // 				panic("mock out the SetComputeNodes method")
 // 			},
+// 			UpgradeClusterFunc: func(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error) {
+// 				panic("mock out the UpgradeCluster method")
+// 			},
 // 		}
 //
 // 		// use mockedProvider in code that requires Provider
@@ -80,6 +86,9 @@ type ProviderMock struct {
 	// CheckClusterStatusFunc mocks the CheckClusterStatus method.
 	CheckClusterStatusFunc func(spec *types.ClusterSpec) (*types.ClusterSpec, error)
 
+	// CheckClusterUpgradeStatusFunc mocks the CheckClusterUpgradeStatus method.
+	CheckClusterUpgradeStatusFunc func(clusterSpec *types.ClusterSpec, upgrade *types.ClusterUpgrade) (*types.ClusterUpgrade, error)
+
 	// CreateFunc mocks the Create method.
 	CreateFunc func(request *types.ClusterRequest) (*types.ClusterSpec, error)
 
@@ -116,6 +125,9 @@ type ProviderMock struct {
 	// SetComputeNodesFunc mocks the SetComputeNodes method.
 	SetComputeNodesFunc func(clusterSpec *types.ClusterSpec, numNodes int) (*types.ClusterSpec, error)
 
+	// UpgradeClusterFunc mocks the UpgradeCluster method.
+	UpgradeClusterFunc func(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AddIdentityProvider holds details about calls to the AddIdentityProvider method.
@@ -137,6 +149,13 @@ type ProviderMock struct {
 			// Spec is the spec argument value.
 			Spec *types.ClusterSpec
 		}
+		// CheckClusterUpgradeStatus holds details about calls to the CheckClusterUpgradeStatus method.
+		CheckClusterUpgradeStatus []struct {
+			// ClusterSpec is the clusterSpec argument value.
+			ClusterSpec *types.ClusterSpec
+			// Upgrade is the upgrade argument value.
+			Upgrade *types.ClusterUpgrade
+		}
 		// Create holds details about calls to the Create method.
 		Create []struct {
 			// Request is the request argument value.
@@ -205,22 +224,31 @@ type ProviderMock struct {
 			// NumNodes is the numNodes argument value.
 			NumNodes int
 		}
+		// UpgradeCluster holds details about calls to the UpgradeCluster method.
+		UpgradeCluster []struct {
+			// ClusterSpec is the clusterSpec argument value.
+			ClusterSpec *types.ClusterSpec
+			// Version is the version argument value.
+			Version string
+		}
 	}
-	lockAddIdentityProvider     sync.RWMutex
-	lockApplyResources          sync.RWMutex
-	lockCheckClusterStatus      sync.RWMutex
-	lockCreate                  sync.RWMutex
-	lockDelete                  sync.RWMutex
-	lockGetCloudProviderRegions sync.RWMutex
-	lockGetCloudProviders       sync.RWMutex
-	lockGetClusterDNS           sync.RWMutex
-	lockGetComputeNodes         sync.RWMutex
-	lockInstallClusterLogging   sync.RWMutex
-	lockInstallKasFleetshard    sync.RWMutex
-	lockInstallStrimzi          sync.RWMutex
-	lockScaleDown               sync.RWMutex
-	lockScaleUp                 sync.RWMutex
-	lockSetComputeNodes         sync.RWMutex
+	lockAddIdentityProvider       sync.RWMutex
+	lockApplyResources            sync.RWMutex
+	lockCheckClusterStatus        sync.RWMutex
+	lockCheckClusterUpgradeStatus sync.RWMutex
+	lockCreate                    sync.RWMutex
+	lockDelete                    sync.RWMutex
+	lockGetCloudProviderRegions   sync.RWMutex
+	lockGetCloudProviders         sync.RWMutex
+	lockGetClusterDNS             sync.RWMutex
+	lockGetComputeNodes           sync.RWMutex
+	lockInstallClusterLogging     sync.RWMutex
+	lockInstallKasFleetshard      sync.RWMutex
+	lockInstallStrimzi            sync.RWMutex
+	lockScaleDown                 sync.RWMutex
+	lockScaleUp                   sync.RWMutex
+	lockSetComputeNodes           sync.RWMutex
+	lockUpgradeCluster            sync.RWMutex
 }
 
 // AddIdentityProvider calls AddIdentityProviderFunc.
@@ -324,6 +352,41 @@ func (mock *ProviderMock) CheckClusterStatusCalls() []struct {
 	return calls
 }
 
+// CheckClusterUpgradeStatus calls CheckClusterUpgradeStatusFunc.
+func (mock *ProviderMock) CheckClusterUpgradeStatus(clusterSpec *types.ClusterSpec, upgrade *types.ClusterUpgrade) (*types.ClusterUpgrade, error) {
+	if mock.CheckClusterUpgradeStatusFunc == nil {
+		panic("ProviderMock.CheckClusterUpgradeStatusFunc: method is nil but Provider.CheckClusterUpgradeStatus was just called")
+	}
+	callInfo := struct {
+		ClusterSpec *types.ClusterSpec
+		Upgrade     *types.ClusterUpgrade
+	}{
+		ClusterSpec: clusterSpec,
+		Upgrade:     upgrade,
+	}
+	mock.lockCheckClusterUpgradeStatus.Lock()
+	mock.calls.CheckClusterUpgradeStatus = append(mock.calls.CheckClusterUpgradeStatus, callInfo)
+	mock.lockCheckClusterUpgradeStatus.Unlock()
+	return mock.CheckClusterUpgradeStatusFunc(clusterSpec, upgrade)
+}
+
+// CheckClusterUpgradeStatusCalls gets all the calls that were made to CheckClusterUpgradeStatus.
+// Check the length with:
+//     len(mockedProvider.CheckClusterUpgradeStatusCalls())
+func (mock *ProviderMock) CheckClusterUpgradeStatusCalls() []struct {
+	ClusterSpec *types.ClusterSpec
+	Upgrade     *types.ClusterUpgrade
+} {
+	var calls []struct {
+		ClusterSpec *types.ClusterSpec
+		Upgrade     *types.ClusterUpgrade
+	}
+	mock.lockCheckClusterUpgradeStatus.RLock()
+	calls = mock.calls.CheckClusterUpgradeStatus
+	mock.lockCheckClusterUpgradeStatus.RUnlock()
+	return calls
+}
+
 // Create calls CreateFunc.
 func (mock *ProviderMock) Create(request *types.ClusterRequest) (*types.ClusterSpec, error) {
 	if mock.CreateFunc == nil {
@@ -710,3 +773,38 @@ func (mock *ProviderMock) SetComputeNodesCalls() []struct {
 	mock.lockSetComputeNodes.RUnlock()
 	return calls
 }
+
+// UpgradeCluster calls UpgradeClusterFunc.
+func (mock *ProviderMock) UpgradeCluster(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error) {
+	if mock.UpgradeClusterFunc == nil {
+		panic("ProviderMock.UpgradeClusterFunc: method is nil but Provider.UpgradeCluster was just called")
+	}
+	callInfo := struct {
+		ClusterSpec *types.ClusterSpec
+		Version     string
+	}{
+		ClusterSpec: clusterSpec,
+		Version:     version,
+	}
+	mock.lockUpgradeCluster.Lock()
+	mock.calls.UpgradeCluster = append(mock.calls.UpgradeCluster, callInfo)
+	mock.lockUpgradeCluster.Unlock()
+	return mock.UpgradeClusterFunc(clusterSpec, version)
+}
+
+// UpgradeClusterCalls gets all the calls that were made to UpgradeCluster.
+// Check the length with:
+//     len(mockedProvider.UpgradeClusterCalls())
+func (mock *ProviderMock) UpgradeClusterCalls() []struct {
+	ClusterSpec *types.ClusterSpec
+	Version     string
+} {
+	var calls []struct {
+		ClusterSpec *types.ClusterSpec
+		Version     string
+	}
+	mock.lockUpgradeCluster.RLock()
+	calls = mock.calls.UpgradeCluster
+	mock.lockUpgradeCluster.RUnlock()
+	return calls
+}
@@ -0,0 +1,312 @@
+package clusters
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// simulatedKasFleetshardOperatorResourceSetName is the name of the resource set recorded when the kas-fleetshard
+// operator is installed in a simulated cluster
+const simulatedKasFleetshardOperatorResourceSetName = "kas-fleetshard-operator"
+
+// SimulatedProvider simulates clusters for local development and tests, without any cloud or OCM account. The simulated
+// clusters are provisioned, get their operators installed and are deprovisioned after the durations of the
+// SimulatedClusterConfig. The resources applied to them and their scale operations are recorded in memory.
+type SimulatedProvider struct {
+	connectionFactory      *db.ConnectionFactory
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+	idGenerator            ocm.IDGenerator
+	now                    func() time.Time
+
+	mutex    sync.Mutex
+	clusters map[string]*simulatedCluster
+}
+
+// blank assignment to verify that SimulatedProvider implements Provider
+var _ Provider = &SimulatedProvider{}
+
+func newSimulatedProvider(connectionFactory *db.ConnectionFactory, dataplaneClusterConfig *config.DataplaneClusterConfig) *SimulatedProvider {
+	return &SimulatedProvider{
+		connectionFactory:      connectionFactory,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+		idGenerator:            ocm.NewIDGenerator(ClusterNamePrefix),
+		now:                    time.Now,
+		clusters:               map[string]*simulatedCluster{},
+	}
+}
+
+// simulatedClusterInfo is the additional information of the simulated clusters, saved in their cluster spec so that
+// their progress survives restarts
+type simulatedClusterInfo struct {
+	CreatedAt     time.Time  `json:"created_at"`
+	ProvisionedAt *time.Time `json:"provisioned_at,omitempty"`
+}
+
+// SimulatedScaleOperation is a change of the number of compute nodes of a simulated cluster
+type SimulatedScaleOperation struct {
+	From int
+	To   int
+}
+
+// simulatedCluster is the in memory state of a simulated cluster
+type simulatedCluster struct {
+	computeNodes        int
+	resourceSets        []types.ResourceSet
+	scaleOperations     []SimulatedScaleOperation
+	deletionRequestedAt *time.Time
+	upgradeRequestedAt  *time.Time
+}
+
+func (s *SimulatedProvider) Create(request *types.ClusterRequest) (*types.ClusterSpec, error) {
+	clusterInfo, err := json.Marshal(simulatedClusterInfo{CreatedAt: s.now()})
+	if err != nil {
+		return nil, err
+	}
+	return &types.ClusterSpec{
+		InternalID:     s.idGenerator.Generate(),
+		Status:         api.ClusterProvisioning,
+		AdditionalInfo: clusterInfo,
+	}, nil
+}
+
+// CheckClusterStatus provisions the cluster once the provisioning duration has elapsed since its creation. The clusters
+// that were not created by the provider, e.g. the clusters of the dataplane cluster configuration, are considered
+// created by the first check.
+func (s *SimulatedProvider) CheckClusterStatus(spec *types.ClusterSpec) (*types.ClusterSpec, error) {
+	info := getSimulatedClusterInfo(spec)
+	if info.CreatedAt.IsZero() {
+		info.CreatedAt = s.now()
+	}
+	if spec.Status == "" {
+		spec.Status = api.ClusterProvisioning
+	}
+
+	if info.ProvisionedAt == nil && s.now().Sub(info.CreatedAt) >= s.dataplaneClusterConfig.SimulatedClusterConfig.ProvisioningDuration {
+		provisionedAt := s.now()
+		info.ProvisionedAt = &provisionedAt
+	}
+	if info.ProvisionedAt != nil {
+		spec.Status = api.ClusterProvisioned
+	}
+
+	clusterInfo, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	spec.AdditionalInfo = clusterInfo
+	return spec, nil
+}
+
+func getSimulatedClusterInfo(spec *types.ClusterSpec) *simulatedClusterInfo {
+	info := &simulatedClusterInfo{}
+	if len(spec.AdditionalInfo) > 0 {
+		// an invalid cluster information is reset
+		_ = json.Unmarshal(spec.AdditionalInfo, info)
+	}
+	return info
+}
+
+// Delete deletes the cluster once the deprovisioning duration has elapsed since the first deletion request
+func (s *SimulatedProvider) Delete(spec *types.ClusterSpec) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster := s.getCluster(spec.InternalID)
+	if cluster.deletionRequestedAt == nil {
+		deletionRequestedAt := s.now()
+		cluster.deletionRequestedAt = &deletionRequestedAt
+	}
+	if s.now().Sub(*cluster.deletionRequestedAt) < s.dataplaneClusterConfig.SimulatedClusterConfig.DeprovisioningDuration {
+		return false, nil
+	}
+	delete(s.clusters, spec.InternalID)
+	return true, nil
+}
+
+func (s *SimulatedProvider) InstallStrimzi(clusterSpec *types.ClusterSpec) (bool, error) {
+	return s.operatorsInstalled(clusterSpec), nil
+}
+
+func (s *SimulatedProvider) InstallKasFleetshard(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error) {
+	namespace := s.dataplaneClusterConfig.KasFleetshardOperatorOLMConfig.Namespace
+	_, err := s.ApplyResources(clusterSpec, types.ResourceSet{
+		Name: simulatedKasFleetshardOperatorResourceSetName,
+		Resources: []interface{}{
+			buildNamespace(namespace),
+			buildKASFleetShardSyncSecret(namespace, params),
+		},
+	})
+	return s.operatorsInstalled(clusterSpec), err
+}
+
+func (s *SimulatedProvider) InstallClusterLogging(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error) {
+	return s.operatorsInstalled(clusterSpec), nil
+}
+
+// operatorsInstalled returns true once the operators install duration has elapsed since the cluster was provisioned
+func (s *SimulatedProvider) operatorsInstalled(clusterSpec *types.ClusterSpec) bool {
+	info := getSimulatedClusterInfo(clusterSpec)
+	return info.ProvisionedAt != nil && s.now().Sub(*info.ProvisionedAt) >= s.dataplaneClusterConfig.SimulatedClusterConfig.OperatorsInstallDuration
+}
+
+func (s *SimulatedProvider) AddIdentityProvider(clusterSpec *types.ClusterSpec, identityProvider types.IdentityProviderInfo) (*types.IdentityProviderInfo, error) {
+	return &identityProvider, nil
+}
+
+// ApplyResources records the resource set, replacing the resource set of the same name applied before
+func (s *SimulatedProvider) ApplyResources(clusterSpec *types.ClusterSpec, resources types.ResourceSet) (*types.ResourceSet, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster := s.getCluster(clusterSpec.InternalID)
+	for i, resourceSet := range cluster.resourceSets {
+		if resourceSet.Name == resources.Name {
+			cluster.resourceSets[i] = resources
+			return &resources, nil
+		}
+	}
+	cluster.resourceSets = append(cluster.resourceSets, resources)
+	return &resources, nil
+}
+
+// AppliedResources returns the resource sets applied to the cluster
+func (s *SimulatedProvider) AppliedResources(clusterID string) []types.ResourceSet {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]types.ResourceSet{}, s.getCluster(clusterID).resourceSets...)
+}
+
+// ScaleOperations returns the scale operations of the compute nodes of the cluster
+func (s *SimulatedProvider) ScaleOperations(clusterID string) []SimulatedScaleOperation {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]SimulatedScaleOperation{}, s.getCluster(clusterID).scaleOperations...)
+}
+
+func (s *SimulatedProvider) GetClusterDNS(clusterSpec *types.ClusterSpec) (string, error) {
+	return fmt.Sprintf("%s.%s.%s", constants.DefaultIngressDnsNamePrefix, clusterSpec.InternalID, s.dataplaneClusterConfig.SimulatedClusterConfig.Domain), nil
+}
+
+func (s *SimulatedProvider) ScaleUp(clusterSpec *types.ClusterSpec, increment int) (*types.ClusterSpec, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster := s.getCluster(clusterSpec.InternalID)
+	s.scale(clusterSpec.InternalID, cluster, cluster.computeNodes+increment)
+	return clusterSpec, nil
+}
+
+func (s *SimulatedProvider) ScaleDown(clusterSpec *types.ClusterSpec, decrement int) (*types.ClusterSpec, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster := s.getCluster(clusterSpec.InternalID)
+	s.scale(clusterSpec.InternalID, cluster, cluster.computeNodes-decrement)
+	return clusterSpec, nil
+}
+
+func (s *SimulatedProvider) SetComputeNodes(clusterSpec *types.ClusterSpec, numNodes int) (*types.ClusterSpec, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.scale(clusterSpec.InternalID, s.getCluster(clusterSpec.InternalID), numNodes)
+	return clusterSpec, nil
+}
+
+// scale sets the compute nodes of the cluster right away and records the scale operation. The caller must hold the mutex.
+func (s *SimulatedProvider) scale(clusterID string, cluster *simulatedCluster, numNodes int) {
+	glog.Infof("scaling compute nodes of simulated cluster %s from %d to %d", clusterID, cluster.computeNodes, numNodes)
+	cluster.scaleOperations = append(cluster.scaleOperations, SimulatedScaleOperation{From: cluster.computeNodes, To: numNodes})
+	cluster.computeNodes = numNodes
+}
+
+func (s *SimulatedProvider) GetComputeNodes(spec *types.ClusterSpec) (*types.ComputeNodesInfo, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	computeNodes := s.getCluster(spec.InternalID).computeNodes
+	return &types.ComputeNodesInfo{
+		Actual:  computeNodes,
+		Desired: computeNodes,
+	}, nil
+}
+
+func (s *SimulatedProvider) UpgradeCluster(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	upgradeRequestedAt := s.now()
+	s.getCluster(clusterSpec.InternalID).upgradeRequestedAt = &upgradeRequestedAt
+	return &types.ClusterUpgrade{
+		ID:      s.idGenerator.Generate(),
+		Version: version,
+		State:   types.ClusterUpgradePending,
+	}, nil
+}
+
+// CheckClusterUpgradeStatus completes the upgrade once the upgrade duration has elapsed since it was requested. The
+// upgrades that are not known, e.g. after a restart, are considered requested by the first check.
+func (s *SimulatedProvider) CheckClusterUpgradeStatus(clusterSpec *types.ClusterSpec, upgrade *types.ClusterUpgrade) (*types.ClusterUpgrade, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster := s.getCluster(clusterSpec.InternalID)
+	if cluster.upgradeRequestedAt == nil {
+		upgradeRequestedAt := s.now()
+		cluster.upgradeRequestedAt = &upgradeRequestedAt
+	}
+	result := *upgrade
+	result.State = types.ClusterUpgradeStarted
+	if s.now().Sub(*cluster.upgradeRequestedAt) >= s.dataplaneClusterConfig.SimulatedClusterConfig.UpgradeDuration {
+		result.State = types.ClusterUpgradeCompleted
+		cluster.upgradeRequestedAt = nil
+	}
+	return &result, nil
+}
+
+func (s *SimulatedProvider) GetCloudProviders() (*types.CloudProviderInfoList, error) {
+	return getCloudProvidersByProviderType(s.connectionFactory, api.ClusterProviderSimulated)
+}
+
+func (s *SimulatedProvider) GetCloudProviderRegions(providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
+	return getCloudProviderRegionsByProviderType(s.connectionFactory, api.ClusterProviderSimulated, providerInf)
+}
+
+// getCluster returns the in memory state of the cluster, initializing it when the cluster is not known yet e.g. after a
+// restart. The caller must hold the mutex.
+func (s *SimulatedProvider) getCluster(clusterID string) *simulatedCluster {
+	cluster, ok := s.clusters[clusterID]
+	if !ok {
+		cluster = &simulatedCluster{
+			computeNodes: s.dataplaneClusterConfig.SimulatedClusterConfig.ComputeNodes,
+		}
+		s.clusters[clusterID] = cluster
+	}
+	return cluster
+}
+
+// GetSimulatedProvider returns the simulated provider of the provider factory, to inspect the simulated clusters in tests
+func GetSimulatedProvider(providerFactory ProviderFactory) (*SimulatedProvider, error) {
+	provider, err := providerFactory.GetProvider(api.ClusterProviderSimulated)
+	if err != nil {
+		return nil, err
+	}
+	simulatedProvider, ok := provider.(*SimulatedProvider)
+	if !ok {
+		return nil, errors.Errorf("the %s provider is not simulated", api.ClusterProviderSimulated)
+	}
+	return simulatedProvider, nil
+}
@@ -0,0 +1,159 @@
+package clusters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
+	. "github.com/onsi/gomega"
+)
+
+const testSimulatedClusterID = "mk-simulated-cluster"
+
+// newTestSimulatedProvider returns a simulated provider whose clock is advanced by the returned function
+func newTestSimulatedProvider() (*SimulatedProvider, func(time.Duration)) {
+	provider := newSimulatedProvider(nil, config.NewDataplaneClusterConfig())
+	provider.idGenerator = &ocm.IDGeneratorMock{
+		GenerateFunc: func() string {
+			return testSimulatedClusterID
+		},
+	}
+	now := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	provider.now = func() time.Time {
+		return now
+	}
+	return provider, func(d time.Duration) {
+		now = now.Add(d)
+	}
+}
+
+func TestSimulatedProvider_ClusterLifecycle(t *testing.T) {
+	RegisterTestingT(t)
+	provider, advance := newTestSimulatedProvider()
+	simulatedConfig := provider.dataplaneClusterConfig.SimulatedClusterConfig
+
+	spec, err := provider.Create(&types.ClusterRequest{})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(spec.InternalID).To(Equal(testSimulatedClusterID))
+	Expect(spec.Status).To(Equal(api.ClusterProvisioning))
+
+	spec, err = provider.CheckClusterStatus(spec)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(spec.Status).To(Equal(api.ClusterProvisioning))
+
+	advance(simulatedConfig.ProvisioningDuration)
+	spec, err = provider.CheckClusterStatus(spec)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(spec.Status).To(Equal(api.ClusterProvisioned))
+
+	installed, err := provider.InstallStrimzi(spec)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(installed).To(BeFalse())
+
+	advance(simulatedConfig.OperatorsInstallDuration)
+	installed, err = provider.InstallStrimzi(spec)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(installed).To(BeTrue())
+	installed, err = provider.InstallKasFleetshard(spec, []types.Parameter{{Id: "sso-client-id", Value: "client"}})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(installed).To(BeTrue())
+	resourceSets := provider.AppliedResources(testSimulatedClusterID)
+	Expect(resourceSets).To(HaveLen(1))
+	Expect(resourceSets[0].Name).To(Equal(simulatedKasFleetshardOperatorResourceSetName))
+
+	deleted, err := provider.Delete(spec)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(deleted).To(BeFalse())
+
+	advance(simulatedConfig.DeprovisioningDuration)
+	deleted, err = provider.Delete(spec)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(deleted).To(BeTrue())
+}
+
+func TestSimulatedProvider_CheckClusterStatusWithoutClusterInfo(t *testing.T) {
+	RegisterTestingT(t)
+	provider, advance := newTestSimulatedProvider()
+
+	spec, err := provider.CheckClusterStatus(&types.ClusterSpec{InternalID: testSimulatedClusterID})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(spec.Status).To(Equal(api.ClusterProvisioning))
+	Expect(spec.AdditionalInfo).NotTo(BeEmpty())
+
+	advance(provider.dataplaneClusterConfig.SimulatedClusterConfig.ProvisioningDuration)
+	spec, err = provider.CheckClusterStatus(spec)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(spec.Status).To(Equal(api.ClusterProvisioned))
+}
+
+func TestSimulatedProvider_ApplyResources(t *testing.T) {
+	RegisterTestingT(t)
+	provider, _ := newTestSimulatedProvider()
+	spec := &types.ClusterSpec{InternalID: testSimulatedClusterID}
+
+	_, err := provider.ApplyResources(spec, types.ResourceSet{Name: "first", Resources: []interface{}{"a"}})
+	Expect(err).NotTo(HaveOccurred())
+	_, err = provider.ApplyResources(spec, types.ResourceSet{Name: "second"})
+	Expect(err).NotTo(HaveOccurred())
+	_, err = provider.ApplyResources(spec, types.ResourceSet{Name: "first", Resources: []interface{}{"b"}})
+	Expect(err).NotTo(HaveOccurred())
+
+	Expect(provider.AppliedResources(testSimulatedClusterID)).To(Equal([]types.ResourceSet{
+		{Name: "first", Resources: []interface{}{"b"}},
+		{Name: "second"},
+	}))
+}
+
+func TestSimulatedProvider_Scale(t *testing.T) {
+	RegisterTestingT(t)
+	provider, _ := newTestSimulatedProvider()
+	spec := &types.ClusterSpec{InternalID: testSimulatedClusterID}
+
+	_, err := provider.ScaleUp(spec, 3)
+	Expect(err).NotTo(HaveOccurred())
+	_, err = provider.ScaleDown(spec, 3)
+	Expect(err).NotTo(HaveOccurred())
+	_, err = provider.SetComputeNodes(spec, 9)
+	Expect(err).NotTo(HaveOccurred())
+
+	nodes, err := provider.GetComputeNodes(spec)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(nodes).To(Equal(&types.ComputeNodesInfo{Actual: 9, Desired: 9}))
+	Expect(provider.ScaleOperations(testSimulatedClusterID)).To(Equal([]SimulatedScaleOperation{
+		{From: 3, To: 6},
+		{From: 6, To: 3},
+		{From: 3, To: 9},
+	}))
+}
+
+func TestSimulatedProvider_GetClusterDNS(t *testing.T) {
+	RegisterTestingT(t)
+	provider, _ := newTestSimulatedProvider()
+
+	dns, err := provider.GetClusterDNS(&types.ClusterSpec{InternalID: testSimulatedClusterID})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(dns).To(Equal("apps.mk-simulated-cluster.simulated.local"))
+}
+
+func TestSimulatedProvider_UpgradeCluster(t *testing.T) {
+	RegisterTestingT(t)
+	provider, advance := newTestSimulatedProvider()
+	spec := &types.ClusterSpec{InternalID: testSimulatedClusterID}
+
+	upgrade, err := provider.UpgradeCluster(spec, "4.10.3")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(upgrade.Version).To(Equal("4.10.3"))
+	Expect(upgrade.State).To(Equal(types.ClusterUpgradePending))
+
+	upgrade, err = provider.CheckClusterUpgradeStatus(spec, upgrade)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(upgrade.State).To(Equal(types.ClusterUpgradeStarted))
+
+	advance(provider.dataplaneClusterConfig.SimulatedClusterConfig.UpgradeDuration)
+	upgrade, err = provider.CheckClusterUpgradeStatus(spec, upgrade)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(upgrade.State).To(Equal(types.ClusterUpgradeCompleted))
+}
@@ -20,6 +20,7 @@ import (
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
 )
@@ -85,14 +86,17 @@ func (s *StandaloneProvider) InstallStrimzi(clusterSpec *types.ClusterSpec) (boo
 }
 
 func (s *StandaloneProvider) buildStrimziOperatorNamespace() *v1.Namespace {
-	strimziOLMConfig := s.dataplaneClusterConfig.StrimziOperatorOLMConfig
+	return buildNamespace(s.dataplaneClusterConfig.StrimziOperatorOLMConfig.Namespace)
+}
+
+func buildNamespace(name string) *v1.Namespace {
 	return &v1.Namespace{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: v1.SchemeGroupVersion.String(),
 			Kind:       "Namespace",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: strimziOLMConfig.Namespace,
+			Name: name,
 		},
 	}
 }
@@ -167,16 +171,7 @@ func (s *StandaloneProvider) InstallKasFleetshard(clusterSpec *types.ClusterSpec
 }
 
 func (s *StandaloneProvider) buildKASFleetShardOperatorNamespace() *v1.Namespace {
-	kasFleetshardOLMConfig := s.dataplaneClusterConfig.KasFleetshardOperatorOLMConfig
-	return &v1.Namespace{
-		TypeMeta: metav1.TypeMeta{
-			APIVersion: v1.SchemeGroupVersion.String(),
-			Kind:       "Namespace",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name: kasFleetshardOLMConfig.Namespace,
-		},
-	}
+	return buildNamespace(s.dataplaneClusterConfig.KasFleetshardOperatorOLMConfig.Namespace)
 }
 
 func (s *StandaloneProvider) buildKASFleetShardOperatorCatalogSource() *operatorsv1alpha1.CatalogSource {
@@ -235,12 +230,17 @@ func (s *StandaloneProvider) buildKASFleetShardOperatorSubscription() *operators
 }
 
 func (s *StandaloneProvider) buildKASFleetShardSyncSecret(params []types.Parameter) *v1.Secret {
+	return buildKASFleetShardSyncSecret(s.dataplaneClusterConfig.KasFleetshardOperatorOLMConfig.Namespace, params)
+}
+
+// buildKASFleetShardSyncSecret builds the secret holding the parameters of the kas-fleetshard operator, which is the same
+// as the one created by the kas-fleetshard addon
+func buildKASFleetShardSyncSecret(namespace string, params []types.Parameter) *v1.Secret {
 	secretStringData := map[string]string{}
 	for _, param := range params {
 		secretStringData[param.Id] = param.Value
 	}
 
-	kasFleetshardOLMConfig := s.dataplaneClusterConfig.KasFleetshardOperatorOLMConfig
 	return &v1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "v1",
@@ -248,7 +248,7 @@ func (s *StandaloneProvider) buildKASFleetShardSyncSecret(params []types.Paramet
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      kasFleetShardOperatorParametersSecretName,
-			Namespace: kasFleetshardOLMConfig.Namespace,
+			Namespace: namespace,
 		},
 		StringData: secretStringData,
 	}
@@ -334,42 +334,70 @@ func (s *StandaloneProvider) buildIdentityProviderResource(identityProvider type
 }
 
 func (s *StandaloneProvider) ApplyResources(clusterSpec *types.ClusterSpec, resources types.ResourceSet) (*types.ResourceSet, error) {
-	if s.dataplaneClusterConfig.RawKubernetesConfig == nil {
+	return applyResourcesToCluster(s.dataplaneClusterConfig, clusterSpec, resources)
+}
+
+// applyResourcesToCluster applies the resources to the cluster whose kubeconfig context is given by the name of the cluster
+// in the dataplane cluster configuration. Nothing is applied when the kubeconfig has not been read.
+func applyResourcesToCluster(dataplaneClusterConfig *config.DataplaneClusterConfig, clusterSpec *types.ClusterSpec, resources types.ResourceSet) (*types.ResourceSet, error) {
+	dynamicClient, mapper, err := newClusterDynamicClient(dataplaneClusterConfig, clusterSpec.InternalID)
+	if err != nil {
+		return nil, err
+	}
+	if dynamicClient == nil {
 		return &resources, nil // no kubeconfig read, do nothing.
 	}
 
-	contextName := s.dataplaneClusterConfig.FindClusterNameByClusterId(clusterSpec.InternalID)
+	return applyResourcesWithClient(dynamicClient, mapper, resources)
+}
+
+func applyResourcesWithClient(dynamicClient dynamic.Interface, mapper *restmapper.DeferredDiscoveryRESTMapper, resources types.ResourceSet) (*types.ResourceSet, error) {
+	for _, resource := range resources.Resources {
+		_, err := applyResource(dynamicClient, mapper, resource)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &resources, nil
+}
+
+// newClusterDynamicClient returns a dynamic client and a REST mapper for the cluster with the given id, or a nil client
+// when the kubeconfig has not been read.
+func newClusterDynamicClient(dataplaneClusterConfig *config.DataplaneClusterConfig, clusterId string) (dynamic.Interface, *restmapper.DeferredDiscoveryRESTMapper, error) {
+	rawKubernetesConfig := dataplaneClusterConfig.GetRawKubernetesConfig()
+	if rawKubernetesConfig == nil {
+		return nil, nil, nil
+	}
+
+	contextName := dataplaneClusterConfig.FindClusterNameByClusterId(clusterId)
 	override := &clientcmd.ConfigOverrides{CurrentContext: contextName}
-	config := *s.dataplaneClusterConfig.RawKubernetesConfig
+	config := *rawKubernetesConfig
 	restConfig, err := clientcmd.NewNonInteractiveClientConfig(config, override.CurrentContext, override, &clientcmd.ClientConfigLoadingRules{}).
 		ClientConfig()
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	return newDynamicClientForConfig(restConfig)
+}
+
+// newDynamicClientForConfig returns a dynamic client and a REST mapper for the cluster of the rest config
+func newDynamicClientForConfig(restConfig *rest.Config) (dynamic.Interface, *restmapper.DeferredDiscoveryRESTMapper, error) {
 	dynamicClient, err := dynamic.NewForConfig(restConfig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Create a REST mapper that tracks information about the available resources in the cluster.
 	dc, err := discovery.NewDiscoveryClientForConfig(restConfig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	discoveryCachedClient := memory.NewMemCacheClient(dc)
-	mapper := restmapper.NewDeferredDiscoveryRESTMapper(discoveryCachedClient)
-
-	for _, resource := range resources.Resources {
-		_, err = applyResource(dynamicClient, mapper, resource)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return &resources, nil
+	return dynamicClient, restmapper.NewDeferredDiscoveryRESTMapper(discoveryCachedClient), nil
 }
 
 func (s *StandaloneProvider) ScaleUp(clusterSpec *types.ClusterSpec, increment int) (*types.ClusterSpec, error) {
@@ -388,14 +416,32 @@ func (s *StandaloneProvider) GetComputeNodes(spec *types.ClusterSpec) (*types.Co
 	return &types.ComputeNodesInfo{}, nil // NOOP
 }
 
+func (s *StandaloneProvider) UpgradeCluster(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error) {
+	return nil, types.ErrClusterUpgradeNotSupported // the clusters are not managed by the fleet manager
+}
+
+func (s *StandaloneProvider) CheckClusterUpgradeStatus(clusterSpec *types.ClusterSpec, upgrade *types.ClusterUpgrade) (*types.ClusterUpgrade, error) {
+	return nil, types.ErrClusterUpgradeNotSupported
+}
+
 func (s *StandaloneProvider) GetCloudProviders() (*types.CloudProviderInfoList, error) {
+	return getCloudProvidersByProviderType(s.connectionFactory, api.ClusterProviderStandalone)
+}
+
+func (s *StandaloneProvider) GetCloudProviderRegions(providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
+	return getCloudProviderRegionsByProviderType(s.connectionFactory, api.ClusterProviderStandalone, providerInf)
+}
+
+// getCloudProvidersByProviderType returns the cloud providers of the clusters of the given provider type, as the clusters
+// of the providers that do not create clusters are only known from the dataplane cluster configuration
+func getCloudProvidersByProviderType(connectionFactory *db.ConnectionFactory, providerType api.ClusterProviderType) (*types.CloudProviderInfoList, error) {
 	type Cluster struct {
 		CloudProvider string
 	}
-	dbConn := s.connectionFactory.New().
+	dbConn := connectionFactory.New().
 		Model(&Cluster{}).
 		Distinct("cloud_provider").
-		Where("provider_type = ?", api.ClusterProviderStandalone.String()).
+		Where("provider_type = ?", providerType.String()).
 		Where("status NOT IN (?)", api.ClusterDeletionStatuses)
 
 	var results []Cluster
@@ -416,16 +462,17 @@ func (s *StandaloneProvider) GetCloudProviders() (*types.CloudProviderInfoList,
 	return &types.CloudProviderInfoList{Items: items}, nil
 }
 
-func (s *StandaloneProvider) GetCloudProviderRegions(providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
+// getCloudProviderRegionsByProviderType returns the regions of the given cloud provider where there are clusters of the given provider type
+func getCloudProviderRegionsByProviderType(connectionFactory *db.ConnectionFactory, providerType api.ClusterProviderType, providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
 	type Cluster struct {
 		Region  string
 		MultiAZ bool
 	}
-	dbConn := s.connectionFactory.New().
+	dbConn := connectionFactory.New().
 		Model(&Cluster{}).
 		Distinct("region", "multi_az").
 		Where("cloud_provider = ?", providerInf.ID).
-		Where("provider_type = ?", api.ClusterProviderStandalone.String()).
+		Where("provider_type = ?", providerType.String()).
 		Where("status NOT IN (?)", api.ClusterDeletionStatuses)
 
 	var results []Cluster
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"errors"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
 )
@@ -98,3 +100,32 @@ func (target *CloudProviderRegionInfoList) Merge(source *CloudProviderRegionInfo
 		}
 	}
 }
+
+// ErrClusterUpgradeNotSupported is returned by the providers whose clusters cannot be upgraded by the fleet manager
+var ErrClusterUpgradeNotSupported = errors.New("the cluster provider does not support cluster upgrades")
+
+// ClusterUpgradeState the state of an upgrade of the OpenShift version of a cluster
+type ClusterUpgradeState string
+
+const (
+	// ClusterUpgradePending the upgrade has been requested to the provider but has not started yet
+	ClusterUpgradePending ClusterUpgradeState = "pending"
+	// ClusterUpgradeStarted the cluster is being upgraded
+	ClusterUpgradeStarted ClusterUpgradeState = "started"
+	// ClusterUpgradeCompleted the cluster has been upgraded
+	ClusterUpgradeCompleted ClusterUpgradeState = "completed"
+	// ClusterUpgradeFailed the upgrade of the cluster failed or has been cancelled in the provider
+	ClusterUpgradeFailed ClusterUpgradeState = "failed"
+)
+
+// ClusterUpgrade information about an upgrade of the OpenShift version of a cluster
+type ClusterUpgrade struct {
+	// id of the upgrade in the provider. Used when checking the progress of the upgrade
+	ID string
+	// the OpenShift version the cluster is upgraded to
+	Version string
+	// the state of the upgrade
+	State ClusterUpgradeState
+	// details about the state (for example, error messages for state = failed)
+	StateDetails string
+}
@@ -42,7 +42,7 @@ func runProviderList(
 		Items: []public.CloudProvider{},
 	}
 
-	supportedProviders := providerConfig.ProvidersConfig.SupportedProviders
+	supportedProviders := providerConfig.GetSupportedProviders()
 	for _, cloudProvider := range cloudProviders {
 		_, cloudProvider.Enabled = supportedProviders.GetByName(cloudProvider.Id)
 		converted := presenters.PresentCloudProvider(&cloudProvider)
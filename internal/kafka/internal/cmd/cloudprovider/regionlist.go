@@ -50,7 +50,7 @@ func runRegionsList(env *environments.Env, cmd *cobra.Command, _ []string) {
 		Items: []public.CloudRegion{},
 	}
 
-	supportedProviders := providerConfig.ProvidersConfig.SupportedProviders
+	supportedProviders := providerConfig.GetSupportedProviders()
 	provider, _ := supportedProviders.GetByName(id)
 	for _, cloudRegion := range cloudRegions {
 		region, _ := provider.Regions.GetByName(cloudRegion.Id)
@@ -19,6 +19,14 @@ type AWSConfig struct {
 	Route53AccessKeyFile       string `json:"route53_access_key_file"`
 	Route53SecretAccessKey     string `json:"route53_secret_access_key"`
 	Route53SecretAccessKeyFile string `json:"route53_secret_access_key_file"`
+
+	// Used for EKS Cluster creation, with the AccessKey and SecretAccessKey credentials
+	EKSClusterRoleARN        string `json:"eks_cluster_role_arn"`
+	EKSNodeRoleARN           string `json:"eks_node_role_arn"`
+	EKSKubernetesVersion     string `json:"eks_kubernetes_version"`
+	EKSSubnetTagKey          string `json:"eks_subnet_tag_key"`
+	EKSComputeNodes          int    `json:"eks_compute_nodes"`
+	EKSRegionsEndpointRegion string `json:"eks_regions_endpoint_region"`
 }
 
 func NewAWSConfig() *AWSConfig {
@@ -28,6 +36,9 @@ func NewAWSConfig() *AWSConfig {
 		SecretAccessKeyFile:        "secrets/aws.secretaccesskey",
 		Route53AccessKeyFile:       "secrets/aws.route53accesskey",
 		Route53SecretAccessKeyFile: "secrets/aws.route53secretaccesskey",
+		EKSSubnetTagKey:            "kas-fleet-manager/eks",
+		EKSComputeNodes:            3,
+		EKSRegionsEndpointRegion:   "us-east-1",
 	}
 }
 
@@ -37,6 +48,12 @@ func (c *AWSConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.SecretAccessKeyFile, "aws-secret-access-key-file", c.SecretAccessKeyFile, "File containing AWS secret access key")
 	fs.StringVar(&c.Route53AccessKeyFile, "aws-route53-access-key-file", c.Route53AccessKeyFile, "File containing AWS access key for route53")
 	fs.StringVar(&c.Route53SecretAccessKeyFile, "aws-route53-secret-access-key-file", c.Route53SecretAccessKeyFile, "File containing AWS secret access key for route53")
+	fs.StringVar(&c.EKSClusterRoleARN, "aws-eks-cluster-role-arn", c.EKSClusterRoleARN, "ARN of the IAM role assumed by the EKS clusters control plane")
+	fs.StringVar(&c.EKSNodeRoleARN, "aws-eks-node-role-arn", c.EKSNodeRoleARN, "ARN of the IAM role assumed by the EKS clusters compute nodes")
+	fs.StringVar(&c.EKSKubernetesVersion, "aws-eks-kubernetes-version", c.EKSKubernetesVersion, "The kubernetes version of the EKS clusters. An empty string indicates that the EKS default version should be used")
+	fs.StringVar(&c.EKSSubnetTagKey, "aws-eks-subnet-tag-key", c.EKSSubnetTagKey, "Tag key of the subnets of a region the EKS clusters are created in")
+	fs.IntVar(&c.EKSComputeNodes, "aws-eks-compute-nodes", c.EKSComputeNodes, "Initial number of compute nodes of the EKS clusters")
+	fs.StringVar(&c.EKSRegionsEndpointRegion, "aws-eks-regions-endpoint-region", c.EKSRegionsEndpointRegion, "Region of the endpoint used to list the regions available to the EKS clusters")
 }
 
 func (c *AWSConfig) ReadFiles() error {
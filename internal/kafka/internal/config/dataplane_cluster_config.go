@@ -5,12 +5,15 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/pkg/errors"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/blang/semver/v4"
 	userv1 "github.com/openshift/api/user/v1"
 	"github.com/spf13/pflag"
 	"gopkg.in/yaml.v2"
@@ -28,6 +31,7 @@ type DataplaneClusterConfig struct {
 	// 'auto' to use dynamic scaling
 	// 'none' to disabled scaling all together, useful in testing
 	DataPlaneClusterScalingType           string `json:"dataplane_cluster_scaling_type"`
+	AutoScalingClusterProviderType        string `json:"auto_scaling_cluster_provider_type"`
 	DataPlaneClusterConfigFile            string `json:"dataplane_cluster_config_file"`
 	ReadOnlyUserList                      userv1.OptionalNames
 	ReadOnlyUserListFile                  string
@@ -39,6 +43,65 @@ type DataplaneClusterConfig struct {
 	RawKubernetesConfig                   *clientcmdapi.Config
 	StrimziOperatorOLMConfig              OperatorInstallationConfig `json:"strimzi_operator_olm_config"`
 	KasFleetshardOperatorOLMConfig        OperatorInstallationConfig `json:"kas_fleetshard_operator_olm_config"`
+	// StrimziOperatorManifestsDir and KasFleetshardOperatorManifestsDir are the directories of the manifests applied to
+	// install the operators in the kubernetes clusters, where OLM is not available
+	StrimziOperatorManifestsDir       string                  `json:"strimzi_operator_manifests_dir"`
+	KasFleetshardOperatorManifestsDir string                  `json:"kas_fleetshard_operator_manifests_dir"`
+	KubernetesIngressControllerConfig IngressControllerConfig `json:"kubernetes_ingress_controller_config"`
+	SimulatedClusterConfig            SimulatedClusterConfig  `json:"simulated_cluster_config"`
+	// ClusterHeartbeatThreshold is the time after which a cluster whose kas-fleetshard operator stopped reporting the
+	// cluster status is considered unresponsive. Zero disables the detection of the unresponsive clusters.
+	ClusterHeartbeatThreshold time.Duration `json:"cluster_heartbeat_threshold"`
+	// StrimziOpenshiftCompatibility is the list of the OpenShift versions each Strimzi version can run on, loaded from
+	// StrimziOpenshiftCompatibilityFile. It is checked before upgrading the OpenShift version of a cluster.
+	StrimziOpenshiftCompatibility     []StrimziOpenshiftCompatibility
+	StrimziOpenshiftCompatibilityFile string
+
+	// supportedProviders returns the supported providers currently loaded, which the reloaded manual cluster
+	// configuration is validated against. It is set by NewSupportedProvidersConfig.
+	supportedProviders func() ProviderList
+}
+
+// StrimziOpenshiftCompatibility is the range of the OpenShift versions a Strimzi version can run on
+type StrimziOpenshiftCompatibility struct {
+	StrimziVersion string `yaml:"strimzi_version"`
+	// OpenshiftVersions is a semantic version range, e.g. '>=4.8.0 <4.11.0'
+	OpenshiftVersions string `yaml:"openshift_versions"`
+}
+
+// SimulatedClusterConfig configures the clusters of the 'simulated' provider and the fleetshard simulator, used for local
+// development and tests
+type SimulatedClusterConfig struct {
+	// ProvisioningDuration, OperatorsInstallDuration and DeprovisioningDuration are the times taken by the simulated
+	// clusters to be provisioned, to get their operators installed and to be deprovisioned
+	ProvisioningDuration     time.Duration `json:"provisioning_duration"`
+	OperatorsInstallDuration time.Duration `json:"operators_install_duration"`
+	DeprovisioningDuration   time.Duration `json:"deprovisioning_duration"`
+	// UpgradeDuration is the time taken by the simulated clusters to be upgraded to a new OpenShift version
+	UpgradeDuration time.Duration `json:"upgrade_duration"`
+	// Domain is the base domain of the dns of the simulated clusters
+	Domain string `json:"domain"`
+	// ComputeNodes is the initial number of compute nodes of the simulated clusters, MaxComputeNodes the number of
+	// compute nodes they can be scaled up to
+	ComputeNodes    int `json:"compute_nodes"`
+	MaxComputeNodes int `json:"max_compute_nodes"`
+	// EnableFleetshardSimulator enables the in-process simulation of the kas-fleetshard operator of the simulated
+	// clusters, which reports their status and the status of their kafkas
+	EnableFleetshardSimulator bool `json:"enable_fleetshard_simulator"`
+	// StrimziVersion, KafkaVersion and KafkaIBPVersion are the versions reported by the fleetshard simulator
+	StrimziVersion  string `json:"strimzi_version"`
+	KafkaVersion    string `json:"kafka_version"`
+	KafkaIBPVersion string `json:"kafka_ibp_version"`
+}
+
+// IngressControllerConfig locates the config map holding the domain of the ingress controller of the kubernetes clusters,
+// which is used as the cluster dns when it is not set in the dataplane cluster configuration. ManifestsDir is the
+// directory of the ingress controller manifests applied to the clusters created by the fleet manager.
+type IngressControllerConfig struct {
+	Namespace     string `json:"namespace"`
+	ConfigMapName string `json:"config_map_name"`
+	DomainKey     string `json:"domain_key"`
+	ManifestsDir  string `json:"manifests_dir"`
 }
 
 type OperatorInstallationConfig struct {
@@ -75,10 +138,13 @@ func NewDataplaneClusterConfig() *DataplaneClusterConfig {
 		DataPlaneClusterConfigFile:            "config/dataplane-cluster-configuration.yaml",
 		ReadOnlyUserListFile:                  "config/read-only-user-list.yaml",
 		KafkaSREUsersFile:                     "config/kafka-sre-user-list.yaml",
+		StrimziOpenshiftCompatibilityFile:     "config/strimzi-openshift-compatibility.yaml",
 		DataPlaneClusterScalingType:           ManualScaling,
+		AutoScalingClusterProviderType:        api.ClusterProviderOCM.String(),
 		ClusterConfig:                         &ClusterConfig{},
 		EnableReadyDataPlaneClustersReconcile: true,
 		Kubeconfig:                            getDefaultKubeconfig(),
+		ClusterHeartbeatThreshold:             5 * time.Minute,
 		StrimziOperatorOLMConfig: OperatorInstallationConfig{
 			IndexImage:             "quay.io/osd-addons/managed-kafka:production-82b42db",
 			CatalogSourceNamespace: "openshift-marketplace",
@@ -93,6 +159,23 @@ func NewDataplaneClusterConfig() *DataplaneClusterConfig {
 			SubscriptionChannel:    "alpha",
 			Package:                "kas-fleetshard-operator",
 		},
+		KubernetesIngressControllerConfig: IngressControllerConfig{
+			Namespace:     "ingress-nginx",
+			ConfigMapName: "ingress-nginx-controller",
+			DomainKey:     "domain",
+		},
+		SimulatedClusterConfig: SimulatedClusterConfig{
+			ProvisioningDuration:     30 * time.Second,
+			OperatorsInstallDuration: 10 * time.Second,
+			DeprovisioningDuration:   10 * time.Second,
+			UpgradeDuration:          30 * time.Second,
+			Domain:                   "simulated.local",
+			ComputeNodes:             3,
+			MaxComputeNodes:          18,
+			StrimziVersion:           "strimzi-cluster-operator.v0.24.0-0",
+			KafkaVersion:             "2.8.0",
+			KafkaIBPVersion:          "2.8",
+		},
 	}
 }
 
@@ -140,6 +223,19 @@ func (c *ManualCluster) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		c.Status = api.ClusterProvisioning // force to cluster provisioning status as we do not want to call StandaloneProvider to create the cluster.
 	}
 
+	if c.ProviderType == api.ClusterProviderKubernetes {
+		// the cluster dns is read from the ingress controller configuration of the cluster when it is not provided
+		if c.Name == "" {
+			return errors.Errorf("Kubernetes cluster with id %s does not have the name field provided", c.ClusterId)
+		}
+
+		c.Status = api.ClusterProvisioning // force to cluster provisioning status as we do not want to call KubernetesProvider to create the cluster.
+	}
+
+	if c.ProviderType == api.ClusterProviderSimulated {
+		c.Status = api.ClusterProvisioning // force to cluster provisioning status as we do not want to call SimulatedProvider to create the cluster.
+	}
+
 	if c.SupportedInstanceType == "" {
 		c.SupportedInstanceType = api.AllInstanceTypeSupport.String()
 	}
@@ -249,6 +345,10 @@ func (c *DataplaneClusterConfig) IsDataPlaneAutoScalingEnabled() bool {
 	return c.DataPlaneClusterScalingType == AutoScaling
 }
 
+func (c *DataplaneClusterConfig) GetAutoScalingClusterProviderType() api.ClusterProviderType {
+	return api.ClusterProviderType(c.AutoScalingClusterProviderType)
+}
+
 func (c *DataplaneClusterConfig) IsReadyDataPlaneClustersReconcileEnabled() bool {
 	return c.EnableReadyDataPlaneClustersReconcile
 }
@@ -259,10 +359,13 @@ func (c *DataplaneClusterConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.ImagePullDockerConfigFile, "image-pull-docker-config-file", c.ImagePullDockerConfigFile, "The file that contains the docker config content for pulling MK operator images on clusters")
 	fs.StringVar(&c.DataPlaneClusterConfigFile, "dataplane-cluster-config-file", c.DataPlaneClusterConfigFile, "File contains properties for manually configuring OSD cluster.")
 	fs.StringVar(&c.DataPlaneClusterScalingType, "dataplane-cluster-scaling-type", c.DataPlaneClusterScalingType, "Set to use cluster configuration to configure clusters. Its value should be either 'none' for no scaling, 'manual' or 'auto'.")
+	fs.StringVar(&c.AutoScalingClusterProviderType, "dataplane-cluster-auto-scaling-provider-type", c.AutoScalingClusterProviderType, "The provider of the clusters created by the 'auto' scaling. Its value should be either 'ocm', 'aws_eks' or 'simulated'.")
 	fs.StringVar(&c.ReadOnlyUserListFile, "read-only-user-list-file", c.ReadOnlyUserListFile, "File contains a list of users with read-only permissions to data plane clusters")
 	fs.StringVar(&c.KafkaSREUsersFile, "kafka-sre-user-list-file", c.KafkaSREUsersFile, "File contains a list of kafka-sre users with cluster-admin permissions to data plane clusters")
+	fs.StringVar(&c.StrimziOpenshiftCompatibilityFile, "strimzi-openshift-compatibility-file", c.StrimziOpenshiftCompatibilityFile, "File contains the OpenShift versions each Strimzi version can run on, checked before upgrading data plane clusters")
 	fs.BoolVar(&c.EnableReadyDataPlaneClustersReconcile, "enable-ready-dataplane-clusters-reconcile", c.EnableReadyDataPlaneClustersReconcile, "Enables reconciliation for data plane clusters in the 'Ready' state")
 	fs.StringVar(&c.Kubeconfig, "kubeconfig", c.Kubeconfig, "A path to kubeconfig file used for communication with standalone clusters")
+	fs.DurationVar(&c.ClusterHeartbeatThreshold, "dataplane-cluster-heartbeat-threshold", c.ClusterHeartbeatThreshold, "Time after which a data plane cluster whose kas-fleetshard operator stopped reporting its status is marked unresponsive. Zero disables the detection of unresponsive clusters")
 	fs.StringVar(&c.StrimziOperatorOLMConfig.CatalogSourceNamespace, "strimzi-operator-cs-namespace", c.StrimziOperatorOLMConfig.CatalogSourceNamespace, "Strimzi operator catalog source namespace.")
 	fs.StringVar(&c.StrimziOperatorOLMConfig.IndexImage, "strimzi-operator-index-image", c.StrimziOperatorOLMConfig.IndexImage, "Strimzi operator index image")
 	fs.StringVar(&c.StrimziOperatorOLMConfig.Namespace, "strimzi-operator-namespace", c.StrimziOperatorOLMConfig.Namespace, "Strimzi operator namespace")
@@ -273,9 +376,34 @@ func (c *DataplaneClusterConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.KasFleetshardOperatorOLMConfig.Namespace, "kas-fleetshard-operator-namespace", c.KasFleetshardOperatorOLMConfig.Namespace, "kas-fleetshard operator namespace")
 	fs.StringVar(&c.KasFleetshardOperatorOLMConfig.Package, "kas-fleetshard-operator-package", c.KasFleetshardOperatorOLMConfig.Package, "kas-fleetshard operator package")
 	fs.StringVar(&c.KasFleetshardOperatorOLMConfig.SubscriptionChannel, "kas-fleetshard-operator-sub-channel", c.KasFleetshardOperatorOLMConfig.SubscriptionChannel, "kas-fleetshard operator subscription channel")
+	fs.StringVar(&c.StrimziOperatorManifestsDir, "strimzi-operator-manifests-dir", c.StrimziOperatorManifestsDir, "Directory of the Strimzi operator manifests installed in kubernetes clusters")
+	fs.StringVar(&c.KasFleetshardOperatorManifestsDir, "kas-fleetshard-operator-manifests-dir", c.KasFleetshardOperatorManifestsDir, "Directory of the kas-fleetshard operator manifests installed in kubernetes clusters")
+	fs.StringVar(&c.KubernetesIngressControllerConfig.Namespace, "kubernetes-ingress-controller-namespace", c.KubernetesIngressControllerConfig.Namespace, "Namespace of the config map holding the ingress controller domain of kubernetes clusters")
+	fs.StringVar(&c.KubernetesIngressControllerConfig.ConfigMapName, "kubernetes-ingress-controller-config-map", c.KubernetesIngressControllerConfig.ConfigMapName, "Name of the config map holding the ingress controller domain of kubernetes clusters")
+	fs.StringVar(&c.KubernetesIngressControllerConfig.DomainKey, "kubernetes-ingress-controller-domain-key", c.KubernetesIngressControllerConfig.DomainKey, "Key of the ingress controller domain in the config map of kubernetes clusters")
+	fs.StringVar(&c.KubernetesIngressControllerConfig.ManifestsDir, "kubernetes-ingress-controller-manifests-dir", c.KubernetesIngressControllerConfig.ManifestsDir, "Directory of the ingress controller manifests installed in the EKS clusters")
+	fs.DurationVar(&c.SimulatedClusterConfig.ProvisioningDuration, "simulated-cluster-provisioning-duration", c.SimulatedClusterConfig.ProvisioningDuration, "Time taken by the simulated clusters to be provisioned")
+	fs.DurationVar(&c.SimulatedClusterConfig.OperatorsInstallDuration, "simulated-cluster-operators-install-duration", c.SimulatedClusterConfig.OperatorsInstallDuration, "Time taken by the simulated clusters to get their operators installed once provisioned")
+	fs.DurationVar(&c.SimulatedClusterConfig.DeprovisioningDuration, "simulated-cluster-deprovisioning-duration", c.SimulatedClusterConfig.DeprovisioningDuration, "Time taken by the simulated clusters to be deprovisioned")
+	fs.DurationVar(&c.SimulatedClusterConfig.UpgradeDuration, "simulated-cluster-upgrade-duration", c.SimulatedClusterConfig.UpgradeDuration, "Time taken by the simulated clusters to be upgraded to a new OpenShift version")
+	fs.StringVar(&c.SimulatedClusterConfig.Domain, "simulated-cluster-domain", c.SimulatedClusterConfig.Domain, "Base domain of the dns of the simulated clusters")
+	fs.IntVar(&c.SimulatedClusterConfig.ComputeNodes, "simulated-cluster-compute-nodes", c.SimulatedClusterConfig.ComputeNodes, "Initial number of compute nodes of the simulated clusters")
+	fs.IntVar(&c.SimulatedClusterConfig.MaxComputeNodes, "simulated-cluster-max-compute-nodes", c.SimulatedClusterConfig.MaxComputeNodes, "Number of compute nodes the simulated clusters can be scaled up to")
+	fs.BoolVar(&c.SimulatedClusterConfig.EnableFleetshardSimulator, "enable-fleetshard-simulator", c.SimulatedClusterConfig.EnableFleetshardSimulator, "Enables the in-process simulation of the kas-fleetshard operator of the simulated clusters")
+	fs.StringVar(&c.SimulatedClusterConfig.StrimziVersion, "fleetshard-simulator-strimzi-version", c.SimulatedClusterConfig.StrimziVersion, "Strimzi version reported by the fleetshard simulator")
+	fs.StringVar(&c.SimulatedClusterConfig.KafkaVersion, "fleetshard-simulator-kafka-version", c.SimulatedClusterConfig.KafkaVersion, "Kafka version reported by the fleetshard simulator")
+	fs.StringVar(&c.SimulatedClusterConfig.KafkaIBPVersion, "fleetshard-simulator-kafka-ibp-version", c.SimulatedClusterConfig.KafkaIBPVersion, "Kafka IBP version reported by the fleetshard simulator")
 }
 
 func (c *DataplaneClusterConfig) ReadFiles() error {
+	if c.IsDataPlaneAutoScalingEnabled() {
+		switch c.GetAutoScalingClusterProviderType() {
+		case api.ClusterProviderOCM, api.ClusterProviderAwsEKS, api.ClusterProviderSimulated:
+		default:
+			return errors.Errorf("invalid auto scaling cluster provider type %s, it should be either '%s', '%s' or '%s'", c.AutoScalingClusterProviderType, api.ClusterProviderOCM, api.ClusterProviderAwsEKS, api.ClusterProviderSimulated)
+		}
+	}
+
 	if c.ImagePullDockerConfigContent == "" && c.ImagePullDockerConfigFile != "" {
 		err := shared.ReadFileValueString(c.ImagePullDockerConfigFile, &c.ImagePullDockerConfigContent)
 		if err != nil {
@@ -283,63 +411,181 @@ func (c *DataplaneClusterConfig) ReadFiles() error {
 		}
 	}
 
+	snapshot, err := c.readSnapshot()
+	if err != nil {
+		return err
+	}
+	c.setSnapshot(snapshot)
+	return nil
+}
+
+// dataplaneClusterConfigSnapshot is the part of the DataplaneClusterConfig that can be reloaded
+type dataplaneClusterConfigSnapshot struct {
+	clusterConfig                 *ClusterConfig
+	readOnlyUserList              userv1.OptionalNames
+	kafkaSREUsers                 userv1.OptionalNames
+	strimziOpenshiftCompatibility []StrimziOpenshiftCompatibility
+	rawKubernetesConfig           *clientcmdapi.Config
+}
+
+func (c *DataplaneClusterConfig) readSnapshot() (*dataplaneClusterConfigSnapshot, error) {
+	snapshot := &dataplaneClusterConfigSnapshot{
+		clusterConfig:       c.GetClusterConfig(),
+		rawKubernetesConfig: c.GetRawKubernetesConfig(),
+	}
+
 	if c.IsDataPlaneManualScalingEnabled() {
 		list, err := readDataPlaneClusterConfig(c.DataPlaneClusterConfigFile)
-		if err == nil {
-			c.ClusterConfig = NewClusterConfig(list)
-		} else {
-			return err
+		if err != nil {
+			return nil, err
 		}
+		snapshot.clusterConfig = NewClusterConfig(list)
 
-		// read kubeconfig and validate standalone clusters are in kubeconfig context
-		for _, cluster := range c.ClusterConfig.clusterList {
-			if cluster.ProviderType != api.ClusterProviderStandalone {
+		// read kubeconfig and validate standalone and kubernetes clusters are in kubeconfig context
+		for _, cluster := range snapshot.clusterConfig.clusterList {
+			if cluster.ProviderType != api.ClusterProviderStandalone && cluster.ProviderType != api.ClusterProviderKubernetes {
 				continue
 			}
 			// make sure we only read kubeconfig once
-			if c.RawKubernetesConfig == nil {
-				err = c.readKubeconfig()
+			if snapshot.rawKubernetesConfig == nil {
+				snapshot.rawKubernetesConfig, err = c.readKubeconfig()
 				if err != nil {
-					return err
+					return nil, err
 				}
 			}
-			validationErr := validateClusterIsInKubeconfigContext(*c.RawKubernetesConfig, cluster)
+			validationErr := validateClusterIsInKubeconfigContext(*snapshot.rawKubernetesConfig, cluster)
 			if validationErr != nil {
-				return validationErr
+				return nil, validationErr
 			}
 		}
 	}
 
-	err := readOnlyUserListFile(c.ReadOnlyUserListFile, &c.ReadOnlyUserList)
+	err := readOnlyUserListFile(c.ReadOnlyUserListFile, &snapshot.readOnlyUserList)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = readKafkaSREUserFile(c.KafkaSREUsersFile, &c.KafkaSREUsers)
+	err = readKafkaSREUserFile(c.KafkaSREUsersFile, &snapshot.kafkaSREUsers)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	snapshot.strimziOpenshiftCompatibility, err = readStrimziOpenshiftCompatibilityFile(c.StrimziOpenshiftCompatibilityFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func (c *DataplaneClusterConfig) setSnapshot(snapshot *dataplaneClusterConfigSnapshot) {
+	snapshotMutex.Lock()
+	defer snapshotMutex.Unlock()
+	c.ClusterConfig = snapshot.clusterConfig
+	c.ReadOnlyUserList = snapshot.readOnlyUserList
+	c.KafkaSREUsers = snapshot.kafkaSREUsers
+	c.StrimziOpenshiftCompatibility = snapshot.strimziOpenshiftCompatibility
+	c.RawKubernetesConfig = snapshot.rawKubernetesConfig
+}
+
+// GetClusterConfig returns the snapshot of the manual cluster configuration currently loaded
+func (c *DataplaneClusterConfig) GetClusterConfig() *ClusterConfig {
+	snapshotMutex.RLock()
+	defer snapshotMutex.RUnlock()
+	return c.ClusterConfig
+}
+
+// GetReadOnlyUserList returns the snapshot of the read-only user list currently loaded
+func (c *DataplaneClusterConfig) GetReadOnlyUserList() userv1.OptionalNames {
+	snapshotMutex.RLock()
+	defer snapshotMutex.RUnlock()
+	return c.ReadOnlyUserList
+}
+
+// GetKafkaSREUsers returns the snapshot of the kafka-sre user list currently loaded
+func (c *DataplaneClusterConfig) GetKafkaSREUsers() userv1.OptionalNames {
+	snapshotMutex.RLock()
+	defer snapshotMutex.RUnlock()
+	return c.KafkaSREUsers
+}
+
+// IsOpenshiftVersionCompatible returns whether the given Strimzi version can run on the given OpenShift version. The Strimzi
+// versions missing from the compatibility list are not compatible with any OpenShift version.
+func (c *DataplaneClusterConfig) IsOpenshiftVersionCompatible(strimziVersion string, openshiftVersion string) (bool, error) {
+	version, err := semver.ParseTolerant(openshiftVersion)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid OpenShift version %q", openshiftVersion)
+	}
+
+	snapshotMutex.RLock()
+	defer snapshotMutex.RUnlock()
+	for _, compatibility := range c.StrimziOpenshiftCompatibility {
+		if compatibility.StrimziVersion == strimziVersion {
+			versionsRange, err := semver.ParseRange(compatibility.OpenshiftVersions)
+			if err != nil {
+				return false, errors.Wrapf(err, "invalid OpenShift versions of Strimzi version %s", strimziVersion)
+			}
+			return versionsRange(version), nil
+		}
 	}
+	return false, nil
+}
+
+// GetRawKubernetesConfig returns the kubeconfig used to communicate with the standalone clusters, nil if it has not been read
+func (c *DataplaneClusterConfig) GetRawKubernetesConfig() *clientcmdapi.Config {
+	snapshotMutex.RLock()
+	defer snapshotMutex.RUnlock()
+	return c.RawKubernetesConfig
+}
+
+var _ environments.Reloadable = &DataplaneClusterConfig{}
 
+func (c *DataplaneClusterConfig) WatchedFiles() []string {
+	files := []string{c.ReadOnlyUserListFile, c.KafkaSREUsersFile, c.StrimziOpenshiftCompatibilityFile}
+	if c.IsDataPlaneManualScalingEnabled() {
+		files = append(files, c.DataPlaneClusterConfigFile)
+	}
+	return files
+}
+
+// Reload replaces the manual cluster configuration, the read-only and kafka-sre user lists and the Strimzi OpenShift
+// compatibility list with the content of their configuration files. The kubeconfig is only read if it was not read
+// before and a standalone cluster has been added. As on startup, the instance type limits of the supported providers
+// must match the capacity of the reloaded manual cluster configuration.
+func (c *DataplaneClusterConfig) Reload() error {
+	snapshot, err := c.readSnapshot()
+	if err != nil {
+		return err
+	}
+	if c.supportedProviders != nil {
+		candidate := &DataplaneClusterConfig{
+			DataPlaneClusterScalingType: c.DataPlaneClusterScalingType,
+			ClusterConfig:               snapshot.clusterConfig,
+		}
+		if err := validateSupportedProviders(c.supportedProviders(), candidate); err != nil {
+			return err
+		}
+	}
+	c.setSnapshot(snapshot)
 	return nil
 }
 
-func (c *DataplaneClusterConfig) readKubeconfig() error {
+func (c *DataplaneClusterConfig) readKubeconfig() (*clientcmdapi.Config, error) {
 	_, err := os.Stat(c.Kubeconfig)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return errors.Errorf("The kubeconfig file %s does not exist", c.Kubeconfig)
+			return nil, errors.Errorf("The kubeconfig file %s does not exist", c.Kubeconfig)
 		}
-		return err
+		return nil, err
 	}
 	config := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
 		&clientcmd.ClientConfigLoadingRules{Precedence: []string{c.Kubeconfig}},
 		&clientcmd.ConfigOverrides{})
 	rawConfig, err := config.RawConfig()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	c.RawKubernetesConfig = &rawConfig
-	return nil
+	return &rawConfig, nil
 }
 
 func validateClusterIsInKubeconfigContext(rawConfig clientcmdapi.Config, cluster ManualCluster) error {
@@ -367,7 +613,7 @@ func readDataPlaneClusterConfig(file string) (ClusterList, error) {
 }
 
 func (c *DataplaneClusterConfig) FindClusterNameByClusterId(clusterId string) string {
-	for _, cluster := range c.ClusterConfig.clusterList {
+	for _, cluster := range c.GetClusterConfig().clusterList {
 		if cluster.ClusterId == clusterId {
 			return cluster.Name
 		}
@@ -394,3 +640,22 @@ func readKafkaSREUserFile(file string, val *userv1.OptionalNames) error {
 
 	return yaml.UnmarshalStrict([]byte(fileContents), val)
 }
+
+// Read the Strimzi OpenShift compatibility list from the file, validating the OpenShift version ranges
+func readStrimziOpenshiftCompatibilityFile(file string) ([]StrimziOpenshiftCompatibility, error) {
+	fileContents, err := shared.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var compatibilities []StrimziOpenshiftCompatibility
+	if err := yaml.UnmarshalStrict([]byte(fileContents), &compatibilities); err != nil {
+		return nil, err
+	}
+	for _, compatibility := range compatibilities {
+		if _, err := semver.ParseRange(compatibility.OpenshiftVersions); err != nil {
+			return nil, errors.Wrapf(err, "invalid OpenShift versions of Strimzi version %s", compatibility.StrimziVersion)
+		}
+	}
+	return compatibilities, nil
+}
@@ -1,6 +1,9 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -85,6 +88,17 @@ func TestDataplaneClusterConfig_IsDataPlaneManualScalingEnabled(t *testing.T) {
 	}
 }
 
+func TestDataplaneClusterConfig_ReadFiles_InvalidAutoScalingClusterProviderType(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	conf := NewDataplaneClusterConfig()
+	conf.DataPlaneClusterScalingType = AutoScaling
+	gomega.Expect(conf.GetAutoScalingClusterProviderType()).To(gomega.Equal(api.ClusterProviderOCM))
+
+	// standalone and kubernetes clusters can't be created by the auto scaling
+	conf.AutoScalingClusterProviderType = api.ClusterProviderStandalone.String()
+	gomega.Expect(conf.ReadFiles()).To(gomega.HaveOccurred())
+}
+
 func TestDataplaneClusterConfig_IsWithinClusterLimit(t *testing.T) {
 	type fields struct {
 		DataPlaneClusterScalingType string
@@ -381,6 +395,73 @@ provider_type: "aws_eks"
 			output:  ManualCluster{},
 			wantErr: true,
 		},
+		{
+			name: "should force the provisioning status of kubernetes clusters",
+			input: `
+---
+name: "test"
+cluster_id: "test"
+cloud_provider: "aws"
+region: "east-1"
+multi_az: true
+schedulable: true
+kafka_instance_limit: 1
+status: "ready"
+provider_type: "kubernetes"
+`,
+			output: ManualCluster{
+				Name:                  "test",
+				ClusterId:             "test",
+				CloudProvider:         "aws",
+				Region:                "east-1",
+				MultiAZ:               true,
+				Schedulable:           true,
+				KafkaInstanceLimit:    1,
+				Status:                api.ClusterProvisioning,
+				ProviderType:          api.ClusterProviderKubernetes,
+				SupportedInstanceType: api.AllInstanceTypeSupport.String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should force the provisioning status of simulated clusters",
+			input: `
+---
+cluster_id: "test"
+cloud_provider: "aws"
+region: "east-1"
+status: "ready"
+provider_type: "simulated"
+`,
+			output: ManualCluster{
+				ClusterId:             "test",
+				CloudProvider:         "aws",
+				Region:                "east-1",
+				Status:                api.ClusterProvisioning,
+				ProviderType:          api.ClusterProviderSimulated,
+				SupportedInstanceType: api.AllInstanceTypeSupport.String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return error because the kubernetes cluster has no name",
+			input: `
+---
+cluster_id: "test"
+cloud_provider: "aws"
+region: "east-1"
+provider_type: "kubernetes"
+`,
+			output: ManualCluster{
+				ClusterId:             "test",
+				CloudProvider:         "aws",
+				Region:                "east-1",
+				Status:                api.ClusterProvisioning,
+				ProviderType:          api.ClusterProviderKubernetes,
+				SupportedInstanceType: api.AllInstanceTypeSupport.String(),
+			},
+			wantErr: true,
+		},
 		{
 			name: "should return error because invalid provider_type value",
 			input: `
@@ -521,3 +602,103 @@ func TestValidateClusterIsInKubeContext(t *testing.T) {
 		})
 	}
 }
+
+func TestDataplaneClusterConfig_IsOpenshiftVersionCompatible(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	conf := NewDataplaneClusterConfig()
+	compatibilities, err := readStrimziOpenshiftCompatibilityFile(conf.StrimziOpenshiftCompatibilityFile)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	gomega.Expect(compatibilities).NotTo(gomega.BeEmpty())
+	conf.StrimziOpenshiftCompatibility = []StrimziOpenshiftCompatibility{
+		{StrimziVersion: "strimzi-cluster-operator.v0.24.0-0", OpenshiftVersions: ">=4.8.0 <4.11.0"},
+	}
+
+	tests := []struct {
+		name             string
+		strimziVersion   string
+		openshiftVersion string
+		want             bool
+		wantErr          bool
+	}{
+		{
+			name:             "compatible when the OpenShift version is in the range",
+			strimziVersion:   "strimzi-cluster-operator.v0.24.0-0",
+			openshiftVersion: "4.10.3",
+			want:             true,
+		},
+		{
+			name:             "not compatible when the OpenShift version is out of the range",
+			strimziVersion:   "strimzi-cluster-operator.v0.24.0-0",
+			openshiftVersion: "4.11.0",
+			want:             false,
+		},
+		{
+			name:             "not compatible when the Strimzi version is not in the list",
+			strimziVersion:   "strimzi-cluster-operator.v0.20.0-0",
+			openshiftVersion: "4.10.3",
+			want:             false,
+		},
+		{
+			name:             "error when the OpenShift version is invalid",
+			strimziVersion:   "strimzi-cluster-operator.v0.24.0-0",
+			openshiftVersion: "latest",
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compatible, err := conf.IsOpenshiftVersionCompatible(tt.strimziVersion, tt.openshiftVersion)
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			gomega.Expect(compatible).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestDataplaneClusterConfig_Reload(t *testing.T) {
+	gomega.RegisterTestingT(t)
+
+	dir, err := ioutil.TempDir("", "dataplane-cluster-config")
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "dataplane-cluster-configuration.yaml")
+	writeClusters := func(kafkaInstanceLimit string) {
+		content := `
+clusters:
+  - cluster_id: test-cluster
+    cloud_provider: aws
+    region: us-east-1
+    schedulable: true
+    kafka_instance_limit: ` + kafkaInstanceLimit + `
+    supported_instance_type: standard
+`
+		gomega.Expect(ioutil.WriteFile(file, []byte(content), 0600)).To(gomega.Succeed())
+	}
+
+	conf := NewDataplaneClusterConfig()
+	conf.DataPlaneClusterScalingType = ManualScaling
+	conf.DataPlaneClusterConfigFile = file
+	limit := 5
+	providerConfig := NewSupportedProvidersConfig(conf)
+	providerConfig.ProvidersConfig = buildProviderConfig("aws", RegionList{
+		{Name: "us-east-1", Default: true, SupportedInstanceTypes: InstanceTypeMap{"standard": {Limit: &limit}}},
+	}).ProvidersConfig
+
+	writeClusters("5")
+	gomega.Expect(conf.ReadFiles()).To(gomega.Succeed())
+	gomega.Expect(conf.GetClusterConfig().GetCapacityForRegion("us-east-1")).To(gomega.Equal(5))
+
+	// the limit of the standard instance type would no longer match the capacity of the region
+	writeClusters("3")
+	gomega.Expect(conf.Reload()).To(gomega.HaveOccurred())
+	gomega.Expect(conf.GetClusterConfig().GetCapacityForRegion("us-east-1")).To(gomega.Equal(5), "the configuration loaded previously should be kept")
+
+	writeClusters("invalid")
+	gomega.Expect(conf.Reload()).To(gomega.HaveOccurred())
+	gomega.Expect(conf.GetClusterConfig().GetCapacityForRegion("us-east-1")).To(gomega.Equal(5), "the configuration loaded previously should be kept")
+
+	limit = 3
+	gomega.Expect(conf.Reload()).To(gomega.HaveOccurred())
+	writeClusters("3")
+	gomega.Expect(conf.Reload()).To(gomega.Succeed())
+	gomega.Expect(conf.GetClusterConfig().GetCapacityForRegion("us-east-1")).To(gomega.Equal(3))
+}
@@ -1,9 +1,14 @@
 package config
 
 import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/ghodss/yaml"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 type KafkaCapacityConfig struct {
@@ -15,6 +20,31 @@ type KafkaCapacityConfig struct {
 	MaxConnectionAttemptsPerSec   int    `json:"maxConnectionAttemptsPerSec"`
 }
 
+// validate ensures that the capacity can be set in the ManagedKafka custom resources
+func (c KafkaCapacityConfig) validate() error {
+	for name, quantity := range map[string]string{
+		"ingressEgressThroughputPerSec": c.IngressEgressThroughputPerSec,
+		"maxDataRetentionSize":          c.MaxDataRetentionSize,
+	} {
+		if _, err := resource.ParseQuantity(quantity); err != nil {
+			return fmt.Errorf("invalid kafka capacity %s %q: %v", name, quantity, err)
+		}
+	}
+	for name, limit := range map[string]int{
+		"totalMaxConnections":         c.TotalMaxConnections,
+		"maxPartitions":               c.MaxPartitions,
+		"maxConnectionAttemptsPerSec": c.MaxConnectionAttemptsPerSec,
+	} {
+		if limit <= 0 {
+			return fmt.Errorf("invalid kafka capacity %s %d: it must be greater than 0", name, limit)
+		}
+	}
+	if c.MaxDataRetentionPeriod == "" {
+		return fmt.Errorf("invalid kafka capacity maxDataRetentionPeriod: it must be set")
+	}
+	return nil
+}
+
 type KafkaConfig struct {
 	KafkaTLSCert                   string              `json:"kafka_tls_cert"`
 	KafkaTLSCertFile               string              `json:"kafka_tls_cert_file"`
@@ -28,6 +58,7 @@ type KafkaConfig struct {
 
 	KafkaLifespan *KafkaLifespanConfig `json:"kafka_lifespan"`
 	Quota         *KafkaQuotaConfig    `json:"kafka_quota"`
+	DNS           *KafkaDNSConfig      `json:"kafka_dns"`
 }
 
 func NewKafkaConfig() *KafkaConfig {
@@ -39,6 +70,7 @@ func NewKafkaConfig() *KafkaConfig {
 		KafkaCapacityConfigFile:        "config/kafka-capacity-config.yaml",
 		KafkaLifespan:                  NewKafkaLifespanConfig(),
 		Quota:                          NewKafkaQuotaConfig(),
+		DNS:                            NewKafkaDNSConfig(),
 		BrowserUrl:                     "http://localhost:8080/",
 	}
 }
@@ -54,6 +86,11 @@ func (c *KafkaConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.Quota.Type, "quota-type", c.Quota.Type, "The type of the quota service to be used. The available options are: 'ams' for AMS backed implementation and 'quota-management-list' for quota list backed implementation (default).")
 	fs.BoolVar(&c.Quota.AllowEvaluatorInstance, "allow-evaluator-instance", c.Quota.AllowEvaluatorInstance, "Allow the creation of kafka evaluator instances")
 	fs.StringVar(&c.BrowserUrl, "browser-url", c.BrowserUrl, "Browser url to kafka admin UI")
+	fs.StringVar(&c.DNS.Provider, "dns-provider", c.DNS.Provider, fmt.Sprintf("The DNS provider publishing the CNAME records of the kafka instances. The available options are: %v", dns.ProviderTypes))
+	fs.StringVar(&c.DNS.RFC2136.Server, "dns-rfc2136-server", c.DNS.RFC2136.Server, "Address of the primary server receiving the RFC 2136 dynamic updates of the kafka domain zone")
+	fs.StringVar(&c.DNS.RFC2136.TSIGKeyName, "dns-rfc2136-tsig-key-name", c.DNS.RFC2136.TSIGKeyName, "Name of the TSIG key signing the RFC 2136 dynamic updates")
+	fs.StringVar(&c.DNS.RFC2136.TSIGSecretFile, "dns-rfc2136-tsig-secret-file", c.DNS.RFC2136.TSIGSecretFile, "File containing the base64 encoded secret of the TSIG key signing the RFC 2136 dynamic updates")
+	fs.StringVar(&c.DNS.RFC2136.TSIGAlgorithm, "dns-rfc2136-tsig-algorithm", c.DNS.RFC2136.TSIGAlgorithm, "Algorithm of the TSIG key signing the RFC 2136 dynamic updates, e.g. hmac-sha256 (default) or hmac-sha512")
 }
 
 func (c *KafkaConfig) ReadFiles() error {
@@ -65,13 +102,67 @@ func (c *KafkaConfig) ReadFiles() error {
 	if err != nil {
 		return err
 	}
-	content, err := shared.ReadFile(c.KafkaCapacityConfigFile)
+	err = shared.ReadFileValueString(c.DNS.RFC2136.TSIGSecretFile, &c.DNS.RFC2136.TSIGSecret)
 	if err != nil {
 		return err
 	}
-	err = yaml.Unmarshal([]byte(content), &c.KafkaCapacity)
+	return readKafkaCapacityConfigFile(c.KafkaCapacityConfigFile, &c.KafkaCapacity)
+}
+
+// GetKafkaCapacity returns the snapshot of the kafka capacity configuration currently loaded
+func (c *KafkaConfig) GetKafkaCapacity() KafkaCapacityConfig {
+	snapshotMutex.RLock()
+	defer snapshotMutex.RUnlock()
+	return c.KafkaCapacity
+}
+
+var _ environments.ServiceValidator = &KafkaConfig{}
+
+func (c *KafkaConfig) Validate(env *environments.Env) error {
+	if err := c.GetKafkaCapacity().validate(); err != nil {
+		return err
+	}
+	providerType, err := dns.ParseProviderType(c.DNS.Provider)
 	if err != nil {
 		return err
 	}
+	if providerType == dns.ProviderTypeRFC2136 {
+		if c.DNS.RFC2136.Server == "" {
+			return fmt.Errorf("the server of the %s DNS provider must be set", providerType)
+		}
+		if c.DNS.RFC2136.TSIGKeyName != "" && c.DNS.RFC2136.TSIGSecret == "" {
+			return fmt.Errorf("the secret of the TSIG key %q of the %s DNS provider must be set", c.DNS.RFC2136.TSIGKeyName, providerType)
+		}
+	}
+	return nil
+}
+
+var _ environments.Reloadable = &KafkaConfig{}
+
+func (c *KafkaConfig) WatchedFiles() []string {
+	return []string{c.KafkaCapacityConfigFile}
+}
+
+// Reload replaces the kafka capacity configuration with the content of its configuration file, once validated the same
+// way it is on startup. The capacity only applies to the kafka instances created after the reload.
+func (c *KafkaConfig) Reload() error {
+	var kafkaCapacity KafkaCapacityConfig
+	if err := readKafkaCapacityConfigFile(c.KafkaCapacityConfigFile, &kafkaCapacity); err != nil {
+		return err
+	}
+	if err := kafkaCapacity.validate(); err != nil {
+		return err
+	}
+	snapshotMutex.Lock()
+	defer snapshotMutex.Unlock()
+	c.KafkaCapacity = kafkaCapacity
 	return nil
 }
+
+func readKafkaCapacityConfigFile(file string, val *KafkaCapacityConfig) error {
+	content, err := shared.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal([]byte(content), val)
+}
@@ -0,0 +1,19 @@
+package config
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+)
+
+// KafkaDNSConfig selects the DNS provider publishing the CNAME records of the bootstrap and broker hosts of the
+// Kafka instances when the Kafka external certificate is enabled
+type KafkaDNSConfig struct {
+	// Provider is one of dns.ProviderTypes, route53 by default
+	Provider string            `json:"provider"`
+	RFC2136  dns.RFC2136Config `json:"rfc2136"`
+}
+
+func NewKafkaDNSConfig() *KafkaDNSConfig {
+	return &KafkaDNSConfig{
+		Provider: string(dns.ProviderTypeRoute53),
+	}
+}
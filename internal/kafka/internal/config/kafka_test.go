@@ -0,0 +1,88 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+const validKafkaCapacity = `
+ingressEgressThroughputPerSec: "2Mi"
+totalMaxConnections: 100
+maxDataRetentionSize: "60Gi"
+maxPartitions: 100
+maxDataRetentionPeriod: "P14D"
+maxConnectionAttemptsPerSec: 100
+`
+
+func TestKafkaCapacityConfig_validate(t *testing.T) {
+	valid := KafkaCapacityConfig{
+		IngressEgressThroughputPerSec: "2Mi",
+		TotalMaxConnections:           100,
+		MaxDataRetentionSize:          "60Gi",
+		MaxPartitions:                 100,
+		MaxDataRetentionPeriod:        "P14D",
+		MaxConnectionAttemptsPerSec:   100,
+	}
+
+	tests := []struct {
+		name     string
+		modifyFn func(c *KafkaCapacityConfig)
+		wantErr  bool
+	}{
+		{
+			name:     "valid capacity",
+			modifyFn: func(c *KafkaCapacityConfig) {},
+		},
+		{
+			name:     "error when a quantity cannot be parsed",
+			modifyFn: func(c *KafkaCapacityConfig) { c.MaxDataRetentionSize = "60 gigabytes" },
+			wantErr:  true,
+		},
+		{
+			name:     "error when a limit is not set",
+			modifyFn: func(c *KafkaCapacityConfig) { c.MaxPartitions = 0 },
+			wantErr:  true,
+		},
+		{
+			name:     "error when the data retention period is not set",
+			modifyFn: func(c *KafkaCapacityConfig) { c.MaxDataRetentionPeriod = "" },
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			capacity := valid
+			tt.modifyFn(&capacity)
+			gomega.Expect(capacity.validate() != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestKafkaConfig_Reload(t *testing.T) {
+	gomega.RegisterTestingT(t)
+
+	dir, err := ioutil.TempDir("", "kafka-capacity-config")
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "kafka-capacity-config.yaml")
+	gomega.Expect(ioutil.WriteFile(file, []byte(validKafkaCapacity), 0600)).To(gomega.Succeed())
+
+	conf := NewKafkaConfig()
+	conf.KafkaCapacityConfigFile = file
+	gomega.Expect(conf.ReadFiles()).To(gomega.Succeed())
+	gomega.Expect(conf.GetKafkaCapacity().MaxPartitions).To(gomega.Equal(100))
+
+	gomega.Expect(ioutil.WriteFile(file, []byte("maxPartitions: 200"), 0600)).To(gomega.Succeed())
+	gomega.Expect(conf.Reload()).To(gomega.HaveOccurred())
+	gomega.Expect(conf.GetKafkaCapacity().MaxPartitions).To(gomega.Equal(100), "the configuration loaded previously should be kept")
+
+	gomega.Expect(ioutil.WriteFile(file, []byte(strings.Replace(validKafkaCapacity, "maxPartitions: 100", "maxPartitions: 200", 1)), 0600)).To(gomega.Succeed())
+	gomega.Expect(conf.Reload()).To(gomega.Succeed())
+	gomega.Expect(conf.GetKafkaCapacity().MaxPartitions).To(gomega.Equal(200))
+}
@@ -55,7 +55,7 @@ func (r Region) getLimitSetForInstanceTypeInRegion(t string) (*int, *errs.Servic
 func (r Region) Validate(dataplaneClusterConfig *DataplaneClusterConfig) error {
 	counter := 1
 	totalCapacityUsed := 0
-	regionCapacity := dataplaneClusterConfig.ClusterConfig.GetCapacityForRegion(r.Name)
+	regionCapacity := dataplaneClusterConfig.GetClusterConfig().GetCapacityForRegion(r.Name)
 
 	// verify that Limits set in this configuration matches the capacity of clusters listed in the data plane configuration
 	for k, v := range r.SupportedInstanceTypes {
@@ -65,7 +65,7 @@ func (r Region) Validate(dataplaneClusterConfig *DataplaneClusterConfig) error {
 		}
 
 		if len(r.SupportedInstanceTypes) == 1 {
-			capacity := dataplaneClusterConfig.ClusterConfig.GetCapacityForRegionAndInstanceType(r.Name, k, false)
+			capacity := dataplaneClusterConfig.GetClusterConfig().GetCapacityForRegionAndInstanceType(r.Name, k, false)
 			if *v.Limit != capacity {
 				return fmt.Errorf("limit for instance type '%s'(%d) does not match the capacity in region %s(%d)", k, *v.Limit, r.Name, capacity)
 			}
@@ -75,8 +75,8 @@ func (r Region) Validate(dataplaneClusterConfig *DataplaneClusterConfig) error {
 		// ensure that limit is within min and max capacity
 		// min: the total capacity of clusters that support only this instance type
 		// max: the total capacity of clusters that supports this instance type
-		minCapacity := dataplaneClusterConfig.ClusterConfig.GetCapacityForRegionAndInstanceType(r.Name, k, true)
-		maxCapacity := dataplaneClusterConfig.ClusterConfig.GetCapacityForRegionAndInstanceType(r.Name, k, false)
+		minCapacity := dataplaneClusterConfig.GetClusterConfig().GetCapacityForRegionAndInstanceType(r.Name, k, true)
+		maxCapacity := dataplaneClusterConfig.GetClusterConfig().GetCapacityForRegionAndInstanceType(r.Name, k, false)
 		if minCapacity > *v.Limit || maxCapacity < *v.Limit {
 			return fmt.Errorf("limit for %s instance type (%d) does not match cluster capacity configuration in region '%s': min(%d), max(%d)", k, *v.Limit, r.Name, minCapacity, maxCapacity)
 		}
@@ -156,12 +156,17 @@ type ProviderConfiguration struct {
 type ProviderConfig struct {
 	ProvidersConfig     ProviderConfiguration `json:"providers"`
 	ProvidersConfigFile string                `json:"providers_config_file"`
+
+	dataplaneClusterConfig *DataplaneClusterConfig
 }
 
-func NewSupportedProvidersConfig() *ProviderConfig {
-	return &ProviderConfig{
-		ProvidersConfigFile: "config/provider-configuration.yaml",
+func NewSupportedProvidersConfig(dataplaneClusterConfig *DataplaneClusterConfig) *ProviderConfig {
+	c := &ProviderConfig{
+		ProvidersConfigFile:    "config/provider-configuration.yaml",
+		dataplaneClusterConfig: dataplaneClusterConfig,
 	}
+	dataplaneClusterConfig.supportedProviders = c.GetSupportedProviders
+	return c
 }
 
 var _ environments.ServiceValidator = &ProviderConfig{}
@@ -169,9 +174,12 @@ var _ environments.ServiceValidator = &ProviderConfig{}
 func (c *ProviderConfig) Validate(env *environments.Env) error {
 	var dataplaneClusterConfig *DataplaneClusterConfig
 	env.MustResolve(&dataplaneClusterConfig)
+	return validateSupportedProviders(c.GetSupportedProviders(), dataplaneClusterConfig)
+}
 
+func validateSupportedProviders(supportedProviders ProviderList, dataplaneClusterConfig *DataplaneClusterConfig) error {
 	providerDefaultCount := 0
-	for _, p := range c.ProvidersConfig.SupportedProviders {
+	for _, p := range supportedProviders {
 		if err := p.Validate(dataplaneClusterConfig); err != nil {
 			return err
 		}
@@ -214,8 +222,37 @@ func (c *ProviderConfig) ReadFiles() error {
 	return readFileProvidersConfig(c.ProvidersConfigFile, &c.ProvidersConfig)
 }
 
+// GetSupportedProviders returns the snapshot of the supported providers currently loaded
+func (c *ProviderConfig) GetSupportedProviders() ProviderList {
+	snapshotMutex.RLock()
+	defer snapshotMutex.RUnlock()
+	return c.ProvidersConfig.SupportedProviders
+}
+
+var _ environments.Reloadable = &ProviderConfig{}
+
+func (c *ProviderConfig) WatchedFiles() []string {
+	return []string{c.ProvidersConfigFile}
+}
+
+// Reload replaces the supported providers with the content of the configuration file, once validated against the data
+// plane cluster configuration currently loaded
+func (c *ProviderConfig) Reload() error {
+	var providersConfig ProviderConfiguration
+	if err := readFileProvidersConfig(c.ProvidersConfigFile, &providersConfig); err != nil {
+		return err
+	}
+	if err := validateSupportedProviders(providersConfig.SupportedProviders, c.dataplaneClusterConfig); err != nil {
+		return err
+	}
+	snapshotMutex.Lock()
+	defer snapshotMutex.Unlock()
+	c.ProvidersConfig = providersConfig
+	return nil
+}
+
 func (c *ProviderConfig) GetInstanceLimit(region string, providerName string, instanceType string) (*int, *errs.ServiceError) {
-	provider, ok := c.ProvidersConfig.SupportedProviders.GetByName(providerName)
+	provider, ok := c.GetSupportedProviders().GetByName(providerName)
 	if !ok {
 		return nil, errs.ProviderNotSupported(fmt.Sprintf("cloud provider '%s' is unsupported", providerName))
 	}
@@ -0,0 +1,8 @@
+package config
+
+import "sync"
+
+// snapshotMutex guards the configuration snapshots that are replaced when their configuration files are reloaded.
+// A single mutex is shared by the configuration types of this package rather than adding one to each of them, as
+// these types are copied by value in many places.
+var snapshotMutex sync.RWMutex
@@ -19,31 +19,32 @@ func NewIntegrationEnvLoader() environments.EnvLoader {
 
 func (b IntegrationEnvLoader) Defaults() map[string]string {
 	return map[string]string{
-		"v":                                 "0",
-		"logtostderr":                       "true",
-		"ocm-base-url":                      "https://api-integration.6943.hive-integration.openshiftapps.com",
-		"ams-base-url":                      "https://api-integration.6943.hive-integration.openshiftapps.com",
-		"enable-https":                      "false",
-		"enable-metrics-https":              "false",
-		"enable-terms-acceptance":           "false",
-		"ocm-debug":                         "false",
-		"enable-ocm-mock":                   "true",
-		"ocm-mock-mode":                     ocm.MockModeEmulateServer,
-		"enable-sentry":                     "false",
-		"enable-deny-list":                  "true",
-		"enable-instance-limit-control":     "true",
-		"max-allowed-instances":             "1",
-		"mas-sso-base-url":                  "http://127.0.0.1:8180",
-		"mas-sso-realm":                     "rhoas",
-		"osd-idp-mas-sso-realm":             "rhoas-kafka-sre",
-		"enable-kafka-external-certificate": "false",
-		"cluster-compute-machine-type":      "m5.xlarge",
-		"allow-evaluator-instance":          "true",
-		"quota-type":                        "quota-management-list",
-		"enable-deletion-of-expired-kafka":  "true",
-		"dataplane-cluster-scaling-type":    "auto", // need to set this to 'auto' for integration environment as some tests rely on this
-		"strimzi-operator-addon-id":         "managed-kafka-qe",
-		"kas-fleetshard-addon-id":           "kas-fleetshard-operator-qe",
+		"v":                                     "0",
+		"logtostderr":                           "true",
+		"ocm-base-url":                          "https://api-integration.6943.hive-integration.openshiftapps.com",
+		"ams-base-url":                          "https://api-integration.6943.hive-integration.openshiftapps.com",
+		"enable-https":                          "false",
+		"enable-metrics-https":                  "false",
+		"enable-terms-acceptance":               "false",
+		"ocm-debug":                             "false",
+		"enable-ocm-mock":                       "true",
+		"ocm-mock-mode":                         ocm.MockModeEmulateServer,
+		"enable-sentry":                         "false",
+		"enable-deny-list":                      "true",
+		"enable-instance-limit-control":         "true",
+		"max-allowed-instances":                 "1",
+		"mas-sso-base-url":                      "http://127.0.0.1:8180",
+		"mas-sso-realm":                         "rhoas",
+		"osd-idp-mas-sso-realm":                 "rhoas-kafka-sre",
+		"enable-kafka-external-certificate":     "false",
+		"cluster-compute-machine-type":          "m5.xlarge",
+		"allow-evaluator-instance":              "true",
+		"quota-type":                            "quota-management-list",
+		"enable-deletion-of-expired-kafka":      "true",
+		"dataplane-cluster-scaling-type":        "auto", // need to set this to 'auto' for integration environment as some tests rely on this
+		"strimzi-operator-addon-id":             "managed-kafka-qe",
+		"kas-fleetshard-addon-id":               "kas-fleetshard-operator-qe",
+		"dataplane-cluster-heartbeat-threshold": "0", // the integration tests do not run a kas-fleetshard operator reporting the status of their clusters
 	}
 }
 
@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _kasFleetManagerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfb\x53\x1b\x39\xb3\xe8\xef\xfc\x15\x7d\x9d\x7b\xca\xe7\xec\xc5\x4f\x0c\x49\x5c\x77\x6f\x15\x21\x24\xcb\x6e\x42\x12\x1e\x9b\xcd\xb7\xb5\x65\xe4\x19\xd9\x16\xcc\x48\x83\xa4\x01\x9c\xef\x9e\xff\xfd\x94\xa4\x79\x68\x9e\x1e\x03\x49\x60\x17\xbe\xfa\x6a\xe3\x19\x49\xd3\xea\x6e\x75\xb7\x5a\xdd\x2d\x16\x60\x8a\x02\x32\x86\xad\x6e\xbf\xdb\x87\x67\x40\x31\x76\x41\x2e\x88\x00\x24\x60\x46\xb8\x90\xe0\x11\x8a\x41\x32\x40\x9e\xc7\xae\x41\x30\x1f\xc3\xc1\xeb\x7d\xa1\x1e\x5d\x50\x76\x6d\x5a\xab\x0e\x14\xa2\xe1\xc0\x65\x4e\xe8\x63\x2a\xbb\x1b\xcf\x60\xd7\xf3\x00\x53\x37\x60\x84\x4a\x01\x2e\x9e\x11\x8a\x5d\x58\x60\x8e\xe1\x9a\x78\x1e\x4c\x31\xb8\x44\x38\xec\x0a\x73\x34\xf5\x30\x4c\x97\xea\x4b\x10\x0a\xcc\x45\x17\x0e\x66\x20\x75\x5b\xf5\x81\x08\x3a\x06\x17\x18\x07\x06\x92\x74\xe4\x56\xc0\xc9\x15\x92\xb8\xb5\x09\xc8\x55\x73\xc0\xbe\x6a\x2a\x17\x18\x5a\x3e\xa2\x68\x8e\xdd\x8e\xc0\xfc\x8a\x38\x58\x74\x50\x40\x3a\x51\xfb\xee\x12\xf9\x5e\x0b\x66\xc4\xc3\x1b\x84\xce\xd8\x78\x03\x40\x12\xe9\xe1\x31\xfc\x86\x66\x17\x08\x8e\x4d\x27\x78\xe3\x61\x2c\xe1\xbd\x1e\x8a\x6f\x00\x5c\x61\x2e\x08\xa3\x63\x18\x74\x47\xdd\xfe\x06\x80\x8b\x85\xc3\x49\x20\xf5\xc3\x9a\xbe\x66\x2e\x47\x58\x48\xd8\xfd\x78\xa0\x80\x34\xf0\x45\x7d\x08\x15\x12\x51\x07\x8b\xee\x86\x82\x17\x73\xa1\x40\xea\x40\xc8\xbd\x31\x2c\xa4\x0c\xc4\xb8\xd7\x43\x01\xe9\x2a\x6c\x8b\x05\x99\xc9\xae\xc3\xfc\x0d\x80\x1c\x04\xef\x11\xa1\xf0\x9f\x01\x67\x6e\xe8\xa8\x27\xff\x05\x66\xb8\xf2\xc1\x84\x44\x73\xbc\x6a\xc8\x63\x89\xe6\x84\xce\x4b\x07\x1a\xf7\x7a\x1e\x73\x90\xb7\x60\x42\x8e\x5f\xf4\xfb\xfd\x62\xf7\xe4\x7d\xda\xb3\x57\x6c\xe5\x84\x9c\x63\x2a\xc1\x65\x3e\x22\x74\x23\x40\x72\xa1\x31\xa0\xc0\xec\x5d\x28\x14\x89\x89\x3f\xf7\x65\xef\x6a\x30\xd6\xbd\xe7\x58\x9a\x7f\x80\x62\x40\x8e\xd4\x30\x07\xee\x58\x3d\xff\xdd\xd0\xe8\x3d\x96\xc8\x45\x12\x45\xad\x38\x16\x01\xa3\x02\x8b\xb8\x1b\x40\x6b\xd8\xef\xb7\xd2\x9f\x00\x0e\xa3\x12\x53\x69\x3f\x02\x40\x41\xe0\x11\x47\x7f\xa0\x77\x2e\x18\xcd\xbe\x05\x10\xce\x02\xfb\x28\xff\x14\xe0\x7f\x73\x3c\x1b\x43\xfb\x59\xcf\x61\x7e\xc0\x28\xa6\x52\xf4\x4c\x5b\xd1\xcb\x81\xd8\xb6\x3a\x67\xd0\x12\xb5\x03\x3f\x3b\x17\x11\xfa\x3e\xe2\xcb\x31\x1c\x61\x19\x72\x2a\x34\xc3\x5f\xe5\xdb\x96\xa3\xaf\x87\x39\x67\x5c\xf4\xfe\x4d\xdc\xff\x5e\x89\xca\x7d\xd5\xf6\xd5\xf2\xc0\x7d\x88\x48\xd4\xc0\x55\xa2\xee\x2d\x96\xa0\xa7\xaa\x84\x4b\x32\x81\x52\xcc\x25\xcd\x48\xdc\x4c\xa2\xb9\x35\xc5\x8e\x69\x21\xa2\x07\x01\xe2\xc8\xc7\x32\x5a\xa3\x71\x13\x03\x69\x2b\x03\x69\xda\xb2\x47\xdc\x56\x3d\x41\x9a\xd1\x42\x3c\x58\x42\xbc\x23\x42\x56\x12\x43\xbd\x04\x36\x83\x80\x09\x41\x94\xc0\xcf\x20\xb4\x94\x28\x5e\xbe\x8b\x12\x9b\x99\x6e\x15\x44\xaa\xc0\xb2\xf9\xd9\x8c\xed\xb5\x4c\x7e\xa8\x6c\xaf\x81\x3b\xc2\x97\x21\xce\x22\x5c\xfd\xe1\x1b\xe4\x07\x9e\x0d\x67\xfc\x67\xf7\x7a\x8b\xe5\x51\x34\xa3\x7d\xd3\xa1\xd8\xbe\x1c\x86\x78\xfc\x0c\x10\xd1\x18\x79\x58\x2a\xbf\xf9\x99\xc8\xc5\x1b\x44\x3c\xec\xee\x71\xac\x71\x73\x2c\x91\x0c\xc5\x7d\xc0\x52\x33\x6e\x25\x73\x1a\x0d\xcc\xcd\x00\x30\x63\x21\x75\xb5\xcc\x78\x9d\x12\x7b\xd4\x1f\x3c\x10\x19\x57\x4f\xe5\x51\x7f\x70\x5b\x2c\xa6\x5d\x2b\x11\xb5\x1b\xca\x05\x48\x76\x81\xa9\xb2\x66\x08\xbd\x42\x5e\x22\x31\x35\x92\xb6\x1e\x09\x92\xb6\x6e\x8f\xa4\xad\x55\x48\x3a\x15\x98\x03\x65\x12\x50\x28\x17\x8c\x93\xaf\xc6\x7a\x45\x8e\x83\x85\x91\x6c\x91\x41\x6a\x23\x6e\xf4\x48\x10\x37\xba\x3d\xe2\x46\xab\x10\x77\xc8\x72\x2b\xf1\x9a\xc8\x05\x88\x00\x3b\x64\x46\xb0\x0b\x07\xaf\x01\xdf\x10\x21\x45\x8a\xb8\xed\x07\x63\x7a\xd4\x23\x6e\xbb\xdf\xbf\x2d\xe2\xd2\xae\xd5\x1c\x47\xf1\x4d\x80\x1d\x89\xdd\xc8\x92\x61\x8e\x36\xa7\x13\x9b\x07\x3b\x21\x27\x72\x69\xeb\xca\x57\x18\x71\xcc\xc7\xf0\x27\xfc\x55\xa5\x84\x51\x8e\x1c\xa9\x48\x74\xb1\x87\x25\x2e\x55\x9e\xe6\x55\x5e\x7f\x96\x5b\x4c\x84\x8e\xe1\x32\xc4\x7c\x69\x4d\x8c\x22\x1f\x8f\x01\x89\x25\x75\xaa\xa6\xfb\x11\xf3\x19\xe3\xbe\x5e\x4a\x48\x6f\x72\x80\x50\xb5\x11\xd5\xbd\x16\x9c\x51\x16\x0a\xb5\xbb\xa2\x7a\xb7\x52\x47\x66\xb9\x0c\xf0\x18\xa6\x8c\x79\x18\x51\xeb\x8d\x9a\x32\xe1\xd8\x1d\x83\xe4\x21\xae\x35\x02\x86\x0f\x8f\x01\xf3\x23\x3d\x3b\x64\xb0\x67\x00\xab\xc2\xe9\x6b\x4d\xb6\x8c\x2c\x7f\x1c\x2b\x6b\xd4\xef\x6b\xd8\x09\xa3\xb7\x17\x4d\xf9\x21\xaa\xb7\x63\x4a\xe1\xe9\xf9\x46\xc6\x66\x7e\xa9\x3d\x99\x0a\x4f\xa6\xc2\x93\xa9\x60\x4c\x05\x23\x53\xee\x60\x30\x64\x06\xf8\x87\x9a\x0d\x77\x43\x62\x7e\x80\xdb\x9b\x10\xb1\x71\x60\x86\xab\x33\x0e\x9a\xd9\x1b\x01\x92\xce\x62\x9c\x1f\xfd\x34\x70\x91\xc4\xc9\xe0\xb1\x53\x34\xe3\x9a\x69\x66\xcd\x64\x8c\x92\x50\x0f\x5b\xdc\xd4\x6b\xd0\x5f\x31\xd7\x1a\x2b\x8b\x15\x03\x0e\xbb\xa6\x98\x03\x9b\x81\x76\x21\x6c\xd4\x70\x4d\x3d\xcf\x94\x73\xcc\xca\xad\xbe\x81\xa2\xb0\xe1\x5f\xc3\x46\xc9\x72\x7b\xc9\xde\xd7\x20\x28\xbf\xeb\x7d\x54\x3e\x8d\x8f\x4c\x7c\x5b\xa7\x46\xc1\x24\xca\xe0\xf1\x15\x72\x63\x86\x7a\x04\x82\xe5\x3d\x11\x82\xd0\xf9\xc7\xd8\x2c\xbf\x83\xe9\x54\x31\x54\xbb\xda\x20\x5a\xc3\x4e\x78\xb8\x18\x5c\x6d\x3d\xc1\x5a\xe6\x53\xc1\x22\x2a\x1a\x0a\x44\xd8\xb6\x82\x58\x69\x2b\x3c\x64\xe4\xdd\xab\x55\x55\x30\x8a\xca\xed\x03\xe3\xd8\xd3\xd6\x81\x46\x97\x65\x21\x3c\x0a\x9c\xdd\xab\xef\xa5\x60\x03\xad\x65\x0e\x3c\x64\x44\xdd\xa3\xaf\xa5\xe8\xb6\x68\x74\xcc\x53\x77\xfe\x60\x06\x0a\x98\x28\x3f\x7b\x70\x38\x8e\x2d\x95\xe8\xf5\xdf\xc4\x75\xb2\xca\xd4\x32\x4b\xd4\x3a\xe2\xfc\x7e\xf6\x55\x6c\x40\xa0\xa5\xc7\x90\x9b\x65\xb4\x2a\x36\x3b\x3d\x3e\xc2\x73\x52\xe4\xef\x15\x0c\x16\x77\xab\x38\x31\xd9\x3f\xbd\xd5\xa8\x71\xb7\xc2\xa8\x0f\xdf\x8d\xf5\x08\xec\xbe\xbc\xc1\xe2\x38\x38\x78\xac\xae\xb2\xf8\x5c\xec\x0e\xf6\x5e\x6e\x88\x27\x57\xd9\x93\xab\x2c\x46\xd2\x3d\xbb\xca\x92\x61\xdf\xa3\x9b\x5d\xcf\x63\xd7\xd8\x3d\x88\x1c\x02\x47\x18\x39\x0b\xec\xde\xe1\x7b\xab\xc6\x2c\x05\xe4\x04\x73\x5f\x1c\x32\x19\xcb\x80\x3b\x7c\xbf\x62\xa8\x7a\x57\xe1\x8c\xf1\x29\x71\x5d\x4c\x01\x13\xb9\xc0\x1c\xa6\xd8\x41\xa1\xc0\x5a\x9f\x87\xc5\x3d\x42\xa5\x3f\x11\x58\xb6\xaf\x8f\x6e\x88\x1f\xfa\x40\x43\x7f\x6a\x5c\x1d\x49\x3c\x1a\xc8\x05\x92\xe0\x20\x0a\x53\x1c\x99\x27\xda\x4f\xa0\x03\x00\xf5\x37\x17\x48\xc0\x14\x63\x0a\xdc\x60\xb0\x5b\x6d\x98\x3f\x5c\xde\xfd\x96\x07\x9b\x27\x0b\x1c\x5b\x40\xd8\x55\xfa\x97\x85\xdc\xc1\xe0\x32\x2c\x68\x5b\x1a\xef\xa4\x8d\xb3\x97\x8f\x04\x67\x2f\x0f\x91\x8f\xf7\x18\x9d\x79\xc4\x91\xb7\xc7\x5f\xd9\x30\xd5\xc2\x52\xe1\x43\xb7\x4c\xf9\xce\xc5\xd2\xec\x55\x08\xd5\xdc\xec\x44\x2a\x4a\xf1\xb1\x66\xd3\x18\xe5\xd5\xbb\x9f\x87\x8a\xe4\x6f\x7b\x70\xbc\x4b\x21\xac\xda\xe9\xc1\xf5\x82\x78\x31\x2e\xe9\x5c\x23\x36\xe3\xf2\x8d\x06\x5d\xf3\x70\x59\x9b\x0f\x45\xff\xb1\x6e\x66\x05\x64\x95\x1c\x46\xc7\xf1\x60\x99\x7e\xa2\x6c\x13\x15\x07\x70\x89\xb5\x40\x5c\xdb\x75\xba\x5b\x0f\xd2\x77\x65\x2b\xdb\x82\xcd\x06\xe2\xfd\x9d\xdc\x96\x07\xc6\x36\xfa\xa4\x36\xbe\x77\x30\x61\x4b\x86\x79\x72\x57\xde\xcd\x5b\xf9\x70\xe7\xfd\xc0\xce\x6f\x9f\xdc\x6e\x4d\x34\x55\x5d\x84\x75\xbb\xca\xf5\x16\xa0\xb9\x45\xaa\x95\xcd\x05\xf9\xba\x4e\x73\xc6\x5d\xcc\x5f\x2d\xd7\xf9\x00\x46\xdc\x59\xb4\x2b\xdc\x81\x8e\xc7\x42\x77\x12\x70\x76\x45\x5c\x5c\x12\xfd\x5d\x1b\x13\x2d\xc2\x20\x60\x5c\xf1\x89\x1e\x06\x92\x61\x2a\xd4\xe1\x9e\x6a\xf5\x31\xd7\xe8\xd6\x6a\xb1\x3d\xec\xf7\xdb\x95\x4c\x6c\xe0\xc5\x6e\x63\x60\xe1\x7b\x72\x75\x06\x13\x59\x4d\xd9\x1e\xf5\x07\xd5\xd3\x7a\x92\xfc\x06\x49\xdb\x75\xb4\x7f\x12\x60\x3f\x40\x80\x35\x90\x2e\x3a\xeb\xa1\xc7\xb5\x97\xf8\xd6\xa2\x26\xea\x6e\x76\x55\xb8\x72\x59\x37\x11\x41\xc6\x5f\xfd\x50\x04\x51\x3c\xb3\x1f\x26\x8f\x0c\x3a\x9e\xa4\xd1\x93\x34\x4a\xfe\xbe\x9b\x34\x5a\x71\x92\x99\x6d\xfc\xad\x6c\xaf\xb2\xe3\x4c\x17\x07\x1c\x3b\x48\xe6\x8e\xaf\x20\x39\xe9\x8c\x5d\x94\x13\xb9\x0c\x70\x15\x0f\xfc\xff\x4e\x06\x7d\x27\x8b\x7c\xc2\xad\x3e\xc8\x54\x56\xfb\x8c\x78\x12\x73\x2d\xda\x38\x16\xa1\x27\x05\x4c\x97\x1b\x99\xde\xaf\xf7\x3f\x1e\xed\xef\xed\x9e\x1c\x7c\x38\x84\xc3\x0f\x27\x07\x7b\xfb\x1a\x76\x0b\x8c\x34\xbb\x39\x81\xde\x1e\xa2\xfa\x20\x55\x48\x4e\xe8\xdc\x7a\x91\x9e\xdd\xcd\x90\x27\xec\xf9\x95\x33\x0d\xbe\x42\xde\x24\x03\x4b\x9e\x71\xae\x90\x17\xe2\x31\xb4\x54\xcb\x56\xe6\x9d\xea\xe4\x22\xee\x36\xeb\x1f\xb7\xae\x3a\xe8\x8e\xf6\x3c\x13\xe4\x38\x2c\xa4\xb2\xa8\x6f\xd6\x3d\xd2\x76\x3c\x82\xa9\x9c\x64\x04\x5c\x35\x7a\xd6\xc0\x71\x36\x03\x39\xfe\x4a\xa2\xe0\x22\x5f\x79\x34\x0f\xc5\x23\x53\xc5\x1b\x92\x13\x7c\x85\x6b\x52\x03\x0b\x6a\xe9\xbb\x09\x99\x28\xf3\x7c\xd7\x40\x5c\x9b\x91\x59\xd4\x8e\xd9\xe9\x8a\x6a\x4d\xf4\x50\x65\xe6\x8f\x3c\xa6\x6b\x8f\xfa\x5b\x8f\x04\x49\x0f\xcb\x23\x52\x50\xe1\x0f\x15\x71\x8f\x21\x87\x2b\x9f\x11\x1d\xf7\xaa\xb0\xc9\xb3\xe2\xa2\x32\x1b\x1b\xd5\xcb\x08\x3b\x98\x69\x75\xa0\xcf\x71\x4e\xaa\xe6\xbd\xcf\xdf\x21\xea\x27\x3b\xed\xd2\xe8\x93\x2a\x36\x10\x0d\x79\x2c\x21\x7d\xe9\xb7\x6e\x1d\xa8\xf3\x50\x34\x4b\xf3\x55\x13\x71\x4c\x44\xed\xb5\x57\x4e\xf6\xb3\xab\x16\x51\x9e\xb7\xa2\xe3\xea\x27\x4d\xf6\xa4\xc9\xd6\xd6\x64\xef\x56\x9a\x45\x4f\x8a\xeb\xfe\x14\x57\x49\x10\x6c\x76\xe9\x37\x53\x70\x25\xc7\xcc\x39\xfa\x35\xdc\xb3\x94\x97\x09\xb9\xe3\x3e\xfa\xef\x21\xd0\x4b\xbe\xb3\x96\x10\x7f\xb5\x3c\x58\x19\xed\x94\x5a\x1e\xf9\x4d\xd8\xba\x79\x66\xab\x8c\x1e\x2b\x1f\xac\x29\x6f\x25\x3b\xa7\x6a\xd8\x92\xb6\x6f\xb1\x2c\x6b\x16\x89\xdb\x42\xbd\xa2\xb2\x6d\x67\x92\xb0\x30\x27\x57\x4a\x62\xc7\x5d\xed\x14\xfc\x6f\xc2\x98\xa3\x07\x22\xdd\x6a\x13\xd5\x9f\x54\xfa\xdf\x4b\xa5\xdf\x01\x49\x0f\x7d\x73\x0a\xff\x86\xff\xfe\xfb\x2a\x6d\x23\x90\xee\x2c\x5c\xd3\xfc\xe2\x2a\xe9\xda\x58\x7d\xf7\x38\x16\x58\x4e\x1c\x8e\x5d\x4c\x25\x41\x5e\x49\xf2\xcd\x93\x46\x57\x1a\xbd\xa3\x31\xf5\x8d\x37\x67\x47\xea\x1b\x60\x51\xe3\x49\x86\x3f\xc9\xf0\x27\x19\xfe\x90\x64\xb8\x16\x03\xd9\x55\xbd\xc7\xb1\x5b\x55\x6f\xb1\xda\x40\x16\x58\x8a\x38\x14\x3b\x5e\xee\x30\x63\x7c\x5d\xb1\x2e\x58\xf3\x08\x29\x10\x82\xa5\x47\xfa\x84\xce\x58\xd5\x06\x40\xb0\xdb\x85\x42\xad\x98\xff\xdf\x2c\x52\xca\x42\xd3\x53\x54\xc2\x53\x54\x82\xfe\xbb\x37\x89\xb6\x01\xf0\x4c\xfd\x1f\x4e\x16\x58\x60\x40\x3c\xcd\x61\xea\xcc\x90\x43\xe8\x1c\x38\xf6\x74\xae\x51\x52\xec\x3b\xea\xb3\xa2\xb6\x6b\xcf\xc7\x92\x13\x47\xf4\xf4\x59\xf2\x84\x23\x3a\xc7\xab\x44\x87\x80\xa8\x53\xb4\xd9\x26\x3e\x16\x98\x13\x2c\x40\x77\x37\xc7\xd2\x4a\x52\x99\xd0\x81\xc4\x01\x91\x97\x2c\xef\xcd\x28\xaf\x96\x47\xaa\xdb\x27\xeb\x30\xfb\x5b\x87\x38\xfd\x7a\xfc\xe1\x10\x10\xe7\x68\xa9\xe4\xc8\x47\xce\x7c\x2c\x17\x38\x4c\x27\xc6\xa6\xe7\xd8\x91\x02\x66\x9c\xf9\xc0\xa6\x4a\x0a\x23\xc9\x38\x09\xfd\x1f\xc1\x76\x11\xa2\x52\x34\x3d\xc5\x3e\x3d\x49\x99\xe4\xef\x61\xc6\x3e\x55\x36\x76\x43\x23\x04\xd6\xe8\x42\xa8\x54\x0b\xd0\x5b\xa3\x8b\x09\x4f\x12\xf5\xd5\x25\x4a\x24\xe0\x9a\xb2\xcf\x44\x00\xc9\xf5\x45\x9e\x49\xa3\x95\x4f\x42\x6f\x95\xd0\xb3\x11\xf5\x24\xf6\x9e\xc4\x5e\xf2\xf7\xc8\xc4\xde\x2d\x04\xd2\x0c\xbb\x4a\x7a\x34\xb0\xc7\x90\xe7\x25\xab\x98\x50\x10\x0e\x47\x01\xd6\x37\xc5\xcc\x18\xf7\x91\x8c\x36\x93\xe6\x48\xe4\xc2\x04\x74\xba\x65\x22\x2a\xfe\x64\xb4\xf8\xbe\x93\x64\x32\x42\xd3\x9a\x00\xb2\xc5\x93\xc4\x37\x32\x9a\xc7\x2a\xb6\x54\x4d\x7b\x81\x87\x48\x63\x86\x2c\x0d\x75\x6c\x8f\xea\xc0\x7e\x5c\x49\xa0\xdf\xb3\x76\xdd\x93\x44\x6e\x22\x91\x47\xb9\xa3\xc2\x92\xc2\x4e\xc4\xd5\x4e\x3b\x5d\x82\xed\x51\x60\xe8\x5e\xeb\x41\x3c\xe9\xac\x6f\xab\xb3\x36\xd2\x57\xaa\x67\x34\x17\x33\xc8\x07\x6d\x03\x1e\xe1\x19\xe6\x98\x3a\x09\x98\x46\x4c\x1a\x03\x31\xfe\x3c\x57\x9a\x43\x12\x7b\x9e\xc4\xb5\xe7\x55\x2a\x5b\x2f\x08\x5d\xdd\x68\xa1\x26\x51\xd7\x48\x59\x82\xe3\x44\xef\x44\xd1\x80\x16\x16\xd4\x57\xac\x9f\x01\x9a\x63\xeb\xa7\x20\x5f\xed\x9f\x92\x49\xe4\x59\xbf\x89\xc4\xbe\x58\x6f\xe2\x8d\x66\xa5\xa0\x28\x36\x52\x9b\x9b\xb9\x55\x3f\x4e\x01\xb7\xba\x95\x86\x79\x75\x33\x3d\x95\x62\x33\xbd\x0b\xb0\x9e\x16\x9a\x41\x29\x1f\xc5\x5c\x9f\x63\x12\x63\x05\xe9\xa5\x10\x8f\x81\x3c\xef\xc3\x6c\x15\x5b\xd6\x0e\x17\x91\xa6\x88\xfe\x2a\x12\x80\x5e\xf7\x6e\x61\x65\x55\x24\x33\x28\xbe\x41\x25\x52\xa0\xb2\x79\x62\x27\x4d\xb2\x5c\x5e\xda\x29\xb9\xe2\xe9\x56\x08\x51\x1d\xef\x80\x85\x12\x6a\x56\x11\xbe\xb2\x79\x3d\x03\xe8\xe9\x19\x08\xed\x52\x1a\xdf\x89\xfa\xc5\x05\x6f\x9a\x73\x8c\x42\xb9\xc0\x54\x46\x52\x7e\x82\xa9\xb2\x81\xdd\x5c\x33\x3f\xf4\x24\x99\xa0\xaf\x0d\x30\x29\xf4\x85\x48\x79\xdc\x64\xd4\x51\xeb\x77\xe4\x85\x58\x8c\xe1\x4f\x14\xd5\xa6\xda\x84\x80\xe3\x00\x29\x5e\xd8\x34\x67\x12\x82\x30\xaa\x7f\x71\x8c\xdc\xe5\x26\xcc\xf4\xad\x4b\x9b\x3a\xeb\x29\x7a\xbd\x69\x22\x02\x08\x9d\xff\x05\xad\xa6\x2c\x99\x4d\x5b\xad\x07\xf3\x10\xf9\x58\xed\xfb\x75\x06\x25\x84\x51\x3d\x5c\x17\x07\x1e\x5b\x76\xe1\x0d\xe3\xb1\xda\x82\xdd\xcf\xc7\x8d\x21\x88\x71\x59\xce\x6d\xc5\x72\x97\x10\x25\x8f\x36\x41\x69\x92\x1c\x66\x65\xd2\x46\x55\x68\x9d\xdc\x89\x4f\x66\x02\x63\x08\x45\x07\x23\x21\x3b\x03\xbd\xef\x59\x67\x3e\xba\x76\x79\x63\x91\xa0\xf3\xad\x9a\x36\x9e\x32\x26\x85\xe4\x28\x98\x98\x9b\x21\x27\x0b\x2b\xb0\x62\x65\xef\x28\x36\x7b\x82\x0a\x5d\xcc\xce\x68\x0c\x2e\x92\xb8\x23\x89\x8f\x9b\x0e\x19\x55\x31\xbf\xcf\x21\x0d\x63\x4f\xd6\x94\xac\xf1\x25\xa1\x4d\xdb\xd7\xa6\xdd\xd5\x89\xfb\x52\xe9\xd0\x9c\x75\xf5\xc6\x79\x22\x24\xe3\x68\x8e\x27\x79\x3d\x5d\xfb\xf1\x29\x67\xd7\x02\xf3\x49\xc8\xbd\x86\x7d\x4a\x2f\xa7\x29\x93\xa3\x75\x65\x39\x8b\x22\xfa\x5b\xeb\xa4\x52\xb0\xb5\x75\x04\xad\x3c\x1c\xd9\x55\xa9\xad\x23\x68\x0d\x72\xb9\x96\x0a\xcb\x85\xa7\xc6\xfa\x29\x3c\x56\x9a\x2c\x8f\xde\xbb\x54\x32\xfd\xb6\x0a\x36\x87\xfe\xf4\xaf\x9e\x10\x36\xcc\x66\xfa\xb9\xdb\x51\xbf\x93\x16\xae\xa3\xf4\xee\xc7\x83\x08\xa8\x1c\x81\xd4\xcb\xab\x1c\xd5\x16\x06\xac\x12\xaf\x58\x2b\x67\xdc\x79\x1e\xd6\x55\x98\x0b\xc8\xec\x98\x91\x4d\xef\xbc\xb0\xaf\xfb\x42\xaf\xaa\x8b\xcd\xb2\x79\x5e\xad\xb6\x3e\x2b\x01\xfc\x5e\xcc\x51\x4a\xc6\x92\xb2\xcd\xf1\xc8\xd9\x7c\x20\x3d\x88\xd6\x99\x32\xad\xc1\x08\x53\xe6\x2e\x41\x60\x93\xd2\x1b\x21\x0c\x3e\x7e\x38\x3e\xa9\xd9\x7f\x29\xcd\xb8\xde\x0e\xaa\xda\x96\x29\x14\x87\xcc\x95\xa3\xb8\xd6\x77\x5f\xa7\x05\xf7\x1c\x2f\x14\x52\x3d\x8f\xcc\x87\xb8\x0a\x27\xa1\xab\x36\x68\x65\xd6\x4c\x2e\x63\x4a\x9a\x12\x89\x92\xe9\xd4\x33\xf5\x5f\x87\xd1\x19\x99\x87\xa5\x20\x98\x1c\x68\x3d\xec\xee\xbf\x0a\x5f\xcf\xeb\x98\xbc\x39\x91\xf9\x74\x5b\xcd\x9c\x46\x46\x5c\xe1\x4b\x5d\x38\x90\xe0\x87\x42\x2a\x70\x44\x14\x1f\xe3\xb1\x6b\xcc\x3b\x0e\x12\x18\x90\x17\x2c\x10\x0d\x7d\xcc\x95\xed\xb4\x40\x1c\x39\x12\x73\x01\x8c\x43\xbb\xdd\x69\xb7\x37\x95\xa9\xcb\xa3\xe8\x79\x44\x4d\xfb\x29\x96\x76\xeb\x4d\x40\x54\xc7\x17\x64\x5b\x15\x46\x35\xed\x1c\x44\xb5\x0f\x6b\x8a\xc1\x63\x74\xae\x4b\x05\x20\x0a\x5b\x43\xeb\xf3\xdd\xf6\x2a\x8a\x14\xad\xc5\x92\x52\xa1\xaa\xc9\x3d\x72\x41\x13\x43\x21\x03\xc5\xe7\x05\xd6\xe5\x65\x1d\x46\xa9\x59\xff\x85\x31\x80\x08\x88\x86\x51\x38\xa7\x4c\xea\x6b\xdb\x05\x96\x31\x2b\x6d\xd6\x76\x67\xd4\x9a\x5a\x52\x8b\x21\x35\x90\xcd\x0a\x04\x7c\x85\xf9\x12\xb6\xc1\x27\x34\x94\x58\x74\x35\x82\x5c\x3c\x43\xa1\x27\x4d\x41\x02\x05\x48\xae\x3c\x44\x95\xc1\x43\x43\xcf\x53\x10\x5b\x59\x96\x85\x72\x50\x3f\xca\x94\x28\x00\xf2\xe3\x6d\x89\x0c\x48\x8f\xc5\x98\xc8\x00\xdd\x4a\x69\x9c\x96\xd8\xf9\xa1\x14\x4e\xc1\x78\x20\xf4\xad\xbc\x8f\xe0\xe1\x52\xd7\x80\xdc\x2a\xae\xdf\x52\x1b\xa0\xbd\x97\xdd\x58\xb7\x6b\x54\x76\xde\xe9\x99\x1d\xe8\x80\xba\x4a\x7a\x61\x13\xd9\xaa\xab\xbd\xc4\x05\x86\x0d\x23\x74\xe1\x73\x24\xbf\xda\xed\x0c\x60\xed\x36\x78\x84\x5e\xac\xd6\x0e\xa4\xe6\xf3\xa7\x94\x5c\x2a\x71\xa7\xe3\x69\x67\xc4\x94\xe9\x56\x90\x44\x1f\x5f\x39\xb8\x4b\x44\xe0\xa1\xe5\xa4\x5e\x2b\x1f\x5a\x1a\x39\x67\x97\x28\x3b\x2a\x1a\x04\x82\x90\x07\x4c\xe0\x06\x1a\xaf\xfe\x73\xbf\x84\x3e\xa2\x30\xe3\x04\x53\xd7\x5b\x96\xcc\x2e\x0b\xc3\xa6\x06\x22\x76\xec\x9c\xa1\x6b\x71\xb6\x1a\x82\x55\xea\xae\x1d\xeb\xbb\x92\x39\x5b\x6a\x4e\x4f\x5f\xbb\x97\x08\x9d\x2b\x6b\xe1\xc3\xf1\xeb\xc4\x5c\x29\x02\x91\xd5\x3f\x65\x36\xa5\xed\xcd\xb3\x38\xbb\x9c\x8d\x5f\xa7\xbf\x14\x6a\x50\x6c\x26\xe8\x7f\x3b\x3f\x8e\xc7\x0d\xcc\xed\xf6\xa3\x63\xee\x08\x7f\x65\x4c\x9d\xe3\xb2\xc3\x2e\xfc\x4e\xf8\x9c\x50\x82\xee\x9b\xdb\x22\x20\xee\x8b\xcb\xcc\xc7\xb4\x75\x94\x2f\x8c\x94\xc4\x96\x67\x8b\x3c\x89\x2c\x9c\xd9\x92\x5b\x3a\x4e\xb7\x74\x12\x0d\xeb\x6a\x09\x2b\xa4\x3d\xbe\x33\xc0\x4c\xb9\x7b\x1f\x95\xb5\xf2\xc8\x68\x70\x0a\x54\x4a\x32\x07\x05\xc8\xc9\x84\x83\x54\xaf\x8b\xeb\x94\x7a\x5c\x1b\x9f\x71\x67\xf0\xf0\x4c\x42\xa0\x73\x0f\x2c\x14\x14\x49\xd6\x00\xca\x52\xf5\x58\xaf\x1a\xcd\x3a\xdc\x8b\x80\x51\x16\xc6\x81\xc4\x7e\xab\xa1\xf8\x31\x4f\xaa\x78\xc4\x6a\x12\xcf\x56\x3f\xca\xa6\x8a\x94\xcb\xad\xb8\x26\xc7\x6e\xb6\x26\x07\x10\x0a\xef\x77\x8f\x3b\xc7\xc7\x1f\x92\xfd\xb9\x61\xa0\xbd\x68\x9f\xa3\x23\x7c\x32\x9b\x86\xf6\x6d\x2c\xb7\xfb\x3b\x8b\x2b\x9e\x92\x65\x67\x6a\xbc\xe0\x30\xc7\x54\x47\x1c\xb9\x10\xc6\x42\xad\xa2\xa2\x58\xfe\x98\x7d\x2d\xb7\x7c\xf6\xdb\x8d\x87\xb2\xbb\xdd\xcf\x88\x49\xdd\xb4\xe6\xae\x7f\xd3\x43\x60\x87\x17\xb3\x0e\xef\xe9\x24\xa3\xb6\x7e\x60\x7a\xfa\x30\x5d\xfe\xb8\x03\x8b\xf5\x1d\xe2\xa5\x09\x97\xad\x92\xa5\x98\x3b\xbe\xcc\xad\xc8\x72\xaf\x98\x64\xd1\x14\x8b\x49\x5a\xed\x1a\x29\xb2\xbe\x63\x6c\x3d\xaf\x50\xcd\x9a\x29\x37\x04\xca\x19\x3c\xfb\x91\x5d\xfb\x77\x82\x89\xf5\x3e\x55\x20\xdf\x1a\xa4\x2b\x3b\xd4\x28\x17\xe0\xe5\x24\x14\x29\x09\x51\x1c\xfe\x98\xa9\x67\x99\x28\x25\x42\x23\x85\xdb\x5e\x8f\x48\x95\x47\x52\x59\x40\x4a\xbe\xbd\x92\x42\x3e\xba\x99\xc4\xf0\x4d\xa2\x3b\x83\xaa\xbf\x30\xf3\xd0\x1c\x88\x51\xbf\xca\x22\xba\xb6\x6d\xf5\x78\x96\x31\x05\xb3\x48\x88\xae\x83\x49\x6d\xac\xe8\x63\xb7\xb1\xd5\xcb\x80\x2e\x59\x78\xf5\x64\xfb\xc7\x2a\xb0\x4a\x1d\x91\x05\xc0\x34\xfb\x2e\x0a\xb3\xa1\x88\xa9\xfd\x4a\xa9\x46\xca\x7e\x26\xb9\x3a\xfc\x2e\xdf\xb9\xb5\x2e\x2b\x92\xb7\xa4\x3a\x9a\x31\xcc\x4d\xee\x5d\x73\x82\xde\x5a\x19\x36\x80\x49\xad\x56\x9d\x83\x27\x91\x1f\xdc\x87\x65\x53\x8b\x59\x1b\x1c\x37\xbb\xc9\xae\x24\x5a\x71\xd1\x57\x7a\x15\x6f\xe1\x29\x2c\x8e\x5e\xf4\xf4\x95\x9c\x18\xae\x51\xab\x21\x16\x53\x6b\xf8\xfd\xf2\x7e\x83\x5a\xbc\xfe\x50\x27\x61\xf9\x54\x6d\x14\x56\xc5\x5b\x65\x82\x2c\x21\x17\x3a\xf9\x2c\x93\x9d\x1a\xc7\xf6\xc7\x59\xaa\xcf\x0c\x5f\xa4\x39\xd3\x15\xb6\xd7\xf1\x07\xc8\x67\x55\xff\x20\x6d\x30\x45\x02\x97\x85\x6f\x64\x01\x56\xad\x20\xe4\x5e\xe3\x65\xa8\xe3\xe1\xd7\x08\x0b\x01\x38\xbf\xbe\xa8\xe0\x95\xb2\xd0\x1a\xe4\x11\x77\x42\x84\x08\x1b\x6f\x06\x6e\x61\x67\xa7\x64\x8c\x4d\x34\xd3\x4b\x0f\x51\x9a\xbc\x7a\x9f\xeb\xbf\xf4\x03\x25\x71\x07\x03\x3a\x0d\x8e\x9f\xf7\x7f\x71\xc3\x8f\x78\xe4\xf5\x25\x7b\x71\x7e\x3c\x1f\xee\xbd\xfb\x3a\x0b\x1b\x08\x8c\x5a\x71\x51\x00\xe1\x9b\x49\x8a\x47\x22\x54\x52\x4c\x44\xd6\x7a\xf2\x7b\xbc\x9e\x61\x6d\x04\xc7\xb8\x60\x82\x16\x38\x04\xb9\x2e\x51\x8b\x10\x79\x1f\x2b\x10\x5d\x8a\xa9\x2b\x13\xbd\x59\x18\xbf\x71\x84\x74\x79\x5e\x80\x19\xd6\x90\x3f\xfb\x89\x86\xf3\x4e\x14\x7a\x11\xb4\x7c\x8c\x77\x6a\x44\x10\x2a\x77\x46\xd9\xa9\x15\xbb\x9b\x4b\x4b\x4b\x7a\xbb\x2c\x9c\x7a\xb8\xc6\xa8\xd7\x03\xda\x6b\x3a\x9f\x9b\xf9\x0d\x56\x75\xfe\x13\x3f\x64\x5d\xdb\x40\xfc\xd3\x57\xb6\x8d\x8b\x96\xcd\x0c\x6f\x4c\xea\x20\x61\xf4\x48\xdf\x07\x91\x65\x78\x6b\x1a\xf6\x08\x0f\x4c\x1a\x3c\xec\x55\xa7\x1d\xbe\xa7\x3a\x6a\x37\xe7\xb1\x6a\x88\xbe\x67\x7a\xe7\x4f\xd9\xb5\xd9\x8b\xe9\x58\x90\x05\x06\x46\xbd\xa5\x75\xf2\x30\x23\xd8\x33\x07\x2b\x26\x42\x38\xe9\x5e\xd8\xc0\x55\x70\x68\x49\xe0\x08\xfc\xbd\xe2\x6a\x0a\x38\x58\x19\x3d\xb3\x51\xcc\xd7\x4a\x57\x7c\x74\x41\x8b\x5b\x66\x03\x9f\x2c\x30\x1c\xbc\x56\xdb\x2b\x8e\x1d\xc6\x93\x72\x55\xb9\x64\xb5\x12\x52\x10\x3a\x86\x00\xc9\x45\x9e\xb7\x52\xaa\xc4\xa5\x18\xb2\x70\xc4\x4f\xad\x61\xec\x8b\x46\x0a\xd0\x79\x98\xce\xe5\x42\x6f\x00\x89\xaf\xdd\x48\x11\x9a\x34\x0f\x5d\x2f\x88\xb3\x50\xc4\xe0\xa6\xec\x94\xbe\x3f\x3b\x93\x5d\x5c\x5a\xcd\xbd\x7c\x7e\xf9\x45\x58\xbe\x04\x93\x23\xbd\xed\x54\x70\x10\x4a\xfc\xd0\x1f\xc3\x20\x7d\x64\x2e\xf1\x1e\xc3\x68\x6b\xd8\x8f\x9e\x16\x33\xf7\xf2\x28\x82\xf4\x66\x17\x33\x7a\x5c\x9b\x22\x47\xcb\xe8\x69\x53\x1c\xc6\xed\x75\xf6\x36\x76\x18\x75\x05\x4c\xb1\xbc\xd6\xf7\x35\x23\x89\x20\xa9\xe9\xf3\x6d\x31\xb6\xd5\x6f\x84\xb2\x41\xff\x45\xbf\x1a\x67\x79\x94\x58\x38\x8b\xc6\x8f\x92\xe1\xb3\x38\x8b\x1e\x36\x41\x59\x5c\x6d\x3c\xde\x56\x4a\x06\x33\x2c\x9d\x45\x17\xde\xa8\xff\x64\xf2\xe1\xaf\x17\x98\x02\xf6\x03\xb9\xec\x9a\x7e\x98\x4a\x5d\xac\x08\xf1\x74\xe1\x4b\xcc\x29\x8a\xfb\x68\x78\x92\x45\x5e\x8e\xd7\xac\xa2\xad\x48\xb3\x2b\xf8\xda\x23\x2c\xc7\x39\xf3\x76\x42\xa0\xc1\x81\x95\xa8\x58\x8b\x80\x8f\x68\xae\x98\xc6\xc5\x37\x05\x96\xb0\xcf\xb1\x1b\x48\x89\x22\xf9\xf2\x69\x8a\xf1\x45\x46\x51\x00\x95\x9d\xf7\x60\x80\xb6\xd2\x29\x6b\x81\x3e\x4c\xaf\xcb\x57\xf8\x52\xbc\x8e\x91\xb3\xb0\x27\x7d\x8f\xd3\xc8\xe7\x67\x24\xd3\xe8\xf7\xcd\x44\xa2\x2b\x4a\x4b\x3d\x0f\xd6\x79\xfd\xb1\x49\x39\x8a\x62\x3c\x74\x27\x98\x2e\xc1\xe1\x44\x62\x4e\x90\x89\xb1\x14\x4b\x2a\xd1\x4d\x12\xfc\x91\x88\x7a\x20\xc2\x02\xc8\x27\x1e\xd2\x41\xc1\x32\xd7\x05\xc3\x59\x3c\xf0\x19\x38\x1e\x0a\x85\xf6\xb6\x22\x0a\xc7\x9f\xde\xe9\x2c\x34\xec\x63\x2a\x53\xbd\xb3\xaf\xf0\x66\x8a\xce\x38\x88\x2a\x5d\xa5\xfb\x1b\xf7\x24\xa2\xcb\x78\xd8\x19\xf3\x3c\x76\x4d\xe8\x1c\xce\x2e\xac\x28\x70\x71\x66\xb4\xbc\x18\xa7\x71\x05\x3f\x95\x67\x28\x59\xef\xb3\x21\xda\x99\x17\xfa\x0c\xda\xbe\x72\xea\x27\xcb\xe7\x69\x3d\x5c\x70\x3c\xb3\x7e\x66\x3a\x94\x5f\x50\xf6\x53\x31\x5f\xef\x27\xfb\x14\x4d\xfd\x64\x7c\x8e\x28\x11\x71\x76\xa6\xfd\x46\x99\x2c\xd6\xef\x95\x29\x82\x3f\x45\xe7\x1f\xd6\x03\x93\x04\x68\x3d\x48\x13\xa7\xac\x87\x51\x12\x53\x8a\x4f\x2b\x23\x6d\xd3\xd2\x7f\x4a\x34\x65\xcd\x0d\x61\xd3\x4e\x2e\x30\xe1\x7a\x7e\x9b\xa0\xb8\x20\x4b\x44\xc3\x33\x16\xd1\xce\xce\xce\xc4\x65\x9a\xbb\xac\xdd\xf4\x48\x38\xf6\xfb\xb4\xf1\xc9\xfa\x40\xc0\x04\x51\x77\x92\xf8\xbe\xd5\xbc\xef\x02\xd7\xa6\xc5\x15\xd5\x70\x1e\x18\xde\xb5\x17\x11\x6d\xcb\x38\x5e\xcb\xdd\x54\x96\x1e\x31\x6d\x92\x98\x66\x2d\xe0\x37\xd5\xb3\x94\x74\xf6\xdd\x74\x4a\xd8\x5b\x33\x54\x00\x75\x13\xd1\x11\x78\xcc\xcd\x5a\xab\x45\x71\x92\x93\x16\x60\x49\x94\x78\x76\xad\x0a\x21\x68\xa4\x64\x34\xc0\x5d\x05\x9d\x90\x4b\x65\x54\x2a\x3d\x6e\xc4\xb1\xbe\x36\xb9\x5c\x88\xa5\x32\x4c\x37\x4a\x65\x96\xc5\x13\xf5\xc2\x6b\x85\xd0\xd2\x41\xf7\x59\x89\x95\x7e\x33\x23\xb9\x60\x57\xf1\x4a\xbc\xbb\x10\xf1\x61\xa3\x81\x5e\x53\xe7\x2c\x2b\x5e\xce\x36\xe1\x4c\x21\x4e\xfd\x57\xaf\x62\xf5\x0f\xb3\x36\xcf\x4c\x86\xc1\x99\x59\x98\x67\xe9\xd8\x6a\xbb\x8a\x38\x92\x8c\x1b\x82\x9f\xfd\xdf\xff\xa7\x7a\xfd\x7c\xa6\x59\xe6\xec\xdd\xc1\x6f\xfb\x67\xa9\x0c\x8d\x7b\x9d\x33\x42\xa3\xf6\xbb\x87\xaf\xcf\xcc\xd8\x1f\x8e\xce\xba\xf0\x0b\xbb\x56\x96\xff\x26\x2c\x59\xa8\xe5\xac\x9a\x25\x8a\xcd\x20\x35\xdf\x41\x3f\xea\xae\x8b\xd6\x44\xb3\xd1\xb4\xb7\x70\xbc\x9f\x30\x53\xd9\x52\x2c\x6e\x3e\xa2\x0b\x0c\x34\x5b\x9d\xf9\xcb\x8e\x96\xdc\x06\x2e\xeb\x80\x56\x47\x73\x36\x5d\x8c\xd9\x95\xf8\x33\xc4\xa3\x9a\x54\x8d\x0c\xe2\xe1\x67\x40\xd7\xc2\xee\xfc\x67\xd0\xf9\xab\x39\xe8\xc8\x7c\x43\x2e\x90\x34\x49\x25\x51\xa9\xb4\x33\x7f\x79\x4b\x70\x3d\x72\x81\xc1\x5f\xfe\xc7\x70\xfb\x9b\xc8\x0b\x2d\x0d\x8b\xbb\x40\x61\xc9\x11\x24\x93\x33\x3f\x58\x20\x01\x01\xe6\x3e\x11\x42\x9f\xbc\x31\x10\xd8\x94\xe4\xe4\x51\x3d\x23\x8b\xf4\x87\x4c\xe2\x6e\x0c\xa0\xd1\xd7\x69\xed\x1b\xc5\xc6\x51\x0d\x13\x7d\xda\x1e\xf7\xae\x16\x4b\x91\xbd\xa5\xd9\xac\x42\xd8\x94\x0b\x96\x12\xf3\x28\x23\x37\x20\x2f\xce\x1a\xb0\x48\xeb\x76\x42\x6b\x23\xad\x27\xa5\x43\x6b\x62\xa0\xa2\x82\x52\xf6\xa0\x6a\x63\xad\x9f\x46\x0f\xcd\x8f\x37\xd1\x16\xe6\xd7\xcf\x71\x06\x9a\xf9\xe2\x42\xca\x60\x23\x3f\xd5\xd3\xe3\x4c\xc8\x7e\x3c\x7c\xce\x4b\x13\xa5\x19\x41\x2b\xc9\x1c\x4f\x3d\x87\xb9\xc4\x34\x68\x59\x53\x8f\x29\xd2\x8a\xef\xed\x0a\x88\x4c\xd2\x32\xf7\x4f\xd7\xfa\x34\x0e\x3b\xd7\xf8\x9e\x3e\x5d\x92\xd7\x5a\xf1\x79\xe3\x41\x25\xc7\x5f\x76\x8e\x3e\x6d\xfd\xfa\xdb\xc1\x8b\x4f\xfd\x0f\x27\xfe\xf9\xa7\x37\xee\x16\x73\xde\x1c\xcd\xd3\xcf\x45\x7e\x59\xcd\x13\xe9\xd3\x95\xa9\x95\xbd\x46\x83\x47\x85\x16\xa0\xa5\x2b\x24\x34\xc5\x40\x92\xaf\x97\x77\x34\x55\x53\xd3\xf8\xb0\xa0\x85\x02\x32\x89\xf2\xb9\x0d\xfe\x6a\xf0\x9a\xbe\x2a\xcf\xe1\xb7\xdb\x76\x06\x44\x2c\x77\xf8\xe5\xd6\xf9\x05\x79\x71\xd9\x67\xd2\x3f\xbf\x9c\xa9\xe9\xce\xf8\xbc\x8b\x82\x40\x74\xfd\x8b\xce\x54\xca\x79\xff\x9c\x0e\x9e\xf7\x17\x41\xf7\x66\x3b\x7c\xd1\x15\x83\xae\x8b\xaf\xc4\x82\xcc\x64\x97\x71\x0b\x31\x56\xe4\x00\xb4\x86\xfd\x61\xbf\x33\xe8\x77\xfa\xdb\x27\x83\xe1\x78\x7b\x30\x1e\x8e\xba\xfd\xed\xad\xc1\x68\xf8\xaf\xb4\x87\x95\xd6\x5f\xe8\xb1\x33\xde\xda\xe9\x6e\xed\x0c\x87\xfd\x17\x56\x8f\x38\xff\x1e\x5a\xc3\xee\x4e\xb7\x9f\xbe\xc8\x46\x35\x25\xf7\xde\xa6\xe8\xb0\xb2\xda\xa1\xa5\xd6\x9f\x18\xf7\x7a\x0e\xa3\x82\x79\xb8\xcb\xb1\xbb\x40\xb2\xeb\x30\xbf\x67\x95\x5e\xea\x44\xb8\x12\x3d\x21\x39\x46\xbe\x48\xf9\xa4\x12\x71\x3d\x17\x89\xc5\x94\x45\x97\xe8\xc6\x44\xae\xf0\x26\xa6\xbc\x60\xaf\x82\x37\xba\x32\xc1\x5e\x14\x2f\x71\xac\xd9\xed\x71\xad\x0c\x53\x5b\xe1\x69\x69\x7c\xd7\xa5\x91\x2d\x68\x01\x2d\x14\x55\x0d\xb2\xec\x85\x38\x20\x34\x89\xc5\xc9\x13\x6a\xd5\x2a\x6a\xc0\xc9\x65\xb9\x85\x15\x6c\x5b\x96\x20\xd9\xca\x32\x75\x99\x16\xc9\x3c\xcb\x64\x87\x40\x6b\xd7\x47\x5f\x19\x85\xcf\x78\x1a\x47\xf2\x58\x6d\x2b\x80\x6d\xa2\xfa\x8a\x99\x7e\x39\x40\x4b\x98\x34\x07\xda\xe9\x31\xec\x23\x21\x37\xc1\x4a\x3a\xa9\x83\x0d\xea\x52\x3b\xe0\xcf\xf4\xb2\xee\xbf\x52\x36\x8b\xb3\x1d\xe0\x4f\xcb\x58\xfa\xb7\xf5\xef\x12\x22\xa7\x03\x6d\xe6\x1a\x96\x86\x73\xe6\xa3\xd4\xd2\x4b\x33\x0c\x1c\x75\xe1\xb0\x15\xc8\x8d\x10\xe4\x2f\x3b\x28\x08\x3a\xc2\xc2\x4a\xb6\xd6\x4e\x3e\xa4\x6c\xc6\x38\xf8\x4b\x40\x41\x50\x16\x29\xdd\x44\x64\x16\x04\x63\x76\x88\x46\x12\x32\x7b\x0f\xaa\xe8\x0d\x0a\x0c\x7b\xe7\x89\x41\x26\xd0\x12\x5a\xc7\xbb\x9d\xc1\x50\xfd\xaf\xf0\x3a\x8a\xbc\x57\x43\xaa\x7f\x14\x25\xa6\x32\xbc\x3a\x6a\x73\x50\x14\x4e\xd3\x65\xfd\xfb\x58\x14\x0d\x3a\xfd\x51\xa7\xff\xfc\x64\xb0\x33\x1e\x8e\xc6\xfd\xc1\xff\xe9\x6f\x8f\xb7\x22\x55\x5c\x8c\xaf\xa9\x5f\x50\x56\xfb\x66\xc8\xb6\xef\x0d\xb1\x24\x7a\x1c\xf1\x94\xaa\x76\x93\x70\x26\x97\x5d\x14\x10\x4b\xbf\xa7\x7d\x74\x70\x52\x45\x7b\x16\x60\x6a\xc4\xb8\x36\x09\x42\xb9\xe8\x71\x8c\x3c\x5f\xf4\xf8\x82\x21\xd1\x0b\x38\x93\xcc\x61\x5e\x4f\x35\x24\x6e\x27\x3a\xb7\xeb\x39\x98\x4b\x0b\xac\x34\x62\xea\x9e\xbf\xa3\x07\xb6\xac\x22\x3b\x74\xea\x76\x9f\x32\x61\x50\x25\xcb\xc8\xba\xd4\xf1\x1f\xb2\x94\xbe\xd7\x52\xa9\x8b\xdb\xbc\x0b\xaa\x8b\x71\x91\x4f\x28\xcf\x84\x7f\x14\x62\xdf\x2a\xb0\x5d\x0c\xe1\x98\x68\x65\x3e\x99\x8c\x21\xb5\x3a\x31\x9f\x4c\x39\xbb\xc0\x5c\xb2\x80\x38\xd1\x51\xde\x64\xba\x94\x58\x4c\x08\x9d\x64\x2b\x76\x82\x76\x36\xf8\x5f\xc9\x84\xb0\x49\x74\x16\x11\x0d\xd6\xc9\x5f\x6f\xa5\xe4\x47\x40\x9c\x31\x4c\x26\x6a\x9f\x12\xfa\x98\x4f\xd8\x6c\x26\xb0\x75\x55\x71\x31\x26\xac\x63\x45\x86\xc0\x60\x67\x30\xd8\x79\xde\x1f\x6e\xf5\xfb\xfd\x7e\x46\xbb\x47\x1e\x94\x17\xa3\xc1\xf6\x68\x55\xef\x9d\xca\xde\xdb\x2f\x5e\xbc\x58\xd5\xfb\x65\x65\xef\xe7\x3b\xc3\xa1\x4d\x97\x92\xd8\xa5\xc7\x4b\x99\x95\x54\x28\x50\x60\xd4\xef\xeb\x3b\x12\x57\x1a\xa3\x46\x0a\xf4\xb7\x0a\x72\xc0\xaa\x75\x09\xf5\xcb\x5e\xbb\xf2\x44\x2f\x33\x88\xae\x48\x0a\xad\xdf\x76\xdf\xfc\xb6\x7b\xdc\x79\xff\xf6\xfd\x49\x27\xf3\x3e\xd9\x59\x1c\x2f\xa9\xb3\xe0\x8c\xb2\x50\x00\x72\xe2\xd8\x16\xca\x64\x6a\xaf\x1a\xef\x29\x12\x4b\xea\xfc\xac\xf3\x8d\x13\x8f\xa7\xb5\xe8\xed\x2a\xa5\x6a\xff\xfa\xf9\x80\xf8\x97\x6f\x1d\xfe\x3a\x7c\xb7\x33\x40\xa7\x37\x07\xff\xba\x7c\x75\x72\x79\x78\x14\x49\x9e\x51\xbf\x1f\x6f\x8a\x9f\xf0\x53\x8e\x9f\x03\xe3\xad\x6d\xb0\x82\xf4\x90\xc3\x7b\x40\xd1\xb0\x1e\x43\xc3\x32\x04\x19\x0f\x07\x48\xa6\xa6\x2d\x70\xe6\x30\x62\x0c\xa7\x7a\x2b\xa4\xde\xea\xdb\xd3\x32\x5b\xd7\xe8\xae\xb9\xfc\xb6\x7f\x0c\xd9\x6f\x8e\x61\xd5\x27\xac\x0b\xd9\x98\x17\xfa\xd4\xb8\xef\xd5\xe0\x91\xb7\x19\xda\xc4\x6d\x77\xe1\xb8\xac\x9d\x3e\x82\x19\x47\x1e\x8a\xcd\xe8\x08\x34\xeb\xe4\x88\x9f\x1a\x9f\x48\x17\x3e\x19\x87\xba\xa1\xcf\x18\x88\x0b\x3f\xc3\xc0\x46\x4e\x9e\xda\xde\xe7\xd7\x6f\xc3\xe5\xf4\x80\xef\xd3\x1b\xbe\x8b\xfd\xe7\xc3\xd1\xfc\xf2\xe2\x82\xbc\xbe\x4a\xa8\xbd\xa2\x5e\x7e\x29\xc5\x8b\xc6\xc3\xfa\x14\x1f\xd4\x53\x7c\x50\x42\x71\xdf\x80\xaa\x83\xbc\x52\x5e\x1f\x27\x17\x3c\xdc\x05\x0f\xf9\x82\xee\x65\xf3\x7e\x7e\xf7\x69\x3f\xaf\x9d\xf5\xf3\x92\x49\x9f\xa4\x39\xb8\xd8\x05\x8e\x05\x0b\xb9\x83\xc1\x65\x58\x1f\xfa\xe0\x9b\x24\x48\x78\xd4\x1f\x99\xeb\x71\x1f\xea\x54\x22\xff\x64\x34\x03\x73\x9f\x90\xfb\x73\x7b\x40\x7e\xdb\x72\xc3\xdf\xbf\x1c\x5c\x5d\x6d\x7f\xb9\x7a\xe7\x2d\xbf\x0e\xfc\xb7\x47\x5b\xbf\x2e\x2f\x0f\xdb\xe9\xb5\x00\x35\x22\xed\xcb\x87\xe7\xf3\xe1\x7c\xe7\x97\x13\xf7\xf4\xb7\x53\x34\xbc\x10\xbf\xbc\x18\x5e\x7c\x7a\xbd\xb5\x8c\xf1\x92\xbf\xcf\xa0\x54\xd4\xdf\x03\x53\x0f\xea\x99\x7a\x50\xc6\xd4\xa9\xa0\xba\xc2\x9c\xcc\x96\xf0\xeb\xe7\x13\xb3\xe7\x1b\xc3\x51\x1c\x8f\x19\xdf\x66\x1a\x25\xbf\xe9\x3b\x25\x1a\x61\x66\xeb\x74\xb1\xbf\xb8\xf6\xff\x78\x15\x7c\xfe\x38\x3b\x18\x7a\x87\xf8\x22\x70\x47\xff\x7a\x1d\x63\x66\xab\x01\x66\x46\x77\x47\xcc\xa8\x16\x2f\xa3\x32\xb4\x08\xcc\xa1\x3d\x63\xac\x33\x45\xbc\x1d\xab\xbe\x55\xb7\xba\x76\x6b\x44\xc0\x97\xad\x53\xb2\xbf\xf8\x4a\x2d\x5c\x9c\x07\xee\xe8\xcb\x5e\x82\x8b\xf7\xe8\x26\x3a\x23\x3f\x88\xbc\x5b\x47\xc6\x5f\xd5\x00\x49\xdb\x77\x47\xd2\x76\x2d\x92\xb6\x57\x23\x69\x81\x92\x1c\x66\xeb\xd4\x9e\x26\x51\x68\x3b\x80\xa2\x10\x80\xe4\xcc\x77\x25\xc2\x2e\x6e\x14\xc2\x7e\xff\x88\x0f\x86\xec\x10\x9f\xbb\x5b\x7f\xbc\x4a\xf0\x75\x82\xb9\x2f\x0e\x99\xdc\x8d\x0a\x81\x37\x59\x65\xc3\x7b\x58\x65\xc3\xfa\x55\x36\x2c\xc1\x54\xb2\x92\xa4\x82\x19\x16\xe8\x0a\x47\xd5\x1a\x31\x85\xb8\x90\x79\x25\x2e\x2e\xfe\xd8\xfb\xfa\x59\xa3\x20\xc6\xc5\xbb\xab\x37\x2f\xcf\xdf\x7f\xfa\x12\xe3\xe2\xe5\x21\xf2\xf1\x1e\xa3\x33\x8f\x38\x4d\x9c\x86\x5b\x3b\x77\xc7\x83\x3d\x46\x09\x1e\xec\xd7\x59\x11\x9c\xd4\x8a\xd4\xe6\x0a\x11\x80\x3c\x7d\x0c\xa9\xab\xa4\x57\x22\x61\xe7\xe2\x4b\x5f\x31\xc4\xd7\x14\x1b\x5f\xf0\xc2\xdd\xda\x8f\x84\x49\xf1\xae\x8f\xb2\x89\xbf\xbc\xfb\xbc\x5f\xd6\x4e\xfb\x65\xa9\x8c\x8d\xea\xa8\xc7\x77\xa8\xd4\x88\x4c\xbc\x1f\xd3\x76\xe7\xcb\x7c\x31\x7b\xff\x72\xfe\xf6\x48\xfc\x72\xb5\xff\x39\x99\x65\x63\x25\xfb\x43\xe6\x6a\xe2\x2b\xe2\xe2\xfa\xa0\x36\x07\x02\xcb\x31\x7c\xd8\x7b\xdf\xd9\xff\xa3\xf3\x72\x1c\x9d\xd7\x98\x6a\xf8\x6a\x26\x69\x1b\x7c\x23\x3b\x99\xf3\xab\x9b\xfe\x96\x47\x5d\xcf\xbf\xec\x5f\xce\x9c\xe7\x82\x48\xb4\x2d\xbc\xf3\xab\x17\xf6\x2e\x56\x99\xbb\x31\x43\xa9\x69\x0f\xe6\xdb\xee\x8b\x17\x97\x7d\x8f\x3b\xee\xd5\x68\xfe\x1c\x79\xd3\xe7\xc2\x9b\xcd\xe9\xf9\x96\xbb\x98\x8a\xf3\xff\xf8\x5f\xff\xb9\xff\xc7\xc9\xd1\x2e\xfc\x64\xe6\xd8\xd5\x48\xf9\x39\xad\xed\x65\x8d\x4d\x84\xb9\x3e\x68\x53\xcf\x5e\xff\xdc\x7b\x77\x7a\x7c\xb2\x7f\x14\xab\x8e\xfe\xa8\xad\x03\x36\x12\x3a\xda\x45\xc2\x54\xfb\xc1\x7c\x9b\xf1\xed\xfe\x15\x09\xfb\xcf\x19\x56\x54\x5a\xf0\x0b\x67\xb8\xe3\xce\x67\xf2\x7c\x80\x9c\xcc\xc5\x3b\x71\x71\xa1\xf6\xaa\x49\x58\x86\xc9\x7f\xd5\xe9\xdf\x13\xf1\x99\x2f\x77\xa8\xb8\x9c\x0e\xc5\xa1\xff\xe6\x7c\x7b\xfa\x47\xf0\xfa\xf9\x1e\x6a\x6d\xfc\x4f\x00\x00\x00\xff\xff\x4d\xcd\x00\x23\x66\xd3\x00\x00")
+var _kasFleetManagerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x3d\x6b\x77\xdb\x38\xae\xdf\xf3\x2b\x78\xdd\xbb\xc7\xbb\x73\x63\xc7\xce\xab\xad\xcf\x9d\x7b\x4e\x9a\xa4\x9d\xcc\xb4\x69\x9a\xa4\xd3\xe9\xce\xe9\x71\x18\x8b\xb6\x95\xc8\x92\x2b\xca\x49\xdd\xdd\xfd\xef\x17\x20\x29\x89\x94\xa8\x87\xf3\x68\xd2\x8e\x67\x77\xa6\xb5\x44\x42\x20\x08\x02\x20\x08\x80\xc1\x94\xf9\x74\xea\xf6\xc8\x46\xbb\xd3\xee\x90\x27\xc4\x67\xcc\x21\xd1\xd8\xe5\x84\x72\x32\x74\x43\x1e\x11\xcf\xf5\x19\x89\x02\x42\x3d\x2f\xb8\x26\x3c\x98\x30\x72\xb0\xb7\xcf\xf1\xd1\xa5\x0f\x4f\x44\x6b\xec\xe0\x93\x40\x82\x23\x4e\x30\x98\x4d\x98\x1f\xb5\x57\x9e\x90\x1d\xcf\x23\xcc\x77\xa6\x81\xeb\x47\x9c\x38\x6c\x08\xe0\x1c\x32\x66\x21\x23\xd7\x2e\xbc\x3b\x67\xc4\x71\xf9\x20\xb8\x62\x21\x3d\xf7\x18\x39\x9f\xe3\x97\xc8\x8c\xb3\x90\xb7\xc9\xc1\x10\xe0\x63\x5b\xfc\x80\xc2\x0e\xbe\xcb\xd8\x54\x62\x92\x42\x6e\x4c\x43\xf7\x8a\x46\xac\xb1\x4a\xa8\x83\x63\x60\x13\x6c\x0a\x7f\x92\xc6\x84\xfa\x74\xc4\x9c\x16\xc0\xbc\x72\x07\x8c\xb7\x00\xc9\x96\x6a\xdf\x9e\xd3\x89\xd7\x80\xb1\x7a\x6c\xc5\xf5\x87\x41\x6f\x85\x90\xc8\x8d\x3c\xd6\x23\xbf\xd1\xe1\x25\x25\x27\xb2\x13\x79\xe9\x31\x16\x91\x37\x02\x54\x08\x8d\x00\x61\xee\x06\x7e\x8f\x74\xdb\x9b\xed\x0e\x3c\x70\x18\x1f\x84\xee\x34\x12\x0f\x4b\xfa\xca\xb1\x1c\x33\xa0\xed\xce\xd1\x01\x22\x29\xf1\x53\x7d\x5c\x9f\x47\xd4\x07\x2c\xdb\x2b\x88\x2f\x7c\x05\x51\x6a\x91\x59\xe8\xf5\xc8\x38\x8a\xa6\xbc\xb7\xb6\x06\x03\x68\x23\xb5\xf9\xd8\x1d\x46\xed\x41\x30\x81\x26\x19\x0c\xde\x50\xd7\x27\x7f\x9f\x86\x81\x33\x1b\xe0\x93\x7f\x10\x09\xce\x0e\x0c\xbe\x39\x62\x55\x20\x4f\xa0\x91\xeb\x8f\xac\x80\x00\x8e\x17\x0c\xa8\x37\x0e\x78\xd4\x7b\xd6\xe9\x74\xf2\xdd\x93\xf7\x69\xcf\xb5\x7c\xab\xc1\x2c\x0c\x81\x77\x80\x89\x26\x30\x82\x95\x29\x8d\xc6\x82\x02\x88\xe6\xda\x25\x92\x88\xf7\x27\xa3\x49\xb4\x76\xd5\xed\x89\xde\x23\x16\xc9\xbf\x10\x64\xc0\x90\x22\x98\x03\xa7\x87\xcf\x7f\x97\x73\xf4\x86\x45\xd4\xa1\x11\x55\xad\x42\xc6\xa7\x81\xcf\x19\x8f\xbb\x11\xd2\x58\xef\x74\x1a\xe9\x4f\x42\x06\x81\x1f\x01\x16\xfa\x23\x42\xe8\x74\xea\xb9\x03\xf1\x81\xb5\x0b\x0e\xc8\x1a\x6f\x09\xe1\x03\xe0\x3a\x9a\x7d\x4a\xc8\x7f\x87\x6c\xd8\x23\xcd\x27\x6b\x40\x55\xf8\x32\xc0\xe5\x6b\xb2\x2d\x5f\xcb\xa0\xd8\xd4\x3a\x1b\x64\x51\xed\xc8\xc4\x1c\x0b\x9f\x4d\x26\x34\x9c\xf7\x80\x9f\xa2\x59\xe8\x73\xc1\xf0\x57\xd9\xb6\x76\xf2\xad\xb1\x30\x0c\x42\xbe\xf6\x2f\xd7\xf9\x4f\x25\x29\xf7\xb1\xed\x8b\xf9\x81\xf3\x18\x89\x28\x90\x2b\x24\xdd\x2b\x58\x7b\x62\xa8\x28\x5c\x92\x01\x58\x29\x97\x34\x73\xe3\x66\xc0\xf2\xda\x10\x5b\xb2\x05\x57\x0f\xa6\x34\xa4\x40\x64\xb5\x46\xe3\x26\x12\xd3\x86\x81\x69\xda\x72\xcd\x75\x1a\xe5\x13\x52\x6f\x2e\xf8\xa3\x9d\x88\xd7\x2e\x8f\x0a\x27\x03\x5f\x92\x60\x48\xa6\x01\xe7\x2e\x0a\x7c\x83\xa0\xd6\x49\xf1\xb2\x5d\x50\x6c\x1a\xdd\x0a\x26\xa9\x80\xca\xf2\x67\x3d\xb6\x17\x32\xf9\xb1\xb2\xbd\x40\xee\x98\x7d\x9e\x31\x93\xe0\xf8\x0f\xfb\x42\x27\x53\x4f\xc7\x33\xfe\x47\xef\x05\x4b\xe3\x58\x8d\x68\x5f\x76\xc8\xb7\xb7\xe3\x10\xc3\x37\x90\x50\x30\x9a\x75\xbf\xf9\xc1\x8d\xc6\x2f\x29\xa8\x5e\x67\x37\x64\x82\x36\xa0\x62\xa2\x19\xbf\x0b\x5c\x4a\xe0\x16\x32\xa7\xd4\xc0\xa1\x04\x40\x86\xc1\xcc\x77\x84\xcc\xd8\x4b\x27\x7b\xb3\xd3\x7d\x24\x32\xae\x7c\x96\x01\xcf\x9b\x52\x31\xed\x5a\x48\xa8\x9d\x59\x34\x06\xcb\xe5\x92\xf9\x68\xcd\xb8\xfe\x15\xf5\x12\x89\x29\x88\xb4\xf1\x9d\x10\x69\xe3\xe6\x44\xda\xa8\x22\xd2\x7b\xb0\x93\x88\x1f\x44\x84\x02\xb5\x82\xd0\xfd\x2a\xad\x57\x3a\x00\xe3\x4e\x4a\x36\x65\x90\xea\x84\xdb\xfc\x4e\x08\xb7\x79\x73\xc2\x6d\x56\x11\xee\x30\xc8\xac\xc4\x6b\x90\x13\x84\x4f\xd9\xc0\x1d\xba\x40\xc4\x83\x3d\x40\x0d\x94\x02\x4f\x09\xb7\xf5\x68\x4c\x8f\x72\xc2\x01\x9e\x37\x25\x5c\xda\xb5\x98\xe3\x7c\xf6\x05\xa8\x14\x01\x8d\xa4\x25\x13\x0c\x84\x39\x9d\xd8\x3c\x0c\x7e\xba\xd1\x5c\xd7\x95\x2f\x18\x0d\x59\xd8\x23\x7f\x92\x4f\x45\x4a\x98\x66\xa6\x23\x15\x89\x0e\xf3\xc0\xa8\xb1\x2a\x4f\xf9\x2a\xab\x3f\xed\x16\x93\x0b\xb8\x03\xe8\x70\xae\x0d\xcc\x87\x76\x3d\xd8\x86\xce\xfd\x41\xd1\x70\x8f\x58\x38\x0c\xc2\x89\x58\x4a\x54\x6c\x72\x00\x12\x6e\x44\x45\xaf\x71\x18\xf8\xc1\x8c\xe3\xee\xca\x17\xbb\x95\xb2\x69\x8e\xe6\x53\xf8\xda\x79\x10\x78\x8c\xfa\xda\x1b\x1c\xb2\x0b\x04\xec\x91\x28\x9c\xb1\x52\x23\x60\xfd\xf1\x31\x60\x16\xd2\x13\x58\x59\xbb\x12\xb1\x22\x9a\xee\x89\x69\x33\x64\x79\xe7\x3b\x11\x49\x1d\x81\x3b\xa0\x70\x73\xd1\x94\x05\x51\xbc\x1d\x43\x85\x27\xc6\xab\x8c\xcd\xec\x52\x5b\x9a\x0a\x4b\x53\x61\x69\x2a\x48\x53\x41\xca\x94\x5b\x18\x0c\x06\x80\xbf\xa8\xd9\x70\x3b\x22\x66\x01\xdc\xdc\x84\x88\x8d\x03\x09\xae\xcc\x38\xa8\x67\x6f\x4c\x69\x34\x18\xf7\xb2\xd0\xdf\x4f\x41\xba\xb2\x04\x78\xec\x14\x35\x5c\x33\xf5\xac\x19\xc3\x28\x99\x09\xb0\xf9\x4d\xbd\x40\xfd\x45\xe0\x68\xb0\x4c\xaa\x48\x74\x82\x6b\xb0\x24\xd0\x15\x21\x5c\x08\x2b\x25\x5c\x53\xce\x33\x76\x8e\xa9\xdc\xea\x4b\x2c\x72\x1b\xfe\x05\x6c\x14\x93\xdb\x2d\x7b\x5f\x49\xa0\xec\xae\xf7\xbb\xf2\x69\x1c\x05\xfc\x7e\x9d\x1a\x39\x93\xc8\xa0\xe3\x0b\xea\xc4\x0c\xf5\x1d\x08\x96\x37\x2e\xe7\xae\x3f\x3a\x8a\xcd\xf2\x5b\x98\x4e\x05\xa0\x9a\xc5\x06\xd1\x02\x76\xc2\xf7\x6c\x3d\x91\x85\xcc\xa7\x9c\x45\x94\x37\x14\x80\x3e\x9a\xad\xc0\x2b\x6d\x85\xbf\x8c\x55\x95\x33\x8a\xec\xf6\x81\x74\xec\x09\xeb\x40\x90\x4b\xb3\x10\xfe\x7a\xbe\x97\x9c\x0d\xb4\x90\x39\xf0\x17\xf1\xb5\xe4\xdd\x16\xb5\x8e\x79\xca\xce\x1f\x24\xa0\x29\x1e\x97\xda\x2c\x95\x01\x3a\xae\xa5\xa5\xf2\x63\xb9\x4e\xaa\x4c\x2d\xb9\x44\xb5\x23\xce\x6f\x67\x5f\xc5\x06\x04\x9d\x7b\x01\x75\x4c\x46\x2b\x62\xb3\xf7\x27\xc7\x6c\xe4\xe6\xf9\xbb\x82\xc1\xe2\x6e\x05\x27\x26\xfb\xef\x6f\x04\x35\xee\x96\x83\xfa\xf8\xdd\x58\xdf\x81\xdd\x97\x35\x58\x40\xe1\x4e\xbf\x57\x57\x59\x7c\x2e\x76\x0b\x7b\x2f\x03\x62\xe9\x2a\x5b\xba\xca\xee\xc9\x55\x96\x80\x7d\x43\xbf\xec\x60\x18\x1a\x73\x0e\x94\x43\xe0\x98\x51\x40\xd2\xb9\xc5\xf7\xaa\x60\x5a\x11\x39\x65\xe1\x84\x1f\x06\x51\x2c\x03\x6e\xf1\xfd\x02\x50\xe5\xae\x42\xd0\xdd\xe7\xae\xe3\x00\xa3\x30\x17\x03\xe4\xc8\x39\x1b\xd0\x19\x67\x42\x9f\xcf\xf2\x7b\x84\x42\x7f\x22\x09\xcc\xbe\x13\xfa\xc5\x9d\xcc\x26\xc4\x9f\x4d\xce\xa5\xab\x23\x89\x47\x83\xf7\x34\x22\x03\xb0\x11\xce\x99\x32\x4f\x84\x9f\x40\x04\x00\x8a\x6f\x8e\x29\x87\x77\x80\x54\x28\x29\xd8\x5e\x1e\x6c\x9a\x73\x77\x0a\x14\x56\x16\x10\x43\x2f\x01\x0f\x66\x21\xcc\x81\x13\x30\xee\x37\x23\xe9\x9d\xd4\x69\xf6\xfc\x3b\xa1\xd9\xf3\x43\xb0\x38\x77\x03\x7f\x08\xa8\x44\x37\xa7\x9f\x0d\x4c\xb1\xb0\x44\x7a\x88\x96\x29\xdf\x39\x60\x1e\x8b\xbd\x0a\xd8\xb2\xc8\xcd\x03\xa5\xa2\x90\x8f\x05\x9b\xc6\x24\x5f\x1e\x1c\x67\x88\xe9\x93\x59\xd1\x4e\x8f\x5c\x8f\x5d\x2f\xa6\xa5\x3f\x12\x84\x35\x5c\xbe\x37\x3b\x5c\x16\xe6\x43\xde\x7f\x9c\x0d\xc8\xb2\x1c\x46\xc7\xf1\x60\x46\x3f\x5e\x16\xc0\xc5\x17\x42\x71\x61\xd7\xe9\x4e\x39\x4a\x0f\x66\x47\x9b\x81\x78\x3f\x92\xdb\xf2\x40\xda\x46\xef\x70\xe3\x7b\x0b\x13\xd6\x02\x66\xe9\xae\xbc\x9d\xb7\x72\x79\x7e\x5b\xf3\xfc\x76\xe9\x76\xab\xa3\xa9\xca\x22\xac\x9b\x45\xae\xb7\x29\x1d\x69\x53\x55\xd9\x9c\xc3\x74\x2d\xd0\x1c\x26\x82\xeb\xf4\xa8\xec\x10\x84\x0e\x0b\x5f\xcc\x17\xc1\x08\x74\xd2\x60\xdc\x2c\xf0\x1f\x0e\xbc\x60\xe6\xf4\xa7\x61\x70\xe5\x3a\xcc\x12\x2e\x5e\x1a\x44\xcd\x67\xd3\x69\x10\x22\x63\x09\x30\x24\x01\x53\xa0\x3f\x77\xb1\xd5\x51\xa6\xd1\x8d\xf5\x68\x13\xf4\x68\xb3\x90\xeb\x25\xbe\x80\x5a\x5d\x64\xbf\xe9\x32\x30\x28\x61\xaa\xd6\x26\x88\xc6\xe6\x52\x55\x94\xab\x8a\xe6\x56\xd9\xdc\x2f\x25\xde\x03\x48\xbc\x1a\xd2\x45\xa4\x49\xac\x85\xc2\xad\x7c\x63\x51\xa3\xba\xcb\x6d\x18\x2b\x5c\xd6\x75\x44\x90\x74\x70\x3f\x16\x41\x14\x8f\xec\xc1\xe4\x91\x24\xc7\x52\x1a\x2d\xa5\xd1\xb7\x97\x46\x15\x47\x9f\xdf\xc6\x58\xb3\x9d\x7f\x3a\x6c\x1a\xb2\x01\xfa\x27\x8d\xf3\xae\xf4\x68\x34\xf6\x69\xf6\xf1\xec\xb2\x88\x07\xfe\xdd\x32\xc8\x77\x3a\xce\x66\xe8\x8a\x93\x4f\x34\xf3\x87\xae\x07\xb8\x09\xd1\x06\xa2\x66\xe6\x45\x9c\x9c\xcf\x57\x8c\xde\x7b\xfb\x47\xc7\xfb\xbb\x3b\xa7\x07\x6f\x0f\xc9\xe1\xdb\xd3\x83\xdd\x7d\x81\xbb\x86\x46\x9a\x0e\x9d\x60\xbf\x52\xeb\xe4\x95\x47\xa1\xeb\x8f\xac\x07\xaf\x43\xea\x71\x7d\x7c\x76\xa6\x61\x20\x02\xfa\x06\x2e\x59\xc6\x81\x06\x33\xf8\x52\x03\x5b\x36\xcc\x93\x56\xe8\xe4\xd0\xd0\xa9\xd7\x3f\x6e\x5d\x74\x32\xae\x36\x49\x7d\xd8\x37\x05\x33\x98\xf8\x9c\xbe\x59\xf4\x0c\x7c\xe0\xb9\xc0\x40\x7d\x43\xc0\x15\x93\x67\x01\x1a\x9b\x29\xcb\xf1\x57\x12\x05\xa7\x9c\xeb\x6a\x1c\xc8\x23\xe7\xc8\x1b\x00\x85\x5d\x31\x67\x01\xb5\xf4\xcd\x84\x8c\x4a\x55\xdf\x91\x18\x97\xa6\x70\xe6\xb5\xa3\x39\x5c\x5e\xac\x89\x96\xe7\x7a\x79\x95\x0b\x44\xda\x68\x2e\x5d\x28\x8b\xbb\x50\x72\x2a\x7c\x99\xf4\x75\xf3\xa4\xaf\x6c\x0a\x75\xdc\xab\xc0\x26\x37\xc5\x05\xaf\x76\xd6\x5b\x65\x84\x1e\xfd\x54\x1d\x19\x74\x92\x91\xaa\x59\x77\xf5\x37\x08\x13\x32\x87\x6d\x0d\x57\x29\x62\x03\x4e\x17\x0c\xe6\xb1\x7e\xeb\xc6\x91\x3d\x8f\x45\xb3\xd4\x5f\x35\x8a\x63\xd4\x6c\x2f\xbc\x72\xcc\xcf\x56\x2d\xa2\x2c\x6f\xa9\xf3\xed\xa5\x26\x5b\x6a\xb2\x85\x35\xd9\xeb\x4a\xb3\x68\xa9\xb8\xee\x4e\x71\x59\xa2\x66\xcd\xa5\x5f\x4f\xc1\x59\xce\xa5\x33\xf3\x57\x73\xcf\x62\xaf\x2b\x72\xcb\x7d\xf4\x8f\x21\xd0\xe9\x2d\x85\x38\xa6\x6c\x55\x31\x55\x6a\x79\x64\x37\x61\x8b\x26\xa6\x55\x19\x3d\x5a\x02\x59\x5d\xde\x4a\x76\x4e\xc5\xb8\x25\x6d\xb1\x6a\x91\xa5\x99\x12\xb7\xb9\x02\x47\xb6\x6d\x67\x92\xe1\x30\x72\xaf\x50\x62\x3b\x96\x9c\xfd\x7b\x61\xcc\xcd\xe6\x23\x2c\x03\x95\xcd\x6c\x5f\xaa\xf4\x1f\x4b\xa5\x77\x7f\xdc\xcd\x29\xf9\x17\xf9\xcf\x8f\xab\xb4\xa5\x40\xba\xb5\x70\x4d\x13\x92\x8b\xa4\x6b\x6d\xf5\xbd\x06\x62\x8d\x45\x7d\xb0\x26\x1c\x20\x90\x4b\x3d\x4b\xb6\xce\x52\xa3\xa3\x46\x6f\x09\x4a\xdd\xf3\xe6\xec\x18\xbf\x41\xb4\xd9\x58\xca\xf0\xa5\x0c\x5f\xca\xf0\xc7\x24\xc3\x85\x18\x30\x57\x35\x6c\xa4\x1c\xbe\xb0\x81\x0c\x60\x78\x1c\xbb\x1d\x2f\x77\x4c\x77\x58\x54\xac\xf3\xa0\x7e\x84\x14\x81\xd6\xe9\x91\x3e\x56\x01\x2e\xda\x00\xf0\xe0\x66\xa1\x50\x15\xe3\xff\xc1\x22\xa5\x34\x32\x2d\xa3\x12\x96\x51\x09\x77\x2b\xd1\xe0\xff\x4f\xf0\x5f\x3c\x90\xe7\x20\x0c\xc2\x34\xe9\xa9\x35\xa4\x03\xcc\x50\x08\x99\x27\x92\x93\x92\xea\xe0\xaa\x8f\x4d\x50\x84\x81\xc7\xfa\xe7\xae\xef\x40\xc7\xbc\xa0\x78\x54\x56\xda\x31\xa0\xfa\x42\x62\xba\xd8\xf9\xac\x08\x4c\x08\xb0\xb8\xba\x1a\x67\xfc\x30\x08\x47\xd4\x77\xb9\x40\x73\x69\x53\x2d\x5d\xdd\xcb\x43\xdb\x1f\xfa\xd0\x56\x13\x20\xa5\x05\xb7\xeb\xc9\x8a\xc5\x0e\x6f\x8f\x35\x98\x0f\x71\x72\xab\x8d\xfd\xc6\x05\xb4\x40\xf4\x77\x1f\x5c\xf4\x17\x8b\x7d\x9d\xc2\x96\xf3\xcb\xce\x63\x74\xca\xd6\x28\x0e\xb0\xd4\x47\x4b\x7d\x74\x4b\x7d\xa4\x2e\x58\x59\xd8\x0e\x7a\xfe\x18\x97\xcc\xa9\x88\xa0\x3f\xbf\x00\xed\x21\xae\x92\xf1\x60\xa9\x3b\x73\x72\x2e\x8a\x5a\xa1\xf2\x15\x63\x5c\x2a\xdc\x87\x56\xb8\x52\x04\x6b\x92\x3b\xab\x72\xf1\x31\x57\xd3\x65\xe3\x44\x39\x99\xa2\xb2\x82\xcd\xff\x51\x67\x43\xa3\x1d\x46\x7f\xf3\x63\xbf\xe5\xe1\xdb\x52\xa8\x3f\x46\xa1\xfe\x28\x0f\xa7\x0f\x03\x63\x10\xd9\xd2\xc5\xae\x93\x2d\x5d\xbc\x14\xeb\x0f\x24\xd6\xa5\x1c\xd5\xc4\xba\x76\x54\x69\x39\x8b\x34\x66\xb5\xcc\x63\x0d\xdf\x98\x60\x05\x55\x5b\x9a\xdd\xa3\x72\x43\x1d\xa5\x88\x56\xbb\xa0\x92\xd5\x49\x7d\xf9\x43\x1b\x66\xbc\x50\x51\xc3\x2d\x15\xc3\x42\x8a\x61\xb9\xf8\x1f\xcc\x89\xa2\xb1\x7f\xa5\x0f\x45\x63\x70\x2c\x49\xe4\x46\xdc\x34\xf0\x6c\x8b\x62\x14\x52\x3f\x92\x1a\xce\x8d\xaa\x6f\x30\x5b\x9b\x60\x56\xd1\x80\xaf\x89\x04\xa8\x3e\x74\x1e\xb1\xea\x34\x5d\xd5\x49\x45\x88\xb9\x13\x06\x18\xba\x20\xaf\x44\x77\x99\x4b\x85\xc2\x4a\xe6\xbb\x25\x51\x73\x59\x52\xbc\x91\x50\x5e\xcc\x8f\xb1\xdb\x3b\x2d\x03\xeb\xbe\xf3\x72\x7f\x3d\x79\x7b\x48\x68\x18\xd2\x39\xd2\xf8\x28\x0c\x60\x40\x63\x36\x4b\x07\x16\x88\xfd\x11\x27\x43\x78\x05\x3f\xd0\x74\xa6\x11\xd8\x0e\xb3\xc9\x43\xac\x1a\x45\xa8\x94\x4c\xcb\x84\xdd\xe5\xd1\xd8\xfd\x08\xc9\x3b\x4b\xd8\x2d\x6c\xec\xcc\xa4\x10\x58\xa0\x8b\x0b\x84\x0f\x8d\xdc\xd1\xca\x2e\x32\xa7\x96\x37\x16\x95\x80\x0b\xca\x3e\x99\xb6\x1a\x2d\x2e\xf2\x64\xb1\xc8\x68\x29\xf4\xaa\x84\x9e\x4e\xa8\xa5\xd8\x5b\x8a\xbd\xef\x55\xec\xdd\x40\x20\x0d\x99\x83\xd2\xa3\x86\x3d\x86\x57\x9e\xc7\xab\x18\x0c\x45\x98\x61\x3a\x65\xe2\x3e\x74\xac\xd2\x4e\x23\x15\x01\x25\xe3\xf8\x2f\x65\x15\x02\xc7\x26\xa2\xe2\x4f\xaa\xc5\xf7\x8d\x24\x93\x14\x9a\xda\x00\xa8\x2e\x9e\x22\xf6\x25\x52\xe3\xa8\x62\x4b\x6c\xba\x36\xf5\xa8\x5b\x9b\x21\xad\xf9\xf9\xb9\xd3\xae\xe5\x0d\x2d\xb5\x6e\x68\x59\x4a\xe4\x3a\x12\x79\xb3\xcc\xd1\xad\x4a\x84\x38\xc2\x61\x29\x2e\x1a\xf9\xeb\x55\x3d\x5e\xea\xac\xfb\xd6\x59\x55\x1a\x68\xcc\xa8\x17\x8d\xeb\x95\xeb\x92\x6d\xd1\xa4\xb4\xdd\xb6\x96\x58\xc2\xb9\x33\x48\xd5\x0f\x44\x01\x62\x3a\xc3\xf9\x14\x86\x27\xc2\x3c\x16\x47\x92\x30\x8b\x8e\x6b\x38\x3a\xd0\xd5\x10\x7b\x45\x3c\xca\x23\xac\x1f\x13\xcd\xb0\x2e\x33\x46\xaf\xc6\x6f\xf0\xf2\x0f\x02\x5a\xc0\x67\xab\xd2\x93\x3f\x1c\x7a\xae\xcf\x90\x60\x91\x80\xc7\xe5\xf3\xf3\x10\x84\x51\x08\x30\x82\x10\x4f\x02\x66\x1c\xff\x1b\x7f\x6a\x36\x1d\x85\x14\x90\x46\x75\x34\x0d\x83\x11\x68\x37\xde\x2e\x2b\x12\xfc\x8b\x18\x8f\xee\xcd\xbd\x1f\xad\x29\x69\xac\xa8\x67\xbb\x63\xfc\x5b\x57\x0b\x96\x03\x5f\x6a\x81\x3b\xd4\x02\x8b\x5f\x45\xb9\x54\x0e\x4b\xe5\x70\x27\xca\x61\x25\x7d\x85\x3d\xd5\x58\x24\x90\xb7\xc2\x41\x70\xcc\x86\x2c\x64\x20\xdf\x63\xc8\xd2\x86\x96\xde\x83\xf8\xf3\x21\xca\xc8\xc8\xd5\xc7\xe9\x3a\xfa\xb8\xac\x86\xf7\xa5\xeb\x57\x37\x1a\xe3\x20\xca\x1a\xa1\x9b\x40\x8f\x2c\x14\xb1\x79\x1a\x15\xf0\x2b\xda\x4f\xac\x20\xa7\xfb\xef\xdd\xaf\xfa\xcf\x28\x88\xa8\xa7\x17\x0b\x8b\xd8\x84\x2f\x36\xf0\x5a\xa3\x42\x2c\xf2\x8d\xd0\xf3\x35\xd2\x8e\xb7\x10\xb9\xea\x56\x02\xe7\xea\x66\x62\x28\xf9\x66\xc2\x45\xa4\x3d\xcd\x35\x23\x56\x3e\x8a\xb9\x3e\xc3\x24\x72\x8b\x2c\x96\x42\x0c\x03\x76\xab\x6f\x87\x55\x6c\x59\x0a\x4e\x4d\x4d\x9e\xfc\x45\x53\x20\xd7\xbd\x93\x5b\x59\x05\xe5\xd9\x90\x6f\xa8\x45\x0a\x14\x36\x4f\x2c\x82\xbe\xc9\xe5\xd6\x4e\x82\x18\x3a\x93\x2e\x44\x10\xec\x78\x0b\x2a\x58\x66\xb3\x68\xe2\x0b\x9b\x97\x33\x80\x18\x9e\xc4\x50\xbf\x4d\xe0\x1b\xcd\x7e\x7e\xc1\xcb\xe6\x30\xa1\x60\x79\x60\x46\x98\x94\xf2\x7d\xe6\xa3\x83\xc4\xc9\x34\x9b\xcc\xbc\xc8\xed\xd3\xaf\x35\x28\x29\x6d\xcf\x2c\x6d\x0c\x75\xd4\xf8\x1d\x2b\x17\x72\xb0\xf7\xa8\xba\x9e\x67\x15\xc0\x31\x10\xb9\xc0\x0b\xab\x32\xcb\x0a\x8f\xed\xc4\x2f\x11\x87\xb7\x4a\x86\xd4\xf5\xb0\x1d\xd6\x71\x54\xaf\x57\x65\xd4\x00\xb4\xfa\x44\x1a\x75\x59\xd2\x2c\xc4\x5b\x8e\x26\x5e\x9c\x82\xd6\xb3\xa8\x09\x8b\xe7\x8d\xe2\xfc\x10\x30\xf0\x82\x79\x9b\xbc\x04\x3d\xaa\x54\x0d\xd9\xf9\x70\x52\x1b\x83\x98\x96\x76\x6e\xcb\xdf\xf8\x47\x54\x39\xdc\x3a\x24\x4d\xca\x5d\x6a\xb5\x81\x95\x7d\x34\xc8\xe4\xb0\x19\x03\xe8\xc1\xe8\x5a\xb0\xb6\xa3\x56\x57\x38\xc5\x16\x19\x8f\xb8\xbe\xb9\xb6\x48\x10\x15\x24\xeb\x36\x06\x62\x44\xf0\x98\x4e\xfb\xe8\x75\x67\x61\x7f\xac\x45\xc7\x57\x4f\xb5\x8c\xd6\xee\xd3\x5c\x17\xe9\x36\xeb\xe1\x96\x88\xb5\x70\xf7\x54\x17\xa4\xba\xc8\xf9\x2e\x41\x4a\xc6\xee\x2f\x28\x59\x81\x18\xdc\x5d\xa0\x7d\x69\x21\xd1\x32\x71\x6f\x95\x0e\xf5\x59\x57\x6c\x9f\xfb\x6a\x2f\xd9\xcf\xea\xe9\xf2\xc9\x0f\x83\x6b\x98\xf6\xfe\x2c\xf4\xea\x4f\x79\xbc\x37\x2e\x17\x40\xb8\xd1\x16\xdb\x5e\xea\x09\x79\xc5\x92\xa8\x99\x6c\x2d\x5a\xbc\x9c\x0b\x93\xe1\xd0\xef\x04\x02\xd9\x93\x46\x34\x56\x8c\x89\xb8\x12\x75\xf7\xa9\x34\x04\x3a\xbb\xf1\xa0\xcc\x25\x39\xa0\x53\x3a\x30\x36\xd2\x75\xe1\xa9\x8e\x8d\x7c\x0d\xdb\x6a\x7b\xa6\xec\x52\x47\x4d\xbb\x25\x38\xf7\x6c\x8e\x8e\x1d\x93\xfa\x36\x2f\x49\xb1\x2d\x69\xb3\x5d\x8d\x8a\xc3\xad\xec\xc4\xb4\x84\x57\xa4\x0f\x82\xc4\xe7\x02\xa9\xbe\xb6\x40\x6d\x7a\x2c\xbb\x48\x0a\xb4\xd7\x71\x30\x03\xd6\x91\xa5\xad\x40\x35\x9d\xf0\x60\x57\xd4\xac\x4d\x9e\xec\x52\x9f\x86\xf3\x5c\x02\xb7\x7c\xf9\x5e\xb8\x52\x84\x8e\xdb\xa3\x11\x3d\x42\xcf\x8c\xf0\xf0\x7c\x6a\x54\x59\xc5\x79\x1d\x5b\x80\xe1\x69\x38\x63\xab\xe4\x25\x56\xe4\x85\x0f\xfa\x97\x3e\x08\xeb\x6a\xf0\x79\x59\x64\x6d\x36\x61\x9c\x5b\xcd\xf3\x4c\x3b\x1b\xf1\xf5\x4e\x65\xa2\x33\x07\x50\xf3\xad\xf4\x2a\x7c\x68\x16\xae\x5a\xb5\x78\xd5\xb8\x3b\xf2\x31\x1d\x5e\x7a\xcb\xe2\xdb\xf7\x74\x7f\x99\xf0\x7e\x25\xed\x8d\x63\x99\xc5\xd8\xd4\x75\x8a\xf7\x5b\x39\x9e\x85\x25\x3b\xb8\x14\xba\xc6\xd8\x86\x09\x64\xbf\xc1\x7e\xb2\x36\x8f\x49\x6a\xcf\xd1\x1a\x13\xbe\x41\x60\xed\x99\x4f\xaf\x40\xad\xa1\xae\xa8\xe6\xb6\x74\x9c\x37\x66\x0a\xb9\x07\x14\x94\xb9\xc5\xc6\xad\xd2\x99\x77\x22\x3e\xd1\xcc\xf2\xa1\x7c\x5c\x20\xe8\x24\x5a\xc2\xbb\x12\xba\xe7\x33\x79\xd7\x5b\x50\xe1\x22\x5e\x8c\xab\xd0\xa4\x2a\x62\x24\x1b\x87\x64\x4d\xb0\x82\x79\x95\x70\x94\x01\xde\x4f\x34\xeb\xaa\xfa\x40\x5f\xae\x97\x3e\x48\x80\xd5\xd8\x97\xdc\xd7\x7d\xc9\x8e\xcb\x2f\xfb\xc2\x7b\xbc\x1a\xbb\x8d\xef\x50\xb2\x95\x73\x1d\xfe\x90\xc2\xae\x2d\x24\x82\xfa\x95\xac\x75\x27\x10\x1a\x3d\x99\x15\x96\x9f\x93\xbc\x35\xd0\xb8\x2b\x81\x28\x0b\xb7\x97\x65\x22\x4d\x40\x02\xcf\xf0\x96\x40\xd1\x34\x29\x80\x2e\xb0\x5f\x25\x2e\x70\x8c\x3f\xcf\x7d\x45\xde\x2e\x6a\x5b\x43\xc1\xec\xdc\x33\xea\xf5\xcf\x3c\x41\x26\x2d\x35\xd3\xb0\x0c\x0a\x25\x6b\x6c\x73\x58\xcf\x35\x28\x97\xa7\x0f\xc5\x82\xb4\x4d\x0e\xa2\xf8\x0e\x55\x2c\x3e\x04\xea\xd0\xf5\xb2\xc2\x16\xef\x9d\x4c\x40\xb8\x51\x7b\x41\x77\x9a\x2f\xce\x24\xfa\x4c\xfe\x11\x8d\xc3\x60\x36\x1a\x83\xc8\xef\x43\x53\xd8\x46\x0c\xca\xe9\xae\x2e\x6a\x55\x50\x84\xe0\x97\x90\x48\x0a\x69\x95\xb0\xf6\xa8\x4d\x36\x3a\x6f\xdc\xaa\x99\x16\x2e\xa7\x3e\x40\xc5\xf5\xe3\xb3\x41\xce\x34\xb5\xbb\xa0\xb0\x03\x52\x04\xd6\x18\xfa\x64\x51\x69\x66\x4d\xe7\x42\xd4\xb1\x61\x52\x9e\x80\x45\xd4\xc5\x50\x02\x04\xa6\xd0\xee\x76\x3a\xaf\x2a\xf1\x46\x04\xd2\xb5\x7c\x23\x84\x81\xdc\x6e\xe0\xd4\x42\x39\xe9\x44\x64\x27\xe3\xc4\x8a\xe2\x2c\x90\x83\x93\xb7\xad\x67\xdb\x9d\x2e\x89\xe3\xe4\xd4\x68\x8e\xba\x9b\x7b\x75\x06\x93\x92\x1f\xf4\x0c\x28\x80\x69\xc4\x6d\x0c\x91\x1f\x5d\xf6\xe6\xc7\xfb\x74\x4e\x59\x8d\x70\xa1\xac\x49\x23\x8b\x87\xb9\x17\x10\x6e\x52\xd2\xe8\x66\xae\x91\x40\x9e\xc9\x3d\x95\x6e\xd0\xdc\x63\x54\x8b\x75\x52\xbf\xeb\xde\xea\x7e\xbf\x9e\xb6\x0c\xf9\x75\x5f\x55\xe5\xfe\x47\xe1\x6c\x0e\xdf\x67\x5f\xa2\xbe\xbc\x84\xae\x72\xeb\x28\x9b\xc5\x5c\x8a\x3d\xc5\x04\x90\x6b\xd8\x2a\x8b\xf2\x20\x49\xae\x11\x55\x6d\xb3\x82\x2f\xf0\xd3\xc3\x5a\xec\xda\xae\xda\xe0\xfe\x2e\xf7\xfb\x6f\x60\x41\xe3\xaa\xf8\x46\x5e\xc3\x32\x86\xdc\x39\x3a\x50\x48\x65\xf8\x08\x5f\x5e\x65\x98\x6b\x2c\xd1\xb2\x1c\xb0\x67\xf6\xb4\x81\xe7\x59\xa4\x64\x6c\x3d\x03\x08\xd9\xbb\x91\x9b\xf9\xe2\x2f\xac\x15\x75\xd1\x57\x56\x76\x49\x15\x7b\xcb\x0b\x11\xfc\x56\x3c\x6c\x9d\x46\x9d\xb1\x8f\xe8\xdc\x0b\xa8\x63\x55\xe2\x27\x02\x88\xf0\xf1\x45\xe9\xb5\xd9\xe4\x3c\x70\xe6\xc0\x98\xf2\x52\x15\x45\x30\x72\xf4\xf6\xe4\xb4\x9e\xd9\x59\x53\x37\x17\xfb\x5e\xf3\x4b\xcc\xbc\x10\x0c\x56\x96\xaa\x48\x24\x6d\x8e\x81\x37\xe3\x78\x39\x50\xec\xee\x8c\x2f\x4e\x77\xfd\x4a\x65\x60\xf1\xbe\x66\x6a\xd6\x47\xf2\x56\x6b\xb4\x08\xc1\x3a\xc2\x3f\xf1\x4e\x6c\x77\x34\xb3\xa2\x20\x6f\xa1\x11\x60\x77\xfe\xb9\x52\xe5\x13\x2b\xb5\xbd\x9b\x38\x72\x9f\xa6\xc1\x1c\xc6\x97\x84\x0c\x99\xc0\x5f\x11\x1d\xae\x8a\x10\x79\xc1\x35\x0b\x5b\x03\x8a\x35\x9b\xbc\xe9\x98\x82\x0d\x08\x2a\x74\x00\x9b\x2a\x1a\xd2\x01\x1e\x5f\x62\x96\x73\xb3\xd9\x6a\x36\x85\xe1\x1e\xaa\x43\x73\x50\xa9\xa2\xfd\x39\x8b\xf4\xd6\xab\xd2\xe2\x81\x7f\x8d\x56\x39\xa8\xb2\x1d\xde\x5a\x8f\x12\x0d\xc6\xef\x05\xfe\x48\x5c\xd6\x04\x8f\x36\xd6\xb5\xcf\xb7\x9b\xd5\xde\x86\xac\x77\xdb\x72\xbb\x3b\x36\xb9\x43\x2e\xa8\xe3\xd8\x34\xb0\xf8\x30\xc6\xb0\xcb\x90\xa4\x76\x44\x0e\x06\xca\x77\x05\x06\x69\x0e\x84\x81\x19\x1b\x0a\x71\xaf\x58\x69\xb5\xb4\xbb\xd2\x09\x19\xa3\x3a\x75\xe8\xcb\x15\x48\xd8\x15\xc6\xd4\x6f\x91\x89\xeb\xa3\xf7\x49\xee\x6f\x1c\x36\xa4\xc0\x81\x6a\xbb\x80\xcc\x6b\x5e\xd0\x55\xe4\xa0\xb5\x6c\x04\x72\x17\x72\x3e\x94\xc5\x93\x43\xe4\xe1\x4d\x1e\x03\xa5\xef\xc5\xe6\x31\x90\x6e\xa4\x73\x9c\x5e\x72\xf8\xa0\x33\x9c\xa2\xf1\x48\xe6\x57\x22\xf4\x5d\xcd\xae\x44\xb9\x91\x5f\xbf\x56\x1b\xa0\xb9\x6b\x1e\x04\x36\x17\x08\xd2\x30\x01\x1d\xf8\x0e\x4a\x2f\x26\x43\x1e\xc5\x7d\x7b\x42\x73\xb9\x71\xd6\x4c\x9b\x7c\x50\xf2\xab\xd9\x34\x10\x6b\x36\xc1\x50\xf6\x2f\xab\xb5\x83\x5b\xf2\xf9\xf7\xbe\xfb\x19\xc5\x9d\xa8\x68\x3a\x74\x59\x62\x92\xab\x8f\x57\x02\x77\x5c\x3e\xf5\xe8\xbc\x5f\xae\x95\x0f\x35\x8d\x9c\xb1\x4b\xd0\x8e\x52\x40\xc8\x74\x16\x4e\x03\xce\x6a\x68\xbc\xf2\xcf\xfd\x32\x9b\x80\x12\x1d\x86\x2e\xa8\x61\x6f\x6e\x19\x9d\x89\xc3\xaa\x40\x22\x3e\x88\x3e\xa3\xd7\xfc\xac\x1a\x83\x2a\x75\xd7\x8c\xf5\x9d\x65\xcc\x9a\x9a\x13\xc3\x17\xc7\xe1\xb8\xe3\x01\xac\xdf\x9e\xec\x25\xe6\x4a\xb3\x42\xff\xd8\x6c\x4a\x3d\xfa\x40\xe3\x6c\x3b\x1b\xef\xa5\xbf\xa4\x3f\x4a\x99\x09\xe2\xef\x83\x87\xe3\x71\x89\x73\xb3\xf9\xdd\x31\xb7\xa2\x9f\x8d\xa9\x33\x5c\x76\xd8\x26\xbf\xbb\xe1\xc8\xf5\x5d\x7a\xd7\xdc\xa6\x90\xb8\x2b\x2e\x93\x1f\x13\xd6\x51\xf6\x6a\xca\xa4\xba\xaf\x79\xcd\x66\xc6\xfb\x6c\x5e\x7a\x2a\x2a\xa5\x5a\x07\x51\xf3\x66\x53\xae\x15\x15\x16\xfe\x51\x97\xab\x21\xb7\xef\xe2\x6e\xd3\x1b\x1c\x7e\xd8\x0f\x65\x2c\x87\xcb\x45\xeb\xe2\x3a\x9d\xbd\x50\x18\x9f\x89\x97\xd8\x63\xc3\x08\x7d\x7a\x26\x09\x9a\x37\xc1\xd2\xaa\x1e\xcb\x55\xa3\x5c\x87\xb1\x4f\x1b\x2d\x8c\x03\x80\xdb\xa8\x29\x7e\xd4\x61\x4a\x01\x8f\xe8\xe7\x74\xea\x03\xe2\x91\x79\xd6\x6b\x97\x5b\xf1\xad\x68\x3b\xe6\xad\x68\x18\x52\xff\x66\xe7\xa4\x75\x72\xf2\x36\xd9\x9f\x4b\x06\xda\x55\xfb\x1c\x91\xae\x66\x6c\x1a\x9a\x0f\x1b\x3b\x98\x8f\xea\x33\x47\x2a\xa3\x76\xc8\x88\xf9\x22\x7d\xce\x21\xb3\x58\xa8\x15\xdc\xe9\xda\xbc\x4d\x18\x91\xf9\xed\xda\xa0\xf4\x6e\x77\x03\x31\xb9\xb9\xb6\xb7\x60\x0f\xce\x80\x17\xea\x07\x38\x2d\x16\x79\x55\x7a\x83\x73\x1a\x2d\x75\x3e\x7f\xb8\x00\xab\xc5\xa3\x50\xac\x57\x5e\x34\x2c\x4b\x31\x13\x6e\x99\x59\x91\x76\xaf\x18\x7a\x7e\xc4\x10\xf3\x65\xe2\x9a\x77\xea\x18\x5b\xcc\x2b\x54\xb2\x66\xec\x86\x80\x9d\xc1\xcd\x8f\xec\xe8\xbf\x13\x4a\x2c\xf6\xa9\xdc\xf4\x2d\x30\x75\xb6\x48\x22\xbb\x00\xb7\x4f\x21\x4f\xa7\x90\xc6\xb9\xbc\xc6\x8d\xe2\x89\x52\x72\x7d\xa5\x70\x9b\x8b\x9e\x2c\x16\x84\xd0\x99\x88\x58\xbe\xdd\xac\x75\x42\xa5\xf0\xc3\x48\x40\x18\x4b\x89\xb5\x34\xf4\xe8\x88\xb8\x52\xfd\x8a\x93\x06\xdd\x56\x8f\x47\x19\xcf\xa0\x49\x04\xd7\xcf\xd8\x58\xea\x63\x37\xb1\xd5\x6d\x48\x5b\x16\x5e\xf9\xb4\xfd\x65\x15\x58\xa1\x8e\x30\x11\x90\xcd\xbe\x89\xc2\xac\x29\x62\x16\xd7\x48\xe6\x67\x44\x93\xdb\x7e\xe7\xc6\xba\x2c\x3f\xbd\x96\xfb\x69\xe3\xc0\x05\xac\x30\xd5\xbc\x7f\x65\x58\x03\x27\x51\xb7\x14\x0b\x4a\x45\x20\x1e\xef\xc2\xb2\x29\xa5\xac\x8e\x8e\x63\x6e\xb2\x0b\x27\x2d\xbf\xe8\x0b\xbd\x8a\x37\xf0\x14\xe6\xa1\x37\xaa\x5d\x70\xad\x45\x6e\xcb\x8a\xc5\xd4\x02\x7e\xbf\xac\xdf\xa0\x3c\x00\xfb\x21\x9d\x84\xf6\xa1\x36\x6a\xe4\x87\x18\x41\x8a\xd9\x54\xaf\x7c\xf5\x77\xbb\x90\xbf\x65\x49\xde\x05\xf5\xb4\xaa\xe2\x5c\xac\x3f\xf1\x43\xba\xb0\x8b\x3f\x7c\x43\xf3\x4a\x7d\x2f\x67\x16\x14\xd9\xb8\xfe\x6c\xd2\x23\x7f\xe2\x47\x57\x49\xe6\x9e\xbc\x4f\xe9\x01\x55\xe0\x2d\x00\xec\xca\x65\xd7\x08\x8e\x39\x6e\x14\xe0\xe1\x9c\x33\x71\xfd\x4f\x25\xba\x5b\xe1\x9c\x7f\xd2\xcf\x44\x52\x27\x45\xaf\xb5\xb9\xb6\x4f\xf2\x4e\xf1\xf4\x6a\x45\xb4\x2b\x27\xf9\x81\x0c\x01\x1d\xdf\x45\x36\x6e\x16\x66\xab\xd3\x7e\xc1\x34\x8c\xc0\x63\x0b\x6f\xca\x1e\x66\x1f\x97\xb9\x4d\xa7\x77\x0f\x29\xa1\x0f\x9a\xc6\xa9\x8d\xaf\x51\x3f\x97\xb6\x38\x5b\x36\xad\x7b\xf9\x0a\x2b\x54\x16\x2d\xad\xb4\x94\x65\x52\xc9\x12\xeb\x3f\x24\xee\xe8\x90\xf1\x60\x16\x0e\xd8\x82\xa2\x32\xee\x56\x4f\x76\xa5\x48\x14\xcb\xd6\x24\x3e\x17\xa3\x86\x57\x15\x67\xad\xaa\xbc\x29\x95\xb0\xc7\x3e\x55\xcb\xd4\x41\x50\xb6\xc7\x4a\xbe\xa2\x2f\xdb\x55\x61\x7d\x3a\x9f\xda\x1a\x51\x79\x7a\x5b\xb3\x78\x29\x01\x8b\x8c\x9e\xc0\xf7\xe6\x3a\x31\xe5\xbe\x48\xd2\x83\xab\xd6\x05\x76\xa0\x05\x65\x1b\x13\x18\xd4\xd5\x33\xab\x13\xf4\x74\xf9\x8b\x88\x65\x58\x82\xdb\xd9\xe1\xf4\xce\x0a\xa1\x36\xef\x61\x79\x5a\x24\xa2\xb5\x5d\x89\xc4\xb5\x87\x6c\xd4\xd1\x88\x62\x74\x77\xbf\xea\x33\xab\x74\x81\x95\x9f\x57\xb4\xc6\x35\x12\x2d\x85\xb1\x78\xf0\xc4\xb8\x6e\x2d\xae\xfb\x15\x5f\xbb\xf6\x44\x9a\xd9\xe9\x25\x80\x05\xae\xac\x93\xb7\x24\x7b\x4d\xe0\x03\xe9\xd4\x73\xca\x99\x2d\x7b\xcf\x44\x18\x5b\x11\x68\x55\x7b\x57\x23\xaa\xa4\x2c\x94\x15\x78\x71\x7d\xc9\xeb\x67\x56\x62\xe5\x95\x3e\xcc\xf6\xac\xb6\x6f\xf5\x06\x6e\xcb\x74\x1a\x63\x8f\x97\xec\x25\x40\x58\x0b\xdb\xde\xe5\x76\xca\xfa\x01\x4b\x18\x67\xd7\x3f\x9f\x9e\x3c\xed\xfc\xe2\xcc\x8e\xd8\xa6\xd7\x89\x82\x67\x17\x27\xa3\xf5\xdd\xd7\x5f\x87\xb3\x1a\xfb\xaf\xd2\xdd\x57\x0e\x85\x7b\xdb\x78\x7d\x27\x7b\xb4\x94\x12\xca\xf9\x99\xfc\x5e\xd0\x88\x92\x82\x23\x2f\x03\x73\x1c\x42\x1d\x99\xd1\x43\xbd\xa3\x02\x42\x17\x67\xaf\xdc\x7d\x9e\x95\x04\x2b\xa7\xdf\xfc\x44\xcd\x71\x27\xfe\x91\x6a\xdb\x30\x35\x6c\xe1\xcd\xf6\x66\x71\x62\xce\x22\x89\x35\x36\x6d\x20\x00\xea\x6b\x3a\x5b\xb7\xf5\x1e\x56\x75\xf6\x13\x0f\xb2\xae\x75\x24\xfe\xea\x2b\x5b\xa7\x45\x43\x67\x86\x97\xb2\xac\x28\x2c\xc1\x63\xc6\x31\x40\x61\xa5\x60\x18\x3a\x84\x47\x26\x0d\x1e\xf7\xaa\x13\xe7\xe7\xef\xc5\xe6\x23\xe3\xa1\xaa\x49\xbe\x27\xe2\x20\xc5\x0f\xae\xa5\x6b\x5b\x84\xd6\x8e\xd5\xe6\x21\x0d\xe4\x18\xba\xcc\x93\x71\x2a\x72\xa3\xb3\x52\xe8\x0f\x2f\xe0\x50\x4b\x1c\xee\x0f\x16\xa6\xbc\x78\x30\xf2\x4a\xbe\x5c\x57\xba\xe2\xc5\x71\x46\x9a\x4f\x9d\x8b\x18\x3f\xd8\x93\xdb\xe2\x41\x10\x26\x85\x07\x33\xb5\xca\x2c\x53\xe1\x42\xef\x29\x8d\xc6\x59\xde\x4a\x67\x25\x4e\x3f\x33\xf1\x88\x9f\x6a\x60\x3e\x6b\x25\xcc\x73\xd8\x79\xcc\x1f\xa9\x2c\x53\x2c\xdd\x08\x7b\x37\x45\x26\xc1\x43\xd7\x63\x77\x80\xc5\x00\x31\x2f\x0e\xef\x51\x8f\x44\x42\xa8\x9e\x6e\x6e\xbd\x5a\xd3\x3e\xbe\xec\x22\xb4\x2f\xc1\x24\x42\x6a\x2b\x15\x1c\xae\x8f\xc9\x79\x3d\xd2\xd5\x8f\x29\xe5\xa3\xcd\x8d\xf5\x8e\x79\xe6\xab\x2d\x99\x2c\x89\xd2\x25\xae\xa0\xc7\x75\xeb\x33\x73\xa9\x9e\xd6\xa5\x61\xdc\x5e\x54\x76\x66\x98\x9e\xcc\x81\x01\xa3\x6b\xc6\x7c\x95\x4d\x1a\x5f\x52\x7d\xbf\x14\xdb\xe8\xd4\x22\x59\xb7\xf3\xac\x53\x4c\xb3\x2c\x49\x34\x9a\x29\xf8\xaa\x50\xb6\x49\x33\xf5\xb0\x0e\xc9\x5e\xab\x84\x91\x78\x5b\x09\xec\x35\x64\xd1\x60\xdc\x26\x2f\xf1\x0f\xa3\x56\xb6\xc8\x63\xc3\x1c\xc9\x79\x5b\xf6\x63\x98\x26\x8d\x17\x2f\x85\xe9\xc2\x8f\xd0\x89\x1e\xf7\x11\xf8\x24\x8b\xdc\x4e\x57\x53\xd1\x16\xb8\xe7\x72\xa1\x0b\x8a\xca\x71\x65\x50\xbd\x1e\x9c\xa4\x81\x56\xa7\xae\x94\x00\x47\x98\xa1\x07\xe6\x05\xfb\x92\x63\x09\x3d\x2c\xb0\x86\x94\xc8\x4f\x5f\xb6\x4a\x9d\x9a\xba\x38\x1e\x5d\xcf\xdd\x95\x48\x6b\xd5\xf4\x4a\x91\x3e\x14\x3a\x10\xe7\x4d\xd0\x0b\x79\x1d\x0f\xda\xf5\x41\xdf\xe1\x30\xb2\x39\xc6\xc9\x30\x3a\x1d\x39\x10\x33\x3f\x52\x0e\x45\x3e\xab\x33\x18\x2d\x40\xf2\xed\x94\xe2\x31\xfa\x34\x90\x25\x41\x62\x4f\x96\xc8\xa2\x4c\x04\x5f\x9b\x9c\x60\x26\x12\x55\xbc\xa8\x92\x5b\x4c\xb9\x38\x74\x43\x95\x42\x29\x8a\xd7\x8a\x87\xc9\x57\xce\xb4\xa4\xce\xb3\xe4\x1b\x21\xbb\x72\x83\x19\xcf\x7c\x2c\x4d\xe5\x0c\x30\x4f\xfd\x03\xc2\x02\x9d\xb8\x4a\xce\xb0\xdd\x99\xa8\xca\x3a\xf2\x03\x54\x6d\xe8\x56\xa3\x11\x99\x04\x5a\xa5\x73\xe8\x44\xce\x40\xd7\xb0\xf0\xc5\xfc\x4c\xd9\x03\x98\x1e\x75\x2e\xdc\x73\x4e\xfb\x76\x93\xa5\x00\xf7\x2a\xa8\x7a\xa2\x2a\xa1\x2a\x1b\x05\x3b\xa1\x0b\x13\xda\xc2\x62\x75\xa9\x4c\x15\xe2\x73\x3f\xa2\x5f\x92\x18\xe6\x44\xc5\xc2\x08\x35\x46\x98\xb8\x1e\x0d\xe3\xa2\x08\x7a\x97\x78\x98\x00\xf8\x8c\x0c\x3c\x0a\xa3\x13\x01\xd7\x3e\x39\x79\xf7\x5a\x96\xf3\x99\x80\xb8\x48\xf5\xfd\x3e\xf2\xab\xbc\x08\x44\x11\x44\xf4\x97\xde\x55\xea\xcf\x63\xb0\xc3\xc0\xf3\x82\x6b\xf4\x7c\x9d\x5d\x6a\xc9\x8c\x5c\x51\x13\xd8\x34\x01\xf9\x93\xbd\x30\x98\xf6\xde\xcc\x34\x34\x5e\x88\x50\xca\xbe\x56\x89\xe5\x27\xed\xfc\x43\x7b\x88\x09\xa5\xda\x4f\xa3\x83\x11\x0a\xa4\x3d\xcf\x95\xc9\xfb\xc9\x2c\xce\xf1\x53\xd6\xeb\xa9\xbf\x41\x53\x51\xfb\x5d\x59\x99\xef\x27\x15\xc6\xa3\x3d\xc8\xd4\x90\xf9\x49\xab\x57\xa6\x3d\x54\xb5\xc3\x52\x7a\x6a\x85\xe0\x56\xb5\x15\x81\x2a\xc1\x34\xf3\xb8\x3e\x77\x80\x9c\x1b\x8a\xf1\xad\x22\x8f\x67\x26\x51\xf2\x8c\x36\x69\x67\x67\x67\xfc\xb3\x67\x84\xbc\x11\xca\x07\xfa\xfb\xb4\xf1\xe9\xe2\x48\x90\x3e\xac\xcb\x7e\x12\xc2\x21\xcf\x1b\x6e\x8e\xd7\xaa\xc6\x15\xc5\x78\x1e\xc4\x72\x2b\x5d\x44\x7e\x33\x8a\xd3\x0e\x9c\x55\xb4\xb0\x5d\xd9\x26\x49\xcd\x13\xc2\x4c\x4a\xab\x74\xc5\xcb\xc3\x07\x60\x1f\xa9\x64\xb5\x11\x22\x42\xed\x44\x64\x4f\x3d\x2c\xd7\xa9\x1b\x31\x79\x31\x9e\x91\x16\xba\x24\x8f\x47\xd7\x28\x90\xd7\x52\xa4\x2b\x00\xb7\x55\x30\x3c\x9a\xa3\x31\x8f\xf6\x93\x54\x83\x8c\x86\x03\x7b\xd5\xa6\x7f\xa7\x32\x4c\x34\x4a\x65\x96\xc6\x13\xe5\xc2\xab\x42\x68\x89\xdc\x51\x53\x62\xa5\xdf\x34\x24\x17\xd9\x41\x5e\x89\x77\x75\x3c\x8e\x99\x93\xd8\x8b\xd9\x39\x33\xc5\xcb\x19\x28\x09\x24\x1c\xfe\x29\x56\x31\xfe\x45\xae\xcd\x33\x99\x28\x7b\x26\x17\xe6\x59\x0a\x1b\xdd\x04\x80\x7c\x84\x77\xb3\x0b\x90\xff\xfb\x7f\xd8\xeb\xe7\x33\xc1\x32\x67\xaf\x0f\x7e\xdb\x3f\x4b\x65\x68\xdc\xeb\x02\x4c\x5a\xd5\x7e\xe7\x70\xef\x4c\xc2\x7e\x7b\x0c\x70\x7f\x81\xf7\x57\x18\x02\x30\x0f\x66\x42\xce\xe2\x28\x69\x52\x61\x03\xc6\xdb\xed\xa8\xee\xe2\x22\x11\x35\x1a\x31\xf7\x1a\x8d\xf7\x13\x66\xb2\x2d\xc5\xfc\xa6\x4f\x9d\xad\x09\xb6\x3a\x9b\xcc\x5b\x42\x72\x9f\x25\x07\x4f\x2a\xce\x50\x24\x25\xd5\x5d\x8c\xe6\x4a\xfc\x99\xc4\x50\x65\xc6\xb1\x41\x78\x78\x0b\x90\xf5\xce\x7f\x4e\x5b\x9f\xea\xa3\x4e\xe5\x37\x44\x91\x3f\x91\x1b\xad\x8e\x0b\x61\x24\x37\x44\xd7\x73\x2f\x61\xaf\x36\xff\xdb\xfa\xd6\xbd\xc8\x0b\x21\x0d\xf3\xbb\x6f\xae\xc9\x11\x1a\xa5\x67\x82\x58\x49\x47\x3b\x39\x86\x85\xc1\x99\x3c\xfc\x0c\xd5\x1d\x33\xda\xd4\x1f\x06\x11\x6b\xc7\x08\x4a\x7d\x9d\x56\xa2\x5f\x15\x56\x98\x28\x1d\x2e\x82\x46\xe3\xde\xc5\x62\x49\xd9\xb9\x82\xcd\x0a\x84\x8d\x5d\xb0\x58\xcc\x52\x43\x6e\xe4\xc4\x59\x0d\x16\x69\xdc\x4c\x68\xad\xa4\xb7\x15\x88\x08\xf1\x18\x29\x75\x5d\x81\x0e\x14\x1d\x1a\xe2\xa9\x7a\x28\x7f\xbc\x54\x5b\xc7\x5f\x3f\x9c\x1a\x6e\xa7\x71\x14\x4d\x57\xb2\x43\x7d\x7f\x62\x64\x9e\xc6\xe0\x33\xde\x31\x95\x2d\x4f\x1a\x49\xc1\xd6\x46\x51\x7d\x05\xd2\xd0\x86\x1e\xcf\x48\x43\xc5\xdd\xd0\x29\x08\xd8\xd8\x39\xbc\xff\x7e\xa1\x4f\xb3\x59\xeb\x9a\xdd\xd1\xa7\x2d\x55\x64\x0a\x3e\x2f\x3d\xd7\xee\xc9\xc7\xed\xe3\x77\x1b\xbf\xfe\x76\xf0\xec\x5d\xe7\xed\xe9\xe4\xe2\xdd\x4b\x67\x23\x18\xbc\x3c\x1e\x35\x56\x32\xfe\x70\xc1\x13\x8d\x95\xda\x15\x42\xd6\x6a\x01\x57\x15\xca\x48\x43\xd4\x45\xab\x4b\x81\xa4\xec\x44\xd6\xc1\x57\x3c\x9b\xd2\x77\x08\x70\xa6\x6e\x5f\x95\x51\x95\xf4\x2b\xa1\x6b\xfa\xca\x5e\x3a\x57\x6f\xdb\xea\xba\x7c\xbe\x1d\x7e\xde\xb8\xb8\x74\x9f\x7d\xee\x04\xd1\xe4\xe2\xf3\x10\x87\x3b\x0c\x47\x6d\x3a\x9d\xf2\xf6\xe4\xb2\x75\x1e\x45\xa3\xce\x85\xdf\x7d\xda\x19\x4f\xdb\x5f\xb6\x66\xcf\xda\xbc\xdb\x76\xd8\x15\x1f\xbb\xc3\xa8\x0d\xd6\xac\x46\x80\x34\x8a\x88\x34\xd6\x3b\xeb\x9d\x56\xb7\xd3\xea\x6c\x9d\x76\xd7\x7b\x5b\xdd\xde\xfa\x66\xbb\xb3\xb5\xd1\xdd\x5c\xff\x67\xda\x43\xab\xa6\x9b\xeb\xb1\xdd\xdb\xd8\x6e\x6f\x6c\xaf\xaf\x77\x9e\x69\x3d\xe2\xb2\xb7\xd0\xbc\xbd\xdd\xee\x34\x0a\x82\xf3\x71\x92\x7c\x87\x86\xa9\xb1\xac\x17\x93\x25\x0d\x5c\x7f\xbc\xb7\xb6\x86\x85\x34\x02\x8f\xb5\x41\x08\x81\xe0\x6c\x83\x52\x5e\xd3\x6e\x3c\x68\x29\x5a\xf1\x35\x20\x24\xa3\x13\x9e\xf2\x49\x21\xe1\xd6\x1c\xca\xc7\xe7\x01\x7c\xba\x51\xed\xc5\xcd\x54\x5f\x53\xab\xe0\xa5\x28\x08\xbc\xab\xc2\x7e\x4f\x04\xbb\x7d\x5f\x2b\x43\x96\x34\x5e\x2e\x8d\x6f\xba\x34\xcc\x3a\xd2\x40\x1b\x55\xc6\x55\xb3\x17\xe2\xbc\xa6\x24\xa4\x3c\x3b\x51\x55\xab\xa8\x06\x27\xdb\x4a\x64\x14\xb0\xad\xad\xce\x47\xc3\x64\x6a\x9b\x16\x31\x9e\x19\x49\xce\xa4\xb1\x33\xa1\x5f\x61\x5c\x1f\xd8\x79\x1c\x90\xae\xb5\x2d\x40\xb6\x8e\xea\xcb\x17\xac\xc8\x20\x6a\x61\xd2\x0c\x6a\xef\x4f\xc8\x3e\xb4\x58\x25\x5a\xee\x74\x19\x6e\xa5\x19\xca\xe4\xcf\x46\x3c\x39\x8d\x4f\xf9\xa4\x5d\xf2\xa7\x66\x2c\xfd\x2b\x7b\xb8\x69\x4e\x72\x0a\x68\x35\xd3\xd0\x9a\x95\x94\x4d\xb6\xf8\x4f\xf2\xf7\x4f\xc5\x59\x77\xe5\xc4\x55\x04\x02\x2b\x0e\x96\x56\x8b\x6b\x54\x31\x6b\x7f\x66\x33\x23\xf0\xac\x64\x32\xc7\x3b\x6a\x6c\x09\x7f\x75\x44\x66\x4e\x30\x9a\x20\x6a\x49\xc8\x58\x6a\xc8\x2e\x20\x2a\x1b\x77\x3e\x30\x33\x5f\x08\xb0\xdc\x69\x75\xd7\xf1\x7f\xb9\xd7\x2a\x81\x14\x41\xe2\x5f\xf2\x12\x13\x0d\xaf\x16\x6e\x0e\xf2\xc2\xe9\x7c\x5e\xfe\x3e\x16\x45\xdd\x56\x67\xb3\xd5\x79\x7a\xda\xdd\x06\xc9\xd5\xeb\x74\xff\xa7\xb3\xd5\xdb\x50\xaa\x38\x1f\xd7\x54\xbe\xa0\xb4\xf6\xf5\x88\xcd\x83\x44\x8f\x68\x2b\x3b\x89\x34\x4b\x55\xbb\xac\x9b\x10\xcd\x41\x5c\xbb\x9a\x7e\x4f\xfb\x88\xa0\xb0\x82\xf6\xc1\x94\xf9\x52\x8c\x0b\x93\x00\x64\xde\x1a\x10\xc1\x03\x0b\x20\x1c\x07\xa0\x0e\x01\x85\x28\x18\x04\xde\x1a\x36\x74\x9d\x96\x3a\x2f\x5d\x1b\x30\xd8\x43\x36\x56\xf2\x91\x6a\x77\xfc\x1d\x01\xb8\xb1\x62\x0d\x59\xbb\xd9\xa7\x1a\x69\xf4\x99\xb9\x06\xf0\xe6\xb6\xbf\xd6\x52\xfa\x56\x4b\xa5\x2c\xfd\xe8\x36\xa4\xce\xa7\xf7\x2c\x49\xde\xb0\xc7\x55\x96\x53\x3b\x1f\x3a\xd3\x17\xca\xbc\xdf\xef\x91\xd4\xea\x04\xfb\x51\xde\x98\x18\x05\x53\x77\xa0\x8e\x50\x01\x5d\xc0\x15\xb4\x76\xdf\x0c\xfd\x27\xc2\xd9\x30\xf9\xea\xf6\xdd\xa0\xaf\xce\x22\x14\xb0\x78\xb7\xa1\x1f\x89\x22\xc4\x1e\x7c\x15\xf7\x29\x58\x82\xaf\x1f\x0c\x87\x9c\x69\xf7\xdb\xe5\x63\xf1\x5a\x5a\x44\x0e\xe9\x6e\x77\xbb\xdb\x4f\x3b\xeb\x1b\x9d\x4e\xa7\x93\x8d\x72\x45\x0f\xca\xb3\xcd\xee\xd6\x66\x55\xef\xed\xc2\xde\x5b\xcf\x9e\x3d\xab\xea\xfd\xbc\xb0\xf7\x53\x30\x61\x8b\x62\xe3\xbe\xfb\x99\xa9\x9c\x85\xdc\x0c\x6c\x76\x3a\x7b\xe2\x3e\xa3\x2a\x63\x54\x4a\x81\xce\x46\x4e\x0e\x68\x57\x4c\x55\x2c\x7b\xe1\xca\x83\xd5\xae\x03\x11\x17\x81\x91\xc6\x6f\x3b\x2f\x7f\xdb\x39\x69\xbd\x79\xf5\xe6\xb4\x65\xbc\x4f\x76\x16\x27\x73\x7f\x30\x0e\x03\x1f\x0f\x51\xe9\x20\x8e\x29\x12\xa5\x6d\x63\x7b\x55\x7a\x4f\x29\x87\x96\x3f\x8b\xb2\x39\x89\xc7\x53\x5b\xf4\xfa\xe5\x60\xb8\x7f\xfd\x70\xe0\x4e\x3e\xbf\x1a\x84\x7b\xb3\xd7\xdb\x5d\xfa\xfe\xcb\xc1\x3f\x3f\xbf\x38\xfd\x7c\x78\xac\x24\x0f\xd0\x27\xde\x14\x2f\xe9\x63\xa7\xcf\x81\xf4\xd6\xd6\x58\x41\x02\xe4\xfa\x1d\x90\x68\xbd\x9c\x42\xeb\x36\x02\x49\x0f\x07\xfa\xa3\x61\xd8\x9c\x19\x87\x11\x78\x59\xa5\xb8\xa2\x1d\xde\x62\x29\x65\x73\xeb\x2a\x03\xa4\x72\xdb\xfe\x1e\x31\xbf\xd9\x23\x55\x9f\x48\x63\xf7\xc0\xbc\x9a\x4d\x7c\xe9\xbe\x47\xe0\xca\xdb\x4c\x9a\xae\xd3\x6c\x93\x13\x5b\x3b\x71\x04\xd3\x53\x1e\x8a\x55\x75\x04\x6a\x3a\x39\xe2\xa7\xd2\x27\xd2\x26\xef\xa4\x43\x5d\xce\x0f\x06\xae\x91\x9f\x49\x57\x27\x4e\x76\xb6\xbd\x0f\x7b\xaf\x66\xf3\xf3\x83\x70\xdf\xff\x12\xee\xb0\xc9\xd3\xf5\xcd\xd1\xe7\xcb\x4b\x77\xef\x2a\x99\xed\x8a\x3b\xcc\xad\x33\xde\xbd\x83\x19\xef\x96\xcf\x78\xd7\x32\xe3\x13\x89\xaa\x08\xae\x4b\x79\xbd\x17\x17\xea\x70\x6e\x43\x87\xcd\x1a\xe3\x7e\x7a\xfb\x61\x3f\x2d\x1d\xf5\x53\xcb\xa0\x4f\xd3\x52\x32\xc0\x3f\x71\x36\x18\x71\x02\x26\x0e\x7d\xc4\x55\xb5\xc9\x20\x84\xe8\x67\x8f\x75\x28\xca\x3f\xa9\x46\x20\x0e\xc9\x5c\xe7\xe7\x66\xd7\xfd\x6d\xc3\x99\xfd\xfe\xf1\xe0\xea\x6a\xeb\xe3\xd5\x6b\x6f\xfe\xb5\x3b\x79\x75\xbc\xf1\xeb\xfc\xf3\x61\x33\xbd\xaa\xbd\x44\xa4\x7d\x7c\xfb\x74\xb4\x3e\xda\xfe\xe5\xd4\x79\xff\xdb\x7b\xba\x7e\xc9\x7f\x79\xb6\x7e\xf9\x6e\x6f\x63\x1e\xd3\xa5\x5b\x47\xd4\xdf\x01\x53\x77\xcb\x99\xba\x6b\x63\xea\x54\x50\x81\xa9\xe1\x0e\xe7\x78\xcc\x23\xf7\x7c\x78\x15\xb8\x8a\x83\xc5\x9d\x56\x10\xba\x5f\xe3\x44\x77\xbc\xe1\xb9\x16\x65\x36\xde\x8f\xf7\xc7\xd7\x93\x3f\x5e\x4c\x3f\x1c\x0d\x0f\xd6\xbd\x43\x76\x39\x75\x36\xff\xb9\x17\x53\x66\xa3\x06\x65\x36\x6f\x4f\x98\xcd\x52\xba\x6c\xda\xc8\x82\x47\x8f\xcd\x61\x10\xb4\xce\x69\xd8\x8c\x55\x5f\x4c\x07\x29\x94\xf1\xde\x47\xce\xf5\x74\xfb\x76\x89\x08\x00\x5a\xb8\xfb\xe3\xaf\xbe\x46\x8b\x0b\xa0\xc5\xc7\xdd\x84\x16\x6f\xe8\x17\x75\x46\x7e\xa0\xbc\x5b\xc7\xd2\x5f\x55\x83\x48\x5b\xb7\x27\xd2\x56\x29\x91\xb6\xaa\x89\x24\xef\x3c\x11\x18\x6b\xa7\xf6\x7e\x12\xfd\xb7\x8d\x27\xbf\x22\x04\x20\x39\xf3\xad\x24\xd8\xe5\x17\x24\xd8\xef\x47\xec\x60\x3d\x00\x82\x39\x1b\x7f\xbc\x48\xe8\x75\xca\xc2\x09\x3f\x0c\xa2\x1d\x75\xff\x66\x9d\x55\xb6\x7e\x07\xab\x6c\xbd\x7c\x95\xad\x5b\x28\x95\xac\xa4\x08\x71\x06\x4a\x5d\x31\x55\x74\x1c\xcf\xc3\x15\xfe\x85\xb4\xb8\xfc\x63\xf7\xeb\x07\x41\x82\x98\x16\xaf\xaf\x5e\x3e\xbf\x78\xf3\xee\x63\x4c\x8b\xe7\x58\x01\x73\x37\xf0\x87\x9e\x3b\xa8\xe3\x34\xdc\xd8\xbe\x3d\x1d\x74\x18\x16\x3a\xe8\xaf\x4d\x11\x9c\x94\x3c\x17\xe6\x0a\x2c\x2d\xea\x89\x63\x48\x11\x64\x58\x48\x84\xed\xcb\x8f\x1d\x64\x88\xaf\x29\x35\x3e\xb2\xb1\xb3\xb1\xaf\x84\x49\xfe\x8a\x6d\xdb\xc0\x9f\xdf\x7e\xdc\xcf\x4b\x87\xfd\xdc\x2a\x63\xd3\xeb\xdd\x99\xf9\xb9\x9c\xc8\x64\xfb\xf1\xdc\x6e\x7f\x1c\x8d\x87\x6f\x9e\x8f\x5e\x1d\xf3\x5f\xae\xf6\x3f\x24\xa3\xac\xad\x64\x1f\x64\xac\x32\xbe\x22\xbe\xd3\x16\xa3\x4d\x06\x1c\x9d\xb9\x6f\x77\xdf\xb4\xf6\xff\x68\x3d\xef\xa9\xf3\x1a\x79\x09\x2d\x8e\x24\x6d\xc3\xbe\x44\x2d\xe3\xfc\xea\x4b\x67\xc3\xf3\x1d\x6f\xf2\xb9\xf3\x79\x38\x78\xca\xdd\x88\x6e\x71\xef\xe2\xea\x19\x33\x13\x6a\x12\x86\xc2\x61\x77\x47\x5b\xce\xb3\x67\x9f\x3b\x5e\x38\x70\xae\x36\x47\x4f\xa9\x77\xfe\x94\x7b\xc3\x91\x7f\xb1\xe1\x8c\xcf\xf9\xc5\xdf\xfe\xeb\xef\xfb\x7f\x9c\x1e\xef\x90\x9f\xe4\x18\xdb\x82\x28\x3f\xa7\x25\x6a\xf5\xec\x3f\x4e\x9a\x60\xd6\x34\x57\xc5\xe8\xc5\xcf\xdd\xd7\xef\x4f\x4e\xf7\x8f\x63\xd5\x01\x2f\x45\xc0\x46\x32\x8f\x7a\xad\x5b\x6c\x0f\xe8\x04\xe1\x56\xe7\xca\x9d\x75\x9e\x06\x0c\x67\x69\x1c\x5e\x0e\xd6\xb7\x9d\xd1\x30\xba\xe8\xd2\x81\x71\xdf\x7d\x5c\x23\xb3\x59\x35\x08\xcd\x30\xf9\x47\x99\xfe\x3d\xe5\x1f\xc2\xf9\xb6\xcf\x3f\x9f\xaf\xf3\xc3\xc9\xcb\x8b\xad\xf3\x3f\xa6\x7b\x4f\x77\x61\xb3\xf5\xff\x06\x8d\xc3\x07\xe0\x07\x01\x00")
 
 func kasFleetManagerYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -93,7 +93,7 @@ func kasFleetManagerYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "kas-fleet-manager.yaml", size: 54118, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "kas-fleet-manager.yaml", size: 67552, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -64,11 +64,12 @@ func (h adminKafkaHandler) List(w http.ResponseWriter, r *http.Request) {
 			}
 
 			kafkaRequestList := private.KafkaList{
-				Kind:  "KafkaList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
-				Items: []private.Kafka{},
+				Kind:       "KafkaList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				Items:      []private.Kafka{},
+				NextCursor: paging.NextCursor,
 			}
 
 			for _, kafkaRequest := range kafkaRequests {
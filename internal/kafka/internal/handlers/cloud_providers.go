@@ -23,7 +23,7 @@ const cloudProvidersCacheKey = "cloudProviderList"
 type cloudProvidersHandler struct {
 	service                  services.CloudProvidersService
 	cache                    *cache.Cache
-	supportedProviders       config.ProviderList
+	providerConfig           *config.ProviderConfig
 	kafkaService             services.KafkaService
 	clusterPlacementStrategy services.ClusterPlacementStrategy
 }
@@ -31,7 +31,7 @@ type cloudProvidersHandler struct {
 func NewCloudProviderHandler(service services.CloudProvidersService, providerConfig *config.ProviderConfig, kafkaService services.KafkaService, clusterPlacementStrategy services.ClusterPlacementStrategy) *cloudProvidersHandler {
 	return &cloudProvidersHandler{
 		service:                  service,
-		supportedProviders:       providerConfig.ProvidersConfig.SupportedProviders,
+		providerConfig:           providerConfig,
 		cache:                    cache.New(5*time.Minute, 10*time.Minute),
 		kafkaService:             kafkaService,
 		clusterPlacementStrategy: clusterPlacementStrategy,
@@ -58,7 +58,7 @@ func (h cloudProvidersHandler) ListCloudProviderRegions(w http.ResponseWriter, r
 				Items: []public.CloudRegion{},
 			}
 
-			provider, _ := h.supportedProviders.GetByName(id)
+			provider, _ := h.providerConfig.GetSupportedProviders().GetByName(id)
 			for _, cloudRegion := range cloudRegions {
 				region, _ := provider.Regions.GetByName(cloudRegion.Id)
 
@@ -123,8 +123,9 @@ func (h cloudProvidersHandler) ListCloudProviders(w http.ResponseWriter, r *http
 				Items: []public.CloudProvider{},
 			}
 
+			supportedProviders := h.providerConfig.GetSupportedProviders()
 			for _, cloudProvider := range cloudProviders {
-				_, cloudProvider.Enabled = h.supportedProviders.GetByName(cloudProvider.Id)
+				_, cloudProvider.Enabled = supportedProviders.GetByName(cloudProvider.Id)
 				converted := presenters.PresentCloudProvider(&cloudProvider)
 				cloudProviderList.Items = append(cloudProviderList.Items, converted)
 			}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type clusterUpgradeHandler struct {
+	service services.ClusterUpgradeService
+}
+
+func NewClusterUpgradeHandler(service services.ClusterUpgradeService) *clusterUpgradeHandler {
+	return &clusterUpgradeHandler{
+		service: service,
+	}
+}
+
+func (h clusterUpgradeHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			clusters, err := h.service.ListUpgrades()
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterUpgradeList(clusters), nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h clusterUpgradeHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var upgradeRequest private.ClusterUpgradeRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &upgradeRequest,
+		Action: func() (interface{}, *errors.ServiceError) {
+			clusters, err := h.service.ScheduleUpgrade(services.ClusterUpgradeRequest{
+				OpenshiftVersion: upgradeRequest.OpenshiftVersion,
+				ClusterIDs:       upgradeRequest.ClusterIds,
+				CloudProvider:    upgradeRequest.CloudProvider,
+				Region:           upgradeRequest.Region,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterUpgradeList(clusters), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+func (h clusterUpgradeHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			cluster, err := h.service.CancelUpgrade(id)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterUpgrade(cluster), nil
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusOK)
+}
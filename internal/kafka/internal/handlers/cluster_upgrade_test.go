@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+const clusterUpgradesUrl = "/api/kafkas_mgmt/v1/admin/clusters/upgrades"
+
+func buildUpgradeCluster(clusterID string, status api.ClusterStatus) *api.Cluster {
+	scheduledAt := time.Now()
+	return &api.Cluster{
+		ClusterID:          clusterID,
+		CloudProvider:      "aws",
+		Region:             "us-east-1",
+		Status:             status,
+		OpenshiftVersion:   "4.9.0",
+		UpgradeVersion:     "4.10.3",
+		UpgradeScheduledAt: &scheduledAt,
+	}
+}
+
+func Test_ClusterUpgradeHandler_List(t *testing.T) {
+	tests := []struct {
+		name       string
+		clusters   []*api.Cluster
+		listErr    *errors.ServiceError
+		wantStatus int
+		wantIDs    []string
+	}{
+		{
+			name:       "should return the cluster upgrades",
+			clusters:   []*api.Cluster{buildUpgradeCluster("first", api.ClusterReady), buildUpgradeCluster("second", api.ClusterUpgrading)},
+			wantStatus: http.StatusOK,
+			wantIDs:    []string{"first", "second"},
+		},
+		{
+			name:       "should return an empty list when no upgrade is scheduled",
+			wantStatus: http.StatusOK,
+			wantIDs:    []string{},
+		},
+		{
+			name:       "should return an error when the upgrades cannot be listed",
+			listErr:    errors.GeneralError("test"),
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			h := NewClusterUpgradeHandler(&services.ClusterUpgradeServiceMock{
+				ListUpgradesFunc: func() ([]*api.Cluster, *errors.ServiceError) {
+					return tt.clusters, tt.listErr
+				},
+			})
+
+			req := httptest.NewRequest(http.MethodGet, clusterUpgradesUrl, nil)
+			rw := httptest.NewRecorder()
+			h.List(rw, req)
+
+			gomega.Expect(rw.Code).To(gomega.Equal(tt.wantStatus))
+			if tt.wantIDs == nil {
+				return
+			}
+			var list private.ClusterUpgradeList
+			gomega.Expect(json.Unmarshal(rw.Body.Bytes(), &list)).To(gomega.Succeed())
+			gomega.Expect(list.Kind).To(gomega.Equal(presenters.KindClusterUpgradeList))
+			gomega.Expect(list.Total).To(gomega.Equal(int32(len(tt.wantIDs))))
+			ids := []string{}
+			for _, upgrade := range list.Items {
+				ids = append(ids, upgrade.Id)
+			}
+			gomega.Expect(ids).To(gomega.Equal(tt.wantIDs))
+		})
+	}
+}
+
+func Test_ClusterUpgradeHandler_Create(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		scheduleErr *errors.ServiceError
+		wantStatus  int
+		wantRequest *services.ClusterUpgradeRequest
+	}{
+		{
+			name:       "should schedule the upgrade of the clusters",
+			body:       `{"openshift_version": "4.10.3", "cluster_ids": ["first"]}`,
+			wantStatus: http.StatusAccepted,
+			wantRequest: &services.ClusterUpgradeRequest{
+				OpenshiftVersion: "4.10.3",
+				ClusterIDs:       []string{"first"},
+			},
+		},
+		{
+			name:       "should schedule the upgrade of the clusters of a region",
+			body:       `{"openshift_version": "4.10.3", "cloud_provider": "aws", "region": "us-east-1"}`,
+			wantStatus: http.StatusAccepted,
+			wantRequest: &services.ClusterUpgradeRequest{
+				OpenshiftVersion: "4.10.3",
+				CloudProvider:    "aws",
+				Region:           "us-east-1",
+			},
+		},
+		{
+			name:        "should return the errors of the scheduling",
+			body:        `{"openshift_version": "4.10.3", "cluster_ids": ["first"]}`,
+			scheduleErr: errors.BadRequest("no cluster to upgrade"),
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:       "should return an error when the request is malformed",
+			body:       `{"openshift_version": `,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			service := &services.ClusterUpgradeServiceMock{
+				ScheduleUpgradeFunc: func(request services.ClusterUpgradeRequest) ([]*api.Cluster, *errors.ServiceError) {
+					if tt.scheduleErr != nil {
+						return nil, tt.scheduleErr
+					}
+					return []*api.Cluster{buildUpgradeCluster("first", api.ClusterReady)}, nil
+				},
+			}
+			h := NewClusterUpgradeHandler(service)
+
+			req := httptest.NewRequest(http.MethodPost, clusterUpgradesUrl, strings.NewReader(tt.body))
+			rw := httptest.NewRecorder()
+			h.Create(rw, req)
+
+			gomega.Expect(rw.Code).To(gomega.Equal(tt.wantStatus))
+			if tt.wantRequest == nil {
+				return
+			}
+			gomega.Expect(service.ScheduleUpgradeCalls()).To(gomega.HaveLen(1))
+			gomega.Expect(service.ScheduleUpgradeCalls()[0].Request).To(gomega.Equal(*tt.wantRequest))
+			var list private.ClusterUpgradeList
+			gomega.Expect(json.Unmarshal(rw.Body.Bytes(), &list)).To(gomega.Succeed())
+			gomega.Expect(list.Items).To(gomega.HaveLen(1))
+			gomega.Expect(list.Items[0].UpgradeVersion).To(gomega.Equal("4.10.3"))
+		})
+	}
+}
+
+func Test_ClusterUpgradeHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		cancelErr  *errors.ServiceError
+		wantStatus int
+	}{
+		{
+			name:       "should cancel the upgrade of the cluster",
+			id:         "first",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "should return not found when the cluster does not exist",
+			id:         "unknown",
+			cancelErr:  errors.NotFound("cluster unknown not found"),
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "should return an error when the upgrade cannot be cancelled",
+			id:         "first",
+			cancelErr:  errors.BadRequest("the upgrade of cluster first has already started and cannot be cancelled"),
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			service := &services.ClusterUpgradeServiceMock{
+				CancelUpgradeFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					if tt.cancelErr != nil {
+						return nil, tt.cancelErr
+					}
+					cluster := buildUpgradeCluster(clusterID, api.ClusterReady)
+					cluster.UpgradeVersion = ""
+					cluster.UpgradeScheduledAt = nil
+					return cluster, nil
+				},
+			}
+			h := NewClusterUpgradeHandler(service)
+
+			req := httptest.NewRequest(http.MethodDelete, clusterUpgradesUrl+"/"+tt.id, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.id})
+			rw := httptest.NewRecorder()
+			h.Delete(rw, req)
+
+			gomega.Expect(rw.Code).To(gomega.Equal(tt.wantStatus))
+			gomega.Expect(service.CancelUpgradeCalls()).To(gomega.HaveLen(1))
+			gomega.Expect(service.CancelUpgradeCalls()[0].ClusterID).To(gomega.Equal(tt.id))
+			if tt.cancelErr != nil {
+				return
+			}
+			var upgrade private.ClusterUpgrade
+			gomega.Expect(json.Unmarshal(rw.Body.Bytes(), &upgrade)).To(gomega.Succeed())
+			gomega.Expect(upgrade.Id).To(gomega.Equal(tt.id))
+			gomega.Expect(upgrade.UpgradeVersion).To(gomega.BeEmpty())
+		})
+	}
+}
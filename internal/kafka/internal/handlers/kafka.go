@@ -10,7 +10,9 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
 
 	"github.com/gorilla/mux"
 
@@ -19,18 +21,20 @@ import (
 )
 
 type kafkaHandler struct {
-	service        services.KafkaService
-	providerConfig *config.ProviderConfig
-	authService    authorization.Authorization
-	kafkaConfig    *config.KafkaConfig
+	service            services.KafkaService
+	providerConfig     *config.ProviderConfig
+	authService        authorization.Authorization
+	kafkaConfig        *config.KafkaConfig
+	idempotencyService idempotency.IdempotencyService
 }
 
-func NewKafkaHandler(service services.KafkaService, providerConfig *config.ProviderConfig, authService authorization.Authorization, kafkaConfig *config.KafkaConfig) *kafkaHandler {
+func NewKafkaHandler(service services.KafkaService, providerConfig *config.ProviderConfig, authService authorization.Authorization, kafkaConfig *config.KafkaConfig, idempotencyService idempotency.IdempotencyService) *kafkaHandler {
 	return &kafkaHandler{
-		service:        service,
-		providerConfig: providerConfig,
-		authService:    authService,
-		kafkaConfig:    kafkaConfig,
+		service:            service,
+		providerConfig:     providerConfig,
+		authService:        authService,
+		kafkaConfig:        kafkaConfig,
+		idempotencyService: idempotencyService,
 	}
 }
 
@@ -49,6 +53,9 @@ func (h kafkaHandler) Create(w http.ResponseWriter, r *http.Request) {
 			ValidateKafkaClaims(ctx, &kafkaRequest, convKafka),
 			ValidateCloudProvider(&h.service, convKafka, h.providerConfig, "creating kafka requests"),
 			handlers.ValidateMultiAZEnabled(&kafkaRequest.MultiAz, "creating kafka requests"),
+			func() *errors.ServiceError {
+				return rbac.AuthorizeAny(ctx, rbac.ResourceKafka, rbac.PermissionCreate)
+			},
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			svcErr := h.service.RegisterKafkaJob(convKafka)
@@ -57,6 +64,7 @@ func (h kafkaHandler) Create(w http.ResponseWriter, r *http.Request) {
 			}
 			return presenters.PresentKafkaRequest(convKafka, h.kafkaConfig.BrowserUrl), nil
 		},
+		IdempotencyService: h.idempotencyService,
 	}
 
 	// return 202 status accepted
@@ -72,6 +80,7 @@ func (h kafkaHandler) Get(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			handlers.SetETag(w, handlers.TimestampETag(kafkaRequest.UpdatedAt))
 			return presenters.PresentKafkaRequest(kafkaRequest, h.kafkaConfig.BrowserUrl), nil
 		},
 	}
@@ -83,6 +92,9 @@ func (h kafkaHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{
 			handlers.ValidateAsyncEnabled(r, "deleting kafka requests"),
+			func() *errors.ServiceError {
+				return rbac.AuthorizeAny(r.Context(), rbac.ResourceKafka, rbac.PermissionDelete)
+			},
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
 			id := mux.Vars(r)["id"]
@@ -112,11 +124,12 @@ func (h kafkaHandler) List(w http.ResponseWriter, r *http.Request) {
 			}
 
 			kafkaRequestList := public.KafkaRequestList{
-				Kind:  "KafkaRequestList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
-				Items: []public.KafkaRequest{},
+				Kind:       "KafkaRequestList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				Items:      []public.KafkaRequest{},
+				NextCursor: paging.NextCursor,
 			}
 
 			for _, kafkaRequest := range kafkaRequests {
@@ -147,6 +160,13 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 		Validate: []handlers.Validate{
 			validateKafkaFound(),
 			ValidateKafkaUserFacingUpdateFields(ctx, h.authService, kafkaRequest, &kafkaUpdateReq),
+			handlers.ValidateIfMatch(r, "kafka_requests/"+id, func() (string, *errors.ServiceError) {
+				current, err := h.service.Get(ctx, id)
+				if err != nil {
+					return "", err
+				}
+				return handlers.TimestampETag(current.UpdatedAt), nil
+			}),
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
 			updatedNeeded := false
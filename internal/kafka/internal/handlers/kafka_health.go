@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type kafkaHealthHandler struct {
+	service services.KafkaHealthService
+}
+
+func NewKafkaHealthHandler(service services.KafkaHealthService) *kafkaHealthHandler {
+	return &kafkaHealthHandler{
+		service: service,
+	}
+}
+
+// Get is the handler for getting the health of a kafka request
+func (h kafkaHealthHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			health, err := h.service.GetKafkaHealth(r.Context(), id)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaHealth(health), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
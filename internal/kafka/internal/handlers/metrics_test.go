@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium/prometheustest"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func newPrometheusMetricsHandler(t *testing.T, server *prometheustest.Server) *metricsHandler {
+	client, err := observatorium.NewObservatoriumClient(&observatorium.ObservabilityConfiguration{
+		MetricsBackend:      observatorium.MetricsBackendPrometheus,
+		PrometheusUrl:       server.URL,
+		PrometheusAuthToken: "test-token",
+		Timeout:             10 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kafkaService := &services.KafkaServiceMock{
+		GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+			if id != "test-kafka" {
+				return nil, errors.NotFound("kafka %s not found", id)
+			}
+			return &dbapi.KafkaRequest{Meta: api.Meta{ID: id}, Namespace: "kafka-test"}, nil
+		},
+	}
+	return NewMetricsHandler(services.NewObservatoriumService(client, kafkaService, &observatorium.ObservabilityConfiguration{}))
+}
+
+func Test_MetricsHandler_PrometheusBackend(t *testing.T) {
+	server := prometheustest.NewServer("test-token")
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		id         string
+		url        string
+		handler    func(h *metricsHandler) http.HandlerFunc
+		wantStatus int
+		verify     func(body []byte)
+	}{
+		{
+			name:       "should return the instant query metrics",
+			id:         "test-kafka",
+			url:        "/api/kafkas_mgmt/v1/kafkas/test-kafka/metrics/query?filters=kubelet_volume_stats_used_bytes",
+			handler:    func(h *metricsHandler) http.HandlerFunc { return h.GetMetricsByInstantQuery },
+			wantStatus: http.StatusOK,
+			verify: func(body []byte) {
+				var list public.MetricsInstantQueryList
+				gomega.Expect(json.Unmarshal(body, &list)).To(gomega.Succeed())
+				gomega.Expect(list.Id).To(gomega.Equal("test-kafka"))
+				gomega.Expect(list.Items).To(gomega.HaveLen(1))
+				gomega.Expect(list.Items[0].Metric["__name__"]).To(gomega.Equal("kubelet_volume_stats_used_bytes"))
+				gomega.Expect(list.Items[0].Value).To(gomega.Equal(float64(42)))
+			},
+		},
+		{
+			name:       "should return the range query metrics",
+			id:         "test-kafka",
+			url:        "/api/kafkas_mgmt/v1/kafkas/test-kafka/metrics/query_range?duration=5&interval=30&filters=kubelet_volume_stats_used_bytes",
+			handler:    func(h *metricsHandler) http.HandlerFunc { return h.GetMetricsByRangeQuery },
+			wantStatus: http.StatusOK,
+			verify: func(body []byte) {
+				var list public.MetricsRangeQueryList
+				gomega.Expect(json.Unmarshal(body, &list)).To(gomega.Succeed())
+				gomega.Expect(list.Id).To(gomega.Equal("test-kafka"))
+				gomega.Expect(list.Items).To(gomega.HaveLen(1))
+				gomega.Expect(list.Items[0].Values).To(gomega.HaveLen(1))
+				gomega.Expect(list.Items[0].Values[0].Value).To(gomega.Equal(float64(42)))
+			},
+		},
+		{
+			name:       "should federate the kafka metrics",
+			id:         "test-kafka",
+			url:        "/api/kafkas_mgmt/v1/kafkas/test-kafka/metrics/federate",
+			handler:    func(h *metricsHandler) http.HandlerFunc { return h.FederateMetrics },
+			wantStatus: http.StatusOK,
+			verify: func(body []byte) {
+				gomega.Expect(string(body)).To(gomega.ContainSubstring("kubelet_volume_stats_used_bytes"))
+			},
+		},
+		{
+			name:       "should return not found when the kafka does not exist",
+			id:         "unknown-kafka",
+			url:        "/api/kafkas_mgmt/v1/kafkas/unknown-kafka/metrics/federate",
+			handler:    func(h *metricsHandler) http.HandlerFunc { return h.FederateMetrics },
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			h := newPrometheusMetricsHandler(t, server)
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.id})
+			rw := httptest.NewRecorder()
+			tt.handler(h)(rw, req)
+
+			gomega.Expect(rw.Code).To(gomega.Equal(tt.wantStatus))
+			if tt.verify != nil {
+				tt.verify(rw.Body.Bytes())
+			}
+		})
+	}
+}
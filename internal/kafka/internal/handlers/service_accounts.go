@@ -5,6 +5,8 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
 	"net/http"
 	"net/url"
@@ -15,12 +17,14 @@ import (
 )
 
 type serviceAccountsHandler struct {
-	service sso.KeycloakService
+	service            sso.KeycloakService
+	idempotencyService idempotency.IdempotencyService
 }
 
-func NewServiceAccountHandler(service sso.KafkaKeycloakService) *serviceAccountsHandler {
+func NewServiceAccountHandler(service sso.KafkaKeycloakService, idempotencyService idempotency.IdempotencyService) *serviceAccountsHandler {
 	return &serviceAccountsHandler{
-		service: service,
+		service:            service,
+		idempotencyService: idempotencyService,
 	}
 }
 
@@ -71,6 +75,9 @@ func (s serviceAccountsHandler) CreateServiceAccount(w http.ResponseWriter, r *h
 			handlers.ValidateMaxLength(&serviceAccountRequest.Description, "description", &handlers.MaxServiceAccountDescLength),
 			handlers.ValidateServiceAccountName(&serviceAccountRequest.Name, "name"),
 			handlers.ValidateServiceAccountDesc(&serviceAccountRequest.Description, "description"),
+			func() *errors.ServiceError {
+				return rbac.AuthorizeAny(r.Context(), rbac.ResourceServiceAccount, rbac.PermissionCreate)
+			},
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
@@ -81,6 +88,7 @@ func (s serviceAccountsHandler) CreateServiceAccount(w http.ResponseWriter, r *h
 			}
 			return presenters.PresentServiceAccount(serviceAccount), nil
 		},
+		IdempotencyService: s.idempotencyService,
 	}
 	handlers.Handle(w, r, cfg, http.StatusAccepted)
 }
@@ -91,6 +99,9 @@ func (s serviceAccountsHandler) DeleteServiceAccount(w http.ResponseWriter, r *h
 		Validate: []handlers.Validate{
 			handlers.ValidateLength(&id, "id", &handlers.MinRequiredFieldLength, &handlers.MaxServiceAccountId),
 			handlers.ValidateServiceAccountId(&id, "id"),
+			func() *errors.ServiceError {
+				return rbac.AuthorizeAny(r.Context(), rbac.ResourceServiceAccount, rbac.PermissionDelete)
+			},
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
@@ -108,6 +119,9 @@ func (s serviceAccountsHandler) ResetServiceAccountCredential(w http.ResponseWri
 		Validate: []handlers.Validate{
 			handlers.ValidateLength(&id, "id", &handlers.MinRequiredFieldLength, &handlers.MaxServiceAccountId),
 			handlers.ValidateServiceAccountId(&id, "id"),
+			func() *errors.ServiceError {
+				return rbac.AuthorizeAny(r.Context(), rbac.ResourceServiceAccount, rbac.PermissionUpdate)
+			},
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
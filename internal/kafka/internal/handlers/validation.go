@@ -15,6 +15,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
 	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
 	resource "k8s.io/apimachinery/pkg/api/resource"
@@ -55,7 +56,7 @@ func ValidateKafkaClusterNameIsUnique(name *string, kafkaService services.KafkaS
 func ValidateCloudProvider(kafkaService *services.KafkaService, kafkaRequest *dbapi.KafkaRequest, providerConfig *config.ProviderConfig, action string) handlers.Validate {
 	return func() *errors.ServiceError {
 		// Set Cloud Provider default if not received in the request
-		supportedProviders := providerConfig.ProvidersConfig.SupportedProviders
+		supportedProviders := providerConfig.GetSupportedProviders()
 		if kafkaRequest.CloudProvider == "" {
 			defaultProvider, _ := supportedProviders.GetDefault()
 			kafkaRequest.CloudProvider = defaultProvider.Name
@@ -116,12 +117,11 @@ func ValidateKafkaUserFacingUpdateFields(ctx context.Context, authService author
 			return errors.NewWithCause(errors.ErrorUnauthenticated, claimsErr, "User not authenticated")
 		}
 
-		username := auth.GetUsernameFromClaims(claims)
 		orgId := auth.GetOrgIdFromClaims(claims)
-		isOrgAdmin := auth.GetIsOrgAdminFromClaims(claims)
-		// only Kafka owner or organisation admin is allowed to perform the action
-		isOwner := (isOrgAdmin || kafkaRequest.Owner == username) && kafkaRequest.OrganisationId == orgId
-		if !isOwner {
+		// only the Kafka owner or the members of the organisation with an organisation wide update permission are
+		// allowed to perform the action
+		resource := rbac.Resource{Type: rbac.ResourceKafka, OrganisationId: kafkaRequest.OrganisationId, Owner: kafkaRequest.Owner}
+		if !rbac.Evaluate(ctx, rbac.PermissionUpdate, resource) {
 			return errors.New(errors.ErrorUnauthorized, "User not authorized to perform this action")
 		}
 
@@ -0,0 +1,31 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addRateLimitBuckets() *gormigrate.Migration {
+	type RateLimitBucket struct {
+		Key       string `gorm:"primaryKey"`
+		Tokens    float64
+		UpdatedAt time.Time
+	}
+	return &gormigrate.Migration{
+		ID: "20220415100000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&RateLimitBucket{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			// The rate limit buckets table is shared with the connector service, so it is not dropped on rollback.
+			return nil
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addRoleBindings() *gormigrate.Migration {
+	type RoleBinding struct {
+		ID             string `gorm:"primarykey"`
+		CreatedAt      time.Time
+		UpdatedAt      time.Time
+		DeletedAt      gorm.DeletedAt `gorm:"index"`
+		OrganisationId string         `gorm:"uniqueIndex:idx_role_bindings_organisation_subject,where:deleted_at IS NULL"`
+		Subject        string         `gorm:"uniqueIndex:idx_role_bindings_organisation_subject,where:deleted_at IS NULL"`
+		SubjectType    string
+		Role           string
+		CreatedBy      string
+	}
+	return &gormigrate.Migration{
+		ID: "20220420100000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&RoleBinding{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			// The role bindings table is shared with the connector service, so it is not dropped on rollback.
+			return nil
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addClusterStatusReportedAt() *gormigrate.Migration {
+	type Cluster struct {
+		StatusReportedAt *time.Time
+		AgentVersion     string
+	}
+	return &gormigrate.Migration{
+		ID: "20220425100000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&Cluster{}); err != nil {
+				return err
+			}
+			// the existing clusters are checked from now on, instead of from their last update which may be long gone
+			return tx.Exec(`UPDATE clusters SET status_reported_at = now() WHERE status_reported_at IS NULL`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&Cluster{}, "status_reported_at"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn(&Cluster{}, "agent_version")
+		},
+	}
+}
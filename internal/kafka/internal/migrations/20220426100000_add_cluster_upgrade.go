@@ -0,0 +1,37 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addClusterUpgrade() *gormigrate.Migration {
+	type Cluster struct {
+		OpenshiftVersion     string
+		UpgradeVersion       string
+		UpgradeID            string
+		UpgradeStatusDetails string
+		UpgradeScheduledAt   *time.Time
+	}
+	return &gormigrate.Migration{
+		ID: "20220426100000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Cluster{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"openshift_version", "upgrade_version", "upgrade_id", "upgrade_status_details", "upgrade_scheduled_at"} {
+				if err := tx.Migrator().DropColumn(&Cluster{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
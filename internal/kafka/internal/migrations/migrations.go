@@ -75,6 +75,14 @@ var migrations = []*gormigrate.Migration{
 	addKafkaStorageSize(),
 	addClusterServiceAccountId(),
 	addClusterServiceClientSecret(),
+	addKafkaConditions(),
+	addIdempotencyKeys(),
+	addRateLimitBuckets(),
+	addRoleBindings(),
+	addKafkaCapacity(),
+	addKafkaStatusReportedAt(),
+	addClusterStatusReportedAt(),
+	addClusterUpgrade(),
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
@@ -57,6 +57,8 @@ func PresentKafkaRequestAdminEndpoint(kafkaRequest *dbapi.KafkaRequest, accountS
 		ClusterId:              kafkaRequest.ClusterID,
 		InstanceType:           kafkaRequest.InstanceType,
 		Namespace:              kafkaRequest.Namespace,
+		Conditions:             GetConditionsFromKafkaRequest(kafkaRequest),
+		Capacity:               GetCapacityFromKafkaRequest(kafkaRequest),
 	}, nil
 }
 
@@ -72,3 +74,36 @@ func GetRoutesFromKafkaRequest(kafkaRequest *dbapi.KafkaRequest) []private.Kafka
 		return routes
 	}
 }
+
+func GetConditionsFromKafkaRequest(kafkaRequest *dbapi.KafkaRequest) []private.KafkaCondition {
+	var conditions []private.KafkaCondition
+	kafkaConditions, err := kafkaRequest.GetConditions()
+	if err != nil {
+		return conditions
+	}
+	for _, c := range kafkaConditions {
+		conditions = append(conditions, private.KafkaCondition{
+			Type:               string(c.Type),
+			Status:             string(c.Status),
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return conditions
+}
+
+func GetCapacityFromKafkaRequest(kafkaRequest *dbapi.KafkaRequest) private.KafkaCapacity {
+	capacity, err := kafkaRequest.GetCapacity()
+	if err != nil {
+		return private.KafkaCapacity{}
+	}
+	return private.KafkaCapacity{
+		IngressEgressThroughputPerSec: capacity.IngressEgressThroughputPerSec,
+		TotalMaxConnections:           int32(capacity.TotalMaxConnections),
+		MaxDataRetentionSize:          capacity.MaxDataRetentionSize,
+		MaxPartitions:                 int32(capacity.MaxPartitions),
+		MaxDataRetentionPeriod:        capacity.MaxDataRetentionPeriod,
+		MaxConnectionAttemptsPerSec:   int32(capacity.MaxConnectionAttemptsPerSec),
+	}
+}
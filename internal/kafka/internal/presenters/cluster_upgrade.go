@@ -0,0 +1,41 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+// KindClusterUpgrade is a string identifier for the upgrade of a data plane cluster
+const KindClusterUpgrade = "ClusterUpgrade"
+
+// KindClusterUpgradeList is a string identifier for a list of upgrades of data plane clusters
+const KindClusterUpgradeList = "ClusterUpgradeList"
+
+func PresentClusterUpgrade(cluster *api.Cluster) private.ClusterUpgrade {
+	upgrade := private.ClusterUpgrade{
+		Kind:                 KindClusterUpgrade,
+		Id:                   cluster.ClusterID,
+		CloudProvider:        cluster.CloudProvider,
+		Region:               cluster.Region,
+		Status:               cluster.Status.String(),
+		OpenshiftVersion:     cluster.OpenshiftVersion,
+		UpgradeVersion:       cluster.UpgradeVersion,
+		UpgradeStatusDetails: cluster.UpgradeStatusDetails,
+	}
+	if cluster.UpgradeScheduledAt != nil {
+		upgrade.UpgradeScheduledAt = *cluster.UpgradeScheduledAt
+	}
+	return upgrade
+}
+
+func PresentClusterUpgradeList(clusters []*api.Cluster) private.ClusterUpgradeList {
+	upgrades := private.ClusterUpgradeList{
+		Kind:  KindClusterUpgradeList,
+		Total: int32(len(clusters)),
+		Items: []private.ClusterUpgrade{},
+	}
+	for _, cluster := range clusters {
+		upgrades.Items = append(upgrades.Items, PresentClusterUpgrade(cluster))
+	}
+	return upgrades
+}
@@ -27,6 +27,7 @@ func ConvertDataPlaneClusterStatus(status private.DataPlaneClusterUpdateStatusRe
 		ResizeInfo:               getResizeInfo(status),
 		Remaining:                getRemaining(status),
 		AvailableStrimziVersions: availableStrimziVersions,
+		AgentVersion:             status.Version,
 	}, nil
 }
 
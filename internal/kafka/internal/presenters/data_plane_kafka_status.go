@@ -30,6 +30,7 @@ func ConvertDataPlaneKafkaStatus(status map[string]private.DataPlaneKafkaStatus)
 		r = append(r, &dbapi.DataPlaneKafkaStatus{
 			KafkaClusterId:  k,
 			Conditions:      c,
+			Capacity:        convertDataPlaneKafkaCapacity(v.Capacity),
 			Routes:          routes,
 			KafkaVersion:    v.Versions.Kafka,
 			StrimziVersion:  v.Versions.Strimzi,
@@ -39,3 +40,26 @@ func ConvertDataPlaneKafkaStatus(status map[string]private.DataPlaneKafkaStatus)
 
 	return r
 }
+
+func convertDataPlaneKafkaCapacity(capacity private.DataPlaneKafkaStatusCapacity) dbapi.KafkaCapacity {
+	var c dbapi.KafkaCapacity
+	if capacity.IngressEgressThroughputPerSec != nil {
+		c.IngressEgressThroughputPerSec = *capacity.IngressEgressThroughputPerSec
+	}
+	if capacity.TotalMaxConnections != nil {
+		c.TotalMaxConnections = int(*capacity.TotalMaxConnections)
+	}
+	if capacity.MaxDataRetentionSize != nil {
+		c.MaxDataRetentionSize = *capacity.MaxDataRetentionSize
+	}
+	if capacity.MaxPartitions != nil {
+		c.MaxPartitions = int(*capacity.MaxPartitions)
+	}
+	if capacity.MaxDataRetentionPeriod != nil {
+		c.MaxDataRetentionPeriod = *capacity.MaxDataRetentionPeriod
+	}
+	if capacity.MaxConnectionAttemptsPerSec != nil {
+		c.MaxConnectionAttemptsPerSec = int(*capacity.MaxConnectionAttemptsPerSec)
+	}
+	return c
+}
@@ -54,6 +54,41 @@ func PresentKafkaRequest(kafkaRequest *dbapi.KafkaRequest, browserUrl string) pu
 		ReauthenticationEnabled: kafkaRequest.ReauthenticationEnabled,
 		KafkaStorageSize:        kafkaRequest.KafkaStorageSize,
 		BrowserUrl:              fmt.Sprintf("%s/%s/dashboard", strings.TrimSuffix(browserUrl, "/"), reference.Id),
+		Conditions:              presentKafkaConditions(kafkaRequest),
+		Capacity:                presentKafkaCapacity(kafkaRequest),
+	}
+}
+
+func presentKafkaConditions(kafkaRequest *dbapi.KafkaRequest) []public.KafkaCondition {
+	var conditions []public.KafkaCondition
+	kafkaConditions, err := kafkaRequest.GetConditions()
+	if err != nil {
+		return conditions
+	}
+	for _, c := range kafkaConditions {
+		conditions = append(conditions, public.KafkaCondition{
+			Type:               string(c.Type),
+			Status:             string(c.Status),
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return conditions
+}
+
+func presentKafkaCapacity(kafkaRequest *dbapi.KafkaRequest) public.KafkaCapacity {
+	capacity, err := kafkaRequest.GetCapacity()
+	if err != nil {
+		return public.KafkaCapacity{}
+	}
+	return public.KafkaCapacity{
+		IngressEgressThroughputPerSec: capacity.IngressEgressThroughputPerSec,
+		TotalMaxConnections:           int32(capacity.TotalMaxConnections),
+		MaxDataRetentionSize:          capacity.MaxDataRetentionSize,
+		MaxPartitions:                 int32(capacity.MaxPartitions),
+		MaxDataRetentionPeriod:        capacity.MaxDataRetentionPeriod,
+		MaxConnectionAttemptsPerSec:   int32(capacity.MaxConnectionAttemptsPerSec),
 	}
 }
 
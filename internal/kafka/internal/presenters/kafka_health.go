@@ -0,0 +1,29 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+)
+
+// KindKafkaHealth is a string identifier for the type services.KafkaHealth
+const KindKafkaHealth = "KafkaHealth"
+
+func PresentKafkaHealth(health *services.KafkaHealth) public.KafkaHealth {
+	signals := make([]public.KafkaHealthSignal, 0, len(health.Signals))
+	for _, signal := range health.Signals {
+		signals = append(signals, public.KafkaHealthSignal{
+			Name:    signal.Name,
+			Status:  string(signal.Status),
+			Message: signal.Message,
+			Value:   signal.Value,
+		})
+	}
+
+	return public.KafkaHealth{
+		Id:        health.KafkaId,
+		Kind:      KindKafkaHealth,
+		Status:    string(health.Status),
+		CheckedAt: health.CheckedAt,
+		Signals:   signals,
+	}
+}
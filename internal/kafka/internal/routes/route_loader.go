@@ -9,6 +9,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 
@@ -24,6 +25,8 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	coreHandlers "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/ratelimit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/goava/di"
@@ -43,6 +46,7 @@ type options struct {
 	Kafka                    services.KafkaService
 	CloudProviders           services.CloudProvidersService
 	Observatorium            services.ObservatoriumService
+	KafkaHealth              services.KafkaHealthService
 	Keycloak                 sso.KafkaKeycloakService
 	DataPlaneCluster         services.DataPlaneClusterService
 	DataPlaneKafkaService    services.DataPlaneKafkaService
@@ -51,9 +55,16 @@ type options struct {
 	DB                       *db.ConnectionFactory
 	ClusterPlacementStrategy services.ClusterPlacementStrategy
 	ClusterService           services.ClusterService
+	ClusterUpgradeService    services.ClusterUpgradeService
+	IdempotencyService       idempotency.IdempotencyService
+	RoleBindingService       rbac.RoleBindingService
 
 	AccessControlListMiddleware *acl.AccessControlListMiddleware
+	RateLimitMiddleware         *ratelimit.RateLimitMiddleware
 	AccessControlListConfig     *acl.AccessControlListConfig
+	WorkersHandler              *coreHandlers.WorkersHandler
+	ConfigsHandler              *coreHandlers.ConfigsHandler
+	RoleMiddleware              *rbac.RoleMiddleware
 }
 
 func NewRouteLoader(s options) environments.RouteLoader {
@@ -75,14 +86,20 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	if err != nil {
 		return pkgerrors.Wrapf(err, "can't load OpenAPI specification")
 	}
+	openAPIValidationMiddleware, err := coreHandlers.NewOpenAPIValidationMiddleware(openAPIDefinitions)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "can't create OpenAPI validation middleware")
+	}
 
-	kafkaHandler := handlers.NewKafkaHandler(s.Kafka, s.ProviderConfig, s.AuthService, s.KafkaConfig)
+	kafkaHandler := handlers.NewKafkaHandler(s.Kafka, s.ProviderConfig, s.AuthService, s.KafkaConfig, s.IdempotencyService)
 	cloudProvidersHandler := handlers.NewCloudProviderHandler(s.CloudProviders, s.ProviderConfig, s.Kafka, s.ClusterPlacementStrategy)
 	errorsHandler := coreHandlers.NewErrorsHandler()
-	serviceAccountsHandler := handlers.NewServiceAccountHandler(s.Keycloak)
+	serviceAccountsHandler := handlers.NewServiceAccountHandler(s.Keycloak, s.IdempotencyService)
+	roleBindingsHandler := coreHandlers.NewRoleBindingsHandler(s.RoleBindingService, basePath+"/v1")
 	metricsHandler := handlers.NewMetricsHandler(s.Observatorium)
+	kafkaHealthHandler := handlers.NewKafkaHealthHandler(s.KafkaHealth)
 
-	authorizeMiddleware := s.AccessControlListMiddleware.Authorize
+	authorizeMiddleware := s.AccessControlListMiddleware.Authorize(acl.APIKafkasMgmt)
 	requireOrgID := auth.NewRequireOrgIDMiddleware().RequireOrgID(errors.ErrorUnauthenticated)
 	requireIssuer := auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.ServerConfig.TokenIssuerURL}, errors.ErrorUnauthenticated)
 	requireTermsAcceptance := auth.NewRequireTermsAcceptanceMiddleware().RequireTermsAcceptance(s.ServerConfig.EnableTermsAcceptance, s.AMSClient, errors.ErrorTermsNotAccepted)
@@ -125,9 +142,13 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1KafkasRouter.HandleFunc("", kafkaHandler.List).
 		Name(logger.NewLogEvent("list-kafka", "list all kafkas").ToString()).
 		Methods(http.MethodGet)
+	apiV1KafkasRouter.HandleFunc("/{id}/health", kafkaHealthHandler.Get).
+		Name(logger.NewLogEvent("get-kafka-health", "get the health of a kafka instance").ToString()).
+		Methods(http.MethodGet)
 	apiV1KafkasRouter.Use(requireIssuer)
 	apiV1KafkasRouter.Use(requireOrgID)
 	apiV1KafkasRouter.Use(authorizeMiddleware)
+	apiV1KafkasRouter.Use(s.RoleMiddleware.ResolveRole)
 
 	apiV1KafkasCreateRouter := apiV1KafkasRouter.NewRoute().Subrouter()
 	apiV1KafkasCreateRouter.HandleFunc("", kafkaHandler.Create).Methods(http.MethodPost)
@@ -152,6 +173,7 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1MetricsFederateRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.ServerConfig.TokenIssuerURL, s.Keycloak.GetConfig().KafkaRealm.ValidIssuerURI}, errors.ErrorUnauthenticated))
 	apiV1MetricsFederateRouter.Use(requireOrgID)
 	apiV1MetricsFederateRouter.Use(authorizeMiddleware)
+	apiV1MetricsFederateRouter.Use(s.RoleMiddleware.ResolveRole)
 
 	//  /service_accounts
 	v1Collections = append(v1Collections, api.CollectionMetadata{
@@ -185,6 +207,37 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1ServiceAccountsRouter.Use(requireIssuer)
 	apiV1ServiceAccountsRouter.Use(requireOrgID)
 	apiV1ServiceAccountsRouter.Use(authorizeMiddleware)
+	apiV1ServiceAccountsRouter.Use(s.RoleMiddleware.ResolveRole)
+
+	//  /role_bindings
+	v1Collections = append(v1Collections, api.CollectionMetadata{
+		ID:   "role_bindings",
+		Kind: "RoleBindingList",
+	})
+	apiV1RoleBindingsRouter := apiV1Router.PathPrefix("/role_bindings").Subrouter()
+	apiV1RoleBindingsRouter.HandleFunc("", roleBindingsHandler.List).
+		Name(logger.NewLogEvent("list-role-bindings", "list the role bindings of the organisation").ToString()).
+		Methods(http.MethodGet)
+	apiV1RoleBindingsRouter.HandleFunc("", roleBindingsHandler.Create).
+		Name(logger.NewLogEvent("create-role-binding", "bind a role to a user or a service account").ToString()).
+		Methods(http.MethodPost)
+	apiV1RoleBindingsRouter.HandleFunc("/{id}", roleBindingsHandler.Delete).
+		Name(logger.NewLogEvent("delete-role-binding", "delete a role binding").ToString()).
+		Methods(http.MethodDelete)
+	apiV1RoleBindingsRouter.Use(requireIssuer)
+	apiV1RoleBindingsRouter.Use(requireOrgID)
+	apiV1RoleBindingsRouter.Use(authorizeMiddleware)
+	apiV1RoleBindingsRouter.Use(s.RoleMiddleware.ResolveRole)
+
+	//  /permissions
+	apiV1PermissionsRouter := apiV1Router.Path("/permissions").Subrouter()
+	apiV1PermissionsRouter.HandleFunc("", roleBindingsHandler.GetPermissions).
+		Name(logger.NewLogEvent("get-permissions", "get the permissions of the user").ToString()).
+		Methods(http.MethodGet)
+	apiV1PermissionsRouter.Use(requireIssuer)
+	apiV1PermissionsRouter.Use(requireOrgID)
+	apiV1PermissionsRouter.Use(authorizeMiddleware)
+	apiV1PermissionsRouter.Use(s.RoleMiddleware.ResolveRole)
 
 	//  /cloud_providers
 	v1Collections = append(v1Collections, api.CollectionMetadata{
@@ -211,6 +264,8 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	}
 	apiRouter.HandleFunc("", apiMetadata.ServeHTTP).Methods(http.MethodGet)
 	apiRouter.Use(coreHandlers.MetricsMiddleware)
+	apiRouter.Use(s.RateLimitMiddleware.RateLimit)
+	apiRouter.Use(openAPIValidationMiddleware.Validate)
 	apiRouter.Use(db.TransactionMiddleware(s.DB))
 	apiRouter.Use(gorillaHandlers.CompressHandler)
 
@@ -236,10 +291,12 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	auth.UseOperatorAuthorisationMiddleware(apiV1DataPlaneRequestsRouter, s.Keycloak.GetConfig().KafkaRealm.ValidIssuerURI, "id", s.ClusterService)
 
 	adminKafkaHandler := handlers.NewAdminKafkaHandler(s.Kafka, s.AccountService, s.ProviderConfig)
+	clusterUpgradeHandler := handlers.NewClusterUpgradeHandler(s.ClusterUpgradeService)
 	adminRouter := apiV1Router.PathPrefix("/admin").Subrouter()
 	rolesMapping := map[string][]string{
 		http.MethodGet:    {auth.KasFleetManagerAdminReadRole, auth.KasFleetManagerAdminWriteRole, auth.KasFleetManagerAdminFullRole},
 		http.MethodPatch:  {auth.KasFleetManagerAdminWriteRole, auth.KasFleetManagerAdminFullRole},
+		http.MethodPost:   {auth.KasFleetManagerAdminWriteRole, auth.KasFleetManagerAdminFullRole},
 		http.MethodDelete: {auth.KasFleetManagerAdminFullRole},
 	}
 	adminRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.Keycloak.GetConfig().OSDClusterIDPRealm.ValidIssuerURI}, errors.ErrorNotFound))
@@ -257,6 +314,24 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	adminRouter.HandleFunc("/kafkas/{id}", adminKafkaHandler.Update).
 		Name(logger.NewLogEvent("admin-update-kafka", "[admin] update kafka by id").ToString()).
 		Methods(http.MethodPatch)
+	adminRouter.HandleFunc("/workers", s.WorkersHandler.List).
+		Name(logger.NewLogEvent("admin-list-workers", "[admin] list all workers").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/workers/{type}/reconcile", s.WorkersHandler.Reconcile).
+		Name(logger.NewLogEvent("admin-reconcile-worker", "[admin] trigger a worker reconcile by type").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/configs", s.ConfigsHandler.List).
+		Name(logger.NewLogEvent("admin-list-configs", "[admin] list the checksums of the loaded configurations").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/clusters/upgrades", clusterUpgradeHandler.List).
+		Name(logger.NewLogEvent("admin-list-cluster-upgrades", "[admin] list the scheduled, in progress and failed cluster upgrades").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/clusters/upgrades", clusterUpgradeHandler.Create).
+		Name(logger.NewLogEvent("admin-schedule-cluster-upgrade", "[admin] schedule the openshift upgrade of data plane clusters").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/clusters/{id}/upgrade", clusterUpgradeHandler.Delete).
+		Name(logger.NewLogEvent("admin-cancel-cluster-upgrade", "[admin] cancel the upgrade of a data plane cluster by id").ToString()).
+		Methods(http.MethodDelete)
 
 	return nil
 }
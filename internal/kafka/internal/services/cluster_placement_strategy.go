@@ -1,6 +1,8 @@
 package services
 
 import (
+	"math"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
@@ -14,10 +16,10 @@ type ClusterPlacementStrategy interface {
 }
 
 // NewClusterPlacementStrategy return a concrete strategy impl. depends on the placement configuration
-func NewClusterPlacementStrategy(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig) ClusterPlacementStrategy {
+func NewClusterPlacementStrategy(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig) ClusterPlacementStrategy {
 	var clusterSelection ClusterPlacementStrategy
 	if dataplaneClusterConfig.IsDataPlaneManualScalingEnabled() {
-		clusterSelection = &FirstSchedulableWithinLimit{dataplaneClusterConfig, clusterService}
+		clusterSelection = &FirstSchedulableWithinLimit{dataplaneClusterConfig, clusterService, kafkaConfig}
 	} else {
 		clusterSelection = &FirstReadyCluster{clusterService}
 	}
@@ -46,11 +48,13 @@ func (f *FirstReadyCluster) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster
 	return cluster, nil
 }
 
-// FirstSchedulableWithinLimit finds and returns the first cluster which is schedulable and the number of
-// Kafka clusters associated with it is within the defined limit.
+// FirstSchedulableWithinLimit finds and returns the first cluster which is schedulable and the capacity used by
+// the Kafka clusters associated with it is within the defined limit. The limit is a number of standard Kafka instances,
+// the capacity used by each Kafka is weighted by the capacity reported by the kas-fleetshard-operator, see kafkaCapacityUnits.
 type FirstSchedulableWithinLimit struct {
 	DataplaneClusterConfig *config.DataplaneClusterConfig
 	ClusterService         ClusterService
+	KafkaConfig            *config.KafkaConfig
 }
 
 func (f *FirstSchedulableWithinLimit) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
@@ -68,7 +72,7 @@ func (f *FirstSchedulableWithinLimit) FindCluster(kafka *dbapi.KafkaRequest) (*a
 		return nil, err
 	}
 
-	dataplaneClusterConfig := f.DataplaneClusterConfig.ClusterConfig
+	dataplaneClusterConfig := f.DataplaneClusterConfig.GetClusterConfig()
 
 	//#2 - collect schedulable clusters
 	clusterSchIds := []string{}
@@ -84,7 +88,7 @@ func (f *FirstSchedulableWithinLimit) FindCluster(kafka *dbapi.KafkaRequest) (*a
 	}
 
 	//search for limit
-	clusterWithinLimit, errf := f.findClusterKafkaInstanceCount(clusterSchIds)
+	clusterUsage, errf := f.findClusterCapacityUsage(clusterSchIds)
 	if errf != nil {
 		return nil, errf
 	}
@@ -92,8 +96,9 @@ func (f *FirstSchedulableWithinLimit) FindCluster(kafka *dbapi.KafkaRequest) (*a
 	//#3 which schedulable cluster is also within the limit
 	//we want to make sure the order of the ids configuration is always respected: e.g the first cluster in the configuration that passes all the checks should be picked first
 	for _, schClusterid := range clusterSchIds {
-		cnt := clusterWithinLimit[schClusterid]
-		if dataplaneClusterConfig.IsNumberOfKafkaWithinClusterLimit(schClusterid, cnt+1) {
+		// the new kafka has not reported its capacity yet, so it is accounted for as a standard instance
+		used := roundCapacityUnits(clusterUsage[schClusterid] + 1)
+		if dataplaneClusterConfig.IsNumberOfKafkaWithinClusterLimit(schClusterid, int(math.Ceil(used))) {
 			return searchClusterObjInArray(clusterObj, schClusterid), nil
 		}
 	}
@@ -111,15 +116,63 @@ func searchClusterObjInArray(clusters []*api.Cluster, clusterId string) *api.Clu
 	return nil
 }
 
-// findClusterKafkaInstanceCount searches DB for the number of Kafka instance associated with each OSD Clusters
-func (f *FirstSchedulableWithinLimit) findClusterKafkaInstanceCount(clusterIDs []string) (map[string]int, error) {
-	if instanceLst, err := f.ClusterService.FindKafkaInstanceCount(clusterIDs); err != nil {
-		return nil, errors.Wrapf(err, "failed to found kafka instance count for cluster %s", clusterIDs)
-	} else {
-		clusterWithinLimitMap := make(map[string]int)
-		for _, c := range instanceLst {
-			clusterWithinLimitMap[c.Clusterid] = c.Count
+// findClusterCapacityUsage searches DB for the capacity reported by the Kafka instances associated with each OSD Clusters
+// and returns the number of capacity units used on each of them
+func (f *FirstSchedulableWithinLimit) findClusterCapacityUsage(clusterIDs []string) (map[string]float64, error) {
+	capacities, err := f.ClusterService.FindKafkaInstanceCapacities(clusterIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find kafka instance capacities for cluster %s", clusterIDs)
+	}
+
+	standard := f.KafkaConfig.GetKafkaCapacity()
+	usage := make(map[string]float64)
+	for clusterID, kafkas := range capacities {
+		for _, capacity := range kafkas {
+			usage[clusterID] += kafkaCapacityUnits(capacity, standard)
+		}
+	}
+	return usage, nil
+}
+
+// kafkaCapacityUnits returns the capacity used by a Kafka relative to the capacity of a standard Kafka instance. It is the
+// ratio of the most constrained dimension, a Kafka instance whose capacity has not been reported is a standard instance.
+func kafkaCapacityUnits(capacity dbapi.KafkaCapacity, standard config.KafkaCapacityConfig) float64 {
+	standardCapacity := dbapi.KafkaCapacity{
+		IngressEgressThroughputPerSec: standard.IngressEgressThroughputPerSec,
+		TotalMaxConnections:           standard.TotalMaxConnections,
+		MaxDataRetentionSize:          standard.MaxDataRetentionSize,
+		MaxPartitions:                 standard.MaxPartitions,
+	}
+
+	var ratios []float64
+	if reported, ok := capacity.IngressEgressThroughputBytesPerSec(); ok {
+		if max, ok := standardCapacity.IngressEgressThroughputBytesPerSec(); ok && max > 0 {
+			ratios = append(ratios, float64(reported)/float64(max))
+		}
+	}
+	if capacity.TotalMaxConnections > 0 && standardCapacity.TotalMaxConnections > 0 {
+		ratios = append(ratios, float64(capacity.TotalMaxConnections)/float64(standardCapacity.TotalMaxConnections))
+	}
+	if capacity.MaxPartitions > 0 && standardCapacity.MaxPartitions > 0 {
+		ratios = append(ratios, float64(capacity.MaxPartitions)/float64(standardCapacity.MaxPartitions))
+	}
+	if reported, ok := capacity.MaxDataRetentionSizeBytes(); ok {
+		if max, ok := standardCapacity.MaxDataRetentionSizeBytes(); ok && max > 0 {
+			ratios = append(ratios, float64(reported)/float64(max))
 		}
-		return clusterWithinLimitMap, nil
 	}
+
+	if len(ratios) == 0 {
+		return 1
+	}
+	units := ratios[0]
+	for _, r := range ratios[1:] {
+		units = math.Max(units, r)
+	}
+	return units
+}
+
+// roundCapacityUnits drops the floating point errors accumulated when summing up the capacity units
+func roundCapacityUnits(units float64) float64 {
+	return math.Round(units*1000) / 1000
 }
@@ -11,6 +11,15 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 )
 
+var standardKafkaConfig = &config.KafkaConfig{
+	KafkaCapacity: config.KafkaCapacityConfig{
+		IngressEgressThroughputPerSec: "30Mi",
+		TotalMaxConnections:           3000,
+		MaxDataRetentionSize:          "1000Gi",
+		MaxPartitions:                 1000,
+	},
+}
+
 func TestFirstReadyCluster_FindCluster(t *testing.T) {
 	type fields struct {
 		ClusterService         ClusterService
@@ -100,6 +109,7 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 	type fields struct {
 		DataplaneClusterConfig *config.DataplaneClusterConfig
 		ClusterService         ClusterService
+		KafkaConfig            *config.KafkaConfig
 	}
 	type args struct {
 		kafka *dbapi.KafkaRequest
@@ -114,6 +124,7 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 		{
 			name: "find an available schedule cluster and within limit",
 			fields: fields{
+				KafkaConfig: standardKafkaConfig,
 				DataplaneClusterConfig: &config.DataplaneClusterConfig{
 					DataPlaneClusterScalingType: "manual",
 					ClusterConfig:               config.NewClusterConfig(config.ClusterList{config.ManualCluster{ClusterId: "test01", Schedulable: true, KafkaInstanceLimit: 3}}),
@@ -124,10 +135,8 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 						res = append(res, &api.Cluster{ClusterID: "test01"})
 						return res, nil
 					},
-					FindKafkaInstanceCountFunc: func(clusterIds []string) (res []ResKafkaInstanceCount, error *errors.ServiceError) {
-						var res2 []ResKafkaInstanceCount
-						res2 = append(res2, ResKafkaInstanceCount{Clusterid: "test01", Count: 1})
-						return res2, nil
+					FindKafkaInstanceCapacitiesFunc: func(clusterIds []string) (map[string][]dbapi.KafkaCapacity, *errors.ServiceError) {
+						return map[string][]dbapi.KafkaCapacity{"test01": {{}}}, nil
 					},
 				},
 			},
@@ -140,6 +149,7 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 		{
 			name: "Failed to find an available schedulable cluster as exceeds limit",
 			fields: fields{
+				KafkaConfig: standardKafkaConfig,
 				DataplaneClusterConfig: &config.DataplaneClusterConfig{
 					DataPlaneClusterScalingType: "manual",
 					ClusterConfig:               config.NewClusterConfig(config.ClusterList{config.ManualCluster{ClusterId: "test01", Schedulable: true, KafkaInstanceLimit: 1}}),
@@ -150,10 +160,8 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 						res = append(res, &api.Cluster{ClusterID: "test01"})
 						return res, nil
 					},
-					FindKafkaInstanceCountFunc: func(clusterIds []string) (res []ResKafkaInstanceCount, error *errors.ServiceError) {
-						var res2 []ResKafkaInstanceCount
-						res2 = append(res2, ResKafkaInstanceCount{Clusterid: "test01", Count: 1})
-						return res2, nil
+					FindKafkaInstanceCapacitiesFunc: func(clusterIds []string) (map[string][]dbapi.KafkaCapacity, *errors.ServiceError) {
+						return map[string][]dbapi.KafkaCapacity{"test01": {{}}}, nil
 					},
 				},
 			},
@@ -166,6 +174,7 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 		{
 			name: "Find an available schedulable cluster after one exceeds limit",
 			fields: fields{
+				KafkaConfig: standardKafkaConfig,
 				DataplaneClusterConfig: &config.DataplaneClusterConfig{
 					DataPlaneClusterScalingType: "manual",
 					ClusterConfig: config.NewClusterConfig(config.ClusterList{
@@ -178,11 +187,11 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 						res = append(res, &api.Cluster{ClusterID: "test02"})
 						return res, nil
 					},
-					FindKafkaInstanceCountFunc: func(clusterIds []string) (res []ResKafkaInstanceCount, error *errors.ServiceError) {
-						var res2 []ResKafkaInstanceCount
-						res2 = append(res2, ResKafkaInstanceCount{Clusterid: "test01", Count: 1})
-						res2 = append(res2, ResKafkaInstanceCount{Clusterid: "test02", Count: 1})
-						return res2, nil
+					FindKafkaInstanceCapacitiesFunc: func(clusterIds []string) (map[string][]dbapi.KafkaCapacity, *errors.ServiceError) {
+						return map[string][]dbapi.KafkaCapacity{
+							"test01": {{}},
+							"test02": {{}},
+						}, nil
 					},
 				},
 			},
@@ -192,9 +201,59 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 			want:    &api.Cluster{ClusterID: "test02"},
 			wantErr: false,
 		},
+		{
+			name: "Failed to find an available schedulable cluster as the reported capacity exceeds limit",
+			fields: fields{
+				KafkaConfig: standardKafkaConfig,
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: "manual",
+					ClusterConfig:               config.NewClusterConfig(config.ClusterList{config.ManualCluster{ClusterId: "test01", Schedulable: true, KafkaInstanceLimit: 2}}),
+				},
+				ClusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) (cluster []*api.Cluster, serviceError *errors.ServiceError) {
+						return []*api.Cluster{{ClusterID: "test01"}}, nil
+					},
+					FindKafkaInstanceCapacitiesFunc: func(clusterIds []string) (map[string][]dbapi.KafkaCapacity, *errors.ServiceError) {
+						return map[string][]dbapi.KafkaCapacity{"test01": {{MaxPartitions: 2000, TotalMaxConnections: 3000}}}, nil
+					},
+				},
+			},
+			args: args{
+				kafka: &dbapi.KafkaRequest{},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "Find an available schedulable cluster as the reported capacity is within limit",
+			fields: fields{
+				KafkaConfig: standardKafkaConfig,
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: "manual",
+					ClusterConfig:               config.NewClusterConfig(config.ClusterList{config.ManualCluster{ClusterId: "test01", Schedulable: true, KafkaInstanceLimit: 2}}),
+				},
+				ClusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) (cluster []*api.Cluster, serviceError *errors.ServiceError) {
+						return []*api.Cluster{{ClusterID: "test01"}}, nil
+					},
+					FindKafkaInstanceCapacitiesFunc: func(clusterIds []string) (map[string][]dbapi.KafkaCapacity, *errors.ServiceError) {
+						return map[string][]dbapi.KafkaCapacity{"test01": {
+							{IngressEgressThroughputPerSec: "15Mi", MaxPartitions: 500},
+							{IngressEgressThroughputPerSec: "15Mi", MaxPartitions: 500},
+						}}, nil
+					},
+				},
+			},
+			args: args{
+				kafka: &dbapi.KafkaRequest{},
+			},
+			want:    &api.Cluster{ClusterID: "test01"},
+			wantErr: false,
+		},
 		{
 			name: "Failed to find an available cluster as non is schedulable",
 			fields: fields{
+				KafkaConfig: standardKafkaConfig,
 				DataplaneClusterConfig: &config.DataplaneClusterConfig{
 					DataPlaneClusterScalingType: "manual",
 					ClusterConfig:               config.NewClusterConfig(config.ClusterList{config.ManualCluster{ClusterId: "test01", Schedulable: false, KafkaInstanceLimit: 1}}),
@@ -205,7 +264,7 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 						res = append(res, &api.Cluster{ClusterID: "test01"})
 						return res, nil
 					},
-					FindKafkaInstanceCountFunc: func(clusterIds []string) (res []ResKafkaInstanceCount, error *errors.ServiceError) {
+					FindKafkaInstanceCapacitiesFunc: func(clusterIds []string) (map[string][]dbapi.KafkaCapacity, *errors.ServiceError) {
 						return nil, nil
 					},
 				},
@@ -219,6 +278,7 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 		{
 			name: "Failed to find an available cluster due to error",
 			fields: fields{
+				KafkaConfig: standardKafkaConfig,
 				DataplaneClusterConfig: &config.DataplaneClusterConfig{
 					DataPlaneClusterScalingType: "manual",
 				},
@@ -226,7 +286,7 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 					FindAllClustersFunc: func(criteria FindClusterCriteria) (cluster []*api.Cluster, serviceError *errors.ServiceError) {
 						return nil, errors.NotFound("not found")
 					},
-					FindKafkaInstanceCountFunc: func(clusterIds []string) (res []ResKafkaInstanceCount, error *errors.ServiceError) {
+					FindKafkaInstanceCapacitiesFunc: func(clusterIds []string) (map[string][]dbapi.KafkaCapacity, *errors.ServiceError) {
 						return nil, nil
 					},
 				},
@@ -243,6 +303,7 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 			f := &FirstSchedulableWithinLimit{
 				DataplaneClusterConfig: tt.fields.DataplaneClusterConfig,
 				ClusterService:         tt.fields.ClusterService,
+				KafkaConfig:            tt.fields.KafkaConfig,
 			}
 			got, err := f.FindCluster(tt.args.kafka)
 			if (err != nil) != tt.wantErr {
@@ -255,3 +316,40 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 		})
 	}
 }
+
+func Test_kafkaCapacityUnits(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity dbapi.KafkaCapacity
+		want     float64
+	}{
+		{
+			name:     "a kafka whose capacity is not reported is a standard instance",
+			capacity: dbapi.KafkaCapacity{},
+			want:     1,
+		},
+		{
+			name:     "a kafka with the standard capacity is a standard instance",
+			capacity: dbapi.KafkaCapacity{IngressEgressThroughputPerSec: "30Mi", TotalMaxConnections: 3000, MaxDataRetentionSize: "1000Gi", MaxPartitions: 1000},
+			want:     1,
+		},
+		{
+			name:     "the most constrained dimension is used",
+			capacity: dbapi.KafkaCapacity{IngressEgressThroughputPerSec: "15Mi", MaxPartitions: 1500},
+			want:     1.5,
+		},
+		{
+			name:     "the dimensions that cannot be parsed are ignored",
+			capacity: dbapi.KafkaCapacity{IngressEgressThroughputPerSec: "invalid", MaxDataRetentionSize: "250Gi"},
+			want:     0.25,
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			if got := kafkaCapacityUnits(tt.capacity, standardKafkaConfig.GetKafkaCapacity()); got != tt.want {
+				t.Errorf("kafkaCapacityUnits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
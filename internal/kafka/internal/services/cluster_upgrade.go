@@ -0,0 +1,367 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/blang/semver/v4"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// upgradableClusterStatuses are the statuses of the clusters whose upgrade can be scheduled and started
+var upgradableClusterStatuses = []api.ClusterStatus{api.ClusterReady, api.ClusterFull, api.ClusterUpgradeFailed}
+
+// upgradableClusterProviderTypes are the provider types of the clusters whose OpenShift version is managed by the fleet
+// manager, the other clusters are upgraded by their administrators
+var upgradableClusterProviderTypes = []api.ClusterProviderType{api.ClusterProviderOCM, api.ClusterProviderSimulated}
+
+// ClusterUpgradeRequest selects the clusters to upgrade and the OpenShift version they are upgraded to. The clusters are
+// selected by their ids, or by cloud provider and region when no id is given.
+type ClusterUpgradeRequest struct {
+	OpenshiftVersion string
+	ClusterIDs       []string
+	CloudProvider    string
+	Region           string
+}
+
+//go:generate moq -out cluster_upgrade_moq.go . ClusterUpgradeService
+type ClusterUpgradeService interface {
+	// ScheduleUpgrade schedules the upgrade of the clusters selected by the request, after checking that all the Strimzi
+	// versions available in the clusters are compatible with the requested OpenShift version. The clusters selected by
+	// cloud provider and region are limited to the ones whose provider supports upgrades.
+	ScheduleUpgrade(request ClusterUpgradeRequest) ([]*api.Cluster, *apiErrors.ServiceError)
+	// ListUpgrades returns the clusters whose upgrade is scheduled, in progress, failed or could not be started, in the
+	// order their upgrade was scheduled
+	ListUpgrades() ([]*api.Cluster, *apiErrors.ServiceError)
+	// CancelUpgrade cancels the upgrade of a cluster that has not started yet, or acknowledges the failed upgrade of a
+	// cluster so that it can host new Kafka instances again, or the upgrade that could not be started
+	CancelUpgrade(clusterID string) (*api.Cluster, *apiErrors.ServiceError)
+	// StartUpgrade requests the upgrade of a scheduled cluster to its cluster provider and cordons the cluster. The
+	// schedule is cleared when the upgrade cannot be started, the cluster keeping its status. A cluster whose status
+	// changed meanwhile is cordoned once its status allows it to be upgraded again.
+	StartUpgrade(cluster *api.Cluster) *apiErrors.ServiceError
+	// CheckUpgrade checks the progress of the upgrade of a cluster being upgraded, the cluster is uncordoned once the
+	// upgrade is completed
+	CheckUpgrade(cluster *api.Cluster) *apiErrors.ServiceError
+}
+
+var _ ClusterUpgradeService = &clusterUpgradeService{}
+
+type clusterUpgradeService struct {
+	connectionFactory      *db.ConnectionFactory
+	providerFactory        clusters.ProviderFactory
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+}
+
+func NewClusterUpgradeService(connectionFactory *db.ConnectionFactory, providerFactory clusters.ProviderFactory, dataplaneClusterConfig *config.DataplaneClusterConfig) ClusterUpgradeService {
+	return &clusterUpgradeService{
+		connectionFactory:      connectionFactory,
+		providerFactory:        providerFactory,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+	}
+}
+
+func (c *clusterUpgradeService) ScheduleUpgrade(request ClusterUpgradeRequest) ([]*api.Cluster, *apiErrors.ServiceError) {
+	if _, err := semver.ParseTolerant(request.OpenshiftVersion); err != nil {
+		return nil, apiErrors.Validation("invalid OpenShift version %q", request.OpenshiftVersion)
+	}
+	if len(request.ClusterIDs) == 0 && (request.CloudProvider == "" || request.Region == "") {
+		return nil, apiErrors.Validation("either the cluster ids or the cloud provider and region of the clusters to upgrade must be given")
+	}
+
+	dbConn := c.connectionFactory.New().Where("status IN (?)", upgradableClusterStatuses)
+	if len(request.ClusterIDs) > 0 {
+		dbConn = dbConn.Where("cluster_id IN (?)", request.ClusterIDs)
+	} else {
+		dbConn = dbConn.Where("provider_type IN (?)", upgradableClusterProviderTypes)
+	}
+	if request.CloudProvider != "" {
+		dbConn = dbConn.Where("cloud_provider = ?", request.CloudProvider)
+	}
+	if request.Region != "" {
+		dbConn = dbConn.Where("region = ?", request.Region)
+	}
+
+	var clusters []*api.Cluster
+	if err := dbConn.Order("created_at asc").Find(&clusters).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to find the clusters to upgrade")
+	}
+	if len(clusters) == 0 || (len(request.ClusterIDs) > 0 && len(clusters) != len(request.ClusterIDs)) {
+		return nil, apiErrors.BadRequest("no cluster to upgrade, or some of the requested clusters are not in status '%s', '%s' or '%s'", api.ClusterReady, api.ClusterFull, api.ClusterUpgradeFailed)
+	}
+
+	var ids []string
+	for _, cluster := range clusters {
+		if !isUpgradableClusterProviderType(cluster.ProviderType) {
+			return nil, apiErrors.BadRequest("cluster %s cannot be upgraded: the clusters of the %s provider cannot be upgraded", cluster.ClusterID, cluster.ProviderType)
+		}
+		if compatible, reason, err := c.isUpgradeCompatible(cluster, request.OpenshiftVersion); err != nil {
+			return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to check the compatibility of cluster %s", cluster.ClusterID)
+		} else if !compatible {
+			return nil, apiErrors.BadRequest("cluster %s cannot be upgraded: %s", cluster.ClusterID, reason)
+		}
+		ids = append(ids, cluster.ID)
+	}
+
+	now := time.Now()
+	if err := c.connectionFactory.New().Model(&api.Cluster{}).Where("id IN (?)", ids).Updates(map[string]interface{}{
+		"upgrade_version":        request.OpenshiftVersion,
+		"upgrade_id":             "",
+		"upgrade_status_details": "",
+		"upgrade_scheduled_at":   now,
+	}).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to schedule the upgrade of the clusters")
+	}
+
+	for _, cluster := range clusters {
+		cluster.UpgradeVersion = request.OpenshiftVersion
+		cluster.UpgradeID = ""
+		cluster.UpgradeStatusDetails = ""
+		cluster.UpgradeScheduledAt = &now
+	}
+	return clusters, nil
+}
+
+func (c *clusterUpgradeService) ListUpgrades() ([]*api.Cluster, *apiErrors.ServiceError) {
+	var clusters []*api.Cluster
+	if err := c.connectionFactory.New().
+		Where("upgrade_version <> '' OR upgrade_status_details <> '' OR status = ?", api.ClusterUpgradeFailed).
+		Order("upgrade_scheduled_at asc").
+		Find(&clusters).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list the cluster upgrades")
+	}
+	return clusters, nil
+}
+
+func (c *clusterUpgradeService) CancelUpgrade(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+	var cluster api.Cluster
+	if err := c.connectionFactory.New().Where("cluster_id = ?", clusterID).First(&cluster).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apiErrors.NotFound("cluster %s not found", clusterID)
+		}
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to find cluster %s", clusterID)
+	}
+	if cluster.Status == api.ClusterUpgrading || cluster.UpgradeID != "" {
+		return nil, apiErrors.BadRequest("the upgrade of cluster %s has already started and cannot be cancelled", clusterID)
+	}
+	if cluster.UpgradeVersion == "" && cluster.UpgradeStatusDetails == "" && cluster.Status != api.ClusterUpgradeFailed {
+		return nil, apiErrors.BadRequest("no upgrade is scheduled for cluster %s", clusterID)
+	}
+
+	fields := upgradeFields("")
+	if cluster.Status == api.ClusterUpgradeFailed {
+		// the status reports of the kas fleetshard operator set the cluster full again if needed
+		fields["status"] = api.ClusterReady
+	}
+	if err := c.updateUpgrade(&cluster, fields); err != nil {
+		return nil, err
+	}
+	if cluster.Status == api.ClusterReady {
+		metrics.UpdateClusterStatusSinceCreatedMetric(cluster, api.ClusterReady)
+	}
+	return &cluster, nil
+}
+
+func (c *clusterUpgradeService) StartUpgrade(cluster *api.Cluster) *apiErrors.ServiceError {
+	if !isUpgradableClusterStatus(cluster.Status) {
+		// e.g. the cluster is unresponsive, its upgrade starts once it is back
+		return nil
+	}
+	if cluster.UpgradeID != "" {
+		// the upgrade was requested but the status of the cluster changed before it could be cordoned
+		return c.cordonUpgrade(cluster)
+	}
+
+	compatible, reason, err := c.isUpgradeCompatible(cluster, cluster.UpgradeVersion)
+	if err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to check the compatibility of cluster %s", cluster.ClusterID)
+	}
+	if !compatible {
+		return c.rejectUpgrade(cluster, reason)
+	}
+
+	p, err := c.providerFactory.GetProvider(cluster.ProviderType)
+	if err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get provider implementation")
+	}
+	upgrade, err := p.UpgradeCluster(buildClusterSpec(cluster), cluster.UpgradeVersion)
+	if errors.Is(err, types.ErrClusterUpgradeNotSupported) {
+		return c.rejectUpgrade(cluster, fmt.Sprintf("the clusters of the %s provider cannot be upgraded", cluster.ProviderType))
+	}
+	if err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to upgrade cluster %s", cluster.ClusterID)
+	}
+	if upgrade.State == types.ClusterUpgradeFailed {
+		return c.rejectUpgrade(cluster, upgrade.StateDetails)
+	}
+
+	// the upgrade is recorded first so that it is not requested again when the cluster cannot be cordoned yet
+	if err := c.updateUpgrade(cluster, map[string]interface{}{"upgrade_id": upgrade.ID}); err != nil {
+		return err
+	}
+	return c.cordonUpgrade(cluster)
+}
+
+// cordonUpgrade sets the cluster whose upgrade was requested upgrading. The cluster is only cordoned if its status still
+// allows it to be upgraded, e.g. it may have been set unresponsive since it was read: it is then cordoned once its status
+// allows it again.
+func (c *clusterUpgradeService) cordonUpgrade(cluster *api.Cluster) *apiErrors.ServiceError {
+	result := c.connectionFactory.New().Model(&api.Cluster{}).
+		Where("id = ? AND status IN (?)", cluster.ID, upgradableClusterStatuses).
+		Updates(map[string]interface{}{
+			"status":                 api.ClusterUpgrading,
+			"upgrade_status_details": "",
+		})
+	if result.Error != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, result.Error, "failed to cordon cluster %s", cluster.ClusterID)
+	}
+	if result.RowsAffected == 0 {
+		glog.Infof("cluster %s can no longer be upgraded, it is cordoned once its status allows it again", cluster.ClusterID)
+		return nil
+	}
+
+	cluster.Status = api.ClusterUpgrading
+	cluster.UpgradeStatusDetails = ""
+	metrics.UpdateClusterStatusSinceCreatedMetric(*cluster, api.ClusterUpgrading)
+	logger.NewEventLogger(constants2.ClusterUpgradeStartedEvent).Infof("upgrade of cluster %s to OpenShift version %s started", cluster.ClusterID, cluster.UpgradeVersion)
+	return nil
+}
+
+func (c *clusterUpgradeService) CheckUpgrade(cluster *api.Cluster) *apiErrors.ServiceError {
+	p, err := c.providerFactory.GetProvider(cluster.ProviderType)
+	if err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get provider implementation")
+	}
+	upgrade, err := p.CheckClusterUpgradeStatus(buildClusterSpec(cluster), &types.ClusterUpgrade{
+		ID:      cluster.UpgradeID,
+		Version: cluster.UpgradeVersion,
+	})
+	if err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to check the upgrade of cluster %s", cluster.ClusterID)
+	}
+
+	switch upgrade.State {
+	case types.ClusterUpgradeCompleted:
+		version := cluster.UpgradeVersion
+		fields := upgradeFields("")
+		fields["status"] = api.ClusterReady
+		fields["openshift_version"] = version
+		if err := c.updateUpgrade(cluster, fields); err != nil {
+			return err
+		}
+		metrics.UpdateClusterStatusSinceCreatedMetric(*cluster, api.ClusterReady)
+		logger.NewEventLogger(constants2.ClusterUpgradeCompletedEvent).Infof("cluster %s upgraded to OpenShift version %s", cluster.ClusterID, version)
+	case types.ClusterUpgradeFailed:
+		return c.failUpgrade(cluster, upgrade.StateDetails)
+	}
+	return nil
+}
+
+// rejectUpgrade clears the schedule of an upgrade that could not be started and records the reason, the cluster keeping
+// its status as it was not cordoned yet
+func (c *clusterUpgradeService) rejectUpgrade(cluster *api.Cluster, reason string) *apiErrors.ServiceError {
+	details := fmt.Sprintf("upgrade to OpenShift version %s could not be started: %s", cluster.UpgradeVersion, reason)
+	if err := c.updateUpgrade(cluster, upgradeFields(details)); err != nil {
+		return err
+	}
+	logger.NewEventLogger(constants2.ClusterUpgradeFailedEvent).Warningf("cluster %s: %s", cluster.ClusterID, details)
+	return nil
+}
+
+// failUpgrade keeps the cluster cordoned and records the reason of the failure, the cluster is uncordoned when the
+// upgrade is cancelled or scheduled and completed again
+func (c *clusterUpgradeService) failUpgrade(cluster *api.Cluster, reason string) *apiErrors.ServiceError {
+	details := fmt.Sprintf("upgrade to OpenShift version %s failed: %s", cluster.UpgradeVersion, reason)
+	fields := upgradeFields(details)
+	fields["status"] = api.ClusterUpgradeFailed
+	if err := c.updateUpgrade(cluster, fields); err != nil {
+		return err
+	}
+	metrics.UpdateClusterStatusSinceCreatedMetric(*cluster, api.ClusterUpgradeFailed)
+	logger.NewEventLogger(constants2.ClusterUpgradeFailedEvent).Warningf("cluster %s: %s", cluster.ClusterID, details)
+	return nil
+}
+
+// upgradeFields returns the fields resetting the upgrade of a cluster. They are updated with a map as the zero values
+// of the cluster fields are ignored by gorm updates.
+func upgradeFields(statusDetails string) map[string]interface{} {
+	return map[string]interface{}{
+		"upgrade_version":        "",
+		"upgrade_id":             "",
+		"upgrade_status_details": statusDetails,
+		"upgrade_scheduled_at":   nil,
+	}
+}
+
+// updateUpgrade updates the fields of the cluster in the database and in memory
+func (c *clusterUpgradeService) updateUpgrade(cluster *api.Cluster, fields map[string]interface{}) *apiErrors.ServiceError {
+	if err := c.connectionFactory.New().Model(&api.Cluster{}).Where("id = ?", cluster.ID).Updates(fields).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update the upgrade of cluster %s", cluster.ClusterID)
+	}
+
+	for field, value := range fields {
+		switch field {
+		case "status":
+			cluster.Status = value.(api.ClusterStatus)
+		case "openshift_version":
+			cluster.OpenshiftVersion = value.(string)
+		case "upgrade_version":
+			cluster.UpgradeVersion = value.(string)
+		case "upgrade_id":
+			cluster.UpgradeID = value.(string)
+		case "upgrade_status_details":
+			cluster.UpgradeStatusDetails = value.(string)
+		case "upgrade_scheduled_at":
+			cluster.UpgradeScheduledAt = nil
+		}
+	}
+	return nil
+}
+
+// isUpgradeCompatible checks that all the Strimzi versions available in the cluster can run on the OpenShift version,
+// returning the reason of the incompatibility
+func (c *clusterUpgradeService) isUpgradeCompatible(cluster *api.Cluster, openshiftVersion string) (bool, string, error) {
+	strimziVersions, err := cluster.GetAvailableStrimziVersions()
+	if err != nil {
+		return false, "", err
+	}
+	for _, strimziVersion := range strimziVersions {
+		compatible, err := c.dataplaneClusterConfig.IsOpenshiftVersionCompatible(strimziVersion.Version, openshiftVersion)
+		if err != nil {
+			return false, "", err
+		}
+		if !compatible {
+			return false, fmt.Sprintf("Strimzi version %s is not compatible with OpenShift version %s", strimziVersion.Version, openshiftVersion), nil
+		}
+	}
+	return true, "", nil
+}
+
+func isUpgradableClusterProviderType(providerType api.ClusterProviderType) bool {
+	for _, p := range upgradableClusterProviderTypes {
+		if p == providerType {
+			return true
+		}
+	}
+	return false
+}
+
+func isUpgradableClusterStatus(status api.ClusterStatus) bool {
+	for _, s := range upgradableClusterStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,389 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+const testUpgradeVersion = "4.10.3"
+
+func buildUpgradeCluster(status api.ClusterStatus, strimziVersions ...string) *api.Cluster {
+	cluster := &api.Cluster{
+		Meta:           api.Meta{ID: "id"},
+		ClusterID:      "cluster-id",
+		Status:         status,
+		ProviderType:   api.ClusterProviderOCM,
+		UpgradeVersion: testUpgradeVersion,
+	}
+	var versions []api.StrimziVersion
+	for _, v := range strimziVersions {
+		versions = append(versions, api.StrimziVersion{Version: v, Ready: true})
+	}
+	_ = cluster.SetAvailableStrimziVersions(versions)
+	return cluster
+}
+
+func buildUpgradeDataplaneClusterConfig() *config.DataplaneClusterConfig {
+	conf := config.NewDataplaneClusterConfig()
+	conf.StrimziOpenshiftCompatibility = []config.StrimziOpenshiftCompatibility{
+		{StrimziVersion: "strimzi-cluster-operator.v0.24.0-0", OpenshiftVersions: ">=4.8.0 <4.11.0"},
+		{StrimziVersion: "strimzi-cluster-operator.v0.23.0-0", OpenshiftVersions: ">=4.7.0 <4.10.0"},
+	}
+	return conf
+}
+
+func buildUpgradeProviderFactory(provider clusters.Provider) clusters.ProviderFactory {
+	return &clusters.ProviderFactoryMock{
+		GetProviderFunc: func(providerType api.ClusterProviderType) (clusters.Provider, error) {
+			return provider, nil
+		},
+	}
+}
+
+func Test_clusterUpgradeService_ScheduleUpgrade(t *testing.T) {
+	tests := []struct {
+		name    string
+		request ClusterUpgradeRequest
+		setupFn func()
+		want    int
+		wantErr bool
+	}{
+		{
+			name:    "error when the OpenShift version is invalid",
+			request: ClusterUpgradeRequest{OpenshiftVersion: "latest", ClusterIDs: []string{"cluster-id"}},
+			wantErr: true,
+		},
+		{
+			name:    "error when no cluster is selected",
+			request: ClusterUpgradeRequest{OpenshiftVersion: testUpgradeVersion, CloudProvider: "aws"},
+			wantErr: true,
+		},
+		{
+			name:    "error when a requested cluster cannot be upgraded",
+			request: ClusterUpgradeRequest{OpenshiftVersion: testUpgradeVersion, ClusterIDs: []string{"cluster-id", "other-cluster-id"}},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).WithReply(upgradeClusterReply(buildUpgradeCluster(api.ClusterReady)))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "error when a requested cluster is not managed by a provider supporting upgrades",
+			request: ClusterUpgradeRequest{OpenshiftVersion: testUpgradeVersion, ClusterIDs: []string{"cluster-id"}},
+			setupFn: func() {
+				cluster := buildUpgradeCluster(api.ClusterReady, "strimzi-cluster-operator.v0.24.0-0")
+				cluster.ProviderType = api.ClusterProviderStandalone
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).WithReply(upgradeClusterReply(cluster))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "selects the clusters of the region whose provider supports upgrades",
+			request: ClusterUpgradeRequest{OpenshiftVersion: testUpgradeVersion, CloudProvider: "aws", Region: "us-east-1"},
+			setupFn: func() {
+				cluster := buildUpgradeCluster(api.ClusterReady, "strimzi-cluster-operator.v0.24.0-0")
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "clusters" WHERE status IN ($1,$2,$3) AND provider_type IN ($4,$5) AND cloud_provider = $6 AND region = $7`).
+					WithArgs(api.ClusterReady.String(), api.ClusterFull.String(), api.ClusterUpgradeFailed.String(), api.ClusterProviderOCM.String(), api.ClusterProviderSimulated.String(), "aws", "us-east-1").
+					WithReply(upgradeClusterReply(cluster))
+			},
+			want: 1,
+		},
+		{
+			name:    "error when a Strimzi version of a cluster is not compatible",
+			request: ClusterUpgradeRequest{OpenshiftVersion: testUpgradeVersion, CloudProvider: "aws", Region: "us-east-1"},
+			setupFn: func() {
+				cluster := buildUpgradeCluster(api.ClusterReady, "strimzi-cluster-operator.v0.23.0-0", "strimzi-cluster-operator.v0.24.0-0")
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).WithReply(upgradeClusterReply(cluster))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "schedules the upgrade of the compatible clusters",
+			request: ClusterUpgradeRequest{OpenshiftVersion: testUpgradeVersion, ClusterIDs: []string{"cluster-id"}},
+			setupFn: func() {
+				cluster := buildUpgradeCluster(api.ClusterFull, "strimzi-cluster-operator.v0.24.0-0")
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).WithReply(upgradeClusterReply(cluster))
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			s := NewClusterUpgradeService(db.NewMockConnectionFactory(nil), nil, buildUpgradeDataplaneClusterConfig())
+			got, err := s.ScheduleUpgrade(tt.request)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(got).To(HaveLen(tt.want))
+			for _, cluster := range got {
+				Expect(cluster.UpgradeVersion).To(Equal(testUpgradeVersion))
+				Expect(cluster.UpgradeScheduledAt).NotTo(BeNil())
+			}
+		})
+	}
+}
+
+func upgradeClusterReply(cluster *api.Cluster) []map[string]interface{} {
+	strimziVersions, _ := json.Marshal(cluster.AvailableStrimziVersions)
+	return []map[string]interface{}{
+		{
+			"id":                         cluster.ID,
+			"cluster_id":                 cluster.ClusterID,
+			"status":                     cluster.Status,
+			"provider_type":              cluster.ProviderType.String(),
+			"available_strimzi_versions": strimziVersions,
+		},
+	}
+}
+
+func Test_clusterUpgradeService_StartUpgrade(t *testing.T) {
+	cordonQuery := `UPDATE "clusters" SET "status"=$1,"upgrade_status_details"=$2,"updated_at"=$3 WHERE id = $4 AND status IN ($5,$6,$7)`
+	requestedCluster := buildUpgradeCluster(api.ClusterFull)
+	requestedCluster.UpgradeID = "upgrade-id"
+
+	tests := []struct {
+		name        string
+		cluster     *api.Cluster
+		provider    *clusters.ProviderMock
+		setupFn     func()
+		wantStatus  api.ClusterStatus
+		wantUpgrade string
+		wantReject  bool
+		wantErr     bool
+	}{
+		{
+			name:    "starts the upgrade and cordons the cluster",
+			cluster: buildUpgradeCluster(api.ClusterReady, "strimzi-cluster-operator.v0.24.0-0"),
+			provider: &clusters.ProviderMock{
+				UpgradeClusterFunc: func(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error) {
+					return &types.ClusterUpgrade{ID: "upgrade-id", Version: version, State: types.ClusterUpgradePending}, nil
+				},
+			},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(cordonQuery).WithRowsNum(1)
+			},
+			wantStatus:  api.ClusterUpgrading,
+			wantUpgrade: "upgrade-id",
+		},
+		{
+			name:    "records the upgrade without cordoning the cluster when its status changed meanwhile",
+			cluster: buildUpgradeCluster(api.ClusterReady, "strimzi-cluster-operator.v0.24.0-0"),
+			provider: &clusters.ProviderMock{
+				UpgradeClusterFunc: func(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error) {
+					return &types.ClusterUpgrade{ID: "upgrade-id", Version: version, State: types.ClusterUpgradePending}, nil
+				},
+			},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(cordonQuery).WithRowsNum(0)
+			},
+			wantStatus:  api.ClusterReady,
+			wantUpgrade: "upgrade-id",
+		},
+		{
+			name:     "cordons the cluster whose upgrade was already requested without requesting it again",
+			cluster:  requestedCluster,
+			provider: &clusters.ProviderMock{},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(cordonQuery).WithRowsNum(1)
+			},
+			wantStatus:  api.ClusterUpgrading,
+			wantUpgrade: "upgrade-id",
+		},
+		{
+			name:       "waits for the unresponsive clusters",
+			cluster:    buildUpgradeCluster(api.ClusterUnresponsive),
+			provider:   &clusters.ProviderMock{},
+			wantStatus: api.ClusterUnresponsive,
+		},
+		{
+			name:       "rejects the upgrade without cordoning the cluster when a Strimzi version is not compatible",
+			cluster:    buildUpgradeCluster(api.ClusterReady, "strimzi-cluster-operator.v0.23.0-0"),
+			provider:   &clusters.ProviderMock{},
+			wantStatus: api.ClusterReady,
+			wantReject: true,
+		},
+		{
+			name:    "rejects the upgrade when the provider does not support upgrades",
+			cluster: buildUpgradeCluster(api.ClusterReady),
+			provider: &clusters.ProviderMock{
+				UpgradeClusterFunc: func(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error) {
+					return nil, types.ErrClusterUpgradeNotSupported
+				},
+			},
+			wantStatus: api.ClusterReady,
+			wantReject: true,
+		},
+		{
+			name:    "rejects the upgrade when the provider refuses it",
+			cluster: buildUpgradeCluster(api.ClusterFull),
+			provider: &clusters.ProviderMock{
+				UpgradeClusterFunc: func(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error) {
+					return &types.ClusterUpgrade{Version: version, State: types.ClusterUpgradeFailed, StateDetails: "not an available upgrade"}, nil
+				},
+			},
+			wantStatus: api.ClusterFull,
+			wantReject: true,
+		},
+		{
+			name:    "returns the provider errors to retry the upgrade",
+			cluster: buildUpgradeCluster(api.ClusterReady),
+			provider: &clusters.ProviderMock{
+				UpgradeClusterFunc: func(clusterSpec *types.ClusterSpec, version string) (*types.ClusterUpgrade, error) {
+					return nil, errors.New("test")
+				},
+			},
+			wantStatus: api.ClusterReady,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			mocket.Catcher.Reset()
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			s := NewClusterUpgradeService(db.NewMockConnectionFactory(nil), buildUpgradeProviderFactory(tt.provider), buildUpgradeDataplaneClusterConfig())
+			err := s.StartUpgrade(tt.cluster)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(tt.cluster.Status).To(Equal(tt.wantStatus))
+			Expect(tt.cluster.UpgradeID).To(Equal(tt.wantUpgrade))
+			if tt.wantReject {
+				Expect(tt.cluster.UpgradeVersion).To(BeEmpty())
+				Expect(tt.cluster.UpgradeScheduledAt).To(BeNil())
+				Expect(tt.cluster.UpgradeStatusDetails).NotTo(BeEmpty())
+			}
+		})
+	}
+}
+
+func Test_clusterUpgradeService_CheckUpgrade(t *testing.T) {
+	tests := []struct {
+		name                 string
+		state                types.ClusterUpgradeState
+		wantStatus           api.ClusterStatus
+		wantOpenshiftVersion string
+	}{
+		{
+			name:       "keeps the cluster cordoned while the upgrade is in progress",
+			state:      types.ClusterUpgradeStarted,
+			wantStatus: api.ClusterUpgrading,
+		},
+		{
+			name:                 "uncordons the cluster once the upgrade is completed",
+			state:                types.ClusterUpgradeCompleted,
+			wantStatus:           api.ClusterReady,
+			wantOpenshiftVersion: testUpgradeVersion,
+		},
+		{
+			name:       "keeps the cluster cordoned when the upgrade failed",
+			state:      types.ClusterUpgradeFailed,
+			wantStatus: api.ClusterUpgradeFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			mocket.Catcher.Reset()
+			cluster := buildUpgradeCluster(api.ClusterUpgrading)
+			cluster.UpgradeID = "upgrade-id"
+			provider := &clusters.ProviderMock{
+				CheckClusterUpgradeStatusFunc: func(clusterSpec *types.ClusterSpec, upgrade *types.ClusterUpgrade) (*types.ClusterUpgrade, error) {
+					Expect(upgrade.ID).To(Equal("upgrade-id"))
+					Expect(upgrade.Version).To(Equal(testUpgradeVersion))
+					return &types.ClusterUpgrade{ID: upgrade.ID, Version: upgrade.Version, State: tt.state}, nil
+				},
+			}
+			s := NewClusterUpgradeService(db.NewMockConnectionFactory(nil), buildUpgradeProviderFactory(provider), buildUpgradeDataplaneClusterConfig())
+			Expect(s.CheckUpgrade(cluster)).To(BeNil())
+			Expect(cluster.Status).To(Equal(tt.wantStatus))
+			Expect(cluster.OpenshiftVersion).To(Equal(tt.wantOpenshiftVersion))
+		})
+	}
+}
+
+func Test_clusterUpgradeService_CancelUpgrade(t *testing.T) {
+	tests := []struct {
+		name       string
+		cluster    *api.Cluster
+		wantStatus api.ClusterStatus
+		wantErr    bool
+	}{
+		{
+			name:       "cancels a scheduled upgrade",
+			cluster:    buildUpgradeCluster(api.ClusterFull),
+			wantStatus: api.ClusterFull,
+		},
+		{
+			name: "uncordons a cluster whose upgrade failed",
+			cluster: func() *api.Cluster {
+				cluster := buildUpgradeCluster(api.ClusterUpgradeFailed)
+				cluster.UpgradeVersion = ""
+				return cluster
+			}(),
+			wantStatus: api.ClusterReady,
+		},
+		{
+			name: "acknowledges an upgrade that could not be started",
+			cluster: func() *api.Cluster {
+				cluster := buildUpgradeCluster(api.ClusterFull)
+				cluster.UpgradeVersion = ""
+				cluster.UpgradeStatusDetails = "upgrade to OpenShift version 4.10.3 could not be started"
+				return cluster
+			}(),
+			wantStatus: api.ClusterFull,
+		},
+		{
+			name: "error when no upgrade is scheduled",
+			cluster: func() *api.Cluster {
+				cluster := buildUpgradeCluster(api.ClusterReady)
+				cluster.UpgradeVersion = ""
+				return cluster
+			}(),
+			wantErr: true,
+		},
+		{
+			name:    "error when the upgrade has started",
+			cluster: buildUpgradeCluster(api.ClusterUpgrading),
+			wantErr: true,
+		},
+		{
+			name: "error when the upgrade was requested but the cluster is not cordoned yet",
+			cluster: func() *api.Cluster {
+				cluster := buildUpgradeCluster(api.ClusterUnresponsive)
+				cluster.UpgradeID = "upgrade-id"
+				return cluster
+			}(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			reply := upgradeClusterReply(tt.cluster)
+			reply[0]["upgrade_version"] = tt.cluster.UpgradeVersion
+			reply[0]["upgrade_status_details"] = tt.cluster.UpgradeStatusDetails
+			reply[0]["upgrade_id"] = tt.cluster.UpgradeID
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters"`).WithReply(reply)
+			s := NewClusterUpgradeService(db.NewMockConnectionFactory(nil), nil, buildUpgradeDataplaneClusterConfig())
+			got, err := s.CancelUpgrade(tt.cluster.ClusterID)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			if !tt.wantErr {
+				Expect(got.Status).To(Equal(tt.wantStatus))
+				Expect(got.UpgradeVersion).To(BeEmpty())
+				Expect(got.UpgradeStatusDetails).To(BeEmpty())
+			}
+		})
+	}
+}
@@ -28,9 +28,15 @@ type ClusterService interface {
 	ListByStatus(state api.ClusterStatus) ([]api.Cluster, *apiErrors.ServiceError)
 	UpdateStatus(cluster api.Cluster, status api.ClusterStatus) error
 	UpdateStatusAndClient(cluster api.Cluster, status api.ClusterStatus, serviceClientId string, serviceClientSecret string) error
+	// UpdateStatusIfUnchanged updates the status of the cluster only if it is still the status of the given cluster, e.g.
+	// the status computed from a status report does not overwrite the status of a cluster whose upgrade started
+	// meanwhile. It returns false when the status was changed since the cluster was read.
+	UpdateStatusIfUnchanged(cluster api.Cluster, status api.ClusterStatus) (bool, error)
 	// Update updates a Cluster. Only fields whose value is different than the
 	// zero-value of their corresponding type will be updated
 	Update(cluster api.Cluster) *apiErrors.ServiceError
+	// FindCluster returns the first cluster matching the criteria. The clusters that cannot host new Kafka instances,
+	// i.e. unresponsive clusters and clusters being upgraded or whose upgrade failed, are never returned
 	FindCluster(criteria FindClusterCriteria) (*api.Cluster, *apiErrors.ServiceError)
 	// FindClusterByID returns the cluster corresponding to the provided clusterID.
 	// If the cluster has not been found nil is returned. If there has been an issue
@@ -54,6 +60,9 @@ type ClusterService interface {
 	FindAllClusters(criteria FindClusterCriteria) ([]*api.Cluster, *apiErrors.ServiceError)
 	// FindKafkaInstanceCount returns the kafka instance counts associated with the list of clusters. If the list is empty, it will list all clusterIds that have Kafka instances assigned.
 	FindKafkaInstanceCount(clusterIDs []string) ([]ResKafkaInstanceCount, *apiErrors.ServiceError)
+	// FindKafkaInstanceCapacities returns the capacity reported for each kafka instance assigned to the given clusters, indexed by cluster id.
+	// The capacity of the kafka instances that have not been reported yet is empty.
+	FindKafkaInstanceCapacities(clusterIDs []string) (map[string][]dbapi.KafkaCapacity, *apiErrors.ServiceError)
 	// UpdateMultiClusterStatus updates a list of clusters' status to a status
 	UpdateMultiClusterStatus(clusterIds []string, status api.ClusterStatus) *apiErrors.ServiceError
 	// CountByStatus returns the count of clusters for each given status in the database
@@ -234,6 +243,33 @@ func (c clusterService) UpdateStatusAndClient(cluster api.Cluster, status api.Cl
 	return nil
 }
 
+func (c clusterService) UpdateStatusIfUnchanged(cluster api.Cluster, status api.ClusterStatus) (bool, error) {
+	if status.String() == "" {
+		return false, apiErrors.Validation("status is undefined")
+	}
+	if cluster.ID == "" {
+		return false, apiErrors.Validation("id is undefined")
+	}
+
+	if status == api.ClusterReady || status == api.ClusterFailed {
+		metrics.IncreaseClusterTotalOperationsCountMetric(constants2.ClusterOperationCreate)
+	}
+
+	result := c.connectionFactory.New().Model(&api.Cluster{}).Where("id = ? AND status = ?", cluster.ID, cluster.Status).Update("status", status)
+	if result.Error != nil {
+		return false, apiErrors.NewWithCause(apiErrors.ErrorGeneral, result.Error, "failed to update cluster status")
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	if status == api.ClusterReady {
+		metrics.IncreaseClusterSuccessOperationsCountMetric(constants2.ClusterOperationCreate)
+	}
+
+	return true, nil
+}
+
 type ResGroupCPRegion struct {
 	Provider string
 	Region   string
@@ -286,6 +322,10 @@ func (c clusterService) FindCluster(criteria FindClusterCriteria) (*api.Cluster,
 		dbConn = dbConn.Where("supported_instance_type like ?", fmt.Sprintf("%%%s%%", criteria.SupportedInstanceType))
 	}
 
+	// unresponsive clusters cannot host new kafkas until their kas fleetshard operator reports their status again, and
+	// the clusters being upgraded are cordoned until the upgrade is completed
+	dbConn = dbConn.Where("status NOT IN (?)", []api.ClusterStatus{api.ClusterUnresponsive, api.ClusterUpgrading, api.ClusterUpgradeFailed})
+
 	// we order them by "created_at" field instead of the default "id" field.
 	// They are mostly the same as the library we use (xid) does take the generation timestamp into consideration,
 	// However, it only down to the level of seconds. This means that if a few records are created at almost the same time,
@@ -534,6 +574,27 @@ func (c clusterService) FindKafkaInstanceCount(clusterIDs []string) ([]ResKafkaI
 	return res, nil
 }
 
+func (c clusterService) FindKafkaInstanceCapacities(clusterIDs []string) (map[string][]dbapi.KafkaCapacity, *apiErrors.ServiceError) {
+	var kafkas []*dbapi.KafkaRequest
+	if err := c.connectionFactory.New().
+		Select("id", "cluster_id", "capacity").
+		Where("cluster_id in (?)", clusterIDs).
+		Find(&kafkas).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to query kafka capacities by cluster info")
+	}
+
+	res := map[string][]dbapi.KafkaCapacity{}
+	for _, kafka := range kafkas {
+		capacity, err := kafka.GetCapacity()
+		if err != nil {
+			// the kafka is accounted for as if its capacity was not reported
+			glog.Warningf("failed to read capacity of kafka %s: %v", kafka.ID, err)
+		}
+		res[kafka.ClusterID] = append(res[kafka.ClusterID], capacity)
+	}
+	return res, nil
+}
+
 func (c clusterService) FindAllClusters(criteria FindClusterCriteria) ([]*api.Cluster, *apiErrors.ServiceError) {
 	dbConn := c.connectionFactory.New().
 		Model(&api.Cluster{})
@@ -712,6 +712,79 @@ func Test_UpdateStatus(t *testing.T) {
 	}
 }
 
+func Test_UpdateStatusIfUnchanged(t *testing.T) {
+	cluster := api.Cluster{Meta: api.Meta{ID: testID}, Status: api.ClusterReady}
+	tests := []struct {
+		name        string
+		cluster     api.Cluster
+		status      api.ClusterStatus
+		setupFn     func()
+		wantUpdated bool
+		wantErr     bool
+	}{
+		{
+			name:    "error when status is undefined",
+			cluster: cluster,
+			status:  "",
+			wantErr: true,
+		},
+		{
+			name:    "error when id is undefined",
+			cluster: api.Cluster{ClusterID: testID, Status: api.ClusterReady},
+			status:  api.ClusterFull,
+			wantErr: true,
+		},
+		{
+			name:    "fail: database returns an error",
+			cluster: cluster,
+			status:  api.ClusterFull,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("UPDATE").WithExecException()
+			},
+			wantErr: true,
+		},
+		{
+			name:    "update the status when it is still the status of the cluster",
+			cluster: cluster,
+			status:  api.ClusterFull,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`UPDATE "clusters" SET "status"=$1,"updated_at"=$2 WHERE id = $3 AND status = $4`).
+					WithRowsNum(1)
+			},
+			wantUpdated: true,
+		},
+		{
+			name:    "do not update the status when it was changed meanwhile",
+			cluster: cluster,
+			status:  api.ClusterFull,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters"`).WithRowsNum(0)
+			},
+			wantUpdated: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			k := &clusterService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			updated, err := k.UpdateStatusIfUnchanged(tt.cluster, tt.status)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateStatusIfUnchanged() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if updated != tt.wantUpdated {
+				t.Errorf("UpdateStatusIfUnchanged() updated = %v, want %v", updated, tt.wantUpdated)
+			}
+		})
+	}
+}
+
 func Test_RegisterClusterJob(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
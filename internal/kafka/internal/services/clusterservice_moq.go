@@ -4,6 +4,7 @@
 package services
 
 import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
@@ -54,6 +55,9 @@ var _ ClusterService = &ClusterServiceMock{}
 // 			FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *serviceError.ServiceError) {
 // 				panic("mock out the FindClusterByID method")
 // 			},
+// 			FindKafkaInstanceCapacitiesFunc: func(clusterIDs []string) (map[string][]dbapi.KafkaCapacity, *serviceError.ServiceError) {
+// 				panic("mock out the FindKafkaInstanceCapacities method")
+// 			},
 // 			FindKafkaInstanceCountFunc: func(clusterIDs []string) ([]ResKafkaInstanceCount, *serviceError.ServiceError) {
 // 				panic("mock out the FindKafkaInstanceCount method")
 // 			},
@@ -114,6 +118,9 @@ var _ ClusterService = &ClusterServiceMock{}
 // 			UpdateStatusAndClientFunc: func(cluster api.Cluster, status api.ClusterStatus, serviceClientId string, serviceClientSecret string) error {
 // 				panic("mock out the UpdateStatusAndClient method")
 // 			},
+// 			UpdateStatusIfUnchangedFunc: func(cluster api.Cluster, status api.ClusterStatus) (bool, error) {
+// 				panic("mock out the UpdateStatusIfUnchanged method")
+// 			},
 // 		}
 //
 // 		// use mockedClusterService in code that requires ClusterService
@@ -154,6 +161,9 @@ type ClusterServiceMock struct {
 	// FindClusterByIDFunc mocks the FindClusterByID method.
 	FindClusterByIDFunc func(clusterID string) (*api.Cluster, *serviceError.ServiceError)
 
+	// FindKafkaInstanceCapacitiesFunc mocks the FindKafkaInstanceCapacities method.
+	FindKafkaInstanceCapacitiesFunc func(clusterIDs []string) (map[string][]dbapi.KafkaCapacity, *serviceError.ServiceError)
+
 	// FindKafkaInstanceCountFunc mocks the FindKafkaInstanceCount method.
 	FindKafkaInstanceCountFunc func(clusterIDs []string) ([]ResKafkaInstanceCount, *serviceError.ServiceError)
 
@@ -214,6 +224,9 @@ type ClusterServiceMock struct {
 	// UpdateStatusAndClientFunc mocks the UpdateStatusAndClient method.
 	UpdateStatusAndClientFunc func(cluster api.Cluster, status api.ClusterStatus, serviceClientId string, serviceClientSecret string) error
 
+	// UpdateStatusIfUnchangedFunc mocks the UpdateStatusIfUnchanged method.
+	UpdateStatusIfUnchangedFunc func(cluster api.Cluster, status api.ClusterStatus) (bool, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// ApplyResources holds details about calls to the ApplyResources method.
@@ -277,6 +290,11 @@ type ClusterServiceMock struct {
 			// ClusterID is the clusterID argument value.
 			ClusterID string
 		}
+		// FindKafkaInstanceCapacities holds details about calls to the FindKafkaInstanceCapacities method.
+		FindKafkaInstanceCapacities []struct {
+			// ClusterIDs is the clusterIDs argument value.
+			ClusterIDs []string
+		}
 		// FindKafkaInstanceCount holds details about calls to the FindKafkaInstanceCount method.
 		FindKafkaInstanceCount []struct {
 			// ClusterIDs is the clusterIDs argument value.
@@ -403,6 +421,13 @@ type ClusterServiceMock struct {
 			// ServiceClientSecret is the serviceClientSecret argument value.
 			ServiceClientSecret string
 		}
+		// UpdateStatusIfUnchanged holds details about calls to the UpdateStatusIfUnchanged method.
+		UpdateStatusIfUnchanged []struct {
+			// Cluster is the cluster argument value.
+			Cluster api.Cluster
+			// Status is the status argument value.
+			Status api.ClusterStatus
+		}
 	}
 	lockApplyResources                          sync.RWMutex
 	lockCheckClusterStatus                      sync.RWMutex
@@ -415,6 +440,7 @@ type ClusterServiceMock struct {
 	lockFindAllClusters                         sync.RWMutex
 	lockFindCluster                             sync.RWMutex
 	lockFindClusterByID                         sync.RWMutex
+	lockFindKafkaInstanceCapacities             sync.RWMutex
 	lockFindKafkaInstanceCount                  sync.RWMutex
 	lockFindNonEmptyClusterById                 sync.RWMutex
 	lockGetClientId                             sync.RWMutex
@@ -435,6 +461,7 @@ type ClusterServiceMock struct {
 	lockUpdateMultiClusterStatus                sync.RWMutex
 	lockUpdateStatus                            sync.RWMutex
 	lockUpdateStatusAndClient                   sync.RWMutex
+	lockUpdateStatusIfUnchanged                 sync.RWMutex
 }
 
 // ApplyResources calls ApplyResourcesFunc.
@@ -790,6 +817,37 @@ func (mock *ClusterServiceMock) FindClusterByIDCalls() []struct {
 	return calls
 }
 
+// FindKafkaInstanceCapacities calls FindKafkaInstanceCapacitiesFunc.
+func (mock *ClusterServiceMock) FindKafkaInstanceCapacities(clusterIDs []string) (map[string][]dbapi.KafkaCapacity, *serviceError.ServiceError) {
+	if mock.FindKafkaInstanceCapacitiesFunc == nil {
+		panic("ClusterServiceMock.FindKafkaInstanceCapacitiesFunc: method is nil but ClusterService.FindKafkaInstanceCapacities was just called")
+	}
+	callInfo := struct {
+		ClusterIDs []string
+	}{
+		ClusterIDs: clusterIDs,
+	}
+	mock.lockFindKafkaInstanceCapacities.Lock()
+	mock.calls.FindKafkaInstanceCapacities = append(mock.calls.FindKafkaInstanceCapacities, callInfo)
+	mock.lockFindKafkaInstanceCapacities.Unlock()
+	return mock.FindKafkaInstanceCapacitiesFunc(clusterIDs)
+}
+
+// FindKafkaInstanceCapacitiesCalls gets all the calls that were made to FindKafkaInstanceCapacities.
+// Check the length with:
+//     len(mockedClusterService.FindKafkaInstanceCapacitiesCalls())
+func (mock *ClusterServiceMock) FindKafkaInstanceCapacitiesCalls() []struct {
+	ClusterIDs []string
+} {
+	var calls []struct {
+		ClusterIDs []string
+	}
+	mock.lockFindKafkaInstanceCapacities.RLock()
+	calls = mock.calls.FindKafkaInstanceCapacities
+	mock.lockFindKafkaInstanceCapacities.RUnlock()
+	return calls
+}
+
 // FindKafkaInstanceCount calls FindKafkaInstanceCountFunc.
 func (mock *ClusterServiceMock) FindKafkaInstanceCount(clusterIDs []string) ([]ResKafkaInstanceCount, *serviceError.ServiceError) {
 	if mock.FindKafkaInstanceCountFunc == nil {
@@ -1460,3 +1518,38 @@ func (mock *ClusterServiceMock) UpdateStatusAndClientCalls() []struct {
 	mock.lockUpdateStatusAndClient.RUnlock()
 	return calls
 }
+
+// UpdateStatusIfUnchanged calls UpdateStatusIfUnchangedFunc.
+func (mock *ClusterServiceMock) UpdateStatusIfUnchanged(cluster api.Cluster, status api.ClusterStatus) (bool, error) {
+	if mock.UpdateStatusIfUnchangedFunc == nil {
+		panic("ClusterServiceMock.UpdateStatusIfUnchangedFunc: method is nil but ClusterService.UpdateStatusIfUnchanged was just called")
+	}
+	callInfo := struct {
+		Cluster api.Cluster
+		Status  api.ClusterStatus
+	}{
+		Cluster: cluster,
+		Status:  status,
+	}
+	mock.lockUpdateStatusIfUnchanged.Lock()
+	mock.calls.UpdateStatusIfUnchanged = append(mock.calls.UpdateStatusIfUnchanged, callInfo)
+	mock.lockUpdateStatusIfUnchanged.Unlock()
+	return mock.UpdateStatusIfUnchangedFunc(cluster, status)
+}
+
+// UpdateStatusIfUnchangedCalls gets all the calls that were made to UpdateStatusIfUnchanged.
+// Check the length with:
+//     len(mockedClusterService.UpdateStatusIfUnchangedCalls())
+func (mock *ClusterServiceMock) UpdateStatusIfUnchangedCalls() []struct {
+	Cluster api.Cluster
+	Status  api.ClusterStatus
+} {
+	var calls []struct {
+		Cluster api.Cluster
+		Status  api.ClusterStatus
+	}
+	mock.lockUpdateStatusIfUnchanged.RLock()
+	calls = mock.calls.UpdateStatusIfUnchanged
+	mock.lockUpdateStatusIfUnchanged.RUnlock()
+	return calls
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/golang/glog"
 )
@@ -23,6 +25,7 @@ import (
 // this number
 const multiAZClusterNodeScalingMultiple = 3
 
+//go:generate moq -out data_plane_cluster_moq.go . DataPlaneClusterService
 type DataPlaneClusterService interface {
 	UpdateDataPlaneClusterStatus(ctx context.Context, clusterID string, status *dbapi.DataPlaneClusterStatus) *errors.ServiceError
 	GetDataPlaneClusterConfig(ctx context.Context, clusterID string) (*dbapi.DataPlaneClusterConfig, *errors.ServiceError)
@@ -30,7 +33,12 @@ type DataPlaneClusterService interface {
 
 var _ DataPlaneClusterService = &dataPlaneClusterService{}
 
-const dataPlaneClusterStatusCondReadyName = "Ready"
+const (
+	dataPlaneClusterStatusCondReadyName = "Ready"
+
+	// clusterStatusReportResolution is the minimum time between two updates of the last status report time of a cluster
+	clusterStatusReportResolution = time.Minute
+)
 
 type dataPlaneClusterService struct {
 	di.Inject
@@ -79,22 +87,33 @@ func (d *dataPlaneClusterService) UpdateDataPlaneClusterStatus(ctx context.Conte
 		return errors.BadRequest("Cluster agent with ID '%s' not found", clusterID)
 	}
 
+	if svcErr := d.setClusterStatusReport(cluster, status); svcErr != nil {
+		return svcErr
+	}
+
 	if !d.clusterCanProcessStatusReports(cluster) {
 		glog.V(10).Infof("Cluster with ID '%s' is in '%s' state. Ignoring status report...", clusterID, cluster.Status)
 		return nil
 	}
 
+	// the status of the unresponsive clusters is restored from the report like the status of any other cluster
+	if cluster.Status == api.ClusterUnresponsive {
+		logger.NewEventLogger(constants2.ClusterRestoredEvent).Infof("kas fleetshard operator of unresponsive cluster %s reported the cluster status again", clusterID)
+	}
+
 	fleetShardOperatorReady, err := d.isFleetShardOperatorReady(status)
 	if err != nil {
 		return errors.ToServiceError(err)
 	}
 	if !fleetShardOperatorReady {
 		if cluster.Status != api.ClusterWaitingForKasFleetShardOperator {
-			err := d.ClusterService.UpdateStatus(*cluster, api.ClusterWaitingForKasFleetShardOperator)
+			updated, err := d.updateReportedClusterStatus(cluster, api.ClusterWaitingForKasFleetShardOperator)
 			if err != nil {
 				return errors.ToServiceError(err)
 			}
-			metrics.UpdateClusterStatusSinceCreatedMetric(*cluster, api.ClusterWaitingForKasFleetShardOperator)
+			if updated {
+				metrics.UpdateClusterStatusSinceCreatedMetric(*cluster, api.ClusterWaitingForKasFleetShardOperator)
+			}
 		}
 		glog.V(10).Infof("KAS Fleet Shard Operator not ready for Cluster ID '%s", clusterID)
 		return nil
@@ -127,6 +146,33 @@ func (d *dataPlaneClusterService) UpdateDataPlaneClusterStatus(ctx context.Conte
 	return nil
 }
 
+// setClusterStatusReport stores the time of the report and the version of the kas fleetshard operator that sent it. The
+// time of the report is only refreshed once per clusterStatusReportResolution.
+func (d *dataPlaneClusterService) setClusterStatusReport(cluster *api.Cluster, status *dbapi.DataPlaneClusterStatus) *errors.ServiceError {
+	update := api.Cluster{Meta: api.Meta{ID: cluster.ID}}
+	updated := false
+
+	now := time.Now()
+	if cluster.StatusReportedAt == nil || now.Sub(*cluster.StatusReportedAt) >= clusterStatusReportResolution {
+		cluster.StatusReportedAt = &now
+		update.StatusReportedAt = &now
+		updated = true
+	}
+	if status.AgentVersion != "" && status.AgentVersion != cluster.AgentVersion {
+		cluster.AgentVersion = status.AgentVersion
+		update.AgentVersion = status.AgentVersion
+		updated = true
+	}
+
+	if !updated {
+		return nil
+	}
+	if svcErr := d.ClusterService.Update(update); svcErr != nil {
+		return errors.NewWithCause(svcErr.Code, svcErr, "failed to update status report of cluster %s", cluster.ClusterID)
+	}
+	return nil
+}
+
 func (d *dataPlaneClusterService) computeNodeScalingActionInProgress(cluster *api.Cluster, status *dbapi.DataPlaneClusterStatus) (bool, error) {
 	nodesInfo, err := d.ClusterService.GetComputeNodes(cluster.ClusterID)
 	if err != nil {
@@ -225,16 +271,17 @@ func (d *dataPlaneClusterService) setClusterStatus(cluster *api.Cluster, status
 		}
 
 		glog.Infof("Updating Strimzi operator available versions for cluster ID '%s'. Versions: '%v'\n", cluster.ClusterID, status.AvailableStrimziVersions)
-		svcErr := d.ClusterService.Update(*cluster)
+		// only the versions are updated so that the status of the cluster is not overwritten
+		svcErr := d.ClusterService.Update(api.Cluster{Meta: api.Meta{ID: cluster.ID}, AvailableStrimziVersions: cluster.AvailableStrimziVersions})
 		if svcErr != nil {
-			return err
+			return svcErr
 		}
 	}
 
 	if remainingCapacity && cluster.Status != api.ClusterReady {
 		clusterIsWaitingForFleetShardOperator := cluster.Status == api.ClusterWaitingForKasFleetShardOperator
-		err := d.ClusterService.UpdateStatus(*cluster, api.ClusterReady)
-		if err != nil {
+		updated, err := d.updateReportedClusterStatus(cluster, api.ClusterReady)
+		if err != nil || !updated {
 			return err
 		}
 		if clusterIsWaitingForFleetShardOperator {
@@ -252,8 +299,8 @@ func (d *dataPlaneClusterService) setClusterStatus(cluster *api.Cluster, status
 		}
 
 		if cluster.Status != desiredStatus {
-			err := d.ClusterService.UpdateStatus(*cluster, desiredStatus)
-			if err != nil {
+			updated, err := d.updateReportedClusterStatus(cluster, desiredStatus)
+			if err != nil || !updated {
 				return err
 			}
 		}
@@ -263,6 +310,20 @@ func (d *dataPlaneClusterService) setClusterStatus(cluster *api.Cluster, status
 	return nil
 }
 
+// updateReportedClusterStatus updates the status of the cluster computed from its status report unless the status was
+// changed since the cluster was read, e.g. the cluster was cordoned to be upgraded. It returns false in that case, the
+// status being computed again from the next report.
+func (d *dataPlaneClusterService) updateReportedClusterStatus(cluster *api.Cluster, status api.ClusterStatus) (bool, error) {
+	updated, err := d.ClusterService.UpdateStatusIfUnchanged(*cluster, status)
+	if err != nil {
+		return false, err
+	}
+	if !updated {
+		glog.V(10).Infof("Status of cluster ID '%s' changed from '%s' while processing its status report. Ignoring status report...", cluster.ClusterID, cluster.Status)
+	}
+	return updated, nil
+}
+
 func (d *dataPlaneClusterService) isFleetShardOperatorReady(status *dbapi.DataPlaneClusterStatus) (bool, error) {
 	for _, cond := range status.Conditions {
 		if cond.Type == dataPlaneClusterStatusCondReadyName {
@@ -280,7 +341,8 @@ func (d *dataPlaneClusterService) clusterCanProcessStatusReports(cluster *api.Cl
 	return cluster.Status == api.ClusterReady ||
 		cluster.Status == api.ClusterComputeNodeScalingUp ||
 		cluster.Status == api.ClusterFull ||
-		cluster.Status == api.ClusterWaitingForKasFleetShardOperator
+		cluster.Status == api.ClusterWaitingForKasFleetShardOperator ||
+		cluster.Status == api.ClusterUnresponsive
 }
 
 // calculateDesiredNodesToScaleUp returns the desired number of nodes to scale
@@ -431,8 +493,9 @@ func (d *dataPlaneClusterService) scaleUpThresholds(status *dbapi.DataPlaneClust
 // minimumKafkaCapacity returns the minimum Kafka Capacity attributes needed
 // to consider that a kafka cluster has capacity available
 func (d *dataPlaneClusterService) minimumKafkaCapacity() *dataPlaneComputeNodesKafkaCapacityAttributes {
+	kafkaCapacity := d.KafkaConfig.GetKafkaCapacity()
 	return &dataPlaneComputeNodesKafkaCapacityAttributes{
-		Connections: d.KafkaConfig.KafkaCapacity.TotalMaxConnections,
-		Partitions:  d.KafkaConfig.KafkaCapacity.MaxPartitions,
+		Connections: kafkaCapacity.TotalMaxConnections,
+		Partitions:  kafkaCapacity.MaxPartitions,
 	}
 }
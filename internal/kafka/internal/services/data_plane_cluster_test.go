@@ -7,6 +7,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
@@ -62,9 +63,66 @@ func Test_DataPlaneCluster_UpdateDataPlaneClusterStatus(t *testing.T) {
 							Status:    api.ClusterReady,
 						}, nil
 					},
-					UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+					UpdateFunc: func(cluster api.Cluster) *errors.ServiceError {
+						return nil
+					},
+					UpdateStatusIfUnchangedFunc: func(cluster api.Cluster, status api.ClusterStatus) (bool, error) {
+						return true, nil
+					},
+					GetComputeNodesFunc: func(clusterID string) (*types.ComputeNodesInfo, *errors.ServiceError) {
+						return &types.ComputeNodesInfo{
+							Actual:  6,
+							Desired: 6,
+						}, nil
+					},
+				}
+				return NewDataPlaneClusterService(sampleValidApplicationConfigForDataPlaneClusterTest(clusterService))
+			},
+		},
+		{
+			name:      "It restores an unresponsive cluster and records the status report",
+			clusterID: testClusterID,
+			clusterStatus: &dbapi.DataPlaneClusterStatus{
+				Conditions: []dbapi.DataPlaneClusterStatusCondition{
+					{
+						Type:   "Ready",
+						Status: "True",
+					},
+				},
+				NodeInfo: dbapi.DataPlaneClusterStatusNodeInfo{
+					Current: 6,
+				},
+				AgentVersion: "0.21.0",
+			},
+			wantErr: false,
+			dataPlaneClusterServiceFactory: func() *dataPlaneClusterService {
+				reportedAt := time.Now().Add(-time.Hour)
+				clusterService := &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+						return &api.Cluster{
+							Meta: api.Meta{
+								ID: "id",
+							},
+							ClusterID:        clusterID,
+							Status:           api.ClusterUnresponsive,
+							StatusReportedAt: &reportedAt,
+						}, nil
+					},
+					UpdateFunc: func(cluster api.Cluster) *errors.ServiceError {
+						if cluster.StatusReportedAt == nil || !cluster.StatusReportedAt.After(reportedAt) {
+							return errors.GeneralError("status report time was not refreshed")
+						}
+						if cluster.AgentVersion != "0.21.0" {
+							return errors.GeneralError("unexpected agent version %q", cluster.AgentVersion)
+						}
 						return nil
 					},
+					UpdateStatusIfUnchangedFunc: func(cluster api.Cluster, status api.ClusterStatus) (bool, error) {
+						if cluster.Status != api.ClusterUnresponsive || status == api.ClusterUnresponsive {
+							return false, errors.GeneralError("cluster status was not restored")
+						}
+						return true, nil
+					},
 					GetComputeNodesFunc: func(clusterID string) (*types.ComputeNodesInfo, *errors.ServiceError) {
 						return &types.ComputeNodesInfo{
 							Actual:  6,
@@ -627,6 +685,17 @@ func Test_DataPlaneCluster_clusterCanProcessStatusReports(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "When cluster is unresponsive then status reports can be processed",
+			apiCluster: &api.Cluster{
+				Status: api.ClusterUnresponsive,
+			},
+			dataPlaneClusterServiceFactory: func() *dataPlaneClusterService {
+				return NewDataPlaneClusterService(sampleValidApplicationConfigForDataPlaneClusterTest(nil))
+
+			},
+			want: true,
+		},
 		{
 			name: "When cluster is in state provisioning then status reports cannot be processed",
 			apiCluster: &api.Cluster{
@@ -794,12 +863,12 @@ func Test_DataPlaneCluster_setClusterStatus(t *testing.T) {
 						}
 						return nil, nil
 					},
-					UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
-						if cluster.ClusterID != apiCluster.ClusterID {
-							return errors.GeneralError("unexpected test error")
+					UpdateStatusIfUnchangedFunc: func(cluster api.Cluster, status api.ClusterStatus) (bool, error) {
+						if cluster.ClusterID != apiCluster.ClusterID || cluster.Status != apiCluster.Status {
+							return false, errors.GeneralError("unexpected test error")
 						}
 						*spyReceivedUpdateStatus = status
-						return nil
+						return true, nil
 					},
 				}
 				c := sampleValidApplicationConfigForDataPlaneClusterTest(clusterService)
@@ -837,12 +906,12 @@ func Test_DataPlaneCluster_setClusterStatus(t *testing.T) {
 						}
 						return nil, nil
 					},
-					UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
-						if cluster.ClusterID != apiCluster.ClusterID {
-							return errors.GeneralError("unexpected test error")
+					UpdateStatusIfUnchangedFunc: func(cluster api.Cluster, status api.ClusterStatus) (bool, error) {
+						if cluster.ClusterID != apiCluster.ClusterID || cluster.Status != apiCluster.Status {
+							return false, errors.GeneralError("unexpected test error")
 						}
 						*spyReceivedUpdateStatus = status
-						return nil
+						return true, nil
 					},
 				}
 
@@ -881,12 +950,12 @@ func Test_DataPlaneCluster_setClusterStatus(t *testing.T) {
 						}
 						return nil, nil
 					},
-					UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
-						if cluster.ClusterID != apiCluster.ClusterID {
-							return errors.GeneralError("unexpected test error")
+					UpdateStatusIfUnchangedFunc: func(cluster api.Cluster, status api.ClusterStatus) (bool, error) {
+						if cluster.ClusterID != apiCluster.ClusterID || cluster.Status != apiCluster.Status {
+							return false, errors.GeneralError("unexpected test error")
 						}
 						*spyReceivedUpdateStatus = status
-						return nil
+						return true, nil
 					},
 				}
 
@@ -925,12 +994,12 @@ func Test_DataPlaneCluster_setClusterStatus(t *testing.T) {
 						}
 						return nil, nil
 					},
-					UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
-						if cluster.ClusterID != apiCluster.ClusterID {
-							return errors.GeneralError("unexpected test error")
+					UpdateStatusIfUnchangedFunc: func(cluster api.Cluster, status api.ClusterStatus) (bool, error) {
+						if cluster.ClusterID != apiCluster.ClusterID || cluster.Status != apiCluster.Status {
+							return false, errors.GeneralError("unexpected test error")
 						}
 						*spyReceivedUpdateStatus = status
-						return nil
+						return true, nil
 					},
 				}
 
@@ -951,6 +1020,44 @@ func Test_DataPlaneCluster_setClusterStatus(t *testing.T) {
 			want:    api.ClusterFull,
 			wantErr: false,
 		},
+		{
+			name: "when the status of the cluster changed since it was read then the status is not overwritten",
+			inputFactory: func() (*input, *api.ClusterStatus) {
+				apiCluster := &api.Cluster{
+					ClusterID: testClusterID,
+					MultiAZ:   true,
+					Status:    api.ClusterReady,
+				}
+				var spyReceivedUpdateStatus *api.ClusterStatus = new(api.ClusterStatus)
+
+				clusterService := &ClusterServiceMock{
+					UpdateStatusIfUnchangedFunc: func(cluster api.Cluster, status api.ClusterStatus) (bool, error) {
+						// e.g. the cluster was cordoned to be upgraded meanwhile
+						return false, nil
+					},
+					UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+						*spyReceivedUpdateStatus = status
+						return nil
+					},
+				}
+
+				testStatus := sampleValidBaseDataPlaneClusterStatusRequest()
+				c := sampleValidApplicationConfigForDataPlaneClusterTest(clusterService)
+				testStatus.NodeInfo.Current = 10
+				testStatus.NodeInfo.Ceiling = 11
+				testStatus.NodeInfo.CurrentWorkLoadMinimum = 3
+				testStatus.Remaining.Connections = 0
+				testStatus.Remaining.Partitions = 0
+				dataPlaneClusterService := NewDataPlaneClusterService(c)
+				return &input{
+					status:                  testStatus,
+					cluster:                 apiCluster,
+					dataPlaneClusterService: dataPlaneClusterService,
+				}, spyReceivedUpdateStatus
+			},
+			want:    "",
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range cases {
@@ -30,8 +30,12 @@ const (
 	strimziUpdating  string      = "StrimziUpdating"
 	kafkaUpdating    string      = "KafkaUpdating"
 	kafkaIBPUpdating string      = "KafkaIbpUpdating"
+
+	// kafkaStatusReportResolution is the minimum time between two updates of the last status report time of a kafka
+	kafkaStatusReportResolution = time.Minute
 )
 
+//go:generate moq -out data_plane_kafka_moq.go . DataPlaneKafkaService
 type DataPlaneKafkaService interface {
 	UpdateDataPlaneKafkaService(ctx context.Context, clusterId string, status []*dbapi.DataPlaneKafkaStatus) *serviceError.ServiceError
 }
@@ -100,10 +104,22 @@ func (d *dataPlaneKafkaService) UpdateDataPlaneKafkaService(ctx context.Context,
 			log.Error(errors.Wrapf(e, "Error updating kafka %s status", ks.KafkaClusterId))
 		}
 
+		if s := getStatus(ks); s != statusDeleted {
+			e = d.setKafkaRequestStatusReport(kafka, ks)
+			if e != nil {
+				log.Error(errors.Wrapf(e, "Error updating kafka '%s' status report", ks.KafkaClusterId))
+			}
+		}
+
 		e = d.setKafkaRequestVersionFields(kafka, ks)
 		if e != nil {
 			log.Error(errors.Wrapf(e, "Error updating kafka '%s' version fields", ks.KafkaClusterId))
 		}
+
+		e = d.setKafkaRequestCapacity(kafka, ks)
+		if e != nil {
+			log.Error(errors.Wrapf(e, "Error updating kafka '%s' capacity", ks.KafkaClusterId))
+		}
 	}
 	return nil
 }
@@ -200,6 +216,30 @@ func (d *dataPlaneKafkaService) setKafkaRequestVersionFields(kafka *dbapi.KafkaR
 			needsUpdate = true
 		}
 
+		upgradingStatus, upgradingReason, upgradingMessage := dbapi.KafkaConditionStatusFalse, "NotUpgrading", ""
+		switch {
+		case kafka.StrimziUpgrading:
+			upgradingStatus, upgradingReason = dbapi.KafkaConditionStatusTrue, "StrimziUpgrading"
+			upgradingMessage = fmt.Sprintf("upgrading strimzi version to '%s'", kafka.DesiredStrimziVersion)
+		case kafka.KafkaUpgrading:
+			upgradingStatus, upgradingReason = dbapi.KafkaConditionStatusTrue, "KafkaUpgrading"
+			upgradingMessage = fmt.Sprintf("upgrading kafka version to '%s'", kafka.DesiredKafkaVersion)
+		case kafka.KafkaIBPUpgrading:
+			upgradingStatus, upgradingReason = dbapi.KafkaConditionStatusTrue, "KafkaIBPUpgrading"
+			upgradingMessage = fmt.Sprintf("upgrading kafka ibp version to '%s'", kafka.DesiredKafkaIBPVersion)
+		}
+		conditions, err := kafka.GetConditions()
+		if err != nil {
+			return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "failed to get conditions for kafka cluster %s", kafka.ID)
+		}
+		// the upgrading condition is only recorded once the kafka has gone through an upgrade
+		if _, found := conditions.GetCondition(dbapi.KafkaConditionUpgrading); found || upgradingStatus == dbapi.KafkaConditionStatusTrue {
+			conditionChanged, err := kafka.SetCondition(dbapi.KafkaConditionUpgrading, upgradingStatus, upgradingReason, upgradingMessage)
+			if err != nil {
+				return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "failed to set upgrading condition for kafka cluster %s", kafka.ID)
+			}
+			needsUpdate = needsUpdate || conditionChanged
+		}
 	}
 
 	if needsUpdate {
@@ -210,6 +250,7 @@ func (d *dataPlaneKafkaService) setKafkaRequestVersionFields(kafka *dbapi.KafkaR
 			"strimzi_upgrading":        kafka.StrimziUpgrading,
 			"kafka_upgrading":          kafka.KafkaUpgrading,
 			"kafka_ibp_upgrading":      kafka.KafkaIBPUpgrading,
+			"conditions":               kafka.Conditions,
 		}
 
 		if err := d.kafkaService.Updates(kafka, versionFields); err != nil {
@@ -220,6 +261,88 @@ func (d *dataPlaneKafkaService) setKafkaRequestVersionFields(kafka *dbapi.KafkaR
 	return nil
 }
 
+// setKafkaRequestStatusReport stores the Ready condition reported for the kafka and the time of the report, which are the
+// data plane signals of the kafka health. The time of the report is only refreshed once per kafkaStatusReportResolution.
+func (d *dataPlaneKafkaService) setKafkaRequestStatusReport(kafka *dbapi.KafkaRequest, status *dbapi.DataPlaneKafkaStatus) *serviceError.ServiceError {
+	fields := map[string]interface{}{}
+
+	if readyCondition, found := status.GetReadyCondition(); found {
+		conditionStatus := dbapi.KafkaConditionStatusUnknown
+		switch {
+		case strings.EqualFold(readyCondition.Status, string(dbapi.KafkaConditionStatusTrue)):
+			conditionStatus = dbapi.KafkaConditionStatusTrue
+		case strings.EqualFold(readyCondition.Status, string(dbapi.KafkaConditionStatusFalse)):
+			conditionStatus = dbapi.KafkaConditionStatusFalse
+		}
+		conditionChanged, err := kafka.SetCondition(dbapi.KafkaConditionDataPlaneReady, conditionStatus, readyCondition.Reason, readyCondition.Message)
+		if err != nil {
+			return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "failed to set data plane ready condition for kafka cluster %s", kafka.ID)
+		}
+		if conditionChanged {
+			fields["conditions"] = kafka.Conditions
+		}
+	}
+
+	now := time.Now()
+	if kafka.StatusReportedAt == nil || now.Sub(*kafka.StatusReportedAt) >= kafkaStatusReportResolution {
+		kafka.StatusReportedAt = &now
+		fields["status_reported_at"] = now
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+	if err := d.kafkaService.Updates(kafka, fields); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to update status report for kafka cluster %s", kafka.ID)
+	}
+	return nil
+}
+
+// setKafkaRequestCapacity stores the capacity reported for the kafka and exports it as metrics. The capacity
+// previously stored is kept when the status does not contain any capacity.
+func (d *dataPlaneKafkaService) setKafkaRequestCapacity(kafka *dbapi.KafkaRequest, status *dbapi.DataPlaneKafkaStatus) *serviceError.ServiceError {
+	if getStatus(status) == statusDeleted {
+		metrics.DeleteKafkaCapacityMetric(kafka.ID, kafka.ClusterID)
+		return nil
+	}
+	if status.Capacity.IsEmpty() {
+		return nil
+	}
+
+	updateKafkaCapacityMetrics(kafka, status.Capacity)
+
+	changed, err := kafka.SetCapacity(status.Capacity)
+	if err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "failed to set capacity for kafka cluster %s", kafka.ID)
+	}
+	if !changed {
+		return nil
+	}
+	logger.Logger.Infof("Updating capacity for Kafka ID '%s'", kafka.ID)
+	if err := d.kafkaService.Updates(kafka, map[string]interface{}{"capacity": kafka.Capacity}); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to update capacity for kafka cluster %s", kafka.ID)
+	}
+	return nil
+}
+
+func updateKafkaCapacityMetrics(kafka *dbapi.KafkaRequest, capacity dbapi.KafkaCapacity) {
+	if throughput, ok := capacity.IngressEgressThroughputBytesPerSec(); ok {
+		metrics.UpdateKafkaCapacityMetric(kafka.ID, kafka.ClusterID, metrics.KafkaCapacityIngressEgressThroughput, float64(throughput))
+	}
+	if capacity.TotalMaxConnections > 0 {
+		metrics.UpdateKafkaCapacityMetric(kafka.ID, kafka.ClusterID, metrics.KafkaCapacityMaxConnections, float64(capacity.TotalMaxConnections))
+	}
+	if capacity.MaxConnectionAttemptsPerSec > 0 {
+		metrics.UpdateKafkaCapacityMetric(kafka.ID, kafka.ClusterID, metrics.KafkaCapacityMaxConnectionAttempts, float64(capacity.MaxConnectionAttemptsPerSec))
+	}
+	if capacity.MaxPartitions > 0 {
+		metrics.UpdateKafkaCapacityMetric(kafka.ID, kafka.ClusterID, metrics.KafkaCapacityMaxPartitions, float64(capacity.MaxPartitions))
+	}
+	if retention, ok := capacity.MaxDataRetentionSizeBytes(); ok {
+		metrics.UpdateKafkaCapacityMetric(kafka.ID, kafka.ClusterID, metrics.KafkaCapacityMaxDataRetentionSize, float64(retention))
+	}
+}
+
 func (d *dataPlaneKafkaService) setKafkaClusterFailed(kafka *dbapi.KafkaRequest, errMessage string) *serviceError.ServiceError {
 	// if kafka was already reported as failed we don't do anything
 	if kafka.Status == string(constants2.KafkaRequestStatusFailed) {
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 )
 
 func TestDataPlaneKafkaService_UpdateDataPlaneKafkaService(t *testing.T) {
@@ -328,6 +330,9 @@ func TestDataPlaneKafkaService_UpdateDataPlaneKafkaService(t *testing.T) {
 							RoutesCreated:       false,
 						}, nil
 					},
+					UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, fields map[string]interface{}) *errors.ServiceError {
+						return nil
+					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 						return nil
 					},
@@ -761,3 +766,210 @@ func TestDataPlaneKafkaService_UpdateVersions(t *testing.T) {
 		})
 	}
 }
+
+func TestDataPlaneKafkaService_UpdateCapacity(t *testing.T) {
+	reported := dbapi.KafkaCapacity{
+		IngressEgressThroughputPerSec: "30Mi",
+		TotalMaxConnections:           3000,
+		MaxDataRetentionSize:          "1000Gi",
+		MaxPartitions:                 1000,
+		MaxDataRetentionPeriod:        "P14D",
+		MaxConnectionAttemptsPerSec:   100,
+	}
+	readyConditions := []dbapi.DataPlaneKafkaStatusCondition{
+		{
+			Type:   "Ready",
+			Status: "True",
+		},
+	}
+
+	tests := []struct {
+		name             string
+		storedCapacity   []byte
+		status           *dbapi.DataPlaneKafkaStatus
+		wantUpdated      bool
+		expectedCapacity dbapi.KafkaCapacity
+	}{
+		{
+			name:             "should store the reported capacity",
+			status:           &dbapi.DataPlaneKafkaStatus{Conditions: readyConditions, Capacity: reported},
+			wantUpdated:      true,
+			expectedCapacity: reported,
+		},
+		{
+			name:             "should not update the kafka when the reported capacity is unchanged",
+			storedCapacity:   []byte(`{"ingress_egress_throughput_per_sec":"30Mi","total_max_connections":3000,"max_data_retention_size":"1000Gi","max_partitions":1000,"max_data_retention_period":"P14D","max_connection_attempts_per_sec":100}`),
+			status:           &dbapi.DataPlaneKafkaStatus{Conditions: readyConditions, Capacity: reported},
+			wantUpdated:      false,
+			expectedCapacity: reported,
+		},
+		{
+			name:             "should keep the stored capacity when no capacity is reported",
+			storedCapacity:   []byte(`{"max_partitions":1000}`),
+			status:           &dbapi.DataPlaneKafkaStatus{Conditions: readyConditions},
+			wantUpdated:      false,
+			expectedCapacity: dbapi.KafkaCapacity{MaxPartitions: 1000},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			kafka := &dbapi.KafkaRequest{
+				ClusterID:     "test-cluster-id",
+				Status:        constants2.KafkaRequestStatusReady.String(),
+				Routes:        []byte("[]"),
+				RoutesCreated: true,
+				Capacity:      tt.storedCapacity,
+			}
+			updated := false
+			kafkaService := &KafkaServiceMock{
+				GetByIdFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return kafka, nil
+				},
+				UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, fields map[string]interface{}) *errors.ServiceError {
+					if _, ok := fields["capacity"]; ok {
+						updated = true
+					}
+					return nil
+				},
+			}
+			clusterService := &ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return &api.Cluster{}, nil
+				},
+			}
+			s := NewDataPlaneKafkaService(kafkaService, clusterService, &config.KafkaConfig{})
+			if err := s.UpdateDataPlaneKafkaService(context.TODO(), "test-cluster-id", []*dbapi.DataPlaneKafkaStatus{tt.status}); err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+			if updated != tt.wantUpdated {
+				t.Errorf("capacity updated = %v, want %v", updated, tt.wantUpdated)
+			}
+			capacity, err := kafka.GetCapacity()
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+			if !reflect.DeepEqual(capacity, tt.expectedCapacity) {
+				t.Errorf("capacity dont match. want: %v got: %v", tt.expectedCapacity, capacity)
+			}
+		})
+	}
+}
+
+func TestDataPlaneKafkaService_UpdateStatusReport(t *testing.T) {
+	recentReport := time.Now().Add(-10 * time.Second)
+	staleReport := time.Now().Add(-5 * time.Minute)
+
+	tests := []struct {
+		name                 string
+		storedConditions     []byte
+		statusReportedAt     *time.Time
+		status               *dbapi.DataPlaneKafkaStatus
+		wantUpdatedFields    []string
+		wantConditionStatus  dbapi.KafkaConditionStatus
+		wantStatusReportedAt func(reportedAt *time.Time) bool
+	}{
+		{
+			name: "should store the first ready condition and report time",
+			status: &dbapi.DataPlaneKafkaStatus{Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+				{Type: "Ready", Status: "True"},
+			}},
+			wantUpdatedFields:   []string{"conditions", "status_reported_at"},
+			wantConditionStatus: dbapi.KafkaConditionStatusTrue,
+			wantStatusReportedAt: func(reportedAt *time.Time) bool {
+				return reportedAt != nil && time.Since(*reportedAt) < time.Minute
+			},
+		},
+		{
+			name:             "should not update the kafka when the condition is unchanged and the report time is recent",
+			storedConditions: []byte(`[{"type":"DataPlaneReady","status":"True","last_transition_time":"2022-04-22T10:00:00Z"}]`),
+			statusReportedAt: &recentReport,
+			status: &dbapi.DataPlaneKafkaStatus{Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+				{Type: "Ready", Status: "True"},
+			}},
+			wantConditionStatus: dbapi.KafkaConditionStatusTrue,
+			wantStatusReportedAt: func(reportedAt *time.Time) bool {
+				return reportedAt.Equal(recentReport)
+			},
+		},
+		{
+			name:             "should refresh the report time when it is older than the resolution",
+			storedConditions: []byte(`[{"type":"DataPlaneReady","status":"True","last_transition_time":"2022-04-22T10:00:00Z"}]`),
+			statusReportedAt: &staleReport,
+			status: &dbapi.DataPlaneKafkaStatus{Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+				{Type: "Ready", Status: "True"},
+			}},
+			wantUpdatedFields:   []string{"status_reported_at"},
+			wantConditionStatus: dbapi.KafkaConditionStatusTrue,
+			wantStatusReportedAt: func(reportedAt *time.Time) bool {
+				return reportedAt.After(staleReport)
+			},
+		},
+		{
+			name:             "should store the ready condition when it changes",
+			storedConditions: []byte(`[{"type":"DataPlaneReady","status":"True","last_transition_time":"2022-04-22T10:00:00Z"}]`),
+			statusReportedAt: &recentReport,
+			status: &dbapi.DataPlaneKafkaStatus{Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+				{Type: "Ready", Status: "False", Reason: strimziUpdating},
+			}},
+			wantUpdatedFields:   []string{"conditions"},
+			wantConditionStatus: dbapi.KafkaConditionStatusFalse,
+			wantStatusReportedAt: func(reportedAt *time.Time) bool {
+				return reportedAt.Equal(recentReport)
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			kafka := &dbapi.KafkaRequest{
+				ClusterID:        "test-cluster-id",
+				Status:           constants2.KafkaRequestStatusReady.String(),
+				Routes:           []byte("[]"),
+				RoutesCreated:    true,
+				Conditions:       tt.storedConditions,
+				StatusReportedAt: tt.statusReportedAt,
+			}
+			var updatedFields []string
+			kafkaService := &KafkaServiceMock{
+				GetByIdFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return kafka, nil
+				},
+				UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, fields map[string]interface{}) *errors.ServiceError {
+					// the upgrade conditions are also stored when the version fields are updated
+					for _, field := range []string{"conditions", "status_reported_at"} {
+						if _, ok := fields[field]; ok && !shared.Contains(updatedFields, field) {
+							updatedFields = append(updatedFields, field)
+						}
+					}
+					return nil
+				},
+			}
+			clusterService := &ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return &api.Cluster{}, nil
+				},
+			}
+			s := NewDataPlaneKafkaService(kafkaService, clusterService, &config.KafkaConfig{})
+			if err := s.UpdateDataPlaneKafkaService(context.TODO(), "test-cluster-id", []*dbapi.DataPlaneKafkaStatus{tt.status}); err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+			if !reflect.DeepEqual(updatedFields, tt.wantUpdatedFields) {
+				t.Errorf("updated fields dont match. want: %v got: %v", tt.wantUpdatedFields, updatedFields)
+			}
+			conditions, err := kafka.GetConditions()
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+			condition, found := conditions.GetCondition(dbapi.KafkaConditionDataPlaneReady)
+			if !found || condition.Status != tt.wantConditionStatus {
+				t.Errorf("data plane ready condition dont match. want: %v got: %v", tt.wantConditionStatus, condition.Status)
+			}
+			if !tt.wantStatusReportedAt(kafka.StatusReportedAt) {
+				t.Errorf("unexpected status report time %v", kafka.StatusReportedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+)
+
+// NewDNSProvider returns the DNS provider selected by the kafka configuration
+func NewDNSProvider(kafkaConfig *config.KafkaConfig, awsConfig *config.AWSConfig, awsClientFactory aws.ClientFactory) (dns.DNSProvider, error) {
+	providerType, err := dns.ParseProviderType(kafkaConfig.DNS.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	switch providerType {
+	case dns.ProviderTypeRFC2136:
+		return dns.NewRFC2136Provider(kafkaConfig.DNS.RFC2136), nil
+	case dns.ProviderTypeFake:
+		return dns.NewFakeDNSProvider(), nil
+	default:
+		return dns.NewRoute53Provider(awsClientFactory, aws.Config{
+			AccessKeyID:     awsConfig.Route53AccessKey,
+			SecretAccessKey: awsConfig.Route53SecretAccessKey,
+		}), nil
+	}
+}
@@ -25,13 +25,13 @@ import (
 	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
 )
 
 var kafkaDeletionStatuses = []string{constants2.KafkaRequestStatusDeleting.String(), constants2.KafkaRequestStatusDeprovision.String()}
@@ -43,11 +43,6 @@ const KafkaRoutesActionCreate KafkaRoutesAction = "CREATE"
 const KafkaRoutesActionDelete KafkaRoutesAction = "DELETE"
 const CanaryServiceAccountPrefix = "canary"
 
-type CNameRecordStatus struct {
-	Id     *string
-	Status *string
-}
-
 //go:generate moq -out kafkaservice_moq.go . KafkaService
 type KafkaService interface {
 	// PrepareKafkaRequest sets any required information (i.e. bootstrap server host, sso client id and secret)
@@ -78,12 +73,16 @@ type KafkaService interface {
 	// Use this only when you want to update the multiple columns that may contain zero-fields, otherwise use the `KafkaService.Update()` method.
 	// See https://gorm.io/docs/update.html#Updates-multiple-columns for more info
 	Updates(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError
-	ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError)
-	GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
+	// ChangeKafkaCNAMErecords creates or deletes the CNAME records of the routes of the kafka with the DNS provider
+	ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *errors.ServiceError)
+	// GetCNAMERecordStatus returns the status of the change of the CNAME records of the kafka given by its RoutesCreationId
+	GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error)
 	DetectInstanceType(kafkaRequest *dbapi.KafkaRequest) (types.KafkaInstanceType, *errors.ServiceError)
 	RegisterKafkaDeprovisionJob(ctx context.Context, id string) *errors.ServiceError
 	// DeprovisionKafkaForUsers registers all kafkas for deprovisioning given the list of owners
 	DeprovisionKafkaForUsers(users []string) *errors.ServiceError
+	// ListKafkaOwners returns the distinct owners of the kafkas that are not being deleted
+	ListKafkaOwners() ([]KafkaOwner, error)
 	DeprovisionExpiredKafkas(kafkaAgeInHours int) *errors.ServiceError
 	CountByStatus(status []constants2.KafkaStatus) ([]KafkaStatusCount, error)
 	CountByRegionAndInstanceType() ([]KafkaRegionCount, error)
@@ -100,25 +99,23 @@ type kafkaService struct {
 	clusterService           ClusterService
 	keycloakService          sso.KeycloakService
 	kafkaConfig              *config.KafkaConfig
-	awsConfig                *config.AWSConfig
 	quotaServiceFactory      QuotaServiceFactory
 	mu                       sync.Mutex
-	awsClientFactory         aws.ClientFactory
+	dnsProvider              dns.DNSProvider
 	authService              authorization.Authorization
 	dataplaneClusterConfig   *config.DataplaneClusterConfig
 	providerConfig           *config.ProviderConfig
 	clusterPlacementStrategy ClusterPlacementStrategy
 }
 
-func NewKafkaService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, keycloakService sso.KafkaKeycloakService, kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, awsConfig *config.AWSConfig, quotaServiceFactory QuotaServiceFactory, awsClientFactory aws.ClientFactory, authorizationService authorization.Authorization, providerConfig *config.ProviderConfig, clusterPlacementStrategy ClusterPlacementStrategy) *kafkaService {
+func NewKafkaService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, keycloakService sso.KafkaKeycloakService, kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, quotaServiceFactory QuotaServiceFactory, dnsProvider dns.DNSProvider, authorizationService authorization.Authorization, providerConfig *config.ProviderConfig, clusterPlacementStrategy ClusterPlacementStrategy) *kafkaService {
 	return &kafkaService{
 		connectionFactory:        connectionFactory,
 		clusterService:           clusterService,
 		keycloakService:          keycloakService,
 		kafkaConfig:              kafkaConfig,
-		awsConfig:                awsConfig,
 		quotaServiceFactory:      quotaServiceFactory,
-		awsClientFactory:         awsClientFactory,
+		dnsProvider:              dnsProvider,
 		authService:              authorizationService,
 		dataplaneClusterConfig:   dataplaneClusterConfig,
 		providerConfig:           providerConfig,
@@ -254,7 +251,7 @@ func (k *kafkaService) RegisterKafkaJob(kafkaRequest *dbapi.KafkaRequest) *error
 	kafkaRequest.SubscriptionId = subscriptionId
 	kafkaRequest.Status = constants2.KafkaRequestStatusAccepted.String()
 	// when creating new kafka - default storage size is assigned
-	kafkaRequest.KafkaStorageSize = k.kafkaConfig.KafkaCapacity.MaxDataRetentionSize
+	kafkaRequest.KafkaStorageSize = k.kafkaConfig.GetKafkaCapacity().MaxDataRetentionSize
 
 	// Persist the QuotaTyoe to be able to dynamically pick the right Quota service implementation even on restarts.
 	// A typical usecase is when a kafka A is created, at the time of creation the quota-type was ams. At some point in the future
@@ -293,8 +290,12 @@ func (k *kafkaService) PrepareKafkaRequest(kafkaRequest *dbapi.KafkaRequest) *er
 		kafkaRequest.SsoClientID = BuildKeycloakClientNameIdentifier(kafkaRequest.ID)
 		kafkaRequest.SsoClientSecret, err = k.keycloakService.RegisterKafkaClientInSSO(kafkaRequest.SsoClientID, kafkaRequest.OrganisationId)
 		if err != nil {
+			k.updateConditionOnPrepareFailure(kafkaRequest, dbapi.KafkaConditionSsoClientCreated, "SsoClientCreationFailed", err)
 			return errors.FailedToCreateSSOClient("failed to create sso client %s:%v", kafkaRequest.SsoClientID, err)
 		}
+		if _, condErr := kafkaRequest.SetCondition(dbapi.KafkaConditionSsoClientCreated, dbapi.KafkaConditionStatusTrue, "SsoClientCreated", ""); condErr != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, condErr, "failed to set sso client condition")
+		}
 		clientId := strings.ToLower(fmt.Sprintf("%s-%s", CanaryServiceAccountPrefix, kafkaRequest.ID))
 		serviceAccountRequest := sso.CompleteServiceAccountRequest{
 			Owner:          kafkaRequest.Owner,
@@ -308,11 +309,15 @@ func (k *kafkaService) PrepareKafkaRequest(kafkaRequest *dbapi.KafkaRequest) *er
 		canaryServiceAccount, err := k.keycloakService.CreateServiceAccountInternal(serviceAccountRequest)
 
 		if err != nil {
+			k.updateConditionOnPrepareFailure(kafkaRequest, dbapi.KafkaConditionCanaryServiceAccountCreated, "CanaryServiceAccountCreationFailed", err)
 			return errors.FailedToCreateSSOClient("failed to  create canary service account %s:%v", kafkaRequest.ID, err)
 		}
 
 		kafkaRequest.CanaryServiceAccountClientID = canaryServiceAccount.ClientID
 		kafkaRequest.CanaryServiceAccountClientSecret = canaryServiceAccount.ClientSecret
+		if _, condErr := kafkaRequest.SetCondition(dbapi.KafkaConditionCanaryServiceAccountCreated, dbapi.KafkaConditionStatusTrue, "CanaryServiceAccountCreated", ""); condErr != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, condErr, "failed to set canary service account condition")
+		}
 	}
 
 	// Update the Kafka Request record in the database
@@ -329,6 +334,7 @@ func (k *kafkaService) PrepareKafkaRequest(kafkaRequest *dbapi.KafkaRequest) *er
 		PlacementId:                      api.NewID(),
 		Status:                           constants2.KafkaRequestStatusProvisioning.String(),
 		Namespace:                        kafkaRequest.Namespace,
+		Conditions:                       kafkaRequest.Conditions,
 	}
 	if err := k.Update(updatedKafkaRequest); err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka request")
@@ -337,6 +343,21 @@ func (k *kafkaService) PrepareKafkaRequest(kafkaRequest *dbapi.KafkaRequest) *er
 	return nil
 }
 
+// updateConditionOnPrepareFailure records a failed preparation step in the conditions of the kafka request.
+// Failing to persist the condition is only logged so that the original preparation error is returned to the caller.
+func (k *kafkaService) updateConditionOnPrepareFailure(kafkaRequest *dbapi.KafkaRequest, conditionType dbapi.KafkaConditionType, reason string, cause error) {
+	changed, err := kafkaRequest.SetCondition(conditionType, dbapi.KafkaConditionStatusFalse, reason, cause.Error())
+	if err != nil {
+		logger.Logger.Errorf("failed to set %s condition for kafka %s: %v", conditionType, kafkaRequest.ID, err)
+		return
+	}
+	if changed {
+		if err := k.Updates(kafkaRequest, map[string]interface{}{"conditions": kafkaRequest.Conditions}); err != nil {
+			logger.Logger.Errorf("failed to update %s condition for kafka %s: %v", conditionType, kafkaRequest.ID, err)
+		}
+	}
+}
+
 func (k *kafkaService) ListByStatus(status ...constants2.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 	if len(status) == 0 {
 		return nil, errors.GeneralError("no status provided")
@@ -422,7 +443,7 @@ func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id strin
 
 	if auth.GetIsAdminFromContext(ctx) {
 		dbConn = dbConn.Where("id = ?", id)
-	} else if auth.GetIsOrgAdminFromClaims(claims) {
+	} else if rbac.HasOrganisationScope(ctx, rbac.ResourceKafka, rbac.PermissionDelete) {
 		orgId := auth.GetOrgIdFromClaims(claims)
 		dbConn = dbConn.Where("id = ?", id).Where("organisation_id = ?", orgId)
 	} else {
@@ -543,6 +564,11 @@ func (k *kafkaService) Delete(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceE
 	return nil
 }
 
+var validKafkaColumns = []string{"region", "name", "cloud_provider", "status", "owner", "created_at", "updated_at"}
+
+// kafkaKeysetColumns are the columns, besides name, that kafka requests can be ordered by when listing with a cursor
+var kafkaKeysetColumns = []string{"created_at", "updated_at", "id"}
+
 // List returns all Kafka requests belonging to a user.
 func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArguments) (dbapi.KafkaList, *api.PagingMeta, *errors.ServiceError) {
 	var kafkaRequestList dbapi.KafkaList
@@ -557,7 +583,10 @@ func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArgument
 		return nil, nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
 	}
 
-	if !auth.GetIsAdminFromContext(ctx) {
+	if auth.GetIsAdminFromContext(ctx) {
+		// admin lists span all the kafka requests and tolerate the replication lag
+		dbConn = k.connectionFactory.ReadOnly(ctx)
+	} else {
 		user := auth.GetUsernameFromClaims(claims)
 		if user == "" {
 			return nil, nil, errors.Unauthenticated("user not authenticated")
@@ -578,42 +607,63 @@ func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArgument
 
 	// Apply search query
 	if len(listArgs.Search) > 0 {
-		searchDbQuery, err := coreServices.NewQueryParser().Parse(listArgs.Search)
+		searchDbQuery, err := coreServices.NewQueryParser(validKafkaColumns...).Parse(listArgs.Search)
 		if err != nil {
 			return kafkaRequestList, pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "Unable to list kafka requests: %s", err.Error())
 		}
 		dbConn = dbConn.Where(searchDbQuery.Query, searchDbQuery.Values...)
 	}
 
-	if len(listArgs.OrderBy) == 0 {
-		// default orderBy name
-		dbConn = dbConn.Order("name")
-	}
+	var keyset *services.KeysetPaging
+	if listArgs.CursorPaging {
+		keyset, err = services.NewKeysetPaging(&dbapi.KafkaRequest{}, "kafka_requests", listArgs, "name", kafkaKeysetColumns...)
+		if err != nil {
+			return kafkaRequestList, pagingMeta, errors.NewWithCause(errors.ErrorBadRequest, err, "Unable to list kafka requests: %s", err.Error())
+		}
+	} else {
+		if len(listArgs.OrderBy) == 0 {
+			// default orderBy name
+			dbConn = dbConn.Order("name")
+		}
 
-	// Set the order by arguments if any
-	for _, orderByArg := range listArgs.OrderBy {
-		dbConn = dbConn.Order(orderByArg)
+		// Set the order by arguments if any
+		for _, orderByArg := range listArgs.OrderBy {
+			dbConn = dbConn.Order(orderByArg)
+		}
 	}
 
-	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
-	total := int64(pagingMeta.Total)
-	dbConn.Model(&kafkaRequestList).Count(&total)
-	pagingMeta.Total = int(total)
-	if pagingMeta.Size > pagingMeta.Total {
-		pagingMeta.Size = pagingMeta.Total
+	if keyset != nil {
+		// the keyset pages are not counted, as the count would scan all the resources for every page
+		dbConn = keyset.Apply(dbConn)
+	} else {
+		// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
+		total := int64(pagingMeta.Total)
+		dbConn.Model(&kafkaRequestList).Count(&total)
+		pagingMeta.Total = int(total)
+		if pagingMeta.Size > pagingMeta.Total {
+			pagingMeta.Size = pagingMeta.Total
+		}
+		dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 	}
-	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 
 	// execute query
 	if err := dbConn.Find(&kafkaRequestList).Error; err != nil {
 		return kafkaRequestList, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to list kafka requests")
 	}
 
+	if keyset != nil {
+		if pagingMeta.NextCursor, err = keyset.Page(&kafkaRequestList); err != nil {
+			return kafkaRequestList, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to list kafka requests")
+		}
+		pagingMeta.Size = len(kafkaRequestList)
+	}
+
 	return kafkaRequestList, pagingMeta, nil
 }
 
 func (k *kafkaService) GetManagedKafkaByClusterID(clusterID string) ([]managedkafka.ManagedKafka, *errors.ServiceError) {
-	dbConn := k.connectionFactory.New().
+	// polled by the fleetshard operators, which tolerate the replication lag
+	dbConn := k.connectionFactory.ReadOnly(context.Background()).
 		Where("cluster_id = ?", clusterID).
 		Where("status IN (?)", kafkaManagedCRStatuses).
 		Where("bootstrap_server_host != ''")
@@ -772,51 +822,32 @@ func (k *kafkaService) UpdateStatus(id string, status constants2.KafkaStatus) (b
 	return true, nil
 }
 
-func (k *kafkaService) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+func (k *kafkaService) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *errors.ServiceError) {
 	routes, err := kafkaRequest.GetRoutes()
 	if routes == nil || err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get routes")
 	}
 
-	domainRecordBatch := buildKafkaClusterCNAMESRecordBatch(routes, string(action))
-
-	// Create AWS client with the region of this Kafka Cluster
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53AccessKey,
-		SecretAccessKey: k.awsConfig.Route53SecretAccessKey,
-	}
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, kafkaRequest.Region)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create aws client")
+	dnsAction := dns.ActionUpsert
+	if action == KafkaRoutesActionDelete {
+		dnsAction = dns.ActionDelete
 	}
 
-	changeRecordsOutput, err := awsClient.ChangeResourceRecordSets(k.kafkaConfig.KafkaDomainName, domainRecordBatch)
+	change, err := k.dnsProvider.ChangeRecords(k.kafkaConfig.KafkaDomainName, dnsAction, buildKafkaClusterCNAMERecords(routes))
 	if err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create domain record sets")
 	}
 
-	return changeRecordsOutput, nil
+	return change, nil
 }
 
-func (k *kafkaService) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53AccessKey,
-		SecretAccessKey: k.awsConfig.Route53SecretAccessKey,
-	}
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, kafkaRequest.Region)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create aws client")
-	}
-
-	changeOutput, err := awsClient.GetChange(kafkaRequest.RoutesCreationId)
+func (k *kafkaService) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error) {
+	change, err := k.dnsProvider.GetChange(kafkaRequest.RoutesCreationId)
 	if err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to CNAME record status")
 	}
 
-	return &CNameRecordStatus{
-		Id:     changeOutput.ChangeInfo.Id,
-		Status: changeOutput.ChangeInfo.Status,
-	}, nil
+	return change, nil
 }
 
 type KafkaStatusCount struct {
@@ -833,7 +864,7 @@ type KafkaRegionCount struct {
 }
 
 func (k *kafkaService) CountByRegionAndInstanceType() ([]KafkaRegionCount, error) {
-	dbConn := k.connectionFactory.New()
+	dbConn := k.connectionFactory.ReadOnly(context.Background())
 	var results []KafkaRegionCount
 
 	if err := dbConn.Model(&dbapi.KafkaRequest{}).Select("region as Region, instance_type, cluster_id, cloud_provider, count(1) as Count").Group("region,instance_type,cluster_id,cloud_provider").Scan(&results).Error; err != nil {
@@ -844,7 +875,7 @@ func (k *kafkaService) CountByRegionAndInstanceType() ([]KafkaRegionCount, error
 }
 
 func (k *kafkaService) CountByStatus(status []constants2.KafkaStatus) ([]KafkaStatusCount, error) {
-	dbConn := k.connectionFactory.New()
+	dbConn := k.connectionFactory.ReadOnly(context.Background())
 	var results []KafkaStatusCount
 	if err := dbConn.Model(&dbapi.KafkaRequest{}).Select("status as Status, count(1) as Count").Where("status in (?)", status).Group("status").Scan(&results).Error; err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Failed to count kafkas")
@@ -867,6 +898,21 @@ func (k *kafkaService) CountByStatus(status []constants2.KafkaStatus) ([]KafkaSt
 	return results, nil
 }
 
+type KafkaOwner struct {
+	Owner          string
+	OrganisationId string `gorm:"column:organisation_id"`
+}
+
+func (k *kafkaService) ListKafkaOwners() ([]KafkaOwner, error) {
+	dbConn := k.connectionFactory.ReadOnly(context.Background())
+	var results []KafkaOwner
+	if err := dbConn.Model(&dbapi.KafkaRequest{}).Distinct("owner", "organisation_id").Where("status NOT IN (?)", kafkaDeletionStatuses).Scan(&results).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list kafka owners")
+	}
+
+	return results, nil
+}
+
 type KafkaComponentVersions struct {
 	ID                     string
 	ClusterID              string
@@ -882,7 +928,7 @@ type KafkaComponentVersions struct {
 }
 
 func (k *kafkaService) ListComponentVersions() ([]KafkaComponentVersions, error) {
-	dbConn := k.connectionFactory.New()
+	dbConn := k.connectionFactory.ReadOnly(context.Background())
 	var results []KafkaComponentVersions
 	if err := dbConn.Model(&dbapi.KafkaRequest{}).Select("id", "cluster_id", "desired_strimzi_version", "actual_strimzi_version", "strimzi_upgrading", "desired_kafka_version", "actual_kafka_version", "kafka_upgrading", "desired_kafka_ibp_version", "actual_kafka_ibp_version", "kafka_ibp_upgrading").Scan(&results).Error; err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list component versions")
@@ -900,6 +946,7 @@ func (k *kafkaService) ListKafkasWithRoutesNotCreated() ([]*dbapi.KafkaRequest,
 }
 
 func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.KafkaConfig, keycloakService sso.KeycloakService) *managedkafka.ManagedKafka {
+	kafkaCapacity := kafkaConfig.GetKafkaCapacity()
 	managedKafkaCR := &managedkafka.ManagedKafka{
 		Id: kafkaRequest.ID,
 		TypeMeta: metav1.TypeMeta{
@@ -916,12 +963,12 @@ func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 		},
 		Spec: managedkafka.ManagedKafkaSpec{
 			Capacity: managedkafka.Capacity{
-				IngressEgressThroughputPerSec: kafkaConfig.KafkaCapacity.IngressEgressThroughputPerSec,
-				TotalMaxConnections:           kafkaConfig.KafkaCapacity.TotalMaxConnections,
+				IngressEgressThroughputPerSec: kafkaCapacity.IngressEgressThroughputPerSec,
+				TotalMaxConnections:           kafkaCapacity.TotalMaxConnections,
 				MaxDataRetentionSize:          kafkaRequest.KafkaStorageSize,
-				MaxPartitions:                 kafkaConfig.KafkaCapacity.MaxPartitions,
-				MaxDataRetentionPeriod:        kafkaConfig.KafkaCapacity.MaxDataRetentionPeriod,
-				MaxConnectionAttemptsPerSec:   kafkaConfig.KafkaCapacity.MaxConnectionAttemptsPerSec,
+				MaxPartitions:                 kafkaCapacity.MaxPartitions,
+				MaxDataRetentionPeriod:        kafkaCapacity.MaxDataRetentionPeriod,
+				MaxConnectionAttemptsPerSec:   kafkaCapacity.MaxConnectionAttemptsPerSec,
 			},
 			Endpoint: managedkafka.EndpointSpec{
 				BootstrapServerHost: kafkaRequest.BootstrapServerHost,
@@ -982,36 +1029,10 @@ func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 	return managedKafkaCR
 }
 
-func buildKafkaClusterCNAMESRecordBatch(routes []dbapi.DataPlaneKafkaRoute, action string) *route53.ChangeBatch {
-	var changes []*route53.Change
+func buildKafkaClusterCNAMERecords(routes []dbapi.DataPlaneKafkaRoute) []dns.Record {
+	var records []dns.Record
 	for _, r := range routes {
-		c := buildResourceRecordChange(r.Domain, r.Router, action)
-		changes = append(changes, c)
+		records = append(records, dns.NewCNAMERecord(r.Domain, r.Router))
 	}
-	recordChangeBatch := &route53.ChangeBatch{
-		Changes: changes,
-	}
-
-	return recordChangeBatch
-}
-
-func buildResourceRecordChange(recordName string, clusterIngress string, action string) *route53.Change {
-	recordType := "CNAME"
-	recordTTL := int64(300)
-
-	resourceRecordChange := &route53.Change{
-		Action: &action,
-		ResourceRecordSet: &route53.ResourceRecordSet{
-			Name: &recordName,
-			Type: &recordType,
-			TTL:  &recordTTL,
-			ResourceRecords: []*route53.ResourceRecord{
-				{
-					Value: &clusterIngress,
-				},
-			},
-		},
-	}
-
-	return resourceRecordChange
+	return records
 }
@@ -0,0 +1,346 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	pModel "github.com/prometheus/common/model"
+)
+
+//go:generate moq -out kafka_health_moq.go . KafkaHealthService
+
+type KafkaHealthStatus string
+
+const (
+	KafkaHealthStatusHealthy     KafkaHealthStatus = "healthy"
+	KafkaHealthStatusDegraded    KafkaHealthStatus = "degraded"
+	KafkaHealthStatusUnavailable KafkaHealthStatus = "unavailable"
+	// KafkaHealthStatusUnknown is the status of the signals that could not be evaluated, they do not contribute to the
+	// health verdict
+	KafkaHealthStatusUnknown KafkaHealthStatus = "unknown"
+)
+
+// Severity returns the value of the status in the kafka health metrics
+func (s KafkaHealthStatus) Severity() float64 {
+	switch s {
+	case KafkaHealthStatusHealthy:
+		return 0
+	case KafkaHealthStatusDegraded:
+		return 1
+	case KafkaHealthStatusUnavailable:
+		return 2
+	default:
+		return -1
+	}
+}
+
+const (
+	// KafkaHealthSignalStatus is the status of the kafka request
+	KafkaHealthSignalStatus = "status"
+	// KafkaHealthSignalReadyCondition is the Ready condition last reported by the kas-fleetshard-operator
+	KafkaHealthSignalReadyCondition = "ready_condition"
+	// KafkaHealthSignalStatusReportAge is the time since the kas-fleetshard-operator last reported the status of the kafka
+	KafkaHealthSignalStatusReportAge = "status_report_age"
+	// KafkaHealthSignalOfflinePartitions is the number of offline partitions
+	KafkaHealthSignalOfflinePartitions = "offline_partitions"
+	// KafkaHealthSignalDiskUsage is the highest ratio of the storage used by a broker to its storage soft limit
+	KafkaHealthSignalDiskUsage = "disk_usage"
+	// KafkaHealthSignalUpgrade is whether a strimzi, kafka or kafka ibp upgrade is in progress
+	KafkaHealthSignalUpgrade = "upgrade"
+)
+
+// KafkaHealthSignals are the signals contributing to the kafka health verdict, in the order they are evaluated
+var KafkaHealthSignals = []string{
+	KafkaHealthSignalStatus,
+	KafkaHealthSignalReadyCondition,
+	KafkaHealthSignalStatusReportAge,
+	KafkaHealthSignalOfflinePartitions,
+	KafkaHealthSignalDiskUsage,
+	KafkaHealthSignalUpgrade,
+}
+
+const (
+	// the status of the kafkas is reported every minute at most by the kas-fleetshard-operator, see kafkaStatusReportResolution
+	statusReportAgeDegraded    = 3 * time.Minute
+	statusReportAgeUnavailable = 10 * time.Minute
+	// the producers are throttled by the kafka storage quota plugin when the soft limit is exceeded
+	diskUsageDegradedRatio = 0.9
+
+	offlinePartitionsMetric      = "kafka_controller_kafkacontroller_offline_partitions_count"
+	brokerStorageUsedBytesMetric = "kafka_broker_quota_totalstorageusedbytes"
+	brokerStorageSoftLimitMetric = "kafka_broker_quota_softlimitbytes"
+)
+
+// KafkaHealthSignal is a signal contributing to the health verdict of a kafka
+type KafkaHealthSignal struct {
+	Name    string
+	Status  KafkaHealthStatus
+	Message string
+	// Value is the measured value of the signal, if any
+	Value *float64
+}
+
+// KafkaHealth is the health verdict of a kafka, i.e. the worst status of its signals
+type KafkaHealth struct {
+	KafkaId   string
+	ClusterId string
+	Status    KafkaHealthStatus
+	Signals   []KafkaHealthSignal
+	CheckedAt time.Time
+}
+
+type KafkaHealthService interface {
+	// GetKafkaHealth returns the health of the kafka if the user in the context is allowed to see it
+	GetKafkaHealth(ctx context.Context, id string) (*KafkaHealth, *errors.ServiceError)
+	// EvaluateKafkaHealth returns the health of a kafka that has already been retrieved
+	EvaluateKafkaHealth(kafkaRequest *dbapi.KafkaRequest) *KafkaHealth
+}
+
+var _ KafkaHealthService = &kafkaHealthService{}
+
+type kafkaHealthService struct {
+	kafkaService         KafkaService
+	observatoriumService ObservatoriumService
+}
+
+func NewKafkaHealthService(kafkaService KafkaService, observatoriumService ObservatoriumService) KafkaHealthService {
+	return &kafkaHealthService{
+		kafkaService:         kafkaService,
+		observatoriumService: observatoriumService,
+	}
+}
+
+func (h *kafkaHealthService) GetKafkaHealth(ctx context.Context, id string) (*KafkaHealth, *errors.ServiceError) {
+	kafkaRequest, err := h.kafkaService.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return h.EvaluateKafkaHealth(kafkaRequest), nil
+}
+
+func (h *kafkaHealthService) EvaluateKafkaHealth(kafkaRequest *dbapi.KafkaRequest) *KafkaHealth {
+	now := time.Now()
+	health := &KafkaHealth{
+		KafkaId:   kafkaRequest.ID,
+		ClusterId: kafkaRequest.ClusterID,
+		Status:    KafkaHealthStatusHealthy,
+		CheckedAt: now,
+	}
+
+	signals := []KafkaHealthSignal{
+		statusSignal(kafkaRequest),
+		readyConditionSignal(kafkaRequest),
+		statusReportAgeSignal(kafkaRequest, now),
+	}
+	// the metrics are only available once the kafka has been provisioned
+	if kafkaRequest.Status == constants2.KafkaRequestStatusReady.String() {
+		signals = append(signals, h.metricsSignals(kafkaRequest)...)
+	} else {
+		signals = append(signals,
+			KafkaHealthSignal{Name: KafkaHealthSignalOfflinePartitions, Status: KafkaHealthStatusUnknown, Message: "the kafka is not ready"},
+			KafkaHealthSignal{Name: KafkaHealthSignalDiskUsage, Status: KafkaHealthStatusUnknown, Message: "the kafka is not ready"},
+		)
+	}
+	signals = append(signals, upgradeSignal(kafkaRequest))
+
+	for _, signal := range signals {
+		if signal.Status.Severity() > health.Status.Severity() {
+			health.Status = signal.Status
+		}
+	}
+	health.Signals = signals
+	return health
+}
+
+func statusSignal(kafkaRequest *dbapi.KafkaRequest) KafkaHealthSignal {
+	signal := KafkaHealthSignal{
+		Name:    KafkaHealthSignalStatus,
+		Status:  KafkaHealthStatusUnavailable,
+		Message: fmt.Sprintf("the kafka status is %s", kafkaRequest.Status),
+	}
+	if kafkaRequest.Status == constants2.KafkaRequestStatusReady.String() {
+		signal.Status = KafkaHealthStatusHealthy
+	}
+	return signal
+}
+
+func readyConditionSignal(kafkaRequest *dbapi.KafkaRequest) KafkaHealthSignal {
+	signal := KafkaHealthSignal{Name: KafkaHealthSignalReadyCondition, Status: KafkaHealthStatusUnknown}
+	conditions, err := kafkaRequest.GetConditions()
+	if err != nil {
+		signal.Message = "failed to read the kafka conditions"
+		return signal
+	}
+	condition, found := conditions.GetCondition(dbapi.KafkaConditionDataPlaneReady)
+	if !found {
+		signal.Message = "the data plane has not reported the Ready condition yet"
+		return signal
+	}
+
+	signal.Message = fmt.Sprintf("Ready=%s", condition.Status)
+	if condition.Reason != "" {
+		signal.Message = fmt.Sprintf("%s reason=%s", signal.Message, condition.Reason)
+	}
+	if condition.Message != "" {
+		signal.Message = fmt.Sprintf("%s: %s", signal.Message, condition.Message)
+	}
+	switch {
+	case condition.Status == dbapi.KafkaConditionStatusTrue:
+		signal.Status = KafkaHealthStatusHealthy
+	case condition.Status == dbapi.KafkaConditionStatusFalse && isUpgradingReason(condition.Reason):
+		signal.Status = KafkaHealthStatusDegraded
+	case condition.Status == dbapi.KafkaConditionStatusFalse:
+		signal.Status = KafkaHealthStatusUnavailable
+	default:
+		signal.Status = KafkaHealthStatusDegraded
+	}
+	return signal
+}
+
+func isUpgradingReason(reason string) bool {
+	return reason == strimziUpdating || reason == kafkaUpdating || reason == kafkaIBPUpdating
+}
+
+func statusReportAgeSignal(kafkaRequest *dbapi.KafkaRequest, now time.Time) KafkaHealthSignal {
+	signal := KafkaHealthSignal{Name: KafkaHealthSignalStatusReportAge, Status: KafkaHealthStatusUnknown}
+	if kafkaRequest.StatusReportedAt == nil {
+		signal.Message = "the data plane has not reported the kafka status yet"
+		return signal
+	}
+
+	age := now.Sub(*kafkaRequest.StatusReportedAt)
+	seconds := age.Seconds()
+	signal.Value = &seconds
+	signal.Message = fmt.Sprintf("the kafka status was last reported %s ago", age.Truncate(time.Second))
+	switch {
+	case age >= statusReportAgeUnavailable:
+		signal.Status = KafkaHealthStatusUnavailable
+	case age >= statusReportAgeDegraded:
+		signal.Status = KafkaHealthStatusDegraded
+	default:
+		signal.Status = KafkaHealthStatusHealthy
+	}
+	return signal
+}
+
+func upgradeSignal(kafkaRequest *dbapi.KafkaRequest) KafkaHealthSignal {
+	signal := KafkaHealthSignal{Name: KafkaHealthSignalUpgrade, Status: KafkaHealthStatusDegraded}
+	switch {
+	case kafkaRequest.StrimziUpgrading:
+		signal.Message = fmt.Sprintf("upgrading strimzi version to '%s'", kafkaRequest.DesiredStrimziVersion)
+	case kafkaRequest.KafkaUpgrading:
+		signal.Message = fmt.Sprintf("upgrading kafka version to '%s'", kafkaRequest.DesiredKafkaVersion)
+	case kafkaRequest.KafkaIBPUpgrading:
+		signal.Message = fmt.Sprintf("upgrading kafka ibp version to '%s'", kafkaRequest.DesiredKafkaIBPVersion)
+	default:
+		signal.Status = KafkaHealthStatusHealthy
+		signal.Message = "no upgrade in progress"
+	}
+	return signal
+}
+
+// metricsSignals returns the offline partitions and disk usage signals, which are unknown when the metrics can't be retrieved
+func (h *kafkaHealthService) metricsSignals(kafkaRequest *dbapi.KafkaRequest) []KafkaHealthSignal {
+	query := observatorium.MetricsReqParams{
+		ResultType: observatorium.Query,
+		Filters:    []string{offlinePartitionsMetric, brokerStorageUsedBytesMetric, brokerStorageSoftLimitMetric},
+	}
+	query.FillDefaults()
+	kafkaMetrics := &observatorium.KafkaMetrics{}
+	if err := h.observatoriumService.GetMetricsByKafka(kafkaRequest, kafkaMetrics, query); err != nil {
+		message := fmt.Sprintf("failed to retrieve the kafka metrics: %s", err.Reason)
+		return []KafkaHealthSignal{
+			{Name: KafkaHealthSignalOfflinePartitions, Status: KafkaHealthStatusUnknown, Message: message},
+			{Name: KafkaHealthSignalDiskUsage, Status: KafkaHealthStatusUnknown, Message: message},
+		}
+	}
+
+	samples := map[string]pModel.Vector{}
+	for _, m := range *kafkaMetrics {
+		for _, sample := range m.Vector {
+			name := string(sample.Metric[pModel.MetricNameLabel])
+			samples[name] = append(samples[name], sample)
+		}
+	}
+	return []KafkaHealthSignal{
+		offlinePartitionsSignal(samples[offlinePartitionsMetric]),
+		diskUsageSignal(samples[brokerStorageUsedBytesMetric], samples[brokerStorageSoftLimitMetric]),
+	}
+}
+
+// offlinePartitionsSignal returns the number of offline partitions reported by the active controller
+func offlinePartitionsSignal(offlinePartitions pModel.Vector) KafkaHealthSignal {
+	signal := KafkaHealthSignal{Name: KafkaHealthSignalOfflinePartitions, Status: KafkaHealthStatusUnknown}
+	if len(offlinePartitions) == 0 {
+		signal.Message = "the offline partitions count is not reported"
+		return signal
+	}
+
+	// only the active controller reports the offline partitions
+	var count float64
+	for _, sample := range offlinePartitions {
+		if float64(sample.Value) > count {
+			count = float64(sample.Value)
+		}
+	}
+	signal.Value = &count
+	signal.Message = fmt.Sprintf("%d offline partitions", int(count))
+	if count > 0 {
+		signal.Status = KafkaHealthStatusDegraded
+	} else {
+		signal.Status = KafkaHealthStatusHealthy
+	}
+	return signal
+}
+
+// diskUsageSignal returns the highest ratio of the storage used by a broker to its storage soft limit
+func diskUsageSignal(usedBytes pModel.Vector, softLimitBytes pModel.Vector) KafkaHealthSignal {
+	signal := KafkaHealthSignal{Name: KafkaHealthSignalDiskUsage, Status: KafkaHealthStatusUnknown}
+
+	// the storage used and the soft limit of a broker have the same labels
+	softLimits := map[pModel.Fingerprint]float64{}
+	for _, sample := range softLimitBytes {
+		softLimits[brokerFingerprint(sample.Metric)] = float64(sample.Value)
+	}
+	found := false
+	var ratio float64
+	for _, sample := range usedBytes {
+		softLimit, ok := softLimits[brokerFingerprint(sample.Metric)]
+		if !ok || softLimit <= 0 {
+			continue
+		}
+		found = true
+		if r := float64(sample.Value) / softLimit; r > ratio {
+			ratio = r
+		}
+	}
+	if !found {
+		signal.Message = "the broker storage usage is not reported"
+		return signal
+	}
+
+	signal.Value = &ratio
+	switch {
+	case ratio >= 1:
+		signal.Status = KafkaHealthStatusDegraded
+		signal.Message = fmt.Sprintf("a broker uses %.0f%% of its storage soft limit, the producers are throttled", ratio*100)
+	case ratio >= diskUsageDegradedRatio:
+		signal.Status = KafkaHealthStatusDegraded
+		signal.Message = fmt.Sprintf("a broker uses %.0f%% of its storage soft limit", ratio*100)
+	default:
+		signal.Status = KafkaHealthStatusHealthy
+		signal.Message = fmt.Sprintf("the brokers use at most %.0f%% of their storage soft limit", ratio*100)
+	}
+	return signal
+}
+
+func brokerFingerprint(metric pModel.Metric) pModel.Fingerprint {
+	labels := metric.Clone()
+	delete(labels, pModel.MetricNameLabel)
+	return labels.Fingerprint()
+}
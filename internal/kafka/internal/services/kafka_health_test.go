@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	. "github.com/onsi/gomega"
+	pModel "github.com/prometheus/common/model"
+)
+
+func brokerSample(name string, broker string, value float64) *pModel.Sample {
+	return &pModel.Sample{
+		Metric: pModel.Metric{
+			pModel.MetricNameLabel: pModel.LabelValue(name),
+			"pod":                  pModel.LabelValue(broker),
+		},
+		Value: pModel.SampleValue(value),
+	}
+}
+
+func healthyKafkaMetrics() pModel.Vector {
+	return pModel.Vector{
+		brokerSample(offlinePartitionsMetric, "kafka-0", 0),
+		brokerSample(offlinePartitionsMetric, "kafka-1", 0),
+		brokerSample(brokerStorageUsedBytesMetric, "kafka-0", 50),
+		brokerSample(brokerStorageSoftLimitMetric, "kafka-0", 100),
+		brokerSample(brokerStorageUsedBytesMetric, "kafka-1", 60),
+		brokerSample(brokerStorageSoftLimitMetric, "kafka-1", 100),
+	}
+}
+
+func healthyKafkaRequest() *dbapi.KafkaRequest {
+	reportedAt := time.Now().Add(-30 * time.Second)
+	return &dbapi.KafkaRequest{
+		Meta:             api.Meta{ID: "test-kafka"},
+		ClusterID:        "test-cluster-id",
+		Status:           constants2.KafkaRequestStatusReady.String(),
+		Conditions:       []byte(`[{"type":"DataPlaneReady","status":"True","last_transition_time":"2022-04-22T10:00:00Z"}]`),
+		StatusReportedAt: &reportedAt,
+	}
+}
+
+func Test_kafkaHealthService_EvaluateKafkaHealth(t *testing.T) {
+	tests := []struct {
+		name         string
+		kafka        func(kafka *dbapi.KafkaRequest)
+		metrics      pModel.Vector
+		metricsErr   *errors.ServiceError
+		wantStatus   KafkaHealthStatus
+		wantSignals  map[string]KafkaHealthStatus
+		wantNoMetric bool
+	}{
+		{
+			name:       "should be healthy when all the signals are healthy",
+			metrics:    healthyKafkaMetrics(),
+			wantStatus: KafkaHealthStatusHealthy,
+			wantSignals: map[string]KafkaHealthStatus{
+				KafkaHealthSignalStatus:            KafkaHealthStatusHealthy,
+				KafkaHealthSignalReadyCondition:    KafkaHealthStatusHealthy,
+				KafkaHealthSignalStatusReportAge:   KafkaHealthStatusHealthy,
+				KafkaHealthSignalOfflinePartitions: KafkaHealthStatusHealthy,
+				KafkaHealthSignalDiskUsage:         KafkaHealthStatusHealthy,
+				KafkaHealthSignalUpgrade:           KafkaHealthStatusHealthy,
+			},
+		},
+		{
+			name: "should be unavailable when the data plane reports the kafka as not ready",
+			kafka: func(kafka *dbapi.KafkaRequest) {
+				kafka.Conditions = []byte(`[{"type":"DataPlaneReady","status":"False","reason":"Error","last_transition_time":"2022-04-22T10:00:00Z"}]`)
+			},
+			metrics:     healthyKafkaMetrics(),
+			wantStatus:  KafkaHealthStatusUnavailable,
+			wantSignals: map[string]KafkaHealthStatus{KafkaHealthSignalReadyCondition: KafkaHealthStatusUnavailable},
+		},
+		{
+			name: "should be degraded while the kafka is upgrading",
+			kafka: func(kafka *dbapi.KafkaRequest) {
+				kafka.Conditions = []byte(`[{"type":"DataPlaneReady","status":"False","reason":"KafkaUpdating","last_transition_time":"2022-04-22T10:00:00Z"}]`)
+				kafka.KafkaUpgrading = true
+			},
+			metrics:    healthyKafkaMetrics(),
+			wantStatus: KafkaHealthStatusDegraded,
+			wantSignals: map[string]KafkaHealthStatus{
+				KafkaHealthSignalReadyCondition: KafkaHealthStatusDegraded,
+				KafkaHealthSignalUpgrade:        KafkaHealthStatusDegraded,
+			},
+		},
+		{
+			name: "should be degraded when the status has not been reported for a few minutes",
+			kafka: func(kafka *dbapi.KafkaRequest) {
+				reportedAt := time.Now().Add(-5 * time.Minute)
+				kafka.StatusReportedAt = &reportedAt
+			},
+			metrics:     healthyKafkaMetrics(),
+			wantStatus:  KafkaHealthStatusDegraded,
+			wantSignals: map[string]KafkaHealthStatus{KafkaHealthSignalStatusReportAge: KafkaHealthStatusDegraded},
+		},
+		{
+			name: "should be unavailable when the status has not been reported for a long time",
+			kafka: func(kafka *dbapi.KafkaRequest) {
+				reportedAt := time.Now().Add(-time.Hour)
+				kafka.StatusReportedAt = &reportedAt
+			},
+			metrics:     healthyKafkaMetrics(),
+			wantStatus:  KafkaHealthStatusUnavailable,
+			wantSignals: map[string]KafkaHealthStatus{KafkaHealthSignalStatusReportAge: KafkaHealthStatusUnavailable},
+		},
+		{
+			name: "should be degraded when partitions are offline",
+			metrics: append(healthyKafkaMetrics(),
+				brokerSample(offlinePartitionsMetric, "kafka-2", 3),
+			),
+			wantStatus:  KafkaHealthStatusDegraded,
+			wantSignals: map[string]KafkaHealthStatus{KafkaHealthSignalOfflinePartitions: KafkaHealthStatusDegraded},
+		},
+		{
+			name: "should be degraded when a broker is close to its storage soft limit",
+			metrics: append(healthyKafkaMetrics(),
+				brokerSample(brokerStorageUsedBytesMetric, "kafka-2", 95),
+				brokerSample(brokerStorageSoftLimitMetric, "kafka-2", 100),
+			),
+			wantStatus:  KafkaHealthStatusDegraded,
+			wantSignals: map[string]KafkaHealthStatus{KafkaHealthSignalDiskUsage: KafkaHealthStatusDegraded},
+		},
+		{
+			name:       "should ignore the metrics signals when the metrics can't be retrieved",
+			metricsErr: errors.GeneralError("observatorium unavailable"),
+			wantStatus: KafkaHealthStatusHealthy,
+			wantSignals: map[string]KafkaHealthStatus{
+				KafkaHealthSignalOfflinePartitions: KafkaHealthStatusUnknown,
+				KafkaHealthSignalDiskUsage:         KafkaHealthStatusUnknown,
+			},
+		},
+		{
+			name: "should be unavailable and not query the metrics when the kafka is not ready",
+			kafka: func(kafka *dbapi.KafkaRequest) {
+				kafka.Status = constants2.KafkaRequestStatusProvisioning.String()
+				kafka.Conditions = nil
+				kafka.StatusReportedAt = nil
+			},
+			wantStatus: KafkaHealthStatusUnavailable,
+			wantSignals: map[string]KafkaHealthStatus{
+				KafkaHealthSignalStatus:            KafkaHealthStatusUnavailable,
+				KafkaHealthSignalReadyCondition:    KafkaHealthStatusUnknown,
+				KafkaHealthSignalStatusReportAge:   KafkaHealthStatusUnknown,
+				KafkaHealthSignalOfflinePartitions: KafkaHealthStatusUnknown,
+				KafkaHealthSignalDiskUsage:         KafkaHealthStatusUnknown,
+			},
+			wantNoMetric: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			kafka := healthyKafkaRequest()
+			if tt.kafka != nil {
+				tt.kafka(kafka)
+			}
+			observatoriumService := &ObservatoriumServiceMock{
+				GetMetricsByKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest, csMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *errors.ServiceError {
+					if tt.metricsErr != nil {
+						return tt.metricsErr
+					}
+					*csMetrics = append(*csMetrics, observatorium.Metric{Vector: tt.metrics})
+					return nil
+				},
+			}
+			health := NewKafkaHealthService(&KafkaServiceMock{}, observatoriumService).EvaluateKafkaHealth(kafka)
+
+			Expect(health.KafkaId).To(Equal("test-kafka"))
+			Expect(health.ClusterId).To(Equal("test-cluster-id"))
+			Expect(health.Status).To(Equal(tt.wantStatus))
+			Expect(health.Signals).To(HaveLen(len(KafkaHealthSignals)))
+			signals := map[string]KafkaHealthStatus{}
+			for i, signal := range health.Signals {
+				Expect(signal.Name).To(Equal(KafkaHealthSignals[i]))
+				signals[signal.Name] = signal.Status
+			}
+			for name, status := range tt.wantSignals {
+				Expect(signals[name]).To(Equal(status), "signal %s", name)
+			}
+			Expect(len(observatoriumService.GetMetricsByKafkaCalls()) == 0).To(Equal(tt.wantNoMetric))
+		})
+	}
+}
+
+func Test_kafkaHealthService_GetKafkaHealth(t *testing.T) {
+	RegisterTestingT(t)
+	kafkaService := &KafkaServiceMock{
+		GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+			if id != "test-kafka" {
+				return nil, errors.NotFound("kafka %s not found", id)
+			}
+			return healthyKafkaRequest(), nil
+		},
+	}
+	observatoriumService := &ObservatoriumServiceMock{
+		GetMetricsByKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest, csMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *errors.ServiceError {
+			*csMetrics = append(*csMetrics, observatorium.Metric{Vector: healthyKafkaMetrics()})
+			return nil
+		},
+	}
+	service := NewKafkaHealthService(kafkaService, observatoriumService)
+
+	health, err := service.GetKafkaHealth(context.Background(), "test-kafka")
+	Expect(err).To(BeNil())
+	Expect(health.Status).To(Equal(KafkaHealthStatusHealthy))
+
+	_, err = service.GetKafkaHealth(context.Background(), "unknown-kafka")
+	Expect(err).ToNot(BeNil())
+	Expect(err.Code).To(Equal(errors.ErrorNotFound))
+}
@@ -5,12 +5,12 @@ package services
 
 import (
 	"context"
-	"github.com/aws/aws-sdk-go/service/route53"
 	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
 	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"sync"
@@ -26,7 +26,7 @@ var _ KafkaService = &KafkaServiceMock{}
 //
 // 		// make and configure a mocked KafkaService
 // 		mockedKafkaService := &KafkaServiceMock{
-// 			ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError) {
+// 			ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *serviceError.ServiceError) {
 // 				panic("mock out the ChangeKafkaCNAMErecords method")
 // 			},
 // 			CountByRegionAndInstanceTypeFunc: func() ([]KafkaRegionCount, error) {
@@ -53,7 +53,7 @@ var _ KafkaService = &KafkaServiceMock{}
 // 			GetByIdFunc: func(id string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
 // 				panic("mock out the GetById method")
 // 			},
-// 			GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+// 			GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error) {
 // 				panic("mock out the GetCNAMERecordStatus method")
 // 			},
 // 			GetManagedKafkaByClusterIDFunc: func(clusterID string) ([]managedkafka.ManagedKafka, *serviceError.ServiceError) {
@@ -71,6 +71,9 @@ var _ KafkaService = &KafkaServiceMock{}
 // 			ListComponentVersionsFunc: func() ([]KafkaComponentVersions, error) {
 // 				panic("mock out the ListComponentVersions method")
 // 			},
+// 			ListKafkaOwnersFunc: func() ([]KafkaOwner, error) {
+// 				panic("mock out the ListKafkaOwners method")
+// 			},
 // 			ListKafkasWithRoutesNotCreatedFunc: func() ([]*dbapi.KafkaRequest, *serviceError.ServiceError) {
 // 				panic("mock out the ListKafkasWithRoutesNotCreated method")
 // 			},
@@ -103,7 +106,7 @@ var _ KafkaService = &KafkaServiceMock{}
 // 	}
 type KafkaServiceMock struct {
 	// ChangeKafkaCNAMErecordsFunc mocks the ChangeKafkaCNAMErecords method.
-	ChangeKafkaCNAMErecordsFunc func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError)
+	ChangeKafkaCNAMErecordsFunc func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *serviceError.ServiceError)
 
 	// CountByRegionAndInstanceTypeFunc mocks the CountByRegionAndInstanceType method.
 	CountByRegionAndInstanceTypeFunc func() ([]KafkaRegionCount, error)
@@ -130,7 +133,7 @@ type KafkaServiceMock struct {
 	GetByIdFunc func(id string) (*dbapi.KafkaRequest, *serviceError.ServiceError)
 
 	// GetCNAMERecordStatusFunc mocks the GetCNAMERecordStatus method.
-	GetCNAMERecordStatusFunc func(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
+	GetCNAMERecordStatusFunc func(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error)
 
 	// GetManagedKafkaByClusterIDFunc mocks the GetManagedKafkaByClusterID method.
 	GetManagedKafkaByClusterIDFunc func(clusterID string) ([]managedkafka.ManagedKafka, *serviceError.ServiceError)
@@ -147,6 +150,9 @@ type KafkaServiceMock struct {
 	// ListComponentVersionsFunc mocks the ListComponentVersions method.
 	ListComponentVersionsFunc func() ([]KafkaComponentVersions, error)
 
+	// ListKafkaOwnersFunc mocks the ListKafkaOwners method.
+	ListKafkaOwnersFunc func() ([]KafkaOwner, error)
+
 	// ListKafkasWithRoutesNotCreatedFunc mocks the ListKafkasWithRoutesNotCreated method.
 	ListKafkasWithRoutesNotCreatedFunc func() ([]*dbapi.KafkaRequest, *serviceError.ServiceError)
 
@@ -250,6 +256,9 @@ type KafkaServiceMock struct {
 		// ListComponentVersions holds details about calls to the ListComponentVersions method.
 		ListComponentVersions []struct {
 		}
+		// ListKafkaOwners holds details about calls to the ListKafkaOwners method.
+		ListKafkaOwners []struct {
+		}
 		// ListKafkasWithRoutesNotCreated holds details about calls to the ListKafkasWithRoutesNotCreated method.
 		ListKafkasWithRoutesNotCreated []struct {
 		}
@@ -312,6 +321,7 @@ type KafkaServiceMock struct {
 	lockList                           sync.RWMutex
 	lockListByStatus                   sync.RWMutex
 	lockListComponentVersions          sync.RWMutex
+	lockListKafkaOwners                sync.RWMutex
 	lockListKafkasWithRoutesNotCreated sync.RWMutex
 	lockPrepareKafkaRequest            sync.RWMutex
 	lockRegisterKafkaDeprovisionJob    sync.RWMutex
@@ -323,7 +333,7 @@ type KafkaServiceMock struct {
 }
 
 // ChangeKafkaCNAMErecords calls ChangeKafkaCNAMErecordsFunc.
-func (mock *KafkaServiceMock) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError) {
+func (mock *KafkaServiceMock) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *serviceError.ServiceError) {
 	if mock.ChangeKafkaCNAMErecordsFunc == nil {
 		panic("KafkaServiceMock.ChangeKafkaCNAMErecordsFunc: method is nil but KafkaService.ChangeKafkaCNAMErecords was just called")
 	}
@@ -605,7 +615,7 @@ func (mock *KafkaServiceMock) GetByIdCalls() []struct {
 }
 
 // GetCNAMERecordStatus calls GetCNAMERecordStatusFunc.
-func (mock *KafkaServiceMock) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+func (mock *KafkaServiceMock) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error) {
 	if mock.GetCNAMERecordStatusFunc == nil {
 		panic("KafkaServiceMock.GetCNAMERecordStatusFunc: method is nil but KafkaService.GetCNAMERecordStatus was just called")
 	}
@@ -789,6 +799,32 @@ func (mock *KafkaServiceMock) ListComponentVersionsCalls() []struct {
 	return calls
 }
 
+// ListKafkaOwners calls ListKafkaOwnersFunc.
+func (mock *KafkaServiceMock) ListKafkaOwners() ([]KafkaOwner, error) {
+	if mock.ListKafkaOwnersFunc == nil {
+		panic("KafkaServiceMock.ListKafkaOwnersFunc: method is nil but KafkaService.ListKafkaOwners was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListKafkaOwners.Lock()
+	mock.calls.ListKafkaOwners = append(mock.calls.ListKafkaOwners, callInfo)
+	mock.lockListKafkaOwners.Unlock()
+	return mock.ListKafkaOwnersFunc()
+}
+
+// ListKafkaOwnersCalls gets all the calls that were made to ListKafkaOwners.
+// Check the length with:
+//     len(mockedKafkaService.ListKafkaOwnersCalls())
+func (mock *KafkaServiceMock) ListKafkaOwnersCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListKafkaOwners.RLock()
+	calls = mock.calls.ListKafkaOwners
+	mock.lockListKafkaOwners.RUnlock()
+	return calls
+}
+
 // ListKafkasWithRoutesNotCreated calls ListKafkasWithRoutesNotCreatedFunc.
 func (mock *KafkaServiceMock) ListKafkasWithRoutesNotCreated() ([]*dbapi.KafkaRequest, *serviceError.ServiceError) {
 	if mock.ListKafkasWithRoutesNotCreatedFunc == nil {
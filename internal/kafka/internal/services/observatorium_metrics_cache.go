@@ -0,0 +1,79 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/patrickmn/go-cache"
+	"golang.org/x/sync/singleflight"
+)
+
+// metricsQueryCache caches the results of the kafka metrics queries for a short time and coalesces the concurrent
+// identical queries, so that the dashboards refreshing the metrics of the same kafka every few seconds result in a single
+// set of Observatorium queries.
+type metricsQueryCache struct {
+	ttl      time.Duration
+	cache    *cache.Cache
+	requests singleflight.Group
+}
+
+func newMetricsQueryCache(ttl time.Duration) *metricsQueryCache {
+	return &metricsQueryCache{
+		ttl:   ttl,
+		cache: cache.New(ttl, 2*ttl),
+	}
+}
+
+// getMetrics appends the metrics of the given kafka to kafkaMetrics, fetching them with fetch on a cache miss. The time
+// bounds of the range queries are rounded down to the cache ttl so that the queries sent within the same ttl window share
+// the same result. The errors are not cached.
+func (c *metricsQueryCache) getMetrics(kafkaMetrics *observatorium.KafkaMetrics, kafkaId string, query *observatorium.MetricsReqParams, fetch func(*observatorium.KafkaMetrics, *observatorium.MetricsReqParams) error) error {
+	if query.ResultType == observatorium.RangeQuery {
+		query.Start = query.Start.Truncate(c.ttl)
+		query.End = query.End.Truncate(c.ttl)
+	}
+	key := metricsQueryCacheKey(kafkaId, query)
+
+	if cached, ok := c.cache.Get(key); ok {
+		metrics.IncreaseObservatoriumMetricsCacheRequestCount(metrics.MetricsCacheHit)
+		*kafkaMetrics = append(*kafkaMetrics, cached.(observatorium.KafkaMetrics)...)
+		return nil
+	}
+
+	fetched := false
+	result, err, _ := c.requests.Do(key, func() (interface{}, error) {
+		fetched = true
+		fetchedMetrics := observatorium.KafkaMetrics{}
+		if err := fetch(&fetchedMetrics, query); err != nil {
+			return nil, err
+		}
+		c.cache.SetDefault(key, fetchedMetrics)
+		return fetchedMetrics, nil
+	})
+	if fetched {
+		metrics.IncreaseObservatoriumMetricsCacheRequestCount(metrics.MetricsCacheMiss)
+	} else {
+		metrics.IncreaseObservatoriumMetricsCacheRequestCount(metrics.MetricsCacheHit)
+	}
+	if err != nil {
+		return err
+	}
+	*kafkaMetrics = append(*kafkaMetrics, result.(observatorium.KafkaMetrics)...)
+	return nil
+}
+
+// metricsQueryCacheKey identifies a query by kafka, result type, set of queried metrics and time bounds. The instant
+// queries are always evaluated at the current time, their time bounds are not part of the key.
+func metricsQueryCacheKey(kafkaId string, query *observatorium.MetricsReqParams) string {
+	filters := append([]string{}, query.Filters...)
+	sort.Strings(filters)
+	key := fmt.Sprintf("%s/%s/%s", kafkaId, query.ResultType, strings.Join(filters, ","))
+	if query.ResultType == observatorium.RangeQuery {
+		key = fmt.Sprintf("%s/%d/%d/%s", key, query.Start.Unix(), query.End.Unix(), query.Step)
+	}
+	return key
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	. "github.com/onsi/gomega"
+)
+
+// countingObservatoriumAPI counts the GetMetrics calls, each call returning one metric
+type countingObservatoriumAPI struct {
+	calls   int32
+	err     error
+	release chan struct{}
+}
+
+func (c *countingObservatoriumAPI) GetKafkaState(name string, namespaceName string) (observatorium.KafkaState, error) {
+	return observatorium.KafkaState{}, nil
+}
+
+func (c *countingObservatoriumAPI) GetMetrics(metrics *observatorium.KafkaMetrics, namespace string, rq *observatorium.MetricsReqParams) error {
+	atomic.AddInt32(&c.calls, 1)
+	if c.release != nil {
+		<-c.release
+	}
+	if c.err != nil {
+		return c.err
+	}
+	*metrics = append(*metrics, observatorium.Metric{})
+	return nil
+}
+
+func newCachedObservatoriumService(observatoriumAPI *countingObservatoriumAPI, ttl time.Duration) ObservatoriumService {
+	kafkaService := &KafkaServiceMock{
+		GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
+			return &dbapi.KafkaRequest{Meta: api.Meta{ID: id}, Namespace: "kafka-" + id}, nil
+		},
+	}
+	return NewObservatoriumService(&observatorium.Client{Service: observatoriumAPI}, kafkaService, &observatorium.ObservabilityConfiguration{MetricsCacheTTL: ttl})
+}
+
+func rangeQuery(filters ...string) observatorium.MetricsReqParams {
+	q := observatorium.MetricsReqParams{ResultType: observatorium.RangeQuery, Filters: filters}
+	q.FillDefaults()
+	q.End = time.Date(2022, 4, 21, 10, 0, 0, 0, time.UTC)
+	q.Start = q.End.Add(-5 * time.Minute)
+	return q
+}
+
+func Test_ObservatoriumService_GetMetricsByKafkaId_Cache(t *testing.T) {
+	tests := []struct {
+		name      string
+		ttl       time.Duration
+		queries   []observatorium.MetricsReqParams
+		ids       []string
+		err       error
+		wantCalls int32
+	}{
+		{
+			name:      "should query observatorium once for identical queries",
+			ttl:       time.Hour,
+			queries:   []observatorium.MetricsReqParams{rangeQuery("a", "b"), rangeQuery("b", "a")},
+			ids:       []string{"kafka1", "kafka1"},
+			wantCalls: 1,
+		},
+		{
+			name:      "should query observatorium for each kafka",
+			ttl:       time.Hour,
+			queries:   []observatorium.MetricsReqParams{rangeQuery("a"), rangeQuery("a")},
+			ids:       []string{"kafka1", "kafka2"},
+			wantCalls: 2,
+		},
+		{
+			name:      "should query observatorium for each set of metrics",
+			ttl:       time.Hour,
+			queries:   []observatorium.MetricsReqParams{rangeQuery("a"), rangeQuery("a", "b")},
+			ids:       []string{"kafka1", "kafka1"},
+			wantCalls: 2,
+		},
+		{
+			name:      "should query observatorium for each request when the cache is disabled",
+			ttl:       0,
+			queries:   []observatorium.MetricsReqParams{rangeQuery("a"), rangeQuery("a")},
+			ids:       []string{"kafka1", "kafka1"},
+			wantCalls: 2,
+		},
+		{
+			name:      "should not cache the errors",
+			ttl:       time.Hour,
+			queries:   []observatorium.MetricsReqParams{rangeQuery("a"), rangeQuery("a")},
+			ids:       []string{"kafka1", "kafka1"},
+			err:       errors.New("observatorium unavailable"),
+			wantCalls: 2,
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			observatoriumAPI := &countingObservatoriumAPI{err: tt.err}
+			service := newCachedObservatoriumService(observatoriumAPI, tt.ttl)
+			for i, q := range tt.queries {
+				metrics := &observatorium.KafkaMetrics{}
+				_, err := service.GetMetricsByKafkaId(context.Background(), metrics, tt.ids[i], q)
+				Expect(err != nil).To(Equal(tt.err != nil))
+				if tt.err == nil {
+					Expect(*metrics).To(HaveLen(1))
+				}
+			}
+			Expect(atomic.LoadInt32(&observatoriumAPI.calls)).To(Equal(tt.wantCalls))
+		})
+	}
+}
+
+func Test_ObservatoriumService_GetMetricsByKafkaId_CoalesceConcurrentQueries(t *testing.T) {
+	RegisterTestingT(t)
+	observatoriumAPI := &countingObservatoriumAPI{release: make(chan struct{})}
+	service := newCachedObservatoriumService(observatoriumAPI, time.Hour)
+	query := rangeQuery("a")
+
+	results := make([]int, 5)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			metrics := &observatorium.KafkaMetrics{}
+			if _, err := service.GetMetricsByKafkaId(context.Background(), metrics, "kafka1", query); err == nil {
+				results[i] = len(*metrics)
+			}
+		}(i)
+	}
+	// let the queries reach the cache before releasing the single observatorium call
+	Eventually(func() int32 { return atomic.LoadInt32(&observatoriumAPI.calls) }).Should(Equal(int32(1)))
+	time.Sleep(100 * time.Millisecond)
+	close(observatoriumAPI.release)
+	wg.Wait()
+
+	Expect(atomic.LoadInt32(&observatoriumAPI.calls)).To(Equal(int32(1)))
+	Expect(results).To(Equal([]int{1, 1, 1, 1, 1}))
+}
+
+func Test_metricsQueryCacheKey(t *testing.T) {
+	RegisterTestingT(t)
+	c := newMetricsQueryCache(time.Minute)
+	fetch := func(m *observatorium.KafkaMetrics, q *observatorium.MetricsReqParams) error { return nil }
+
+	query := observatorium.MetricsReqParams{ResultType: observatorium.RangeQuery}
+	query.End = time.Date(2022, 4, 21, 10, 0, 45, 0, time.UTC)
+	query.Start = query.End.Add(-5 * time.Minute)
+	query.Step = 30 * time.Second
+	Expect(c.getMetrics(&observatorium.KafkaMetrics{}, "kafka1", &query, fetch)).To(Succeed())
+
+	// the time bounds are rounded to the cache ttl
+	Expect(query.End).To(Equal(time.Date(2022, 4, 21, 10, 0, 0, 0, time.UTC)))
+	Expect(query.Start).To(Equal(time.Date(2022, 4, 21, 9, 55, 0, 0, time.UTC)))
+
+	instantQuery := observatorium.MetricsReqParams{ResultType: observatorium.Query, Filters: []string{"b", "a"}}
+	instantQuery.FillDefaults()
+	Expect(metricsQueryCacheKey("kafka1", &instantQuery)).To(Equal("kafka1/query/a,b"))
+	Expect(instantQuery.Filters).To(Equal([]string{"b", "a"}))
+}
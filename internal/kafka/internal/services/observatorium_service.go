@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 )
@@ -12,18 +13,26 @@ var _ ObservatoriumService = &observatoriumService{}
 type observatoriumService struct {
 	observatorium *observatorium.Client
 	kafkaService  KafkaService
+	metricsCache  *metricsQueryCache
 }
 
-func NewObservatoriumService(observatorium *observatorium.Client, kafkaService KafkaService) ObservatoriumService {
-	return &observatoriumService{
+func NewObservatoriumService(observatorium *observatorium.Client, kafkaService KafkaService, observabilityConfig *observatorium.ObservabilityConfiguration) ObservatoriumService {
+	service := &observatoriumService{
 		observatorium: observatorium,
 		kafkaService:  kafkaService,
 	}
+	if observabilityConfig.MetricsCacheTTL > 0 {
+		service.metricsCache = newMetricsQueryCache(observabilityConfig.MetricsCacheTTL)
+	}
+	return service
 }
 
 type ObservatoriumService interface {
 	GetKafkaState(name string, namespaceName string) (observatorium.KafkaState, error)
 	GetMetricsByKafkaId(ctx context.Context, csMetrics *observatorium.KafkaMetrics, id string, query observatorium.MetricsReqParams) (string, *errors.ServiceError)
+	// GetMetricsByKafka returns the metrics of a kafka that has already been retrieved, without checking whether the
+	// user is allowed to see it
+	GetMetricsByKafka(kafkaRequest *dbapi.KafkaRequest, csMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *errors.ServiceError
 }
 
 func (obs observatoriumService) GetKafkaState(name string, namespaceName string) (observatorium.KafkaState, error) {
@@ -31,15 +40,27 @@ func (obs observatoriumService) GetKafkaState(name string, namespaceName string)
 }
 
 func (obs observatoriumService) GetMetricsByKafkaId(ctx context.Context, kafkasMetrics *observatorium.KafkaMetrics, id string, query observatorium.MetricsReqParams) (string, *errors.ServiceError) {
+	// the kafka is always retrieved first so that the cached metrics are only returned to the users allowed to see the kafka
 	kafkaRequest, err := obs.kafkaService.Get(ctx, id)
 	if err != nil {
 		return "", err
 	}
 
-	getErr := obs.observatorium.Service.GetMetrics(kafkasMetrics, kafkaRequest.Namespace, &query)
+	return kafkaRequest.ID, obs.GetMetricsByKafka(kafkaRequest, kafkasMetrics, query)
+}
+
+func (obs observatoriumService) GetMetricsByKafka(kafkaRequest *dbapi.KafkaRequest, kafkasMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *errors.ServiceError {
+	var getErr error
+	if obs.metricsCache != nil {
+		getErr = obs.metricsCache.getMetrics(kafkasMetrics, kafkaRequest.ID, &query, func(m *observatorium.KafkaMetrics, q *observatorium.MetricsReqParams) error {
+			return obs.observatorium.Service.GetMetrics(m, kafkaRequest.Namespace, q)
+		})
+	} else {
+		getErr = obs.observatorium.Service.GetMetrics(kafkasMetrics, kafkaRequest.Namespace, &query)
+	}
 	if getErr != nil {
-		return kafkaRequest.ID, errors.NewWithCause(errors.ErrorGeneral, getErr, "failed to retrieve metrics")
+		return errors.NewWithCause(errors.ErrorGeneral, getErr, "failed to retrieve metrics")
 	}
 
-	return kafkaRequest.ID, nil
+	return nil
 }
@@ -5,6 +5,7 @@ package services
 
 import (
 	"context"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"sync"
@@ -23,6 +24,9 @@ var _ ObservatoriumService = &ObservatoriumServiceMock{}
 //             GetKafkaStateFunc: func(name string, namespaceName string) (observatorium.KafkaState, error) {
 // 	               panic("mock out the GetKafkaState method")
 //             },
+//             GetMetricsByKafkaFunc: func(kafkaRequest *dbapi.KafkaRequest, csMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *svcerrs.ServiceError {
+// 	               panic("mock out the GetMetricsByKafka method")
+//             },
 //             GetMetricsByKafkaIdFunc: func(ctx context.Context, csMetrics *observatorium.KafkaMetrics, id string, query observatorium.MetricsReqParams) (string, *svcerrs.ServiceError) {
 // 	               panic("mock out the GetMetricsByKafkaId method")
 //             },
@@ -36,6 +40,9 @@ type ObservatoriumServiceMock struct {
 	// GetKafkaStateFunc mocks the GetKafkaState method.
 	GetKafkaStateFunc func(name string, namespaceName string) (observatorium.KafkaState, error)
 
+	// GetMetricsByKafkaFunc mocks the GetMetricsByKafka method.
+	GetMetricsByKafkaFunc func(kafkaRequest *dbapi.KafkaRequest, csMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *errors.ServiceError
+
 	// GetMetricsByKafkaIdFunc mocks the GetMetricsByKafkaId method.
 	GetMetricsByKafkaIdFunc func(ctx context.Context, csMetrics *observatorium.KafkaMetrics, id string, query observatorium.MetricsReqParams) (string, *errors.ServiceError)
 
@@ -48,6 +55,15 @@ type ObservatoriumServiceMock struct {
 			// NamespaceName is the namespaceName argument value.
 			NamespaceName string
 		}
+		// GetMetricsByKafka holds details about calls to the GetMetricsByKafka method.
+		GetMetricsByKafka []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// CsMetrics is the csMetrics argument value.
+			CsMetrics *observatorium.KafkaMetrics
+			// Query is the query argument value.
+			Query observatorium.MetricsReqParams
+		}
 		// GetMetricsByKafkaId holds details about calls to the GetMetricsByKafkaId method.
 		GetMetricsByKafkaId []struct {
 			// Ctx is the ctx argument value.
@@ -61,6 +77,7 @@ type ObservatoriumServiceMock struct {
 		}
 	}
 	lockGetKafkaState       sync.RWMutex
+	lockGetMetricsByKafka   sync.RWMutex
 	lockGetMetricsByKafkaId sync.RWMutex
 }
 
@@ -99,6 +116,45 @@ func (mock *ObservatoriumServiceMock) GetKafkaStateCalls() []struct {
 	return calls
 }
 
+// GetMetricsByKafka calls GetMetricsByKafkaFunc.
+func (mock *ObservatoriumServiceMock) GetMetricsByKafka(kafkaRequest *dbapi.KafkaRequest, csMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *errors.ServiceError {
+	if mock.GetMetricsByKafkaFunc == nil {
+		panic("ObservatoriumServiceMock.GetMetricsByKafkaFunc: method is nil but ObservatoriumService.GetMetricsByKafka was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+		CsMetrics    *observatorium.KafkaMetrics
+		Query        observatorium.MetricsReqParams
+	}{
+		KafkaRequest: kafkaRequest,
+		CsMetrics:    csMetrics,
+		Query:        query,
+	}
+	mock.lockGetMetricsByKafka.Lock()
+	mock.calls.GetMetricsByKafka = append(mock.calls.GetMetricsByKafka, callInfo)
+	mock.lockGetMetricsByKafka.Unlock()
+	return mock.GetMetricsByKafkaFunc(kafkaRequest, csMetrics, query)
+}
+
+// GetMetricsByKafkaCalls gets all the calls that were made to GetMetricsByKafka.
+// Check the length with:
+//     len(mockedObservatoriumService.GetMetricsByKafkaCalls())
+func (mock *ObservatoriumServiceMock) GetMetricsByKafkaCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+	CsMetrics    *observatorium.KafkaMetrics
+	Query        observatorium.MetricsReqParams
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+		CsMetrics    *observatorium.KafkaMetrics
+		Query        observatorium.MetricsReqParams
+	}
+	mock.lockGetMetricsByKafka.RLock()
+	calls = mock.calls.GetMetricsByKafka
+	mock.lockGetMetricsByKafka.RUnlock()
+	return calls
+}
+
 // GetMetricsByKafkaId calls GetMetricsByKafkaIdFunc.
 func (mock *ObservatoriumServiceMock) GetMetricsByKafkaId(ctx context.Context, csMetrics *observatorium.KafkaMetrics, id string, query observatorium.MetricsReqParams) (string, *errors.ServiceError) {
 	if mock.GetMetricsByKafkaIdFunc == nil {
@@ -18,12 +18,13 @@ type QuotaManagementListService struct {
 func (q QuotaManagementListService) CheckIfQuotaIsDefinedForInstanceType(kafka *dbapi.KafkaRequest, instanceType types.KafkaInstanceType) (bool, *errors.ServiceError) {
 	username := kafka.Owner
 	orgId := kafka.OrganisationId
-	org, orgFound := q.quotaManagementList.QuotaList.Organisations.GetById(orgId)
+	quotaList := q.quotaManagementList.GetQuotaList()
+	org, orgFound := quotaList.Organisations.GetById(orgId)
 	userIsRegistered := false
 	if orgFound && org.IsUserRegistered(username) {
 		userIsRegistered = true
 	} else {
-		_, userFound := q.quotaManagementList.QuotaList.ServiceAccounts.GetByUsername(username)
+		_, userFound := quotaList.ServiceAccounts.GetByUsername(username)
 		userIsRegistered = userFound
 	}
 
@@ -46,14 +47,15 @@ func (q QuotaManagementListService) ReserveQuota(kafka *dbapi.KafkaRequest, inst
 	orgId := kafka.OrganisationId
 	var quotaManagementListItem quota_management.QuotaManagementListItem
 	message := fmt.Sprintf("User '%s' has reached a maximum number of %d allowed instances.", username, quota_management.GetDefaultMaxAllowedInstances())
-	org, orgFound := q.quotaManagementList.QuotaList.Organisations.GetById(orgId)
+	quotaList := q.quotaManagementList.GetQuotaList()
+	org, orgFound := quotaList.Organisations.GetById(orgId)
 	filterByOrd := false
 	if orgFound && org.IsUserRegistered(username) {
 		quotaManagementListItem = org
 		message = fmt.Sprintf("Organization '%s' has reached a maximum number of %d allowed instances.", orgId, org.GetMaxAllowedInstances())
 		filterByOrd = true
 	} else {
-		user, userFound := q.quotaManagementList.QuotaList.ServiceAccounts.GetByUsername(username)
+		user, userFound := quotaList.ServiceAccounts.GetByUsername(username)
 		if userFound {
 			quotaManagementListItem = user
 			message = fmt.Sprintf("User '%s' has reached a maximum number of %d allowed instances.", username, user.GetMaxAllowedInstances())
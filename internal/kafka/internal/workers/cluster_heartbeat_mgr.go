@@ -0,0 +1,105 @@
+package workers
+
+import (
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// heartbeatClusterStatuses are the statuses of the clusters whose kas-fleetshard operator is expected to report the
+// cluster status periodically
+var heartbeatClusterStatuses = []api.ClusterStatus{
+	api.ClusterReady,
+	api.ClusterFull,
+	api.ClusterComputeNodeScalingUp,
+	api.ClusterUnresponsive,
+}
+
+// ClusterHeartbeatManager represents a cluster manager that periodically checks the status reports of the kas-fleetshard
+// operator of the data plane clusters and marks unresponsive the clusters that stopped reporting their status. The
+// status of an unresponsive cluster is restored by the next status report, see DataPlaneClusterService.
+type ClusterHeartbeatManager struct {
+	workers.BaseWorker
+	clusterService         services.ClusterService
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+	now                    func() time.Time
+}
+
+// NewClusterHeartbeatManager creates a new cluster manager to detect the unresponsive data plane clusters.
+func NewClusterHeartbeatManager(clusterService services.ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig, reconciler workers.Reconciler) *ClusterHeartbeatManager {
+	return &ClusterHeartbeatManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "cluster_heartbeat",
+			Reconciler: reconciler,
+		},
+		clusterService:         clusterService,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+		now:                    time.Now,
+	}
+}
+
+// Start initializes the cluster manager to detect the unresponsive data plane clusters.
+func (c *ClusterHeartbeatManager) Start() {
+	c.StartWorker(c)
+}
+
+// Stop causes the process for detecting the unresponsive data plane clusters to stop.
+func (c *ClusterHeartbeatManager) Stop() {
+	c.StopWorker(c)
+}
+
+func (c *ClusterHeartbeatManager) Reconcile() []error {
+	threshold := c.dataplaneClusterConfig.ClusterHeartbeatThreshold
+	if threshold <= 0 {
+		return nil
+	}
+	glog.Infoln("checking the status reports of the data plane clusters")
+
+	var errs []error
+	for _, status := range heartbeatClusterStatuses {
+		clusters, serviceErr := c.clusterService.ListByStatus(status)
+		if serviceErr != nil {
+			errs = append(errs, errors.Wrapf(serviceErr, "failed to list %s clusters", status))
+			continue
+		}
+		for _, cluster := range clusters {
+			if err := c.checkHeartbeat(cluster, threshold); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+// checkHeartbeat marks the cluster unresponsive when its last status report is older than the threshold. The clusters
+// that never reported their status since the status report time is recorded are checked against their last update.
+func (c *ClusterHeartbeatManager) checkHeartbeat(cluster api.Cluster, threshold time.Duration) error {
+	lastReport := cluster.UpdatedAt
+	if cluster.StatusReportedAt != nil {
+		lastReport = *cluster.StatusReportedAt
+	}
+	age := c.now().Sub(lastReport)
+	metrics.UpdateClusterStatusReportAgeMetric(cluster.ClusterID, age)
+
+	if age <= threshold || cluster.Status == api.ClusterUnresponsive {
+		return nil
+	}
+
+	if err := c.clusterService.UpdateStatus(cluster, api.ClusterUnresponsive); err != nil {
+		return errors.Wrapf(err, "failed to update status of unresponsive cluster %s", cluster.ClusterID)
+	}
+	metrics.IncreaseClusterUnresponsiveCountMetric(cluster.ClusterID)
+	metrics.UpdateClusterStatusSinceCreatedMetric(cluster, api.ClusterUnresponsive)
+	logger.NewEventLogger(constants2.ClusterUnresponsiveEvent).Warningf("kas fleetshard operator of %s cluster %s did not report the cluster status for %s, the cluster is now unresponsive", cluster.Status, cluster.ClusterID, age.Round(time.Second))
+	return nil
+}
@@ -0,0 +1,96 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	. "github.com/onsi/gomega"
+)
+
+func TestClusterHeartbeatManager_Reconcile(t *testing.T) {
+	now := time.Date(2022, time.April, 25, 10, 0, 0, 0, time.UTC)
+	recentReport := now.Add(-time.Minute)
+	staleReport := now.Add(-10 * time.Minute)
+
+	tests := []struct {
+		name                 string
+		threshold            time.Duration
+		clusters             []api.Cluster
+		listErr              *errors.ServiceError
+		wantUnresponsive     []string
+		wantErr              bool
+		wantListByStatusCall bool
+	}{
+		{
+			name:      "should not check the clusters when the detection is disabled",
+			threshold: 0,
+			clusters:  []api.Cluster{{ClusterID: "stale", Status: api.ClusterReady, StatusReportedAt: &staleReport}},
+		},
+		{
+			name:                 "should mark unresponsive the clusters whose last status report is older than the threshold",
+			threshold:            5 * time.Minute,
+			wantListByStatusCall: true,
+			clusters: []api.Cluster{
+				{ClusterID: "recent", Status: api.ClusterReady, StatusReportedAt: &recentReport},
+				{ClusterID: "stale", Status: api.ClusterReady, StatusReportedAt: &staleReport},
+				{ClusterID: "never-reported", Status: api.ClusterReady, Meta: api.Meta{UpdatedAt: staleReport}},
+			},
+			wantUnresponsive: []string{"stale", "never-reported"},
+		},
+		{
+			name:                 "should not update the clusters that are already unresponsive",
+			threshold:            5 * time.Minute,
+			wantListByStatusCall: true,
+			clusters:             []api.Cluster{{ClusterID: "stale", Status: api.ClusterUnresponsive, StatusReportedAt: &staleReport}},
+		},
+		{
+			name:                 "should return an error when the clusters cannot be listed",
+			threshold:            5 * time.Minute,
+			wantListByStatusCall: true,
+			listErr:              errors.GeneralError("failed to list clusters"),
+			wantErr:              true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.ClusterHeartbeatThreshold = tt.threshold
+
+			var unresponsive []string
+			clusterService := &services.ClusterServiceMock{
+				ListByStatusFunc: func(state api.ClusterStatus) ([]api.Cluster, *errors.ServiceError) {
+					if tt.listErr != nil {
+						return nil, tt.listErr
+					}
+					var clusters []api.Cluster
+					for _, cluster := range tt.clusters {
+						if cluster.Status == state {
+							clusters = append(clusters, cluster)
+						}
+					}
+					return clusters, nil
+				},
+				UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+					Expect(status).To(Equal(api.ClusterUnresponsive))
+					unresponsive = append(unresponsive, cluster.ClusterID)
+					return nil
+				},
+			}
+			manager := NewClusterHeartbeatManager(clusterService, dataplaneClusterConfig, workers.Reconciler{})
+			manager.now = func() time.Time {
+				return now
+			}
+
+			errs := manager.Reconcile()
+			Expect(len(errs) > 0).To(Equal(tt.wantErr))
+			Expect(unresponsive).To(Equal(tt.wantUnresponsive))
+			Expect(len(clusterService.ListByStatusCalls()) > 0).To(Equal(tt.wantListByStatusCall))
+		})
+	}
+}
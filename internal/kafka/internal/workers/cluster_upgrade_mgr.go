@@ -0,0 +1,91 @@
+package workers
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// ClusterUpgradeManager represents a cluster manager that periodically upgrades the OpenShift version of the data plane
+// clusters whose upgrade has been scheduled through the admin API. The clusters of a region are upgraded one at a time,
+// in the order their upgrade was scheduled.
+type ClusterUpgradeManager struct {
+	workers.BaseWorker
+	clusterUpgradeService services.ClusterUpgradeService
+}
+
+// NewClusterUpgradeManager creates a new cluster manager to upgrade the data plane clusters.
+func NewClusterUpgradeManager(clusterUpgradeService services.ClusterUpgradeService, reconciler workers.Reconciler) *ClusterUpgradeManager {
+	return &ClusterUpgradeManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "cluster_upgrade",
+			Reconciler: reconciler,
+		},
+		clusterUpgradeService: clusterUpgradeService,
+	}
+}
+
+// Start initializes the cluster manager to upgrade the data plane clusters.
+func (c *ClusterUpgradeManager) Start() {
+	c.StartWorker(c)
+}
+
+// Stop causes the process for upgrading the data plane clusters to stop.
+func (c *ClusterUpgradeManager) Stop() {
+	c.StopWorker(c)
+}
+
+func (c *ClusterUpgradeManager) Reconcile() []error {
+	glog.Infoln("reconciling the upgrades of the data plane clusters")
+
+	upgrades, serviceErr := c.clusterUpgradeService.ListUpgrades()
+	if serviceErr != nil {
+		return []error{errors.Wrap(serviceErr, "failed to list cluster upgrades")}
+	}
+
+	var errs []error
+	// the regions where a cluster is being upgraded, no other upgrade is started in them
+	upgradingRegions := map[string]bool{}
+	for _, cluster := range upgrades {
+		if cluster.Status != api.ClusterUpgrading {
+			if cluster.UpgradeID != "" {
+				// the upgrade was requested but the cluster is not cordoned yet
+				upgradingRegions[upgradeRegion(cluster)] = true
+			}
+			continue
+		}
+		if err := c.clusterUpgradeService.CheckUpgrade(cluster); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to check upgrade of cluster %s", cluster.ClusterID))
+		}
+		if cluster.Status == api.ClusterUpgrading {
+			upgradingRegions[upgradeRegion(cluster)] = true
+		}
+	}
+
+	// the upgrades are listed in the order they were scheduled
+	for _, cluster := range upgrades {
+		region := upgradeRegion(cluster)
+		if cluster.Status == api.ClusterUpgrading || cluster.UpgradeVersion == "" || (upgradingRegions[region] && cluster.UpgradeID == "") {
+			continue
+		}
+		if err := c.clusterUpgradeService.StartUpgrade(cluster); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to start upgrade of cluster %s", cluster.ClusterID))
+			// the upgrade is retried, the other clusters of the region wait for it
+			upgradingRegions[region] = true
+		}
+		if cluster.Status == api.ClusterUpgrading {
+			upgradingRegions[region] = true
+		}
+	}
+	return errs
+}
+
+func upgradeRegion(cluster *api.Cluster) string {
+	return fmt.Sprintf("%s/%s", cluster.CloudProvider, cluster.Region)
+}
@@ -0,0 +1,118 @@
+package workers
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	. "github.com/onsi/gomega"
+)
+
+func TestClusterUpgradeManager_Reconcile(t *testing.T) {
+	upgradeCluster := func(clusterID string, region string, status api.ClusterStatus) *api.Cluster {
+		return &api.Cluster{ClusterID: clusterID, CloudProvider: "aws", Region: region, Status: status, UpgradeVersion: "4.10.3"}
+	}
+
+	tests := []struct {
+		name        string
+		clusters    []*api.Cluster
+		listErr     *errors.ServiceError
+		completed   map[string]bool
+		startErr    *errors.ServiceError
+		wantChecked []string
+		wantStarted []string
+		wantErr     bool
+	}{
+		{
+			name: "should start one upgrade per region in the order they were scheduled",
+			clusters: []*api.Cluster{
+				upgradeCluster("first", "us-east-1", api.ClusterReady),
+				upgradeCluster("second", "us-east-1", api.ClusterFull),
+				upgradeCluster("other-region", "eu-west-1", api.ClusterReady),
+			},
+			wantStarted: []string{"first", "other-region"},
+		},
+		{
+			name: "should not start an upgrade in a region where a cluster is being upgraded",
+			clusters: []*api.Cluster{
+				upgradeCluster("scheduled", "us-east-1", api.ClusterReady),
+				upgradeCluster("upgrading", "us-east-1", api.ClusterUpgrading),
+			},
+			wantChecked: []string{"upgrading"},
+		},
+		{
+			name: "should start the next upgrade of the region once the upgrade is completed",
+			clusters: []*api.Cluster{
+				upgradeCluster("scheduled", "us-east-1", api.ClusterReady),
+				upgradeCluster("upgrading", "us-east-1", api.ClusterUpgrading),
+			},
+			completed:   map[string]bool{"upgrading": true},
+			wantChecked: []string{"upgrading"},
+			wantStarted: []string{"scheduled"},
+		},
+		{
+			name: "should cordon the cluster whose upgrade was requested before starting the other upgrades of the region",
+			clusters: []*api.Cluster{
+				upgradeCluster("scheduled", "us-east-1", api.ClusterReady),
+				{ClusterID: "requested", CloudProvider: "aws", Region: "us-east-1", Status: api.ClusterUnresponsive, UpgradeVersion: "4.10.3", UpgradeID: "upgrade-id"},
+			},
+			wantStarted: []string{"requested"},
+		},
+		{
+			name: "should ignore the clusters whose upgrade failed",
+			clusters: []*api.Cluster{
+				{ClusterID: "failed", CloudProvider: "aws", Region: "us-east-1", Status: api.ClusterUpgradeFailed},
+			},
+		},
+		{
+			name: "should wait for the upgrade that failed to start before starting the next one",
+			clusters: []*api.Cluster{
+				upgradeCluster("first", "us-east-1", api.ClusterReady),
+				upgradeCluster("second", "us-east-1", api.ClusterReady),
+			},
+			startErr:    errors.GeneralError("test"),
+			wantStarted: []string{"first"},
+			wantErr:     true,
+		},
+		{
+			name:    "should return an error when the upgrades cannot be listed",
+			listErr: errors.GeneralError("test"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			var checked, started []string
+			clusterUpgradeService := &services.ClusterUpgradeServiceMock{
+				ListUpgradesFunc: func() ([]*api.Cluster, *errors.ServiceError) {
+					return tt.clusters, tt.listErr
+				},
+				CheckUpgradeFunc: func(cluster *api.Cluster) *errors.ServiceError {
+					checked = append(checked, cluster.ClusterID)
+					if tt.completed[cluster.ClusterID] {
+						cluster.Status = api.ClusterReady
+						cluster.UpgradeVersion = ""
+					}
+					return nil
+				},
+				StartUpgradeFunc: func(cluster *api.Cluster) *errors.ServiceError {
+					started = append(started, cluster.ClusterID)
+					if tt.startErr != nil {
+						return tt.startErr
+					}
+					cluster.Status = api.ClusterUpgrading
+					return nil
+				},
+			}
+			manager := NewClusterUpgradeManager(clusterUpgradeService, workers.Reconciler{})
+
+			errs := manager.Reconcile()
+			Expect(len(errs) > 0).To(Equal(tt.wantErr))
+			Expect(checked).To(Equal(tt.wantChecked))
+			Expect(started).To(Equal(tt.wantStarted))
+		})
+	}
+}
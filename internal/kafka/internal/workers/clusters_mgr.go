@@ -68,6 +68,9 @@ var clusterMetricsStatuses = []api.ClusterStatus{
 	api.ClusterReady,
 	api.ClusterComputeNodeScalingUp,
 	api.ClusterFull,
+	api.ClusterUnresponsive,
+	api.ClusterUpgrading,
+	api.ClusterUpgradeFailed,
 	api.ClusterFailed,
 	api.ClusterDeprovisioning,
 }
@@ -473,7 +476,7 @@ func (c *ClusterManager) reconcileClusterInstanceType(cluster api.Cluster) error
 	supportedInstanceType := api.AllInstanceTypeSupport.String()
 	manualScalingEnabled := c.DataplaneClusterConfig.IsDataPlaneManualScalingEnabled()
 	if manualScalingEnabled {
-		supportedType, found := c.DataplaneClusterConfig.ClusterConfig.GetClusterSupportedInstanceType(cluster.ClusterID)
+		supportedType, found := c.DataplaneClusterConfig.GetClusterConfig().GetClusterSupportedInstanceType(cluster.ClusterID)
 		if !found && cluster.SupportedInstanceType != "" {
 			logger.Logger.Infof("cluster instance type already set for cluster = %s", cluster.ClusterID)
 			return nil
@@ -712,7 +715,7 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 	}
 
 	//Create all missing clusters
-	for _, p := range c.DataplaneClusterConfig.ClusterConfig.MissingClusters(clusterIdsMap) {
+	for _, p := range c.DataplaneClusterConfig.GetClusterConfig().MissingClusters(clusterIdsMap) {
 		clusterRequest := api.Cluster{
 			CloudProvider:         p.CloudProvider,
 			Region:                p.Region,
@@ -731,7 +734,7 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 	}
 
 	// Remove all clusters that are not in the config file.
-	excessClusterIds := c.DataplaneClusterConfig.ClusterConfig.ExcessClusters(clusterIdsMap)
+	excessClusterIds := c.DataplaneClusterConfig.GetClusterConfig().ExcessClusters(clusterIdsMap)
 	if len(excessClusterIds) == 0 {
 		return nil
 	}
@@ -765,7 +768,8 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 	return []error{}
 }
 
-// reconcileClustersForRegions creates an OSD cluster for each supported cloud provider and region where no cluster exists.
+// reconcileClustersForRegions creates a cluster of the auto scaling provider type for each supported cloud provider and region
+// where no cluster exists.
 func (c *ClusterManager) reconcileClustersForRegions() []error {
 	var errs []error
 	if !c.DataplaneClusterConfig.IsDataPlaneAutoScalingEnabled() {
@@ -776,7 +780,7 @@ func (c *ClusterManager) reconcileClustersForRegions() []error {
 	var regions []string
 	status := api.StatusForValidCluster
 	//gather the supported providers and regions
-	providerList := c.SupportedProviders.ProvidersConfig.SupportedProviders
+	providerList := c.SupportedProviders.GetSupportedProviders()
 	for _, v := range providerList {
 		providers = append(providers, v.Name)
 		for _, r := range v.Regions {
@@ -805,7 +809,7 @@ func (c *ClusterManager) reconcileClustersForRegions() []error {
 					Region:                v.Name,
 					MultiAZ:               true,
 					Status:                api.ClusterAccepted,
-					ProviderType:          api.ClusterProviderOCM,
+					ProviderType:          c.DataplaneClusterConfig.GetAutoScalingClusterProviderType(),
 					SupportedInstanceType: api.AllInstanceTypeSupport.String(), // TODO - make sure we use the appropriate instance type.
 				}
 				if err := c.ClusterService.RegisterClusterJob(&clusterRequest); err != nil {
@@ -1005,7 +1009,7 @@ func (c *ClusterManager) buildReadOnlyGroupResource() *userv1.Group {
 		ObjectMeta: metav1.ObjectMeta{
 			Name: mkReadOnlyGroupName,
 		},
-		Users: c.DataplaneClusterConfig.ReadOnlyUserList,
+		Users: c.DataplaneClusterConfig.GetReadOnlyUserList(),
 	}
 }
 
@@ -1044,7 +1048,7 @@ func (c *ClusterManager) buildKafkaSREGroupResource() *userv1.Group {
 		ObjectMeta: metav1.ObjectMeta{
 			Name: mkSREGroupName,
 		},
-		Users: c.DataplaneClusterConfig.KafkaSREUsers,
+		Users: c.DataplaneClusterConfig.GetKafkaSREUsers(),
 	}
 }
 
@@ -1107,7 +1111,7 @@ func (c *ClusterManager) reconcileClusterIdentityProvider(cluster api.Cluster) e
 }
 
 func (c *ClusterManager) setClusterStatusMaxCapacityMetrics() error {
-	for _, cluster := range c.DataplaneClusterConfig.ClusterConfig.GetManualClusters() {
+	for _, cluster := range c.DataplaneClusterConfig.GetClusterConfig().GetManualClusters() {
 		if !cluster.Schedulable {
 			continue
 		}
@@ -454,6 +454,151 @@ func TestClusterManager_reconcileClustersForRegions(t *testing.T) {
 	}
 }
 
+func TestClusterManager_reconcileClustersForRegions_ProviderType(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	var registeredClusters []*api.Cluster
+	dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+	dataplaneClusterConfig.DataPlaneClusterScalingType = config.AutoScaling
+	dataplaneClusterConfig.AutoScalingClusterProviderType = api.ClusterProviderAwsEKS.String()
+	c := ClusterManager{
+		ClusterManagerOptions: ClusterManagerOptions{
+			ClusterService: &services.ClusterServiceMock{
+				ListGroupByProviderAndRegionFunc: func(providers []string, regions []string, status []string) ([]*services.ResGroupCPRegion, *apiErrors.ServiceError) {
+					return nil, nil
+				},
+				RegisterClusterJobFunc: func(clusterReq *api.Cluster) *apiErrors.ServiceError {
+					registeredClusters = append(registeredClusters, clusterReq)
+					return nil
+				},
+			},
+			SupportedProviders: &config.ProviderConfig{
+				ProvidersConfig: config.ProviderConfiguration{
+					SupportedProviders: config.ProviderList{
+						config.Provider{
+							Name:    "aws",
+							Regions: config.RegionList{config.Region{Name: "us-east-1"}},
+						},
+					},
+				},
+			},
+			DataplaneClusterConfig: dataplaneClusterConfig,
+		},
+	}
+
+	gomega.Expect(c.reconcileClustersForRegions()).To(gomega.BeEmpty())
+	gomega.Expect(registeredClusters).To(gomega.HaveLen(1))
+	gomega.Expect(registeredClusters[0].ProviderType).To(gomega.Equal(api.ClusterProviderAwsEKS))
+}
+
+// TestClusterManager_reconcileEKSCluster follows an EKS cluster from provisioning to ready: the cluster dns is only read
+// once the provider reports the cluster provisioned, i.e. once its ingress controller is installed, and before the
+// operators are installed.
+func TestClusterManager_reconcileEKSCluster(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	const clusterDNS = "apps.mk-test-cluster.example.com"
+	observabilityConfig := buildObservabilityConfig()
+	dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+	dataplaneClusterConfig.EnableReadyDataPlaneClustersReconcile = true
+	cluster := api.Cluster{
+		Meta:          api.Meta{ID: "cluster-id"},
+		ClusterID:     "mk-test-cluster",
+		ProviderType:  api.ClusterProviderAwsEKS,
+		CloudProvider: testProvider,
+		Region:        testRegion,
+		Status:        api.ClusterProvisioning,
+	}
+	ingressControllerInstalled := false
+	var steps []string
+
+	c := &ClusterManager{
+		ClusterManagerOptions: ClusterManagerOptions{
+			ClusterService: &services.ClusterServiceMock{
+				CheckClusterStatusFunc: func(c *api.Cluster) (*api.Cluster, *apiErrors.ServiceError) {
+					if ingressControllerInstalled {
+						cluster.Status = api.ClusterProvisioned
+					}
+					return &cluster, nil
+				},
+				GetClusterDNSFunc: func(clusterID string) (string, *apiErrors.ServiceError) {
+					gomega.Expect(cluster.Status).To(gomega.Equal(api.ClusterProvisioned))
+					steps = append(steps, "dns")
+					cluster.ClusterDNS = clusterDNS
+					return clusterDNS, nil
+				},
+				ConfigureAndSaveIdentityProviderFunc: func(c *api.Cluster, identityProviderInfo types.IdentityProviderInfo) (*api.Cluster, *apiErrors.ServiceError) {
+					cluster.IdentityProviderID = "identity-provider-id"
+					return &cluster, nil
+				},
+				ApplyResourcesFunc: func(c *api.Cluster, resources types.ResourceSet) *apiErrors.ServiceError {
+					return nil
+				},
+				InstallStrimziFunc: func(c *api.Cluster) (bool, *apiErrors.ServiceError) {
+					steps = append(steps, "strimzi")
+					return true, nil
+				},
+				UpdateStatusAndClientFunc: func(c api.Cluster, status api.ClusterStatus, serviceClientId string, serviceClientSecret string) error {
+					cluster.Status = status
+					cluster.ClientID = serviceClientId
+					cluster.ClientSecret = serviceClientSecret
+					return nil
+				},
+				UpdateFunc: func(c api.Cluster) *apiErrors.ServiceError {
+					cluster.SupportedInstanceType = c.SupportedInstanceType
+					return nil
+				},
+			},
+			KasFleetshardOperatorAddon: &services.KasFleetshardOperatorAddonMock{
+				ProvisionFunc: func(c api.Cluster) (bool, services.ParameterList, *apiErrors.ServiceError) {
+					steps = append(steps, "kas-fleetshard")
+					return true, services.ParameterList{
+						{Id: services.KasFleetshardOperatorParamServiceAccountId, Value: "client-id"},
+						{Id: services.KasFleetshardOperatorParamServiceAccountSecret, Value: "client-secret"},
+					}, nil
+				},
+				ReconcileParametersFunc: func(c api.Cluster) (services.ParameterList, *apiErrors.ServiceError) {
+					return services.ParameterList{}, nil
+				},
+			},
+			OsdIdpKeycloakService: &sso.KeycloakServiceMock{
+				RegisterOSDClusterClientInSSOFunc: func(clusterId, clusterOathCallbackURI string) (string, *apiErrors.ServiceError) {
+					return "client-secret", nil
+				},
+				GetRealmConfigFunc: func() *keycloak.KeycloakRealmConfig {
+					return &keycloak.KeycloakRealmConfig{}
+				},
+			},
+			SupportedProviders:         &config.ProviderConfig{},
+			ObservabilityConfiguration: &observabilityConfig,
+			DataplaneClusterConfig:     dataplaneClusterConfig,
+			OCMConfig:                  &ocm.OCMConfig{},
+		},
+	}
+
+	// the cluster stays provisioning until its ingress controller is installed
+	_, err := c.reconcileClusterStatus(&cluster)
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	gomega.Expect(cluster.Status).To(gomega.Equal(api.ClusterProvisioning))
+
+	ingressControllerInstalled = true
+	_, err = c.reconcileClusterStatus(&cluster)
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	gomega.Expect(cluster.Status).To(gomega.Equal(api.ClusterProvisioned))
+
+	gomega.Expect(c.reconcileProvisionedCluster(cluster)).To(gomega.Succeed())
+	gomega.Expect(cluster.Status).To(gomega.Equal(api.ClusterWaitingForKasFleetShardOperator))
+	gomega.Expect(cluster.ClusterDNS).To(gomega.Equal(clusterDNS))
+	gomega.Expect(cluster.ClientID).To(gomega.Equal("client-id"))
+	// the cluster dns is read by both the identity provider and the dns steps
+	gomega.Expect(steps).To(gomega.Equal([]string{"dns", "dns", "strimzi", "kas-fleetshard"}))
+
+	gomega.Expect(c.reconcileWaitingForKasFleetshardOperatorCluster(cluster)).To(gomega.Succeed())
+
+	// the kas-fleetshard operator reports the cluster ready
+	cluster.Status = api.ClusterReady
+	gomega.Expect(c.reconcileReadyCluster(cluster)).To(gomega.Succeed())
+	gomega.Expect(steps).To(gomega.HaveLen(4))
+}
+
 func TestClusterManager_reconcileAddonOperator(t *testing.T) {
 	type fields struct {
 		agentOperator  services.KasFleetshardOperatorAddon
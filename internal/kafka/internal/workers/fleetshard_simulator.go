@@ -0,0 +1,249 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	kafkaConstants "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/goava/di"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	// simulatedNodeDelta is the number of compute nodes the simulated kas-fleetshard operator asks for to host one more
+	// kafka, the simulated clusters host one kafka per node delta
+	simulatedNodeDelta = 3
+	// simulatedReadyConditionType is the type of the condition reporting the readiness of the clusters and kafkas
+	simulatedReadyConditionType = "Ready"
+	// simulatedKafkaDeletedReason is the reason of the Ready condition reporting the deletion of a kafka
+	simulatedKafkaDeletedReason = "Deleted"
+)
+
+// simulatedClusterStatuses are the statuses of the clusters whose status is reported by the kas-fleetshard operator
+var simulatedClusterStatuses = []api.ClusterStatus{
+	api.ClusterWaitingForKasFleetShardOperator,
+	api.ClusterReady,
+	api.ClusterComputeNodeScalingUp,
+	api.ClusterFull,
+}
+
+// FleetshardSimulator simulates the kas-fleetshard operator of the simulated clusters. It periodically reports the
+// status of the simulated clusters and of their kafkas, the same way the kas-fleetshard operator does through the agent
+// endpoints, so that kafkas get ready and deleted without any data plane.
+type FleetshardSimulator struct {
+	workers.BaseWorker
+	options FleetshardSimulatorOptions
+}
+
+type FleetshardSimulatorOptions struct {
+	di.Inject
+	ClusterService          services.ClusterService
+	KafkaService            services.KafkaService
+	DataPlaneClusterService services.DataPlaneClusterService
+	DataPlaneKafkaService   services.DataPlaneKafkaService
+	KafkaConfig             *config.KafkaConfig
+	DataplaneClusterConfig  *config.DataplaneClusterConfig
+}
+
+// NewFleetshardSimulator creates a new worker simulating the kas-fleetshard operator of the simulated clusters.
+func NewFleetshardSimulator(o FleetshardSimulatorOptions, reconciler workers.Reconciler) *FleetshardSimulator {
+	return &FleetshardSimulator{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "fleetshard_simulator",
+			Reconciler: reconciler,
+		},
+		options: o,
+	}
+}
+
+// Start initializes the worker to simulate the kas-fleetshard operator of the simulated clusters.
+func (f *FleetshardSimulator) Start() {
+	f.StartWorker(f)
+}
+
+// Stop causes the process for simulating the kas-fleetshard operator of the simulated clusters to stop.
+func (f *FleetshardSimulator) Stop() {
+	f.StopWorker(f)
+}
+
+func (f *FleetshardSimulator) Reconcile() []error {
+	if !f.options.DataplaneClusterConfig.SimulatedClusterConfig.EnableFleetshardSimulator {
+		return nil
+	}
+	glog.Infoln("simulating the kas-fleetshard operator of the simulated clusters")
+
+	var errs []error
+	for _, status := range simulatedClusterStatuses {
+		clusters, serviceErr := f.options.ClusterService.ListByStatus(status)
+		if serviceErr != nil {
+			errs = append(errs, errors.Wrapf(serviceErr, "failed to list %s clusters", status))
+			continue
+		}
+		for _, cluster := range clusters {
+			if cluster.ProviderType != api.ClusterProviderSimulated {
+				continue
+			}
+			if err := f.reportStatus(cluster.ClusterID); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+// reportStatus reports the status of the kafkas of the cluster then the status of the cluster itself
+func (f *FleetshardSimulator) reportStatus(clusterID string) error {
+	managedKafkas, serviceErr := f.options.KafkaService.GetManagedKafkaByClusterID(clusterID)
+	if serviceErr != nil {
+		return errors.Wrapf(serviceErr, "failed to get managed kafkas of simulated cluster %s", clusterID)
+	}
+
+	activeKafkas := 0
+	for _, managedKafka := range managedKafkas {
+		if !managedKafka.Spec.Deleted {
+			activeKafkas++
+		}
+	}
+
+	ctx := context.Background()
+	if len(managedKafkas) > 0 {
+		kafkaStatuses, err := f.buildKafkaStatuses(clusterID, managedKafkas)
+		if err != nil {
+			return err
+		}
+		if serviceErr := f.options.DataPlaneKafkaService.UpdateDataPlaneKafkaService(ctx, clusterID, kafkaStatuses); serviceErr != nil {
+			return errors.Wrapf(serviceErr, "failed to report kafkas status of simulated cluster %s", clusterID)
+		}
+	}
+
+	nodes, serviceErr := f.options.ClusterService.GetComputeNodes(clusterID)
+	if serviceErr != nil {
+		return errors.Wrapf(serviceErr, "failed to get compute nodes of simulated cluster %s", clusterID)
+	}
+	if serviceErr := f.options.DataPlaneClusterService.UpdateDataPlaneClusterStatus(ctx, clusterID, f.buildClusterStatus(nodes.Actual, activeKafkas)); serviceErr != nil {
+		return errors.Wrapf(serviceErr, "failed to report status of simulated cluster %s", clusterID)
+	}
+	return nil
+}
+
+// buildClusterStatus returns a ready cluster status whose capacity is the capacity of one kafka per node delta
+func (f *FleetshardSimulator) buildClusterStatus(currentNodes int, activeKafkas int) *dbapi.DataPlaneClusterStatus {
+	simulatedConfig := f.options.DataplaneClusterConfig.SimulatedClusterConfig
+	kafkaCapacity := f.options.KafkaConfig.GetKafkaCapacity()
+
+	workloadMinimum := activeKafkas * simulatedNodeDelta
+	if workloadMinimum < simulatedNodeDelta {
+		workloadMinimum = simulatedNodeDelta
+	}
+	remainingKafkas := currentNodes/simulatedNodeDelta - activeKafkas
+	if remainingKafkas < 0 {
+		remainingKafkas = 0
+	}
+
+	return &dbapi.DataPlaneClusterStatus{
+		Conditions: []dbapi.DataPlaneClusterStatusCondition{
+			{
+				Type:   simulatedReadyConditionType,
+				Status: "True",
+			},
+		},
+		NodeInfo: dbapi.DataPlaneClusterStatusNodeInfo{
+			Ceiling:                simulatedConfig.MaxComputeNodes,
+			Floor:                  simulatedNodeDelta,
+			Current:                currentNodes,
+			CurrentWorkLoadMinimum: workloadMinimum,
+		},
+		ResizeInfo: dbapi.DataPlaneClusterStatusResizeInfo{
+			NodeDelta: simulatedNodeDelta,
+			Delta: dbapi.DataPlaneClusterStatusCapacity{
+				IngressEgressThroughputPerSec: kafkaCapacity.IngressEgressThroughputPerSec,
+				Connections:                   kafkaCapacity.TotalMaxConnections,
+				DataRetentionSize:             kafkaCapacity.MaxDataRetentionSize,
+				Partitions:                    kafkaCapacity.MaxPartitions,
+			},
+		},
+		Remaining: dbapi.DataPlaneClusterStatusCapacity{
+			Connections: remainingKafkas * kafkaCapacity.TotalMaxConnections,
+			Partitions:  remainingKafkas * kafkaCapacity.MaxPartitions,
+		},
+		AvailableStrimziVersions: []api.StrimziVersion{
+			{
+				Version:          simulatedConfig.StrimziVersion,
+				Ready:            true,
+				KafkaVersions:    []api.KafkaVersion{{Version: simulatedConfig.KafkaVersion}},
+				KafkaIBPVersions: []api.KafkaIBPVersion{{Version: simulatedConfig.KafkaIBPVersion}},
+			},
+		},
+	}
+}
+
+// buildKafkaStatuses returns the status of the kafkas of the cluster: the deleted kafkas are reported deleted and the
+// other kafkas are reported ready, with the versions and capacity of their spec and routes through the cluster router
+func (f *FleetshardSimulator) buildKafkaStatuses(clusterID string, managedKafkas []managedkafka.ManagedKafka) ([]*dbapi.DataPlaneKafkaStatus, error) {
+	clusterDNS, serviceErr := f.options.ClusterService.GetClusterDNS(clusterID)
+	if serviceErr != nil {
+		return nil, errors.Wrapf(serviceErr, "failed to get DNS of simulated cluster %s", clusterID)
+	}
+	router := fmt.Sprintf("router-default.%s", strings.TrimPrefix(clusterDNS, fmt.Sprintf("%s.", kafkaConstants.DefaultIngressDnsNamePrefix)))
+
+	var statuses []*dbapi.DataPlaneKafkaStatus
+	for _, managedKafka := range managedKafkas {
+		if managedKafka.Spec.Deleted {
+			statuses = append(statuses, &dbapi.DataPlaneKafkaStatus{
+				KafkaClusterId: managedKafka.Id,
+				Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+					{
+						Type:   simulatedReadyConditionType,
+						Status: "False",
+						Reason: simulatedKafkaDeletedReason,
+					},
+				},
+			})
+			continue
+		}
+		capacity := managedKafka.Spec.Capacity
+		statuses = append(statuses, &dbapi.DataPlaneKafkaStatus{
+			KafkaClusterId: managedKafka.Id,
+			Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+				{
+					Type:   simulatedReadyConditionType,
+					Status: "True",
+				},
+			},
+			Capacity: dbapi.KafkaCapacity{
+				IngressEgressThroughputPerSec: capacity.IngressEgressThroughputPerSec,
+				TotalMaxConnections:           capacity.TotalMaxConnections,
+				MaxDataRetentionSize:          capacity.MaxDataRetentionSize,
+				MaxPartitions:                 capacity.MaxPartitions,
+				MaxDataRetentionPeriod:        capacity.MaxDataRetentionPeriod,
+				MaxConnectionAttemptsPerSec:   capacity.MaxConnectionAttemptsPerSec,
+			},
+			Routes: []dbapi.DataPlaneKafkaRouteRequest{
+				{
+					Name:   "bootstrap",
+					Prefix: "",
+					Router: router,
+				},
+				{
+					Name:   "admin-server",
+					Prefix: "admin-server",
+					Router: router,
+				},
+			},
+			KafkaVersion:    managedKafka.Spec.Versions.Kafka,
+			StrimziVersion:  managedKafka.Spec.Versions.Strimzi,
+			KafkaIBPVersion: managedKafka.Spec.Versions.KafkaIBP,
+		})
+	}
+	return statuses, nil
+}
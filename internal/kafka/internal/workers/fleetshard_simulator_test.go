@@ -0,0 +1,156 @@
+package workers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	. "github.com/onsi/gomega"
+)
+
+func TestFleetshardSimulator_Reconcile(t *testing.T) {
+	kafkaConfig := config.NewKafkaConfig()
+	kafkaConfig.KafkaCapacity = config.KafkaCapacityConfig{
+		TotalMaxConnections: 100,
+		MaxPartitions:       10,
+	}
+
+	tests := []struct {
+		name                   string
+		enableSimulator        bool
+		clusters               []api.Cluster
+		managedKafkas          []managedkafka.ManagedKafka
+		wantClusterStatus      *dbapi.DataPlaneClusterStatus
+		wantKafkaStatuses      []*dbapi.DataPlaneKafkaStatus
+		wantClusterStatusCalls int
+	}{
+		{
+			name:            "should not report any status when the simulator is disabled",
+			enableSimulator: false,
+			clusters:        []api.Cluster{{ClusterID: "simulated-cluster", ProviderType: api.ClusterProviderSimulated}},
+		},
+		{
+			name:            "should not report the status of the clusters that are not simulated",
+			enableSimulator: true,
+			clusters:        []api.Cluster{{ClusterID: "ocm-cluster", ProviderType: api.ClusterProviderOCM}},
+		},
+		{
+			name:            "should report the kafkas and the remaining capacity of the simulated clusters",
+			enableSimulator: true,
+			clusters:        []api.Cluster{{ClusterID: "simulated-cluster", ProviderType: api.ClusterProviderSimulated}},
+			managedKafkas: []managedkafka.ManagedKafka{
+				{
+					Id: "kafka-1",
+					Spec: managedkafka.ManagedKafkaSpec{
+						Capacity: managedkafka.Capacity{TotalMaxConnections: 100, MaxPartitions: 10},
+						Versions: managedkafka.VersionsSpec{Kafka: "2.8.0", Strimzi: "strimzi-cluster-operator.v0.24.0-0", KafkaIBP: "2.8"},
+					},
+				},
+				{
+					Id:   "kafka-2",
+					Spec: managedkafka.ManagedKafkaSpec{Deleted: true},
+				},
+			},
+			wantClusterStatusCalls: 1,
+			wantClusterStatus: &dbapi.DataPlaneClusterStatus{
+				Conditions: []dbapi.DataPlaneClusterStatusCondition{{Type: "Ready", Status: "True"}},
+				NodeInfo: dbapi.DataPlaneClusterStatusNodeInfo{
+					Ceiling:                18,
+					Floor:                  3,
+					Current:                6,
+					CurrentWorkLoadMinimum: 3,
+				},
+				ResizeInfo: dbapi.DataPlaneClusterStatusResizeInfo{
+					NodeDelta: 3,
+					Delta:     dbapi.DataPlaneClusterStatusCapacity{Connections: 100, Partitions: 10},
+				},
+				Remaining: dbapi.DataPlaneClusterStatusCapacity{Connections: 100, Partitions: 10},
+				AvailableStrimziVersions: []api.StrimziVersion{
+					{
+						Version:          "strimzi-cluster-operator.v0.24.0-0",
+						Ready:            true,
+						KafkaVersions:    []api.KafkaVersion{{Version: "2.8.0"}},
+						KafkaIBPVersions: []api.KafkaIBPVersion{{Version: "2.8"}},
+					},
+				},
+			},
+			wantKafkaStatuses: []*dbapi.DataPlaneKafkaStatus{
+				{
+					KafkaClusterId: "kafka-1",
+					Conditions:     []dbapi.DataPlaneKafkaStatusCondition{{Type: "Ready", Status: "True"}},
+					Capacity:       dbapi.KafkaCapacity{TotalMaxConnections: 100, MaxPartitions: 10},
+					Routes: []dbapi.DataPlaneKafkaRouteRequest{
+						{Name: "bootstrap", Prefix: "", Router: "router-default.simulated-cluster.simulated.local"},
+						{Name: "admin-server", Prefix: "admin-server", Router: "router-default.simulated-cluster.simulated.local"},
+					},
+					KafkaVersion:    "2.8.0",
+					StrimziVersion:  "strimzi-cluster-operator.v0.24.0-0",
+					KafkaIBPVersion: "2.8",
+				},
+				{
+					KafkaClusterId: "kafka-2",
+					Conditions:     []dbapi.DataPlaneKafkaStatusCondition{{Type: "Ready", Status: "False", Reason: "Deleted"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.SimulatedClusterConfig.EnableFleetshardSimulator = tt.enableSimulator
+
+			var clusterStatuses []*dbapi.DataPlaneClusterStatus
+			var kafkaStatuses []*dbapi.DataPlaneKafkaStatus
+			simulator := NewFleetshardSimulator(FleetshardSimulatorOptions{
+				ClusterService: &services.ClusterServiceMock{
+					ListByStatusFunc: func(state api.ClusterStatus) ([]api.Cluster, *errors.ServiceError) {
+						if state == api.ClusterReady {
+							return tt.clusters, nil
+						}
+						return nil, nil
+					},
+					GetClusterDNSFunc: func(clusterID string) (string, *errors.ServiceError) {
+						return "apps." + clusterID + ".simulated.local", nil
+					},
+					GetComputeNodesFunc: func(clusterID string) (*types.ComputeNodesInfo, *errors.ServiceError) {
+						return &types.ComputeNodesInfo{Actual: 6, Desired: 6}, nil
+					},
+				},
+				KafkaService: &services.KafkaServiceMock{
+					GetManagedKafkaByClusterIDFunc: func(clusterID string) ([]managedkafka.ManagedKafka, *errors.ServiceError) {
+						return tt.managedKafkas, nil
+					},
+				},
+				DataPlaneClusterService: &services.DataPlaneClusterServiceMock{
+					UpdateDataPlaneClusterStatusFunc: func(ctx context.Context, clusterID string, status *dbapi.DataPlaneClusterStatus) *errors.ServiceError {
+						clusterStatuses = append(clusterStatuses, status)
+						return nil
+					},
+				},
+				DataPlaneKafkaService: &services.DataPlaneKafkaServiceMock{
+					UpdateDataPlaneKafkaServiceFunc: func(ctx context.Context, clusterId string, status []*dbapi.DataPlaneKafkaStatus) *errors.ServiceError {
+						kafkaStatuses = append(kafkaStatuses, status...)
+						return nil
+					},
+				},
+				KafkaConfig:            kafkaConfig,
+				DataplaneClusterConfig: dataplaneClusterConfig,
+			}, workers.Reconciler{})
+
+			Expect(simulator.Reconcile()).To(BeEmpty())
+			Expect(clusterStatuses).To(HaveLen(tt.wantClusterStatusCalls))
+			if tt.wantClusterStatus != nil {
+				Expect(clusterStatuses[0]).To(Equal(tt.wantClusterStatus))
+			}
+			Expect(kafkaStatuses).To(Equal(tt.wantKafkaStatuses))
+		})
+	}
+}
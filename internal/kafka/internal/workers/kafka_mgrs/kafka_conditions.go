@@ -0,0 +1,22 @@
+package kafka_mgrs
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/pkg/errors"
+)
+
+// updateKafkaCondition sets the given condition on the kafka request and persists the conditions if they have changed
+func updateKafkaCondition(kafkaService services.KafkaService, kafkaRequest *dbapi.KafkaRequest, conditionType dbapi.KafkaConditionType, status dbapi.KafkaConditionStatus, reason string, message string) error {
+	changed, err := kafkaRequest.SetCondition(conditionType, status, reason, message)
+	if err != nil {
+		return errors.Wrapf(err, "failed to set %s condition for kafka %s", conditionType, kafkaRequest.ID)
+	}
+	if !changed {
+		return nil
+	}
+	if err := kafkaService.Updates(kafkaRequest, map[string]interface{}{"conditions": kafkaRequest.Conditions}); err != nil {
+		return errors.Wrapf(err, "failed to update %s condition for kafka %s", conditionType, kafkaRequest.ID)
+	}
+	return nil
+}
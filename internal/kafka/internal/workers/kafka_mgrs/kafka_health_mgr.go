@@ -0,0 +1,78 @@
+package kafka_mgrs
+
+import (
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// KafkaHealthManager represents a kafka manager that periodically evaluates the health of the ready kafkas and exposes it
+// as metrics for alerting.
+type KafkaHealthManager struct {
+	workers.BaseWorker
+	kafkaService       services.KafkaService
+	kafkaHealthService services.KafkaHealthService
+	// reportedKafkas are the kafkas whose health metrics were updated by the last reconcile, mapped to their cluster id
+	reportedKafkas map[string]string
+}
+
+// NewKafkaHealthManager creates a new kafka manager to evaluate the health of the ready kafkas.
+func NewKafkaHealthManager(kafkaService services.KafkaService, kafkaHealthService services.KafkaHealthService, reconciler workers.Reconciler) *KafkaHealthManager {
+	return &KafkaHealthManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "kafka_health",
+			Reconciler: reconciler,
+		},
+		kafkaService:       kafkaService,
+		kafkaHealthService: kafkaHealthService,
+		reportedKafkas:     map[string]string{},
+	}
+}
+
+// Start initializes the kafka manager to evaluate the health of the ready kafkas.
+func (k *KafkaHealthManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for evaluating the health of the ready kafkas to stop.
+func (k *KafkaHealthManager) Stop() {
+	k.StopWorker(k)
+	// the kafka health metrics are reset when the worker stops
+	k.reportedKafkas = map[string]string{}
+}
+
+func (k *KafkaHealthManager) Reconcile() []error {
+	glog.Infoln("evaluating the health of the ready kafkas")
+
+	readyKafkas, serviceErr := k.kafkaService.ListByStatus(constants2.KafkaRequestStatusReady)
+	if serviceErr != nil {
+		return []error{errors.Wrap(serviceErr, "failed to list ready kafkas")}
+	}
+
+	reportedKafkas := map[string]string{}
+	for _, kafka := range readyKafkas {
+		health := k.kafkaHealthService.EvaluateKafkaHealth(kafka)
+		glog.V(10).Infof("kafka id = %s health = %s", kafka.ID, health.Status)
+		signals := map[string]float64{}
+		for _, signal := range health.Signals {
+			signals[signal.Name] = signal.Status.Severity()
+		}
+		metrics.UpdateKafkaHealthMetric(kafka.ID, kafka.ClusterID, health.Status.Severity(), signals)
+		reportedKafkas[kafka.ID] = kafka.ClusterID
+	}
+
+	// the kafkas that are no longer ready are not evaluated anymore, their metrics would otherwise keep their last value
+	for kafkaId, clusterId := range k.reportedKafkas {
+		if _, ok := reportedKafkas[kafkaId]; !ok {
+			metrics.DeleteKafkaHealthMetric(kafkaId, clusterId, services.KafkaHealthSignals)
+		}
+	}
+	k.reportedKafkas = reportedKafkas
+
+	return nil
+}
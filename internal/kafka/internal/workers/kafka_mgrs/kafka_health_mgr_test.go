@@ -0,0 +1,55 @@
+package kafka_mgrs
+
+import (
+	"testing"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func TestKafkaHealthManager_Reconcile(t *testing.T) {
+	gomega.RegisterTestingT(t)
+
+	readyKafkas := []*dbapi.KafkaRequest{
+		{Meta: api.Meta{ID: "kafka-1"}, ClusterID: "cluster-1"},
+		{Meta: api.Meta{ID: "kafka-2"}, ClusterID: "cluster-1"},
+	}
+	kafkaService := &services.KafkaServiceMock{
+		ListByStatusFunc: func(status ...constants2.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+			return readyKafkas, nil
+		},
+	}
+	kafkaHealthService := &services.KafkaHealthServiceMock{
+		EvaluateKafkaHealthFunc: func(kafkaRequest *dbapi.KafkaRequest) *services.KafkaHealth {
+			return &services.KafkaHealth{
+				KafkaId:   kafkaRequest.ID,
+				ClusterId: kafkaRequest.ClusterID,
+				Status:    services.KafkaHealthStatusDegraded,
+				Signals: []services.KafkaHealthSignal{
+					{Name: services.KafkaHealthSignalStatus, Status: services.KafkaHealthStatusHealthy},
+					{Name: services.KafkaHealthSignalUpgrade, Status: services.KafkaHealthStatusDegraded},
+				},
+			}
+		},
+	}
+	k := NewKafkaHealthManager(kafkaService, kafkaHealthService, workers.Reconciler{})
+
+	gomega.Expect(k.Reconcile()).To(gomega.BeEmpty())
+	gomega.Expect(kafkaHealthService.EvaluateKafkaHealthCalls()).To(gomega.HaveLen(2))
+	gomega.Expect(k.reportedKafkas).To(gomega.Equal(map[string]string{"kafka-1": "cluster-1", "kafka-2": "cluster-1"}))
+
+	// the kafkas that are no longer ready are no longer reported
+	readyKafkas = readyKafkas[:1]
+	gomega.Expect(k.Reconcile()).To(gomega.BeEmpty())
+	gomega.Expect(k.reportedKafkas).To(gomega.Equal(map[string]string{"kafka-1": "cluster-1"}))
+
+	kafkaService.ListByStatusFunc = func(status ...constants2.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+		return nil, errors.GeneralError("failed to list kafkas")
+	}
+	gomega.Expect(k.Reconcile()).To(gomega.HaveLen(1))
+}
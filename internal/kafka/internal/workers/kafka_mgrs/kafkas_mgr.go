@@ -79,13 +79,15 @@ func (k *KafkaManager) Reconcile() []error {
 		encounteredErrors = append(encounteredErrors, capacityError)
 	}
 
-	// delete kafkas of denied owners
+	// delete kafkas of denied owners. Owners denied by rules that do not deprovision keep their existing kafkas.
 	accessControlListConfig := k.accessControlListConfig
-	if accessControlListConfig.EnableDenyList {
+	if rules := accessControlListConfig.GetRules(); rules.HasDeprovisionRules() {
 		glog.Infoln("reconciling denied kafka owners")
-		kafkaDeprovisioningForDeniedOwnersErr := k.reconcileDeniedKafkaOwners(accessControlListConfig.DenyList)
-		if kafkaDeprovisioningForDeniedOwnersErr != nil {
-			wrappedError := errors.Wrapf(kafkaDeprovisioningForDeniedOwnersErr, "Failed to deprovision kafka for denied owners %s", accessControlListConfig.DenyList)
+		deniedOwners, err := k.findDeniedKafkaOwners(rules)
+		if err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrap(err, "failed to find denied kafka owners"))
+		} else if kafkaDeprovisioningForDeniedOwnersErr := k.reconcileDeniedKafkaOwners(deniedOwners); kafkaDeprovisioningForDeniedOwnersErr != nil {
+			wrappedError := errors.Wrapf(kafkaDeprovisioningForDeniedOwnersErr, "Failed to deprovision kafka for denied owners %s", deniedOwners)
 			encounteredErrors = append(encounteredErrors, wrappedError)
 		}
 	}
@@ -104,6 +106,24 @@ func (k *KafkaManager) Reconcile() []error {
 	return encounteredErrors
 }
 
+// findDeniedKafkaOwners returns the owners of kafkas whose instances must be deprovisioned, i.e. the owners denied
+// access to the kafkas by a rule deprovisioning the instances of the users it denies
+func (k *KafkaManager) findDeniedKafkaOwners(rules *acl.AccessControlRules) (acl.DeniedUsers, error) {
+	owners, err := k.kafkaService.ListKafkaOwners()
+	if err != nil {
+		return nil, err
+	}
+
+	deniedOwners := acl.DeniedUsers{}
+	for _, owner := range owners {
+		subject := acl.Subject{Username: owner.Owner, OrgId: owner.OrganisationId}
+		if rules.Evaluate(subject, acl.APIKafkasMgmt, acl.ActionRead).Deprovision() {
+			deniedOwners = append(deniedOwners, owner.Owner)
+		}
+	}
+	return deniedOwners, nil
+}
+
 func (k *KafkaManager) reconcileDeniedKafkaOwners(deniedUsers acl.DeniedUsers) *serviceErr.ServiceError {
 	if len(deniedUsers) < 1 {
 		return nil
@@ -168,7 +188,7 @@ func (k *KafkaManager) calculateAvailableCapacityByRegionAndInstanceType(kafkasB
 		return totalUsed, instanceTypeUsed
 	}
 
-	for _, cluster := range k.dataplaneClusterConfig.ClusterConfig.GetManualClusters() {
+	for _, cluster := range k.dataplaneClusterConfig.GetClusterConfig().GetManualClusters() {
 		if !cluster.Schedulable {
 			continue
 		}
@@ -79,6 +79,71 @@ func TestKafkaManager_reconcileDeniedKafkaOwners(t *testing.T) {
 	}
 }
 
+func TestKafkaManager_findDeniedKafkaOwners(t *testing.T) {
+	owners := []services.KafkaOwner{
+		{Owner: "denied-user", OrganisationId: "org-1"},
+		{Owner: "user@blocked.example.com", OrganisationId: "org-2"},
+		{Owner: "other-user", OrganisationId: "org-3"},
+	}
+	tests := []struct {
+		name       string
+		rules      *acl.AccessControlRules
+		listErr    error
+		wantOwners acl.DeniedUsers
+		wantErr    bool
+	}{
+		{
+			name: "should only return the owners denied by rules deprovisioning their kafkas",
+			rules: &acl.AccessControlRules{
+				Rules: []acl.AccessControlRule{
+					{Effect: acl.EffectDeny, Usernames: []string{"denied-user"}, Deprovision: true},
+					{Effect: acl.EffectDeny, Organisations: []string{"org-3"}, Actions: []acl.Action{acl.ActionCreate}},
+					{Effect: acl.EffectDeny, EmailDomains: []string{"blocked.example.com"}, Deprovision: true},
+				},
+			},
+			wantOwners: acl.DeniedUsers{"denied-user", "user@blocked.example.com"},
+		},
+		{
+			name: "should not return the owners allowed by a rule with a higher precedence",
+			rules: &acl.AccessControlRules{
+				Rules: []acl.AccessControlRule{
+					{Effect: acl.EffectDeny, Organisations: []string{"org-1", "org-2"}, Deprovision: true},
+					{Effect: acl.EffectAllow, Usernames: []string{"denied-user"}},
+				},
+			},
+			wantOwners: acl.DeniedUsers{"user@blocked.example.com"},
+		},
+		{
+			name:    "should return an error when the owners cannot be listed",
+			rules:   &acl.AccessControlRules{},
+			listErr: errors.GeneralError("failed to list kafka owners"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			k := &KafkaManager{
+				kafkaService: &services.KafkaServiceMock{
+					ListKafkaOwnersFunc: func() ([]services.KafkaOwner, error) {
+						if tt.listErr != nil {
+							return nil, tt.listErr
+						}
+						return owners, nil
+					},
+				},
+			}
+			got, err := k.findDeniedKafkaOwners(tt.rules)
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				gomega.Expect(got).To(gomega.Equal(tt.wantOwners))
+			}
+		})
+	}
+}
+
 var (
 	cloudProviderStandardLimit = 5
 )
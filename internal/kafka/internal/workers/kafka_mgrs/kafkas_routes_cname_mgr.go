@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
@@ -53,26 +54,43 @@ func (k *KafkaRoutesCNAMEManager) Reconcile() []error {
 			if kafka.RoutesCreationId == "" {
 				glog.Infof("creating CNAME records for kafka %s", kafka.ID)
 
-				changeOutput, err := k.kafkaService.ChangeKafkaCNAMErecords(kafka, services.KafkaRoutesActionCreate)
+				change, err := k.kafkaService.ChangeKafkaCNAMErecords(kafka, services.KafkaRoutesActionCreate)
 
 				if err != nil {
 					errs = append(errs, err)
+					if condErr := updateKafkaCondition(k.kafkaService, kafka, dbapi.KafkaConditionRoutesCreated, dbapi.KafkaConditionStatusFalse, "DNSProviderError", err.Error()); condErr != nil {
+						errs = append(errs, condErr)
+					}
 					continue
 				}
 
-				kafka.RoutesCreationId = *changeOutput.ChangeInfo.Id
-				kafka.RoutesCreated = *changeOutput.ChangeInfo.Status == "INSYNC"
+				kafka.RoutesCreationId = change.Id
+				kafka.RoutesCreated = change.IsInSync()
 			} else {
-				recordStatus, err := k.kafkaService.GetCNAMERecordStatus(kafka)
+				change, err := k.kafkaService.GetCNAMERecordStatus(kafka)
 				if err != nil {
 					errs = append(errs, err)
+					if condErr := updateKafkaCondition(k.kafkaService, kafka, dbapi.KafkaConditionRoutesCreated, dbapi.KafkaConditionStatusFalse, "DNSProviderError", err.Error()); condErr != nil {
+						errs = append(errs, condErr)
+					}
 					continue
 				}
-				kafka.RoutesCreated = *recordStatus.Status == "INSYNC"
+				kafka.RoutesCreated = change.IsInSync()
+			}
+
+			status, reason, message := dbapi.KafkaConditionStatusFalse, "RecordsPending", "waiting for the DNS records to be in sync"
+			if kafka.RoutesCreated {
+				status, reason, message = dbapi.KafkaConditionStatusTrue, "RecordsInSync", ""
+			}
+			if _, err := kafka.SetCondition(dbapi.KafkaConditionRoutesCreated, status, reason, message); err != nil {
+				errs = append(errs, errors.Wrapf(err, "failed to set routes created condition for kafka %s", kafka.ID))
 			}
 		} else {
 			glog.Infof("external certificate is disabled, skip CNAME creation for Kafka %s", kafka.ID)
 			kafka.RoutesCreated = true
+			if _, err := kafka.SetCondition(dbapi.KafkaConditionRoutesCreated, dbapi.KafkaConditionStatusTrue, "ExternalCertificateDisabled", ""); err != nil {
+				errs = append(errs, errors.Wrapf(err, "failed to set routes created condition for kafka %s", kafka.ID))
+			}
 		}
 
 		if err := k.kafkaService.Update(kafka); err != nil {
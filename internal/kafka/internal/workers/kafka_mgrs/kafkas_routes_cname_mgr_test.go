@@ -3,17 +3,14 @@ package kafka_mgrs
 import (
 	"testing"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 )
 
 func TestKafkaRoutesCNAMEManager(t *testing.T) {
-	testChangeID := "1234"
-	testChangeINSYNC := route53.ChangeStatusInsync
-
 	type fields struct {
 		kafkaService services.KafkaService
 	}
@@ -34,18 +31,23 @@ func TestKafkaRoutesCNAMEManager(t *testing.T) {
 						kafka,
 					}, nil
 				},
-				ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-					return &route53.ChangeResourceRecordSetsOutput{
-						ChangeInfo: &route53.ChangeInfo{
-							Id:     &testChangeID,
-							Status: &testChangeINSYNC,
-						},
+				ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.Change, *errors.ServiceError) {
+					return &dns.Change{
+						Id:     "1234",
+						Status: dns.ChangeStatusInSync,
 					}, nil
 				},
 				UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 					if !kafkaRequest.RoutesCreated {
 						return errors.GeneralError("RoutesCreated is set to true")
 					}
+					conditions, err := kafkaRequest.GetConditions()
+					if err != nil {
+						return errors.GeneralError("failed to read conditions: %v", err)
+					}
+					if c, ok := conditions.GetCondition(dbapi.KafkaConditionRoutesCreated); !ok || c.Status != dbapi.KafkaConditionStatusTrue {
+						return errors.GeneralError("RoutesCreated condition is expected to be True")
+					}
 					return nil
 				},
 			}},
@@ -72,9 +74,19 @@ func TestKafkaRoutesCNAMEManager(t *testing.T) {
 						kafka,
 					}, nil
 				},
-				ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+				ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.Change, *errors.ServiceError) {
 					return nil, errors.GeneralError("failed to create CNAME")
 				},
+				UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+					conditions, err := kafkaRequest.GetConditions()
+					if err != nil {
+						return errors.GeneralError("failed to read conditions: %v", err)
+					}
+					if c, ok := conditions.GetCondition(dbapi.KafkaConditionRoutesCreated); !ok || c.Status != dbapi.KafkaConditionStatusFalse {
+						return errors.GeneralError("RoutesCreated condition is expected to be False")
+					}
+					return nil
+				},
 			}},
 			wantErr: true,
 		},
@@ -82,14 +82,21 @@ func (k *ReadyKafkaManager) reconcileSsoClientIDAndSecret(kafkaRequest *dbapi.Ka
 		kafkaRequest.SsoClientID = services.BuildKeycloakClientNameIdentifier(kafkaRequest.ID)
 		secret, err := k.keycloakService.GetKafkaClientSecret(kafkaRequest.SsoClientID)
 		if err != nil {
+			if condErr := updateKafkaCondition(k.kafkaService, kafkaRequest, dbapi.KafkaConditionSsoClientCreated, dbapi.KafkaConditionStatusFalse, "SsoClientNotFound", err.Error()); condErr != nil {
+				glog.Error(condErr)
+			}
 			return errors.Wrapf(err, "failed to get sso client id & secret for kafka cluster: %s", kafkaRequest.SsoClientID)
 		}
 		kafkaRequest.SsoClientSecret = secret
+		if _, err := kafkaRequest.SetCondition(dbapi.KafkaConditionSsoClientCreated, dbapi.KafkaConditionStatusTrue, "SsoClientCreated", ""); err != nil {
+			return errors.Wrapf(err, "failed to set sso client condition for kafka %s", kafkaRequest.ID)
+		}
 		if err = k.kafkaService.Update(kafkaRequest); err != nil {
 			return errors.Wrapf(err, "failed to update kafka %s with cluster details", kafkaRequest.ID)
 		}
+		return nil
 	}
-	return nil
+	return updateKafkaCondition(k.kafkaService, kafkaRequest, dbapi.KafkaConditionSsoClientCreated, dbapi.KafkaConditionStatusTrue, "SsoClientCreated", "")
 }
 
 // reconcileCanaryServiceAccount migrates all existing kafkas so that they will have the canary service account created.
@@ -108,14 +115,21 @@ func (k *ReadyKafkaManager) reconcileCanaryServiceAccount(kafkaRequest *dbapi.Ka
 
 		serviceAccount, err := k.keycloakService.CreateServiceAccountInternal(serviceAccountRequest)
 		if err != nil {
+			if condErr := updateKafkaCondition(k.kafkaService, kafkaRequest, dbapi.KafkaConditionCanaryServiceAccountCreated, dbapi.KafkaConditionStatusFalse, "CanaryServiceAccountCreationFailed", err.Error()); condErr != nil {
+				glog.Error(condErr)
+			}
 			return errors.Wrapf(err, "failed to create canary service account: %s", kafkaRequest.SsoClientID)
 		}
 		kafkaRequest.CanaryServiceAccountClientID = serviceAccount.ClientID
 		kafkaRequest.CanaryServiceAccountClientSecret = serviceAccount.ClientSecret
+		if _, err := kafkaRequest.SetCondition(dbapi.KafkaConditionCanaryServiceAccountCreated, dbapi.KafkaConditionStatusTrue, "CanaryServiceAccountCreated", ""); err != nil {
+			return errors.Wrapf(err, "failed to set canary service account condition for kafka %s", kafkaRequest.ID)
+		}
 		if err = k.kafkaService.Update(kafkaRequest); err != nil {
 			return errors.Wrapf(err, "failed to update kafka %s with canary service account details", kafkaRequest.ID)
 		}
+		return nil
 	}
 
-	return nil
+	return updateKafkaCondition(k.kafkaService, kafkaRequest, dbapi.KafkaConditionCanaryServiceAccountCreated, dbapi.KafkaConditionStatusTrue, "CanaryServiceAccountCreated", "")
 }
@@ -38,6 +38,9 @@ func TestReadyKafkaManager_reconcileCanaryServiceAccount(t *testing.T) {
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
 					UpdateFunc: nil, // set to nil as it should not be called
+					UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+						return nil
+					},
 				},
 				keycloakService: &sso.KeycloakServiceMock{
 					CreateServiceAccountInternalFunc: nil, // set to nil as it should not be called,
@@ -58,6 +61,9 @@ func TestReadyKafkaManager_reconcileCanaryServiceAccount(t *testing.T) {
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 						return nil
 					},
+					UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+						return nil
+					},
 				},
 				keycloakService: &sso.KeycloakServiceMock{
 					CreateServiceAccountInternalFunc: func(request sso.CompleteServiceAccountRequest) (*api.ServiceAccount, *errors.ServiceError) {
@@ -102,9 +108,17 @@ func TestReadyKafkaManager_reconcileCanaryServiceAccount(t *testing.T) {
 				t.Errorf("reconcilePreparingKafka() error = %v, wantErr %v", err, tt.wantErr)
 			}
 
+			conditions, err := tt.args.kafka.GetConditions()
+			gomega.Expect(err).To(gomega.BeNil())
+			condition, found := conditions.GetCondition(dbapi.KafkaConditionCanaryServiceAccountCreated)
+			gomega.Expect(found).To(gomega.BeTrue())
+
 			if !tt.wantErr {
 				gomega.Expect(tt.args.kafka.CanaryServiceAccountClientID).NotTo(gomega.BeEmpty())
 				gomega.Expect(tt.args.kafka.CanaryServiceAccountClientSecret).NotTo(gomega.BeEmpty())
+				gomega.Expect(condition.Status).To(gomega.Equal(dbapi.KafkaConditionStatusTrue))
+			} else {
+				gomega.Expect(condition.Status).To(gomega.Equal(dbapi.KafkaConditionStatusFalse))
 			}
 		})
 	}
@@ -42,10 +42,10 @@ func ConfigProviders() di.Option {
 
 		// Configuration for the Kafka service...
 		di.Provide(config.NewAWSConfig, di.As(new(environments2.ConfigModule))),
-		di.Provide(config.NewSupportedProvidersConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
+		di.Provide(config.NewSupportedProvidersConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator)), di.As(new(environments2.Reloadable))),
 		di.Provide(observatoriumClient.NewObservabilityConfigurationConfig, di.As(new(environments2.ConfigModule))),
-		di.Provide(config.NewKafkaConfig, di.As(new(environments2.ConfigModule))),
-		di.Provide(config.NewDataplaneClusterConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewKafkaConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator)), di.As(new(environments2.Reloadable))),
+		di.Provide(config.NewDataplaneClusterConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.Reloadable))),
 		di.Provide(config.NewKasFleetshardConfig, di.As(new(environments2.ConfigModule))),
 
 		// Additional CLI subcommands
@@ -65,13 +65,16 @@ func ConfigProviders() di.Option {
 func ServiceProviders() di.Option {
 	return di.Options(
 		di.Provide(services.NewClusterService),
+		di.Provide(services.NewDNSProvider),
 		di.Provide(services.NewKafkaService, di.As(new(services.KafkaService))),
 		di.Provide(services.NewCloudProvidersService),
 		di.Provide(services.NewObservatoriumService),
+		di.Provide(services.NewKafkaHealthService),
 		di.Provide(services.NewKasFleetshardOperatorAddon),
 		di.Provide(services.NewClusterPlacementStrategy),
 		di.Provide(services.NewDataPlaneClusterService, di.As(new(services.DataPlaneClusterService))),
 		di.Provide(services.NewDataPlaneKafkaService, di.As(new(services.DataPlaneKafkaService))),
+		di.Provide(services.NewClusterUpgradeService),
 		di.Provide(handlers.NewAuthenticationBuilder),
 		di.Provide(clusters.NewDefaultProviderFactory, di.As(new(clusters.ProviderFactory))),
 		di.Provide(routes.NewRouteLoader),
@@ -84,5 +87,9 @@ func ServiceProviders() di.Option {
 		di.Provide(kafka_mgrs.NewProvisioningKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewReadyKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewKafkaCNAMEManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaHealthManager, di.As(new(workers.Worker))),
+		di.Provide(workers.NewFleetshardSimulator, di.As(new(workers.Worker))),
+		di.Provide(workers.NewClusterHeartbeatManager, di.As(new(workers.Worker))),
+		di.Provide(workers.NewClusterUpgradeManager, di.As(new(workers.Worker))),
 	)
 }
@@ -35,7 +35,7 @@ func TestObservatorium_ResourceStateMetric(t *testing.T) {
 	_, _, teardown := test.NewKafkaHelper(t, ocmServer)
 	defer teardown()
 
-	service := services.NewObservatoriumService(test.TestServices.ObservatoriumClient, test.TestServices.KafkaService)
+	service := services.NewObservatoriumService(test.TestServices.ObservatoriumClient, test.TestServices.KafkaService, &observatorium.ObservabilityConfiguration{})
 	kafkaState, err := service.GetKafkaState(mockKafkaClusterName, mockResourceNamespace)
 	Expect(err).NotTo(HaveOccurred(), "Error getting kafka state:  %v", err)
 	Expect(kafkaState.State).NotTo(BeEmpty(), "Should return state")
@@ -76,7 +76,7 @@ func TestObservatorium_GetMetrics(t *testing.T) {
 		t.Fatalf("failed to create seeded kafka request: %s", err.Error())
 	}
 
-	service := services.NewObservatoriumService(test.TestServices.ObservatoriumClient, test.TestServices.KafkaService)
+	service := services.NewObservatoriumService(test.TestServices.ObservatoriumClient, test.TestServices.KafkaService, &observatorium.ObservabilityConfiguration{})
 	metricsList := &observatorium.KafkaMetrics{}
 	q := observatorium.MetricsReqParams{}
 	q.ResultType = observatorium.RangeQuery
@@ -1,6 +1,9 @@
 package acl
 
 import (
+	"sync"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 	"github.com/spf13/pflag"
@@ -16,31 +19,99 @@ func (deniedAccounts DeniedUsers) IsUserDenied(username string) bool {
 }
 
 type AccessControlListConfig struct {
-	DenyList           DeniedUsers
-	DenyListConfigFile string
-	EnableDenyList     bool
+	DenyList                     DeniedUsers
+	DenyListConfigFile           string
+	EnableDenyList               bool
+	AccessControlRules           AccessControlRules
+	AccessControlRulesConfigFile string
+	EnableAccessControlRules     bool
+	mutex                        sync.RWMutex
 }
 
 func NewAccessControlListConfig() *AccessControlListConfig {
 	return &AccessControlListConfig{
-		DenyListConfigFile: "config/deny-list-configuration.yaml",
-		EnableDenyList:     false,
+		DenyListConfigFile:           "config/deny-list-configuration.yaml",
+		EnableDenyList:               false,
+		AccessControlRulesConfigFile: "config/access-control-rules-configuration.yaml",
+		EnableAccessControlRules:     false,
 	}
 }
 
 func (c *AccessControlListConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.DenyListConfigFile, "deny-list-config-file", c.DenyListConfigFile, "DenyList configuration file")
 	fs.BoolVar(&c.EnableDenyList, "enable-deny-list", c.EnableDenyList, "Enable access control via the denied list of users")
+	fs.StringVar(&c.AccessControlRulesConfigFile, "access-control-rules-config-file", c.AccessControlRulesConfigFile, "Access control rules configuration file")
+	fs.BoolVar(&c.EnableAccessControlRules, "enable-access-control-rules", c.EnableAccessControlRules, "Enable access control via the allow and deny rules of the access control rules configuration file")
 }
 
 func (c *AccessControlListConfig) ReadFiles() (err error) {
 	if c.EnableDenyList {
 		err = readDenyListConfigFile(c.DenyListConfigFile, &c.DenyList)
+		if err != nil {
+			return err
+		}
+	}
+	if c.EnableAccessControlRules {
+		err = readAccessControlRulesFile(c.AccessControlRulesConfigFile, &c.AccessControlRules)
 	}
 
 	return err
 }
 
+// GetRules returns the snapshot of the access control rules currently loaded. The users of the deny list are denied
+// by a rule deprovisioning their instances, taking precedence over the rules of the access control rules file.
+func (c *AccessControlListConfig) GetRules() *AccessControlRules {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	rules := &AccessControlRules{Mode: ModeDenyList}
+	if c.EnableDenyList && len(c.DenyList) > 0 {
+		rules.Rules = append(rules.Rules, AccessControlRule{Effect: EffectDeny, Usernames: c.DenyList, Deprovision: true})
+	}
+	if c.EnableAccessControlRules {
+		rules.Mode = c.AccessControlRules.Mode
+		rules.Rules = append(rules.Rules, c.AccessControlRules.Rules...)
+	}
+	return rules
+}
+
+// IsEnabled returns true when access control is enabled via the deny list or the access control rules
+func (c *AccessControlListConfig) IsEnabled() bool {
+	return c.EnableDenyList || c.EnableAccessControlRules
+}
+
+var _ environments.Reloadable = &AccessControlListConfig{}
+
+func (c *AccessControlListConfig) WatchedFiles() []string {
+	var files []string
+	if c.EnableDenyList {
+		files = append(files, c.DenyListConfigFile)
+	}
+	if c.EnableAccessControlRules {
+		files = append(files, c.AccessControlRulesConfigFile)
+	}
+	return files
+}
+
+func (c *AccessControlListConfig) Reload() error {
+	var denyList DeniedUsers
+	if c.EnableDenyList {
+		if err := readDenyListConfigFile(c.DenyListConfigFile, &denyList); err != nil {
+			return err
+		}
+	}
+	var rules AccessControlRules
+	if c.EnableAccessControlRules {
+		if err := readAccessControlRulesFile(c.AccessControlRulesConfigFile, &rules); err != nil {
+			return err
+		}
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.DenyList = denyList
+	c.AccessControlRules = rules
+	return nil
+}
+
 // Read the contents of file into the deny list config
 func readDenyListConfigFile(file string, val *DeniedUsers) error {
 	fileContents, err := shared.ReadFile(file)
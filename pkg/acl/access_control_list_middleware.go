@@ -19,8 +19,15 @@ func NewAccessControlListMiddleware(accessControlListConfig *AccessControlListCo
 	return &middleware
 }
 
-// Middleware handler to authorize users based on the provided ACL configuration
-func (middleware *AccessControlListMiddleware) Authorize(next http.Handler) http.Handler {
+// Authorize returns the middleware handler authorizing users to access the given API based on the provided ACL
+// configuration. The action the rules are evaluated for is derived from the HTTP method of the request.
+func (middleware *AccessControlListMiddleware) Authorize(api API) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return middleware.authorize(api, next)
+	}
+}
+
+func (middleware *AccessControlListMiddleware) authorize(api API, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		context := r.Context()
 		claims, err := auth.GetClaimsFromContext(context)
@@ -30,17 +37,17 @@ func (middleware *AccessControlListMiddleware) Authorize(next http.Handler) http
 		}
 
 		username := auth.GetUsernameFromClaims(claims)
+		orgId := auth.GetOrgIdFromClaims(claims)
 
-		if middleware.accessControlListConfig.EnableDenyList {
-			userIsDenied := middleware.accessControlListConfig.DenyList.IsUserDenied(username)
-			if userIsDenied {
+		if middleware.accessControlListConfig.IsEnabled() {
+			subject := Subject{Username: username, OrgId: orgId, Email: auth.GetEmailFromClaims(claims)}
+			decision := middleware.accessControlListConfig.GetRules().Evaluate(subject, api, ActionFromMethod(r.Method))
+			if !decision.Allowed {
 				shared.HandleError(r, w, errors.New(errors.ErrorForbidden, "User '%s' is not authorized to access the service.", username))
 				return
 			}
 		}
 
-		orgId := auth.GetOrgIdFromClaims(claims)
-
 		// If the users claim has an orgId, resources should be filtered by their organisation. Otherwise, filter them by owner.
 		context = auth.SetFilterByOrganisationContext(context, orgId != "")
 		*r = *r.WithContext(context)
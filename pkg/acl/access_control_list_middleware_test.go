@@ -48,9 +48,10 @@ func Test_AccessControlListMiddleware_UserHasNoAccess(t *testing.T) {
 	}
 
 	tests := []struct {
-		name string
-		arg  *acl.AccessControlListConfig
-		want *errors.ServiceError
+		name   string
+		arg    *acl.AccessControlListConfig
+		method string
+		want   *errors.ServiceError
 	}{
 		{
 			name: "returns 403 Forbidden response when user is not allowed to access service",
@@ -58,6 +59,33 @@ func Test_AccessControlListMiddleware_UserHasNoAccess(t *testing.T) {
 				EnableDenyList: true,
 				DenyList:       acl.DeniedUsers{"username"},
 			},
+			method: http.MethodGet,
+		},
+		{
+			name: "returns 403 Forbidden response when the organisation of the user is not in the allow list",
+			arg: &acl.AccessControlListConfig{
+				EnableAccessControlRules: true,
+				AccessControlRules: acl.AccessControlRules{
+					Mode:  acl.ModeAllowList,
+					Rules: []acl.AccessControlRule{{Effect: acl.EffectAllow, Organisations: []string{"org-id-1"}}},
+				},
+			},
+			method: http.MethodGet,
+		},
+		{
+			name: "returns 403 Forbidden response when the creation of kafkas is denied to the organisation of the user",
+			arg: &acl.AccessControlListConfig{
+				EnableAccessControlRules: true,
+				AccessControlRules: acl.AccessControlRules{
+					Rules: []acl.AccessControlRule{{
+						Effect:        acl.EffectDeny,
+						Organisations: []string{"org-id-0"},
+						APIs:          []acl.API{acl.APIKafkasMgmt},
+						Actions:       []acl.Action{acl.ActionCreate},
+					}},
+				},
+			},
+			method: http.MethodPost,
 		},
 	}
 
@@ -65,7 +93,7 @@ func Test_AccessControlListMiddleware_UserHasNoAccess(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			RegisterTestingT(t)
 
-			req, err := http.NewRequest("GET", "/api/kafkas_mgmt/kafkas", nil)
+			req, err := http.NewRequest(tt.method, "/api/kafkas_mgmt/kafkas", nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -73,7 +101,7 @@ func Test_AccessControlListMiddleware_UserHasNoAccess(t *testing.T) {
 			rr := httptest.NewRecorder()
 
 			middleware := acl.NewAccessControlListMiddleware(tt.arg)
-			handler := middleware.Authorize(http.HandlerFunc(NextHandler))
+			handler := middleware.Authorize(acl.APIKafkasMgmt)(http.HandlerFunc(NextHandler))
 
 			// create a jwt and set it in the context
 			ctx := req.Context()
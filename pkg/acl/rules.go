@@ -0,0 +1,275 @@
+package acl
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"gopkg.in/yaml.v2"
+)
+
+// Effect is the effect of an AccessControlRule on the users it matches
+type Effect string
+
+const (
+	EffectAllow Effect = "allow"
+	EffectDeny  Effect = "deny"
+)
+
+// API identifies the public API an AccessControlRule applies to
+type API string
+
+const (
+	APIKafkasMgmt    API = "kafkas_mgmt"
+	APIConnectorMgmt API = "connector_mgmt"
+)
+
+// Action identifies the kind of request an AccessControlRule applies to
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionRead   Action = "read"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// ActionFromMethod returns the Action of a request given its HTTP method
+func ActionFromMethod(method string) Action {
+	switch method {
+	case http.MethodPost:
+		return ActionCreate
+	case http.MethodPatch, http.MethodPut:
+		return ActionUpdate
+	case http.MethodDelete:
+		return ActionDelete
+	default:
+		return ActionRead
+	}
+}
+
+// Mode defines whether the users not matched by any AccessControlRule are allowed or denied
+type Mode string
+
+const (
+	// ModeDenyList allows the users unless they are denied by a rule
+	ModeDenyList Mode = "deny_list"
+	// ModeAllowList denies the users unless they are allowed by a rule
+	ModeAllowList Mode = "allow_list"
+)
+
+// precedence of the criteria of an AccessControlRule. When several rules match a user, the rule matching the most
+// specific criterion applies and a deny rule wins over an allow rule matching the same criterion.
+const (
+	precedenceNone = iota
+	precedenceUsernamePattern
+	precedenceEmailDomain
+	precedenceOrganisation
+	precedenceUsername
+)
+
+// Subject is the user an access control decision is made for
+type Subject struct {
+	Username string
+	OrgId    string
+	// Email is the email of the user, the username is used instead when the email is empty and the username is an email
+	Email string
+}
+
+func (s Subject) emailDomain() string {
+	email := s.Email
+	if email == "" {
+		email = s.Username
+	}
+	if i := strings.LastIndex(email, "@"); i != -1 {
+		return strings.ToLower(email[i+1:])
+	}
+	return ""
+}
+
+// AccessControlRule allows or denies the users matching any of its criteria
+type AccessControlRule struct {
+	Effect        Effect   `yaml:"effect"`
+	Usernames     []string `yaml:"usernames"`
+	Organisations []string `yaml:"organisations"`
+	EmailDomains  []string `yaml:"email_domains"`
+	// UsernamePatterns are regular expressions that must match the whole username
+	UsernamePatterns []string `yaml:"username_patterns"`
+	// APIs the rule applies to, all of them when empty
+	APIs []API `yaml:"apis"`
+	// Actions the rule applies to, all of them when empty
+	Actions []Action `yaml:"actions"`
+	// Deprovision the instances owned by the users denied by the rule. Otherwise, their existing instances are kept.
+	Deprovision bool `yaml:"deprovision"`
+
+	patterns []*regexp.Regexp
+}
+
+func (r *AccessControlRule) compile() error {
+	if r.Effect != EffectAllow && r.Effect != EffectDeny {
+		return fmt.Errorf("invalid effect %q, must be one of %q or %q", r.Effect, EffectAllow, EffectDeny)
+	}
+	if len(r.Usernames)+len(r.Organisations)+len(r.EmailDomains)+len(r.UsernamePatterns) == 0 {
+		return fmt.Errorf("at least one of usernames, organisations, email_domains or username_patterns must be set")
+	}
+	for _, api := range r.APIs {
+		if api != APIKafkasMgmt && api != APIConnectorMgmt {
+			return fmt.Errorf("invalid api %q, must be one of %q or %q", api, APIKafkasMgmt, APIConnectorMgmt)
+		}
+	}
+	for _, action := range r.Actions {
+		switch action {
+		case ActionCreate, ActionRead, ActionUpdate, ActionDelete:
+		default:
+			return fmt.Errorf("invalid action %q, must be one of %q, %q, %q or %q", action, ActionCreate, ActionRead, ActionUpdate, ActionDelete)
+		}
+	}
+	if r.Deprovision && (r.Effect != EffectDeny || !r.appliesToAction(ActionRead)) {
+		return fmt.Errorf("deprovision can only be set on deny rules that apply to the %q action", ActionRead)
+	}
+	r.patterns = make([]*regexp.Regexp, 0, len(r.UsernamePatterns))
+	for _, pattern := range r.UsernamePatterns {
+		compiled, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", pattern))
+		if err != nil {
+			return fmt.Errorf("invalid username pattern %q: %v", pattern, err)
+		}
+		r.patterns = append(r.patterns, compiled)
+	}
+	return nil
+}
+
+func (r *AccessControlRule) appliesToAPI(api API) bool {
+	if len(r.APIs) == 0 {
+		return true
+	}
+	for _, a := range r.APIs {
+		if a == api {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *AccessControlRule) appliesToAction(action Action) bool {
+	if len(r.Actions) == 0 {
+		return true
+	}
+	for _, a := range r.Actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// match returns the precedence of the most specific criterion of the rule matching the subject
+func (r *AccessControlRule) match(subject Subject) int {
+	for _, username := range r.Usernames {
+		if username == subject.Username {
+			return precedenceUsername
+		}
+	}
+	if subject.OrgId != "" {
+		for _, org := range r.Organisations {
+			if org == subject.OrgId {
+				return precedenceOrganisation
+			}
+		}
+	}
+	if domain := subject.emailDomain(); domain != "" {
+		for _, d := range r.EmailDomains {
+			if strings.ToLower(d) == domain {
+				return precedenceEmailDomain
+			}
+		}
+	}
+	for _, pattern := range r.patterns {
+		if pattern.MatchString(subject.Username) {
+			return precedenceUsernamePattern
+		}
+	}
+	return precedenceNone
+}
+
+// AccessControlRules is the set of rules deciding which users can access the service
+type AccessControlRules struct {
+	Mode  Mode                `yaml:"mode"`
+	Rules []AccessControlRule `yaml:"rules"`
+}
+
+// Decision is the result of the evaluation of the AccessControlRules for a user
+type Decision struct {
+	Allowed bool
+	// Rule is the rule the decision was made from, nil when no rule matched and the Mode applied
+	Rule *AccessControlRule
+}
+
+// Deprovision returns true when the instances owned by the user must be deprovisioned
+func (d Decision) Deprovision() bool {
+	return !d.Allowed && d.Rule != nil && d.Rule.Deprovision
+}
+
+func (r *AccessControlRules) validate() error {
+	switch r.Mode {
+	case "":
+		r.Mode = ModeDenyList
+	case ModeDenyList, ModeAllowList:
+	default:
+		return fmt.Errorf("invalid mode %q, must be one of %q or %q", r.Mode, ModeDenyList, ModeAllowList)
+	}
+	for i := range r.Rules {
+		if err := r.Rules[i].compile(); err != nil {
+			return fmt.Errorf("invalid access control rule %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// Evaluate decides whether the subject can perform the action on the API
+func (r *AccessControlRules) Evaluate(subject Subject, api API, action Action) Decision {
+	var matched *AccessControlRule
+	matchedPrecedence := precedenceNone
+	for i := range r.Rules {
+		rule := &r.Rules[i]
+		if !rule.appliesToAPI(api) || !rule.appliesToAction(action) {
+			continue
+		}
+		precedence := rule.match(subject)
+		if precedence == precedenceNone {
+			continue
+		}
+		if precedence > matchedPrecedence || (precedence == matchedPrecedence && rule.Effect == EffectDeny) {
+			matched = rule
+			matchedPrecedence = precedence
+		}
+	}
+	if matched != nil {
+		return Decision{Allowed: matched.Effect == EffectAllow, Rule: matched}
+	}
+	return Decision{Allowed: r.Mode != ModeAllowList}
+}
+
+// HasDeprovisionRules returns true when at least one rule deprovisions the instances of the users it denies
+func (r *AccessControlRules) HasDeprovisionRules() bool {
+	for i := range r.Rules {
+		if r.Rules[i].Deprovision {
+			return true
+		}
+	}
+	return false
+}
+
+// Read the contents of file into the access control rules
+func readAccessControlRulesFile(file string, val *AccessControlRules) error {
+	fileContents, err := shared.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	if err := yaml.UnmarshalStrict([]byte(fileContents), val); err != nil {
+		return err
+	}
+	return val.validate()
+}
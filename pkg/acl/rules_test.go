@@ -0,0 +1,229 @@
+package acl
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_AccessControlRules_Evaluate(t *testing.T) {
+	t.Parallel()
+	subject := Subject{Username: "user@example.com", OrgId: "org-1"}
+	tests := []struct {
+		name            string
+		rules           AccessControlRules
+		api             API
+		action          Action
+		wantAllowed     bool
+		wantDeprovision bool
+	}{
+		{
+			name:        "allow users when no rule matches in deny list mode",
+			rules:       AccessControlRules{Rules: []AccessControlRule{{Effect: EffectDeny, Usernames: []string{"other-user"}}}},
+			api:         APIKafkasMgmt,
+			action:      ActionRead,
+			wantAllowed: true,
+		},
+		{
+			name:        "deny users when no rule matches in allow list mode",
+			rules:       AccessControlRules{Mode: ModeAllowList, Rules: []AccessControlRule{{Effect: EffectAllow, Organisations: []string{"org-2"}}}},
+			api:         APIKafkasMgmt,
+			action:      ActionRead,
+			wantAllowed: false,
+		},
+		{
+			name:        "allow users of an allowed organisation in allow list mode",
+			rules:       AccessControlRules{Mode: ModeAllowList, Rules: []AccessControlRule{{Effect: EffectAllow, Organisations: []string{"org-1"}}}},
+			api:         APIKafkasMgmt,
+			action:      ActionRead,
+			wantAllowed: true,
+		},
+		{
+			name: "a username rule takes precedence over an organisation rule",
+			rules: AccessControlRules{Rules: []AccessControlRule{
+				{Effect: EffectDeny, Organisations: []string{"org-1"}},
+				{Effect: EffectAllow, Usernames: []string{"user@example.com"}},
+			}},
+			api:         APIKafkasMgmt,
+			action:      ActionRead,
+			wantAllowed: true,
+		},
+		{
+			name: "an organisation rule takes precedence over an email domain rule",
+			rules: AccessControlRules{Rules: []AccessControlRule{
+				{Effect: EffectAllow, Organisations: []string{"org-1"}},
+				{Effect: EffectDeny, EmailDomains: []string{"EXAMPLE.com"}},
+			}},
+			api:         APIKafkasMgmt,
+			action:      ActionRead,
+			wantAllowed: true,
+		},
+		{
+			name: "an email domain rule takes precedence over a username pattern rule",
+			rules: AccessControlRules{Rules: []AccessControlRule{
+				{Effect: EffectAllow, UsernamePatterns: []string{".*@example\\.com"}},
+				{Effect: EffectDeny, EmailDomains: []string{"example.com"}},
+			}},
+			api:         APIKafkasMgmt,
+			action:      ActionRead,
+			wantAllowed: false,
+		},
+		{
+			name: "a deny rule wins over an allow rule matching the same criterion",
+			rules: AccessControlRules{Mode: ModeAllowList, Rules: []AccessControlRule{
+				{Effect: EffectDeny, Organisations: []string{"org-1"}, Deprovision: true},
+				{Effect: EffectAllow, Organisations: []string{"org-1"}},
+			}},
+			api:             APIKafkasMgmt,
+			action:          ActionRead,
+			wantAllowed:     false,
+			wantDeprovision: true,
+		},
+		{
+			name:        "a username pattern must match the whole username",
+			rules:       AccessControlRules{Rules: []AccessControlRule{{Effect: EffectDeny, UsernamePatterns: []string{"user"}}}},
+			api:         APIKafkasMgmt,
+			action:      ActionRead,
+			wantAllowed: true,
+		},
+		{
+			name:        "ignore the rules of other apis",
+			rules:       AccessControlRules{Rules: []AccessControlRule{{Effect: EffectDeny, Organisations: []string{"org-1"}, APIs: []API{APIConnectorMgmt}}}},
+			api:         APIKafkasMgmt,
+			action:      ActionRead,
+			wantAllowed: true,
+		},
+		{
+			name:        "ignore the rules of other actions",
+			rules:       AccessControlRules{Rules: []AccessControlRule{{Effect: EffectDeny, Organisations: []string{"org-1"}, Actions: []Action{ActionCreate}}}},
+			api:         APIKafkasMgmt,
+			action:      ActionRead,
+			wantAllowed: true,
+		},
+		{
+			name:        "deny the actions of the rule",
+			rules:       AccessControlRules{Rules: []AccessControlRule{{Effect: EffectDeny, Organisations: []string{"org-1"}, Actions: []Action{ActionCreate}}}},
+			api:         APIKafkasMgmt,
+			action:      ActionCreate,
+			wantAllowed: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			RegisterTestingT(t)
+			Expect(tt.rules.validate()).To(Succeed())
+			decision := tt.rules.Evaluate(subject, tt.api, tt.action)
+			Expect(decision.Allowed).To(Equal(tt.wantAllowed))
+			Expect(decision.Deprovision()).To(Equal(tt.wantDeprovision))
+		})
+	}
+}
+
+func Test_AccessControlRules_validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		rules   AccessControlRules
+		wantErr bool
+	}{
+		{
+			name: "return no error when the rules are valid",
+			rules: AccessControlRules{Mode: ModeAllowList, Rules: []AccessControlRule{
+				{Effect: EffectAllow, EmailDomains: []string{"example.com"}, APIs: []API{APIKafkasMgmt}, Actions: []Action{ActionRead}},
+				{Effect: EffectDeny, UsernamePatterns: []string{"test-.*"}, Deprovision: true},
+			}},
+			wantErr: false,
+		},
+		{
+			name:    "return an error when the mode is invalid",
+			rules:   AccessControlRules{Mode: "open"},
+			wantErr: true,
+		},
+		{
+			name:    "return an error when the effect is invalid",
+			rules:   AccessControlRules{Rules: []AccessControlRule{{Effect: "block", Usernames: []string{"user"}}}},
+			wantErr: true,
+		},
+		{
+			name:    "return an error when the rule has no criteria",
+			rules:   AccessControlRules{Rules: []AccessControlRule{{Effect: EffectDeny}}},
+			wantErr: true,
+		},
+		{
+			name:    "return an error when the api is invalid",
+			rules:   AccessControlRules{Rules: []AccessControlRule{{Effect: EffectDeny, Usernames: []string{"user"}, APIs: []API{"clusters_mgmt"}}}},
+			wantErr: true,
+		},
+		{
+			name:    "return an error when the action is invalid",
+			rules:   AccessControlRules{Rules: []AccessControlRule{{Effect: EffectDeny, Usernames: []string{"user"}, Actions: []Action{"list"}}}},
+			wantErr: true,
+		},
+		{
+			name:    "return an error when the username pattern is invalid",
+			rules:   AccessControlRules{Rules: []AccessControlRule{{Effect: EffectDeny, UsernamePatterns: []string{"("}}}},
+			wantErr: true,
+		},
+		{
+			name:    "return an error when an allow rule deprovisions",
+			rules:   AccessControlRules{Rules: []AccessControlRule{{Effect: EffectAllow, Usernames: []string{"user"}, Deprovision: true}}},
+			wantErr: true,
+		},
+		{
+			name:    "return an error when a rule deprovisions without denying the read action",
+			rules:   AccessControlRules{Rules: []AccessControlRule{{Effect: EffectDeny, Usernames: []string{"user"}, Actions: []Action{ActionCreate}, Deprovision: true}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			RegisterTestingT(t)
+			err := tt.rules.validate()
+			Expect(err != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_AccessControlListConfig_GetRules(t *testing.T) {
+	RegisterTestingT(t)
+	config := &AccessControlListConfig{
+		EnableDenyList:           true,
+		DenyList:                 DeniedUsers{"denied-user"},
+		EnableAccessControlRules: true,
+		AccessControlRules: AccessControlRules{Mode: ModeAllowList, Rules: []AccessControlRule{
+			{Effect: EffectAllow, Organisations: []string{"org-1"}},
+		}},
+	}
+	rules := config.GetRules()
+	Expect(rules.Mode).To(Equal(ModeAllowList))
+
+	denied := rules.Evaluate(Subject{Username: "denied-user", OrgId: "org-1"}, APIKafkasMgmt, ActionRead)
+	Expect(denied.Allowed).To(BeFalse(), "the users of the deny list should be denied even when their organisation is allowed")
+	Expect(denied.Deprovision()).To(BeTrue(), "the kafkas of the users of the deny list should be deprovisioned")
+
+	Expect(rules.Evaluate(Subject{Username: "user", OrgId: "org-1"}, APIKafkasMgmt, ActionCreate).Allowed).To(BeTrue())
+	Expect(rules.Evaluate(Subject{Username: "user", OrgId: "org-2"}, APIKafkasMgmt, ActionCreate).Allowed).To(BeFalse())
+}
+
+func Test_ActionFromMethod(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(ActionFromMethod(http.MethodPost)).To(Equal(ActionCreate))
+	Expect(ActionFromMethod(http.MethodGet)).To(Equal(ActionRead))
+	Expect(ActionFromMethod(http.MethodHead)).To(Equal(ActionRead))
+	Expect(ActionFromMethod(http.MethodPatch)).To(Equal(ActionUpdate))
+	Expect(ActionFromMethod(http.MethodPut)).To(Equal(ActionUpdate))
+	Expect(ActionFromMethod(http.MethodDelete)).To(Equal(ActionDelete))
+}
+
+func Test_readAccessControlRulesFile(t *testing.T) {
+	RegisterTestingT(t)
+	var rules AccessControlRules
+	Expect(readAccessControlRulesFile("config/access-control-rules-configuration.yaml", &rules)).To(Succeed())
+	Expect(rules.Mode).To(Equal(ModeDenyList))
+	Expect(rules.HasDeprovisionRules()).To(BeTrue())
+}
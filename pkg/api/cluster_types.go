@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
+	"time"
 
 	kasfleetmanagererrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/pkg/errors"
@@ -74,6 +75,10 @@ func (p *ClusterProviderType) UnmarshalYAML(unmarshal func(interface{}) error) e
 		*p = ClusterProviderAwsEKS
 	case ClusterProviderStandalone.String():
 		*p = ClusterProviderStandalone
+	case ClusterProviderKubernetes.String():
+		*p = ClusterProviderKubernetes
+	case ClusterProviderSimulated.String():
+		*p = ClusterProviderSimulated
 	default:
 		return errors.Errorf("invalid value %s", s)
 	}
@@ -101,10 +106,21 @@ const (
 	ClusterFull ClusterStatus = "full"
 	// ClusterComputeNodeScalingUp the cluster is in the process of scaling up a compute node
 	ClusterComputeNodeScalingUp ClusterStatus = "compute_node_scaling_up"
+	// ClusterUnresponsive the kas fleetshard operator of the cluster stopped reporting the cluster status, no new Kafka
+	// clusters are placed in the cluster until it reports again
+	ClusterUnresponsive ClusterStatus = "unresponsive"
+	// ClusterUpgrading the OpenShift version of the cluster is being upgraded, no new Kafka clusters are placed in the
+	// cluster until the upgrade is completed
+	ClusterUpgrading ClusterStatus = "upgrading"
+	// ClusterUpgradeFailed the upgrade of the OpenShift version of the cluster failed, no new Kafka clusters are placed in
+	// the cluster until the upgrade is cancelled or scheduled again
+	ClusterUpgradeFailed ClusterStatus = "upgrade_failed"
 
 	ClusterProviderOCM        ClusterProviderType = "ocm"
 	ClusterProviderAwsEKS     ClusterProviderType = "aws_eks"
 	ClusterProviderStandalone ClusterProviderType = "standalone"
+	ClusterProviderKubernetes ClusterProviderType = "kubernetes"
+	ClusterProviderSimulated  ClusterProviderType = "simulated"
 
 	EvalTypeSupport        ClusterInstanceTypeSupport = "eval"
 	StandardTypeSupport    ClusterInstanceTypeSupport = "standard"
@@ -126,7 +142,8 @@ var ordinals = map[string]int{
 
 // This represents the valid statuses of a dataplane cluster
 var StatusForValidCluster = []string{string(ClusterProvisioning), string(ClusterProvisioned), string(ClusterReady),
-	string(ClusterAccepted), string(ClusterWaitingForKasFleetShardOperator), string(ClusterComputeNodeScalingUp)}
+	string(ClusterAccepted), string(ClusterWaitingForKasFleetShardOperator), string(ClusterComputeNodeScalingUp),
+	string(ClusterUnresponsive), string(ClusterUpgrading), string(ClusterUpgradeFailed)}
 
 // ClusterDeletionStatuses are statuses of clusters under deletion
 var ClusterDeletionStatuses = []string{ClusterCleanup.String(), ClusterDeprovisioning.String()}
@@ -160,6 +177,22 @@ type Cluster struct {
 	// SupportedInstanceType holds information on what kind of instances types can be provisioned on this cluster.
 	// A cluster can support two kinds of instance types: 'eval', 'standard' or both in this case it will be a comma separated list of instance types e.g 'standard,eval'.
 	SupportedInstanceType string `json:"supported_instance_type"`
+	// StatusReportedAt the last time the kas fleetshard operator reported the status of the cluster. It is only refreshed
+	// once per minute at most, see DataPlaneClusterService
+	StatusReportedAt *time.Time `json:"status_reported_at"`
+	// AgentVersion the version of the kas fleetshard operator that last reported the status of the cluster
+	AgentVersion string `json:"agent_version"`
+	// OpenshiftVersion the OpenShift version the cluster was last upgraded to by the fleet manager. It is empty for the
+	// clusters that were never upgraded by the fleet manager
+	OpenshiftVersion string `json:"openshift_version"`
+	// UpgradeVersion the OpenShift version the cluster is scheduled to be upgraded to, empty when no upgrade is scheduled
+	UpgradeVersion string `json:"upgrade_version"`
+	// UpgradeID the id of the upgrade in the cluster provider, set once the upgrade is started
+	UpgradeID string `json:"upgrade_id"`
+	// UpgradeStatusDetails the reason of the failure of the last upgrade
+	UpgradeStatusDetails string `json:"upgrade_status_details"`
+	// UpgradeScheduledAt the time the upgrade was scheduled, the clusters of a region are upgraded in this order
+	UpgradeScheduledAt *time.Time `json:"upgrade_scheduled_at"`
 }
 
 type ClusterList []*Cluster
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"time"
+)
+
+// ConfigStatus represents the state of a configuration loaded from files that can be reloaded
+type ConfigStatus struct {
+	Kind              string     `json:"kind"`
+	Name              string     `json:"name"`
+	Files             []string   `json:"files"`
+	Checksum          string     `json:"checksum"`
+	LoadedAt          time.Time  `json:"loaded_at"`
+	LastReloadAttempt *time.Time `json:"last_reload_attempt,omitempty"`
+	LastReloadError   string     `json:"last_reload_error,omitempty"`
+}
+
+// ConfigStatusList represents a list of configuration states
+type ConfigStatusList struct {
+	Kind  string         `json:"kind"`
+	Total int32          `json:"total"`
+	Items []ConfigStatus `json:"items"`
+}
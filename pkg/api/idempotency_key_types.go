@@ -0,0 +1,22 @@
+package api
+
+import (
+	"time"
+)
+
+// IdempotencyKey records the response of a create request that was sent with an Idempotency-Key header so that
+// retries of the same request can be answered without creating the resource a second time.
+type IdempotencyKey struct {
+	Key          string `gorm:"primaryKey"`
+	Principal    string `gorm:"primaryKey"`
+	RequestHash  string
+	ResponseCode int
+	ResponseBody JSON `gorm:"type:jsonb"`
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+// IsCompleted returns true once the response of the original request has been recorded
+func (k *IdempotencyKey) IsCompleted() bool {
+	return k.ResponseCode != 0
+}
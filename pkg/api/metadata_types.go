@@ -58,7 +58,10 @@ type Meta struct {
 
 // List Paging metadata
 type PagingMeta struct {
-	Page  int
-	Size  int
+	Page int
+	Size int
+	// Total is the number of resources of the list. It is not computed when listing with a cursor.
 	Total int
+	// NextCursor is the position of the next page when listing with a cursor. It is empty on the last page.
+	NextCursor string
 }
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"time"
+)
+
+// RoleBinding binds a role of an organisation to one of its users or service accounts
+type RoleBinding struct {
+	Meta
+	OrganisationId string `gorm:"uniqueIndex:idx_role_bindings_organisation_subject,where:deleted_at IS NULL"`
+	// Subject is the username of the user or of the service account the role is bound to
+	Subject     string `gorm:"uniqueIndex:idx_role_bindings_organisation_subject,where:deleted_at IS NULL"`
+	SubjectType string
+	Role        string
+	CreatedBy   string
+}
+
+type RoleBindingList []*RoleBinding
+
+// RoleBindingRequest represents a request to bind a role to a subject. It is shared by the public APIs of the kafka
+// and connector services, whose RoleBindingRequest schemas are identical.
+type RoleBindingRequest struct {
+	// Subject is the username of the user or of the service account
+	Subject     string `json:"subject"`
+	SubjectType string `json:"subject_type"`
+	Role        string `json:"role"`
+}
+
+// RoleBindingResponse represents a role bound to a subject, as the RoleBinding schema of the public APIs
+type RoleBindingResponse struct {
+	Id             string    `json:"id,omitempty"`
+	Kind           string    `json:"kind,omitempty"`
+	Href           string    `json:"href,omitempty"`
+	OrganisationId string    `json:"organisation_id,omitempty"`
+	Subject        string    `json:"subject,omitempty"`
+	SubjectType    string    `json:"subject_type,omitempty"`
+	Role           string    `json:"role,omitempty"`
+	CreatedBy      string    `json:"created_by,omitempty"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
+}
+
+// RoleBindingResponseList represents a list of role bindings
+type RoleBindingResponseList struct {
+	Kind  string                `json:"kind"`
+	Total int32                 `json:"total"`
+	Items []RoleBindingResponse `json:"items"`
+}
+
+// PermissionGrant represents a permission granted on a type of resource
+type PermissionGrant struct {
+	ResourceType string `json:"resource_type"`
+	Permission   string `json:"permission"`
+	// Scope is either "organisation" when the permission is granted on all the resources of the organisation or
+	// "owned" when it is granted on the resources owned by the principal only
+	Scope string `json:"scope"`
+}
+
+// Permissions represents the role and the permissions of the principal of a request
+type Permissions struct {
+	Kind           string            `json:"kind"`
+	Subject        string            `json:"subject"`
+	OrganisationId string            `json:"organisation_id,omitempty"`
+	Role           string            `json:"role"`
+	Grants         []PermissionGrant `json:"grants"`
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"time"
+)
+
+// WorkerStatus represents the leadership and last reconcile state of a worker
+type WorkerStatus struct {
+	Kind            string     `json:"kind"`
+	ID              string     `json:"id"`
+	Type            string     `json:"type"`
+	Running         bool       `json:"running"`
+	Leader          string     `json:"leader,omitempty"`
+	LeaseExpires    *time.Time `json:"lease_expires,omitempty"`
+	LastRunStart    *time.Time `json:"last_run_start,omitempty"`
+	LastRunEnd      *time.Time `json:"last_run_end,omitempty"`
+	LastRunDuration string     `json:"last_run_duration,omitempty"`
+	LastErrors      []string   `json:"last_errors"`
+}
+
+// WorkerStatusList represents a list of worker statuses
+type WorkerStatusList struct {
+	Kind  string         `json:"kind"`
+	Total int32          `json:"total"`
+	Items []WorkerStatus `json:"items"`
+}
@@ -21,12 +21,16 @@ const (
 	// ocm token claim keys
 	ocmUsernameKey string = "username"
 	ocmOrgIdKey    string = "org_id"
+	emailKey       string = "email"        // same key used in sso.redhat.com and mas-sso tokens
 	isOrgAdmin     string = "is_org_admin" // same key used in mas-sso tokens
 
 	// sso.redhat.com token claim keys
 	ssoRHUsernameKey  string = "preferred_username" // same key used in mas-sso tokens
 	ssoRhAccountIdKey string = "account_id"
 
+	// mas-sso service account token claim keys. Agents authenticate with a service account of their cluster
+	clientIdKey string = "clientId"
+
 	// mas-sso token claim keys
 	// NOTE: This should be removed once we migrate to sso.redhat.com as it will no longer be needed (TODO: to be removed as part of MGDSTRM-6159)
 	masSsoOrgIdKey = "rh-org-id"
@@ -51,6 +55,13 @@ func GetAccountIdFromClaims(claims jwt.MapClaims) string {
 	return ""
 }
 
+func GetClientIdFromClaims(claims jwt.MapClaims) string {
+	if clientId, ok := claims[clientIdKey].(string); ok {
+		return clientId
+	}
+	return ""
+}
+
 func GetOrgIdFromClaims(claims jwt.MapClaims) string {
 	if claims[ocmOrgIdKey] != nil {
 		if orgId, ok := claims[ocmOrgIdKey].(string); ok {
@@ -68,6 +79,13 @@ func GetOrgIdFromClaims(claims jwt.MapClaims) string {
 	return ""
 }
 
+func GetEmailFromClaims(claims jwt.MapClaims) string {
+	if email, ok := claims[emailKey].(string); ok {
+		return email
+	}
+	return ""
+}
+
 func GetIsOrgAdminFromClaims(claims jwt.MapClaims) bool {
 	if claims[isOrgAdmin] != nil {
 		return claims[isOrgAdmin].(bool)
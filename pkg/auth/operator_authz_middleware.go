@@ -33,7 +33,7 @@ func checkClusterId(clusterIdVar string, authAgentService AuthAgentService) mux.
 				shared.HandleError(request, writer, errors.GeneralError("unable to get clientID for cluster with ID '%s'", clusterId))
 			}
 
-			if clientId, ok := claims["clientId"].(string); ok {
+			if clientId, ok := claims[clientIdKey].(string); ok {
 				if clientId == savedClientId {
 					next.ServeHTTP(writer, request)
 					return
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
 )
 
 //go:generate moq -out client_moq.go . Client
@@ -64,6 +65,16 @@ type Config struct {
 }
 
 func newClient(credentials Config, region string) (Client, error) {
+	sess, err := newSession(credentials, region)
+	if err != nil {
+		return nil, err
+	}
+	return &awsClient{
+		route53Client: route53.New(sess),
+	}, nil
+}
+
+func newSession(credentials Config, region string) (*session.Session, error) {
 	cfg := &aws.Config{
 		Credentials: awscredentials.NewStaticCredentials(
 			credentials.AccessKeyID,
@@ -76,9 +87,8 @@ func newClient(credentials Config, region string) (Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &awsClient{
-		route53Client: route53.New(sess),
-	}, nil
+	tracing.AddAWSHandlers(&sess.Handlers)
+	return sess, nil
 }
 
 func (client *awsClient) GetChange(changeId string) (*route53.GetChangeOutput, error) {
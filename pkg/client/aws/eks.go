@@ -0,0 +1,258 @@
+package aws
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+)
+
+const (
+	// clusterIDHeader is the header signed into the cluster tokens, it scopes the token to a single cluster
+	clusterIDHeader = "x-k8s-aws-id"
+	// clusterTokenPrefix is the prefix of the bearer tokens accepted by the EKS clusters authenticator
+	clusterTokenPrefix = "k8s-aws-v1."
+	// clusterTokenExpiration is the validity of the presigned url of the cluster tokens
+	clusterTokenExpiration = 60 * time.Second
+)
+
+// EKSClient manages the EKS clusters, their node groups and the AWS resources they depend on in a single region.
+// The Describe methods return a nil result and the Delete methods succeed when the resource is not found.
+//go:generate moq -out eks_client_moq.go . EKSClient
+type EKSClient interface {
+	// eks
+	CreateCluster(input *eks.CreateClusterInput) (*eks.Cluster, error)
+	DescribeCluster(clusterName string) (*eks.Cluster, error)
+	DeleteCluster(clusterName string) error
+	CreateNodegroup(input *eks.CreateNodegroupInput) (*eks.Nodegroup, error)
+	DescribeNodegroup(clusterName string, nodegroupName string) (*eks.Nodegroup, error)
+	ListNodegroups(clusterName string) ([]string, error)
+	UpdateNodegroupScaling(clusterName string, nodegroupName string, scalingConfig *eks.NodegroupScalingConfig) error
+	DeleteNodegroup(clusterName string, nodegroupName string) error
+	// GetClusterToken returns a bearer token authenticating the AWS credentials of the client to the cluster
+	GetClusterToken(clusterName string) (string, error)
+	// ec2
+	DescribeRegions() ([]*ec2.Region, error)
+	ListSubnetIDsByTag(tagKey string) ([]string, error)
+	// autoscaling
+	CountInServiceInstances(autoScalingGroupNames []string) (int, error)
+}
+
+type EKSClientFactory interface {
+	NewEKSClient(credentials Config, region string) (EKSClient, error)
+}
+
+type DefaultEKSClientFactory struct{}
+
+func (f *DefaultEKSClientFactory) NewEKSClient(credentials Config, region string) (EKSClient, error) {
+	return newEKSClient(credentials, region)
+}
+
+func NewDefaultEKSClientFactory() *DefaultEKSClientFactory {
+	return &DefaultEKSClientFactory{}
+}
+
+type MockEKSClientFactory struct {
+	mock EKSClient
+}
+
+func (m *MockEKSClientFactory) NewEKSClient(credentials Config, region string) (EKSClient, error) {
+	return m.mock, nil
+}
+
+func NewMockEKSClientFactory(client EKSClient) *MockEKSClientFactory {
+	return &MockEKSClientFactory{
+		mock: client,
+	}
+}
+
+type eksClient struct {
+	eksClient         eksiface.EKSAPI
+	ec2Client         ec2iface.EC2API
+	autoscalingClient autoscalingiface.AutoScalingAPI
+	stsClient         stsiface.STSAPI
+}
+
+func newEKSClient(credentials Config, region string) (EKSClient, error) {
+	sess, err := newSession(credentials, region)
+	if err != nil {
+		return nil, err
+	}
+	return &eksClient{
+		eksClient:         eks.New(sess),
+		ec2Client:         ec2.New(sess),
+		autoscalingClient: autoscaling.New(sess),
+		stsClient:         sts.New(sess),
+	}, nil
+}
+
+func (client *eksClient) CreateCluster(input *eks.CreateClusterInput) (*eks.Cluster, error) {
+	output, err := client.eksClient.CreateCluster(input)
+	if err != nil {
+		return nil, wrapAWSError(err, "Failed to create EKS cluster.")
+	}
+	return output.Cluster, nil
+}
+
+func (client *eksClient) DescribeCluster(clusterName string) (*eks.Cluster, error) {
+	output, err := client.eksClient.DescribeCluster(&eks.DescribeClusterInput{
+		Name: &clusterName,
+	})
+	if err != nil {
+		if isResourceNotFound(err) {
+			return nil, nil
+		}
+		return nil, wrapAWSError(err, "Failed to describe EKS cluster.")
+	}
+	return output.Cluster, nil
+}
+
+func (client *eksClient) DeleteCluster(clusterName string) error {
+	_, err := client.eksClient.DeleteCluster(&eks.DeleteClusterInput{
+		Name: &clusterName,
+	})
+	if err != nil && !isResourceNotFound(err) {
+		return wrapAWSError(err, "Failed to delete EKS cluster.")
+	}
+	return nil
+}
+
+func (client *eksClient) CreateNodegroup(input *eks.CreateNodegroupInput) (*eks.Nodegroup, error) {
+	output, err := client.eksClient.CreateNodegroup(input)
+	if err != nil {
+		return nil, wrapAWSError(err, "Failed to create EKS node group.")
+	}
+	return output.Nodegroup, nil
+}
+
+func (client *eksClient) DescribeNodegroup(clusterName string, nodegroupName string) (*eks.Nodegroup, error) {
+	output, err := client.eksClient.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   &clusterName,
+		NodegroupName: &nodegroupName,
+	})
+	if err != nil {
+		if isResourceNotFound(err) {
+			return nil, nil
+		}
+		return nil, wrapAWSError(err, "Failed to describe EKS node group.")
+	}
+	return output.Nodegroup, nil
+}
+
+func (client *eksClient) ListNodegroups(clusterName string) ([]string, error) {
+	var nodegroups []string
+	err := client.eksClient.ListNodegroupsPages(&eks.ListNodegroupsInput{
+		ClusterName: &clusterName,
+	}, func(page *eks.ListNodegroupsOutput, lastPage bool) bool {
+		nodegroups = append(nodegroups, aws.StringValueSlice(page.Nodegroups)...)
+		return true
+	})
+	if err != nil {
+		if isResourceNotFound(err) {
+			return nil, nil
+		}
+		return nil, wrapAWSError(err, "Failed to list EKS node groups.")
+	}
+	return nodegroups, nil
+}
+
+func (client *eksClient) UpdateNodegroupScaling(clusterName string, nodegroupName string, scalingConfig *eks.NodegroupScalingConfig) error {
+	_, err := client.eksClient.UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
+		ClusterName:   &clusterName,
+		NodegroupName: &nodegroupName,
+		ScalingConfig: scalingConfig,
+	})
+	if err != nil {
+		return wrapAWSError(err, "Failed to update EKS node group scaling.")
+	}
+	return nil
+}
+
+func (client *eksClient) DeleteNodegroup(clusterName string, nodegroupName string) error {
+	_, err := client.eksClient.DeleteNodegroup(&eks.DeleteNodegroupInput{
+		ClusterName:   &clusterName,
+		NodegroupName: &nodegroupName,
+	})
+	if err != nil && !isResourceNotFound(err) {
+		return wrapAWSError(err, "Failed to delete EKS node group.")
+	}
+	return nil
+}
+
+// GetClusterToken presigns a sts GetCallerIdentity request scoped to the cluster, which is what the EKS clusters
+// authenticator expects as bearer token (see aws-iam-authenticator).
+func (client *eksClient) GetClusterToken(clusterName string) (string, error) {
+	request, _ := client.stsClient.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+	request.HTTPRequest.Header.Add(clusterIDHeader, clusterName)
+	presignedURL, err := request.Presign(clusterTokenExpiration)
+	if err != nil {
+		return "", wrapAWSError(err, "Failed to presign EKS cluster token.")
+	}
+	return clusterTokenPrefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURL)), nil
+}
+
+func (client *eksClient) DescribeRegions() ([]*ec2.Region, error) {
+	output, err := client.ec2Client.DescribeRegions(&ec2.DescribeRegionsInput{})
+	if err != nil {
+		return nil, wrapAWSError(err, "Failed to describe regions.")
+	}
+	return output.Regions, nil
+}
+
+func (client *eksClient) ListSubnetIDsByTag(tagKey string) ([]string, error) {
+	var subnetIDs []string
+	err := client.ec2Client.DescribeSubnetsPages(&ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("tag-key"),
+				Values: []*string{&tagKey},
+			},
+		},
+	}, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		for _, subnet := range page.Subnets {
+			subnetIDs = append(subnetIDs, aws.StringValue(subnet.SubnetId))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, wrapAWSError(err, "Failed to describe subnets.")
+	}
+	return subnetIDs, nil
+}
+
+func (client *eksClient) CountInServiceInstances(autoScalingGroupNames []string) (int, error) {
+	if len(autoScalingGroupNames) == 0 {
+		return 0, nil
+	}
+	count := 0
+	err := client.autoscalingClient.DescribeAutoScalingGroupsPages(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice(autoScalingGroupNames),
+	}, func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+		for _, group := range page.AutoScalingGroups {
+			for _, instance := range group.Instances {
+				if aws.StringValue(instance.LifecycleState) == autoscaling.LifecycleStateInService {
+					count++
+				}
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return 0, wrapAWSError(err, "Failed to describe auto scaling groups.")
+	}
+	return count, nil
+}
+
+func isResourceNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == eks.ErrCodeResourceNotFoundException
+}
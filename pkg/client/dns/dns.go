@@ -0,0 +1,97 @@
+// The dns package publishes the DNS records of the Kafka instances, e.g. the CNAME records of their bootstrap and
+// broker hosts, through one of the supported DNS providers.
+package dns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProviderType is the type of the DNS provider
+type ProviderType string
+
+const (
+	// ProviderTypeRoute53 publishes the records in an AWS Route53 hosted zone
+	ProviderTypeRoute53 ProviderType = "route53"
+	// ProviderTypeRFC2136 publishes the records with RFC 2136 dynamic updates sent to the primary server of the zone
+	ProviderTypeRFC2136 ProviderType = "rfc2136"
+	// ProviderTypeFake keeps the records in memory, it is meant for tests and local development only
+	ProviderTypeFake ProviderType = "fake"
+)
+
+// ProviderTypes are the supported DNS provider types
+var ProviderTypes = []ProviderType{ProviderTypeRoute53, ProviderTypeRFC2136, ProviderTypeFake}
+
+// ParseProviderType returns the provider type of the given name, an error if the provider type is not supported
+func ParseProviderType(name string) (ProviderType, error) {
+	for _, t := range ProviderTypes {
+		if strings.EqualFold(name, string(t)) {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported DNS provider %q, the supported providers are %v", name, ProviderTypes)
+}
+
+// Action is the change applied to a batch of records
+type Action string
+
+const (
+	// ActionUpsert creates the records or replaces their value when they already exist
+	ActionUpsert Action = "UPSERT"
+	// ActionDelete deletes the records, records that do not exist are ignored
+	ActionDelete Action = "DELETE"
+)
+
+// RecordTypeCNAME is the type of the records of the bootstrap and broker hosts of the Kafka instances
+const RecordTypeCNAME = "CNAME"
+
+// DefaultTTL is the time to live in seconds of the records
+const DefaultTTL int64 = 300
+
+// Record is a DNS resource record
+type Record struct {
+	Name  string
+	Type  string
+	Value string
+	TTL   int64
+}
+
+// ChangeStatus is the propagation status of a change
+type ChangeStatus string
+
+const (
+	// ChangeStatusPending is the status of a change that is not applied to all the name servers of the zone yet
+	ChangeStatusPending ChangeStatus = "PENDING"
+	// ChangeStatusInSync is the status of a change applied to all the name servers of the zone
+	ChangeStatusInSync ChangeStatus = "INSYNC"
+)
+
+// Change is a batch of record changes submitted to a DNS provider
+type Change struct {
+	// Id identifies the change to poll its status, it is empty when the provider had nothing to change
+	Id     string
+	Status ChangeStatus
+}
+
+// IsInSync returns true when the change has been applied to all the name servers of the zone
+func (c *Change) IsInSync() bool {
+	return c.Status == ChangeStatusInSync
+}
+
+//go:generate moq -out dns_provider_moq.go . DNSProvider
+type DNSProvider interface {
+	// ChangeRecords applies the action to the batch of records of the zone of the domain
+	ChangeRecords(domain string, action Action, records []Record) (*Change, error)
+	// GetChange returns the status of a change submitted with ChangeRecords
+	GetChange(changeId string) (*Change, error)
+}
+
+// NewCNAMERecord returns the CNAME record of the host name pointing to the target
+func NewCNAMERecord(name string, target string) Record {
+	return Record{
+		Name:  name,
+		Type:  RecordTypeCNAME,
+		Value: target,
+		TTL:   DefaultTTL,
+	}
+}
@@ -0,0 +1,141 @@
+package dns
+
+import (
+	"net"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	miekgdns "github.com/miekg/dns"
+	. "github.com/onsi/gomega"
+)
+
+func Test_FakeDNSProvider(t *testing.T) {
+	RegisterTestingT(t)
+
+	provider := NewFakeDNSProvider()
+	records := []Record{
+		NewCNAMERecord("admin-server-test.example.com", "elb.example.com"),
+		NewCNAMERecord("bootstrap.test.example.com", "elb.example.com"),
+	}
+
+	change, err := provider.ChangeRecords("example.com", ActionUpsert, records)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(change.IsInSync()).To(BeTrue())
+	Expect(provider.Records()).To(ConsistOf(records))
+
+	got, err := provider.GetChange(change.Id)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(got).To(Equal(change))
+
+	_, err = provider.ChangeRecords("example.com", ActionDelete, records[:1])
+	Expect(err).ToNot(HaveOccurred())
+	Expect(provider.Records()).To(ConsistOf(records[1:]))
+
+	_, err = provider.GetChange("unknown")
+	Expect(err).To(HaveOccurred())
+}
+
+func Test_route53Provider_ChangeRecords(t *testing.T) {
+	changeId := "change-id"
+	pending := route53.ChangeStatusPending
+
+	tests := []struct {
+		name    string
+		output  *route53.ChangeResourceRecordSetsOutput
+		want    *Change
+		wantErr bool
+	}{
+		{
+			name: "should return the change created by route53",
+			output: &route53.ChangeResourceRecordSetsOutput{
+				ChangeInfo: &route53.ChangeInfo{Id: &changeId, Status: &pending},
+			},
+			want: &Change{Id: changeId, Status: ChangeStatusPending},
+		},
+		{
+			name: "should return an in sync change when route53 ignored the changes",
+			want: &Change{Status: ChangeStatusInSync},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			var batch *route53.ChangeBatch
+			provider := NewRoute53Provider(aws.NewMockClientFactory(&aws.ClientMock{
+				ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
+					batch = recordChangeBatch
+					return tt.output, nil
+				},
+			}), aws.Config{})
+
+			got, err := provider.ChangeRecords("example.com", ActionDelete, []Record{NewCNAMERecord("test.example.com", "elb.example.com")})
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(got).To(Equal(tt.want))
+			Expect(batch.Changes).To(HaveLen(1))
+			Expect(*batch.Changes[0].Action).To(Equal("DELETE"))
+			Expect(*batch.Changes[0].ResourceRecordSet.Name).To(Equal("test.example.com"))
+			Expect(*batch.Changes[0].ResourceRecordSet.Type).To(Equal("CNAME"))
+			Expect(*batch.Changes[0].ResourceRecordSet.ResourceRecords[0].Value).To(Equal("elb.example.com"))
+		})
+	}
+}
+
+func Test_rfc2136Provider_ChangeRecords(t *testing.T) {
+	RegisterTestingT(t)
+
+	const (
+		keyName = "fleet-manager."
+		secret  = "c2VjcmV0c2VjcmV0c2VjcmV0"
+	)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+
+	updates := make(chan *miekgdns.Msg, 1)
+	server := &miekgdns.Server{
+		Listener:   listener,
+		TsigSecret: map[string]string{keyName: secret},
+		// the default accept func refuses the update messages
+		MsgAcceptFunc: func(dh miekgdns.Header) miekgdns.MsgAcceptAction { return miekgdns.MsgAccept },
+		Handler: miekgdns.HandlerFunc(func(w miekgdns.ResponseWriter, req *miekgdns.Msg) {
+			reply := new(miekgdns.Msg)
+			reply.SetReply(req)
+			if w.TsigStatus() != nil {
+				reply.Rcode = miekgdns.RcodeNotAuth
+			} else {
+				updates <- req
+			}
+			_ = w.WriteMsg(reply)
+		}),
+	}
+	go func() { _ = server.ActivateAndServe() }()
+	defer func() { _ = server.Shutdown() }()
+
+	provider := NewRFC2136Provider(RFC2136Config{
+		Server:      listener.Addr().String(),
+		TSIGKeyName: keyName,
+		TSIGSecret:  secret,
+	})
+
+	change, err := provider.ChangeRecords("example.com", ActionUpsert, []Record{NewCNAMERecord("test.example.com", "elb.example.com")})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(change.IsInSync()).To(BeTrue())
+
+	update := <-updates
+	Expect(update.Opcode).To(Equal(miekgdns.OpcodeUpdate))
+	Expect(update.Question[0].Name).To(Equal("example.com."))
+	// the record set is removed before the record is inserted
+	Expect(update.Ns).To(HaveLen(2))
+	Expect(update.Ns[0].Header().Class).To(Equal(uint16(miekgdns.ClassANY)))
+	Expect(update.Ns[1].(*miekgdns.CNAME).Target).To(Equal("elb.example.com."))
+
+	_, err = NewRFC2136Provider(RFC2136Config{
+		Server:      listener.Addr().String(),
+		TSIGKeyName: keyName,
+		TSIGSecret:  "d3Jvbmc=",
+	}).ChangeRecords("example.com", ActionDelete, []Record{NewCNAMERecord("test.example.com", "elb.example.com")})
+	Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,70 @@
+package dns
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+var _ DNSProvider = &FakeDNSProvider{}
+
+// FakeDNSProvider keeps the records in memory. The changes are in sync as soon as they are applied.
+type FakeDNSProvider struct {
+	mutex   sync.Mutex
+	records map[string]Record
+	changes map[string]Change
+}
+
+// NewFakeDNSProvider returns a DNS provider keeping the records in memory
+func NewFakeDNSProvider() *FakeDNSProvider {
+	return &FakeDNSProvider{
+		records: map[string]Record{},
+		changes: map[string]Change{},
+	}
+}
+
+func (p *FakeDNSProvider) ChangeRecords(domain string, action Action, records []Record) (*Change, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for _, r := range records {
+		name := strings.TrimSuffix(strings.ToLower(r.Name), ".")
+		switch action {
+		case ActionUpsert:
+			p.records[name] = r
+		case ActionDelete:
+			delete(p.records, name)
+		default:
+			return nil, errors.Errorf("unsupported action %q", action)
+		}
+	}
+
+	change := Change{Id: uuid.New().String(), Status: ChangeStatusInSync}
+	p.changes[change.Id] = change
+	return &change, nil
+}
+
+func (p *FakeDNSProvider) GetChange(changeId string) (*Change, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	change, ok := p.changes[changeId]
+	if !ok {
+		return nil, errors.Errorf("change %q not found", changeId)
+	}
+	return &change, nil
+}
+
+// Records returns the records currently held by the provider
+func (p *FakeDNSProvider) Records() []Record {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	var records []Record
+	for _, r := range p.records {
+		records = append(records, r)
+	}
+	return records
+}
@@ -0,0 +1,104 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+	miekgdns "github.com/miekg/dns"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultRFC2136Port          = "53"
+	defaultRFC2136TSIGAlgorithm = "hmac-sha256"
+	rfc2136Timeout              = 10 * time.Second
+	// tsigFudge is the time in seconds the clocks of the fleet manager and of the DNS server may differ by
+	tsigFudge = 300
+)
+
+// RFC2136Config contains the settings of the primary server receiving the dynamic updates of the zone
+type RFC2136Config struct {
+	// Server is the address of the primary server of the zone, the port defaults to 53
+	Server string `json:"server"`
+	// TSIGKeyName is the name of the TSIG key signing the updates, the updates are not signed when it is empty
+	TSIGKeyName string `json:"tsig_key_name"`
+	// TSIGSecret is the base64 encoded secret of the TSIG key
+	TSIGSecret     string `json:"tsig_secret"`
+	TSIGSecretFile string `json:"tsig_secret_file"`
+	// TSIGAlgorithm is the algorithm of the TSIG key, e.g. hmac-sha256 (default) or hmac-sha512
+	TSIGAlgorithm string `json:"tsig_algorithm"`
+}
+
+var _ DNSProvider = &rfc2136Provider{}
+
+type rfc2136Provider struct {
+	config RFC2136Config
+	client *miekgdns.Client
+}
+
+// NewRFC2136Provider returns the DNS provider sending RFC 2136 dynamic updates to the primary server of the zone
+func NewRFC2136Provider(config RFC2136Config) DNSProvider {
+	if _, _, err := net.SplitHostPort(config.Server); err != nil {
+		config.Server = net.JoinHostPort(config.Server, defaultRFC2136Port)
+	}
+	if config.TSIGAlgorithm == "" {
+		config.TSIGAlgorithm = defaultRFC2136TSIGAlgorithm
+	}
+
+	client := &miekgdns.Client{
+		Net:     "tcp",
+		Timeout: rfc2136Timeout,
+	}
+	if config.TSIGKeyName != "" {
+		client.TsigSecret = map[string]string{miekgdns.Fqdn(config.TSIGKeyName): config.TSIGSecret}
+	}
+	return &rfc2136Provider{
+		config: config,
+		client: client,
+	}
+}
+
+// ChangeRecords sends a single update message with all the records. The primary server applies the update
+// atomically, so the change is in sync once the server has acknowledged it.
+func (p *rfc2136Provider) ChangeRecords(domain string, action Action, records []Record) (*Change, error) {
+	msg := new(miekgdns.Msg)
+	msg.SetUpdate(miekgdns.Fqdn(domain))
+
+	for _, record := range records {
+		rr, err := miekgdns.NewRR(fmt.Sprintf("%s %d IN %s %s", miekgdns.Fqdn(record.Name), record.TTL, record.Type, miekgdns.Fqdn(record.Value)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid record %q", record.Name)
+		}
+		switch action {
+		case ActionUpsert:
+			msg.RemoveRRset([]miekgdns.RR{rr})
+			msg.Insert([]miekgdns.RR{rr})
+		case ActionDelete:
+			msg.RemoveRRset([]miekgdns.RR{rr})
+		default:
+			return nil, errors.Errorf("unsupported action %q", action)
+		}
+	}
+
+	if p.config.TSIGKeyName != "" {
+		msg.SetTsig(miekgdns.Fqdn(p.config.TSIGKeyName), miekgdns.Fqdn(p.config.TSIGAlgorithm), tsigFudge, time.Now().Unix())
+	}
+
+	reply, _, err := p.client.Exchange(msg, p.config.Server)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send the dynamic update of zone %s to %s", domain, p.config.Server)
+	}
+	if reply.Rcode != miekgdns.RcodeSuccess {
+		return nil, errors.Errorf("dynamic update of zone %s refused by %s: %s", domain, p.config.Server, miekgdns.RcodeToString[reply.Rcode])
+	}
+
+	return &Change{Id: uuid.New().String(), Status: ChangeStatusInSync}, nil
+}
+
+// GetChange always returns an in sync change as the updates are applied synchronously by the primary server, the
+// secondary servers are notified of the change by the primary server
+func (p *rfc2136Provider) GetChange(changeId string) (*Change, error) {
+	return &Change{Id: changeId, Status: ChangeStatusInSync}, nil
+}
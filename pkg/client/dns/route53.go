@@ -0,0 +1,90 @@
+package dns
+
+import (
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/pkg/errors"
+)
+
+// route53Region is the region of the Route53 API clients. Route53 is a global service so the region of the hosted
+// zones does not matter.
+const route53Region = "us-east-1"
+
+var _ DNSProvider = &route53Provider{}
+
+type route53Provider struct {
+	clientFactory aws.ClientFactory
+	credentials   aws.Config
+}
+
+// NewRoute53Provider returns the DNS provider publishing the records in the Route53 hosted zone of the domain
+func NewRoute53Provider(clientFactory aws.ClientFactory, credentials aws.Config) DNSProvider {
+	return &route53Provider{
+		clientFactory: clientFactory,
+		credentials:   credentials,
+	}
+}
+
+func (p *route53Provider) ChangeRecords(domain string, action Action, records []Record) (*Change, error) {
+	client, err := p.clientFactory.NewClient(p.credentials, route53Region)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create aws client")
+	}
+
+	output, err := client.ChangeResourceRecordSets(domain, buildRoute53ChangeBatch(action, records))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to change domain record sets")
+	}
+	// the client ignores the changes of records that already exist or that are already deleted
+	if output == nil || output.ChangeInfo == nil {
+		return &Change{Status: ChangeStatusInSync}, nil
+	}
+	return presentRoute53Change(output.ChangeInfo), nil
+}
+
+func (p *route53Provider) GetChange(changeId string) (*Change, error) {
+	client, err := p.clientFactory.NewClient(p.credentials, route53Region)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create aws client")
+	}
+
+	output, err := client.GetChange(changeId)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get domain record sets change")
+	}
+	return presentRoute53Change(output.ChangeInfo), nil
+}
+
+func presentRoute53Change(changeInfo *route53.ChangeInfo) *Change {
+	change := &Change{Status: ChangeStatusPending}
+	if changeInfo.Id != nil {
+		change.Id = *changeInfo.Id
+	}
+	if changeInfo.Status != nil {
+		change.Status = ChangeStatus(*changeInfo.Status)
+	}
+	return change
+}
+
+func buildRoute53ChangeBatch(action Action, records []Record) *route53.ChangeBatch {
+	var changes []*route53.Change
+	for _, r := range records {
+		record := r
+		changes = append(changes, &route53.Change{
+			Action: (*string)(&action),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name: &record.Name,
+				Type: &record.Type,
+				TTL:  &record.TTL,
+				ResourceRecords: []*route53.ResourceRecord{
+					{
+						Value: &record.Value,
+					},
+				},
+			},
+		})
+	}
+	return &route53.ChangeBatch{
+		Changes: changes,
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Nerzal/gocloak/v11"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
 	"github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
 )
@@ -74,6 +75,7 @@ func NewClient(config *KeycloakConfig, realmConfig *KeycloakRealmConfig) *kcClie
 	client := gocloak.NewClient(config.BaseURL)
 	client.RestyClient().SetDebug(config.Debug)
 	client.RestyClient().SetTLSClientConfig(&tls.Config{InsecureSkipVerify: config.InsecureSkipVerify})
+	client.RestyClient().SetTransport(tracing.NewTransport(client.RestyClient().GetClient().Transport))
 	return &kcClient{
 		kcClient:    client,
 		ctx:         context.Background(),
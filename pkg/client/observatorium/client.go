@@ -12,6 +12,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
 	"github.com/pkg/errors"
 	pAPI "github.com/prometheus/client_golang/api"
 	pV1 "github.com/prometheus/client_golang/api/prometheus/v1"
@@ -37,6 +38,21 @@ type Client struct {
 }
 
 func NewObservatoriumClient(c *ObservabilityConfiguration) (client *Client, err error) {
+	switch c.MetricsBackend {
+	case MetricsBackendPrometheus:
+		// the mock client below is used whatever the backend
+		if !c.EnableMock {
+			client, err = NewPrometheusClient(c)
+			if err != nil {
+				glog.Errorf("Unable to create Prometheus client: %s", err)
+			}
+			return
+		}
+	case MetricsBackendObservatorium, "":
+	default:
+		return nil, errors.Errorf("unknown metrics backend %q, the available options are: %v", c.MetricsBackend, MetricsBackends)
+	}
+
 	// Create Observatorium client
 	observatoriumConfig := &Configuration{
 		Cookie:   c.Cookie,
@@ -90,7 +106,7 @@ func NewClient(config *Configuration) (*Client, error) {
 		Address: client.Config.BaseURL,
 		RoundTripper: observatoriumRoundTripper{
 			config:  *client.Config,
-			wrapped: pAPI.DefaultRoundTripper,
+			wrapped: tracing.NewTransport(pAPI.DefaultRoundTripper),
 		},
 	})
 	if err != nil {
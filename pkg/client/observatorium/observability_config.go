@@ -1,6 +1,7 @@
 package observatorium
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
@@ -10,8 +11,15 @@ import (
 const (
 	AuthTypeDex = "dex"
 	AuthTypeSso = "redhat"
+
+	// MetricsBackendObservatorium queries the metrics from an Observatorium tenant
+	MetricsBackendObservatorium = "observatorium"
+	// MetricsBackendPrometheus queries the metrics from any server implementing the Prometheus HTTP API, e.g. Prometheus or Thanos
+	MetricsBackendPrometheus = "prometheus"
 )
 
+var MetricsBackends = []string{MetricsBackendObservatorium, MetricsBackendPrometheus}
+
 type ObservabilityConfiguration struct {
 	// Dex configuration
 	DexUrl          string `json:"dex_url" yaml:"dex_url"`
@@ -36,6 +44,20 @@ type ObservabilityConfiguration struct {
 	LogsSecret                 string `json:"redhat_sso_logs_secret" yaml:"redhat_sso_logs_secret"`
 	LogsSecretFile             string `json:"redhat_sso_logs_secret_file" yaml:"redhat_sso_logs_secret_file"`
 
+	// MetricsBackend is the server the kafka metrics are queried from, one of MetricsBackends
+	MetricsBackend string `json:"metrics_backend"`
+
+	// Prometheus HTTP API configuration, used when MetricsBackend is prometheus
+	PrometheusUrl            string `json:"prometheus_url"`
+	PrometheusAuthToken      string `json:"prometheus_auth_token"`
+	PrometheusAuthTokenFile  string `json:"prometheus_auth_token_file"`
+	PrometheusClientCertFile string `json:"prometheus_client_cert_file"`
+	PrometheusClientKeyFile  string `json:"prometheus_client_key_file"`
+	PrometheusCAFile         string `json:"prometheus_ca_file"`
+
+	// MetricsCacheTTL is how long the results of the kafka metrics queries are cached, the cache is disabled when it is 0
+	MetricsCacheTTL time.Duration `json:"metrics_cache_ttl"`
+
 	// Observatorium configuration
 	ObservatoriumGateway string        `json:"gateway" yaml:"gateway"`
 	ObservatoriumTenant  string        `json:"observatorium_tenant" yaml:"observatorium_tenant"`
@@ -62,6 +84,8 @@ func NewObservabilityConfigurationConfig() *ObservabilityConfiguration {
 		DexSecretFile:                      "secrets/dex.secret",
 		DexPasswordFile:                    "secrets/dex.password",
 		DexUsername:                        "admin@example.com",
+		MetricsBackend:                     MetricsBackendObservatorium,
+		MetricsCacheTTL:                    30 * time.Second,
 		ObservatoriumGateway:               "https://observatorium-observatorium.apps.pbraun-observatorium.observability.rhmw.io",
 		ObservatoriumTenant:                "test",
 		AuthType:                           "dex",
@@ -104,6 +128,14 @@ func (c *ObservabilityConfiguration) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.MetricsSecretFile, "observability-red-hat-sso-metrics-secret-file", c.MetricsSecretFile, "Red Hat SSO metrics secret file")
 	fs.StringVar(&c.RedHatSsoRealm, "observability-red-hat-sso-realm", c.RedHatSsoRealm, "Red Hat SSO realm")
 
+	fs.StringVar(&c.MetricsBackend, "metrics-backend", c.MetricsBackend, fmt.Sprintf("The server the kafka metrics are queried from. The available options are: %v", MetricsBackends))
+	fs.StringVar(&c.PrometheusUrl, "prometheus-url", c.PrometheusUrl, "URL of the Prometheus HTTP API the kafka metrics are queried from when the metrics backend is prometheus")
+	fs.StringVar(&c.PrometheusAuthTokenFile, "prometheus-token-file", c.PrometheusAuthTokenFile, "File containing the bearer token sent to the Prometheus HTTP API")
+	fs.StringVar(&c.PrometheusClientCertFile, "prometheus-client-cert-file", c.PrometheusClientCertFile, "File containing the client certificate presented to the Prometheus HTTP API")
+	fs.StringVar(&c.PrometheusClientKeyFile, "prometheus-client-key-file", c.PrometheusClientKeyFile, "File containing the private key of the client certificate presented to the Prometheus HTTP API")
+	fs.StringVar(&c.PrometheusCAFile, "prometheus-ca-file", c.PrometheusCAFile, "File containing the CA certificates verifying the certificate of the Prometheus HTTP API")
+	fs.DurationVar(&c.MetricsCacheTTL, "metrics-cache-ttl", c.MetricsCacheTTL, "How long the results of the kafka metrics queries are cached. The time bounds of the range queries are rounded to this duration so that the dashboards refreshing them share the cached results. Set to 0 to disable the cache")
+
 	fs.StringVar(&c.ObservatoriumGateway, "observatorium-gateway", c.ObservatoriumGateway, "Observatorium gateway")
 	fs.StringVar(&c.ObservatoriumTenant, "observatorium-tenant", c.ObservatoriumTenant, "Observatorium tenant")
 	fs.StringVar(&c.AuthType, "observatorium-auth-type", c.AuthType, "Observatorium Authentication Type")
@@ -120,16 +152,19 @@ func (c *ObservabilityConfiguration) AddFlags(fs *pflag.FlagSet) {
 }
 
 func (c *ObservabilityConfiguration) ReadFiles() error {
-	dexPassword, err := shared.ReadFile(c.DexPasswordFile)
-	if err != nil {
-		return err
-	}
-	dexSecret, err := shared.ReadFile(c.DexSecretFile)
-	if err != nil {
-		return err
+	// the Dex credentials authenticate the Observatorium client, they are not needed by the prometheus backend
+	if c.MetricsBackend != MetricsBackendPrometheus {
+		dexPassword, err := shared.ReadFile(c.DexPasswordFile)
+		if err != nil {
+			return err
+		}
+		dexSecret, err := shared.ReadFile(c.DexSecretFile)
+		if err != nil {
+			return err
+		}
+		c.DexPassword = dexPassword
+		c.DexSecret = dexSecret
 	}
-	c.DexPassword = dexPassword
-	c.DexSecret = dexSecret
 
 	if c.AuthToken == "" && c.AuthTokenFile != "" {
 		err := shared.ReadFileValueString(c.AuthTokenFile, &c.AuthToken)
@@ -138,6 +173,13 @@ func (c *ObservabilityConfiguration) ReadFiles() error {
 		}
 	}
 
+	if c.PrometheusAuthToken == "" && c.PrometheusAuthTokenFile != "" {
+		err := shared.ReadFileValueString(c.PrometheusAuthTokenFile, &c.PrometheusAuthToken)
+		if err != nil {
+			return err
+		}
+	}
+
 	configFileError := c.ReadObservatoriumConfigFiles()
 	if configFileError != nil {
 		return configFileError
@@ -0,0 +1,60 @@
+package observatorium
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestObservabilityConfiguration_ReadFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name string, content string) string {
+		file := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(file, []byte(content), 0600)).To(Succeed())
+		return file
+	}
+	missingFile := filepath.Join(dir, "missing")
+
+	tests := []struct {
+		name            string
+		metricsBackend  string
+		dexFile         func() string
+		wantErr         bool
+		wantDexPassword string
+	}{
+		{
+			name:            "should read the Dex secret files for the observatorium backend",
+			metricsBackend:  MetricsBackendObservatorium,
+			dexFile:         func() string { return writeFile("dex", "dex-value") },
+			wantDexPassword: "dex-value",
+		},
+		{
+			name:           "should require the Dex secret files for the observatorium backend",
+			metricsBackend: MetricsBackendObservatorium,
+			dexFile:        func() string { return missingFile },
+			wantErr:        true,
+		},
+		{
+			name:           "should not read the Dex secret files for the prometheus backend",
+			metricsBackend: MetricsBackendPrometheus,
+			dexFile:        func() string { return missingFile },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			dexFile := tt.dexFile()
+			c := &ObservabilityConfiguration{
+				MetricsBackend:  tt.metricsBackend,
+				DexPasswordFile: dexFile,
+				DexSecretFile:   dexFile,
+			}
+
+			err := c.ReadFiles()
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(c.DexPassword).To(Equal(tt.wantDexPassword))
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package observatorium
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
+	"github.com/pkg/errors"
+	pAPI "github.com/prometheus/client_golang/api"
+	pV1 "github.com/prometheus/client_golang/api/prometheus/v1"
+)
+
+// NewPrometheusClient returns a client querying the kafka metrics from a server implementing the Prometheus HTTP API,
+// e.g. Prometheus or a Thanos querier. The requests are authenticated with a bearer token, a client certificate or both.
+// The kafka metrics are queried with the same PromQL queries as the Observatorium tenants, see ServiceObservatorium.
+func NewPrometheusClient(c *ObservabilityConfiguration) (*Client, error) {
+	if c.PrometheusUrl == "" {
+		return nil, errors.New("the prometheus url is required when the metrics backend is prometheus")
+	}
+
+	tlsConfig, err := buildPrometheusTLSConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	transport := pAPI.DefaultRoundTripper.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	client := &Client{
+		Config: &ClientConfiguration{
+			BaseURL:   strings.TrimSuffix(c.PrometheusUrl, "/") + "/",
+			Timeout:   c.Timeout,
+			AuthToken: c.PrometheusAuthToken,
+			Debug:     c.Debug,
+			Insecure:  c.Insecure,
+		},
+	}
+
+	apiClient, err := pAPI.NewClient(pAPI.Config{
+		Address: client.Config.BaseURL,
+		RoundTripper: prometheusRoundTripper{
+			authToken: c.PrometheusAuthToken,
+			// the auth type is not set so that the observatorium round tripper only records the request metrics
+			wrapped: observatoriumRoundTripper{
+				config:  *client.Config,
+				wrapped: tracing.NewTransport(transport),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	client.connection = pV1.NewAPI(apiClient)
+	client.Service = &ServiceObservatorium{client: client}
+	return client, nil
+}
+
+func buildPrometheusTLSConfig(c *ObservabilityConfiguration) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if c.PrometheusCAFile != "" {
+		ca, err := ioutil.ReadFile(c.PrometheusCAFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read prometheus CA file %s", c.PrometheusCAFile)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.Errorf("no certificate found in prometheus CA file %s", c.PrometheusCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.PrometheusClientCertFile != "" || c.PrometheusClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.PrometheusClientCertFile, c.PrometheusClientKeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load prometheus client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+type prometheusRoundTripper struct {
+	authToken string
+	wrapped   http.RoundTripper
+}
+
+func (p prometheusRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if p.authToken != "" {
+		request.Header.Set("Authorization", fmt.Sprintf("Bearer %s", p.authToken))
+	}
+	return p.wrapped.RoundTrip(request)
+}
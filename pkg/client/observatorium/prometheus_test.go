@@ -0,0 +1,174 @@
+package observatorium
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium/prometheustest"
+	. "github.com/onsi/gomega"
+)
+
+func TestPrometheusClient_GetMetrics(t *testing.T) {
+	server := prometheustest.NewServer("test-token")
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		authToken string
+		rq        *MetricsReqParams
+		wantErr   bool
+	}{
+		{
+			name:      "should return the instant query results",
+			authToken: "test-token",
+			rq:        &MetricsReqParams{ResultType: Query, Filters: []string{"kubelet_volume_stats_used_bytes"}},
+		},
+		{
+			name:      "should return the range query results",
+			authToken: "test-token",
+			rq: &MetricsReqParams{
+				ResultType: RangeQuery,
+				Filters:    []string{"kubelet_volume_stats_used_bytes"},
+			},
+		},
+		{
+			name:      "should return an error when the token is refused",
+			authToken: "wrong-token",
+			rq:        &MetricsReqParams{ResultType: Query, Filters: []string{"kubelet_volume_stats_used_bytes"}},
+			wantErr:   true,
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			tt.rq.FillDefaults()
+			client, err := NewPrometheusClient(&ObservabilityConfiguration{
+				PrometheusUrl:       server.URL,
+				PrometheusAuthToken: tt.authToken,
+				Timeout:             10 * time.Second,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			metrics := &KafkaMetrics{}
+			err = client.Service.GetMetrics(metrics, "kafka-test", tt.rq)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			Expect(*metrics).To(HaveLen(1))
+			if tt.rq.ResultType == Query {
+				Expect((*metrics)[0].Vector).To(HaveLen(1))
+				Expect(string((*metrics)[0].Vector[0].Metric["__name__"])).To(Equal("kubelet_volume_stats_used_bytes"))
+			} else {
+				Expect((*metrics)[0].Matrix).To(HaveLen(1))
+				Expect((*metrics)[0].Matrix[0].Values).To(HaveLen(1))
+			}
+			Expect(server.Queries()).To(ContainElement(`kubelet_volume_stats_used_bytes{persistentvolumeclaim=~"data-.*-kafka-[0-9]*$", namespace=~'kafka-test'}`))
+		})
+	}
+}
+
+func TestPrometheusClient_MutualTLS(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir := t.TempDir()
+	clientCert, certFile, keyFile := writeClientCertificate(t, dir)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(clientCert)
+	server := prometheustest.NewUnstartedServer("")
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  pool,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	caFile := filepath.Join(dir, "ca.crt")
+	Expect(ioutil.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600)).To(Succeed())
+
+	rq := &MetricsReqParams{ResultType: Query, Filters: []string{"kafka_broker_quota_softlimitbytes"}}
+
+	client, err := NewPrometheusClient(&ObservabilityConfiguration{
+		PrometheusUrl:            server.URL,
+		PrometheusCAFile:         caFile,
+		PrometheusClientCertFile: certFile,
+		PrometheusClientKeyFile:  keyFile,
+		Timeout:                  10 * time.Second,
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(client.Service.GetMetrics(&KafkaMetrics{}, "kafka-test", rq)).To(Succeed())
+
+	// the server requires a client certificate
+	client, err = NewPrometheusClient(&ObservabilityConfiguration{
+		PrometheusUrl:    server.URL,
+		PrometheusCAFile: caFile,
+		Timeout:          10 * time.Second,
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(client.Service.GetMetrics(&KafkaMetrics{}, "kafka-test", rq)).ToNot(Succeed())
+}
+
+func TestNewObservatoriumClient_MetricsBackend(t *testing.T) {
+	RegisterTestingT(t)
+
+	client, err := NewObservatoriumClient(&ObservabilityConfiguration{MetricsBackend: MetricsBackendPrometheus, PrometheusUrl: "http://prometheus:9090"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(client.Config.BaseURL).To(Equal("http://prometheus:9090/"))
+
+	_, err = NewObservatoriumClient(&ObservabilityConfiguration{MetricsBackend: MetricsBackendPrometheus})
+	Expect(err).To(HaveOccurred())
+
+	_, err = NewObservatoriumClient(&ObservabilityConfiguration{MetricsBackend: "unknown"})
+	Expect(err).To(HaveOccurred())
+}
+
+// writeClientCertificate writes a self signed client certificate and its key in the given directory
+func writeClientCertificate(t *testing.T, dir string) (*x509.Certificate, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "kas-fleet-manager"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return cert, certFile, keyFile
+}
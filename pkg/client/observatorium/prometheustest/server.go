@@ -0,0 +1,99 @@
+// Package prometheustest provides a stub of the Prometheus HTTP API, to test the metrics clients and handlers without a
+// Prometheus server.
+package prometheustest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+const (
+	// SampleValue is the value of the samples returned for every query
+	SampleValue = "42"
+	// SampleTimestamp is the unix time of the samples returned for every query
+	SampleTimestamp = 1650000000
+)
+
+// Server answers the instant and range queries with a single series named after the queried metric
+type Server struct {
+	*httptest.Server
+	authToken string
+	mutex     sync.Mutex
+	queries   []string
+}
+
+// NewServer starts a stub of the Prometheus HTTP API. The requests are required to have the given bearer token when
+// it is not empty.
+func NewServer(authToken string) *Server {
+	s := NewUnstartedServer(authToken)
+	s.Start()
+	return s
+}
+
+// NewUnstartedServer returns a stub of the Prometheus HTTP API that is not started yet, e.g. to configure its TLS
+// settings before calling StartTLS.
+func NewUnstartedServer(authToken string) *Server {
+	s := &Server{authToken: authToken}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/query", s.handle("vector"))
+	mux.HandleFunc("/api/v1/query_range", s.handle("matrix"))
+	s.Server = httptest.NewUnstartedServer(mux)
+	return s
+}
+
+// Queries returns the PromQL queries received so far
+func (s *Server) Queries() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]string{}, s.queries...)
+}
+
+func (s *Server) handle(resultType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.authToken != "" && r.Header.Get("Authorization") != fmt.Sprintf("Bearer %s", s.authToken) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		query := r.Form.Get("query")
+		s.mutex.Lock()
+		s.queries = append(s.queries, query)
+		s.mutex.Unlock()
+
+		series := map[string]interface{}{
+			"metric": map[string]string{
+				"__name__": metricName(query),
+			},
+		}
+		sample := []interface{}{SampleTimestamp, SampleValue}
+		if resultType == "vector" {
+			series["value"] = sample
+		} else {
+			series["values"] = []interface{}{sample}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data": map[string]interface{}{
+				"resultType": resultType,
+				"result":     []interface{}{series},
+			},
+		})
+	}
+}
+
+// metricName returns the name of the metric selected by a query like `metric{labels}`
+func metricName(query string) string {
+	if i := strings.Index(query, "{"); i >= 0 {
+		return query[:i]
+	}
+	return query
+}
@@ -7,6 +7,7 @@ import (
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
 	sdkClient "github.com/openshift-online/ocm-sdk-go"
 	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	v1 "github.com/openshift-online/ocm-sdk-go/authorizations/v1"
@@ -48,6 +49,8 @@ type Client interface {
 	GetOrganisationIdFromExternalId(externalId string) (string, error)
 	Connection() *sdkClient.Connection
 	GetQuotaCostsForProduct(organizationID, resourceName, product string) ([]*amsv1.QuotaCost, error)
+	CreateUpgradePolicy(clusterID string, upgradePolicy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error)
+	GetUpgradePolicyState(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error)
 }
 
 var _ Client = &client{}
@@ -66,7 +69,8 @@ func NewOCMConnection(ocmConfig *OCMConfig, BaseUrl string) (*sdkClient.Connecti
 
 	builder := sdkClient.NewConnectionBuilder().
 		URL(BaseUrl).
-		MetricsSubsystem("api_outbound")
+		MetricsSubsystem("api_outbound").
+		TransportWrapper(tracing.NewTransport)
 
 	if !ocmConfig.EnableMock {
 		// Create a logger that has the debug level enabled:
@@ -386,6 +390,35 @@ func (c client) GetIdentityProviderList(clusterID string) (*clustersmgmtv1.Ident
 	return response.Items(), nil
 }
 
+func (c client) CreateUpgradePolicy(clusterID string, upgradePolicy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error) {
+	response, upgradePolicyErr := c.connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).
+		UpgradePolicies().
+		Add().
+		Body(upgradePolicy).
+		Send()
+
+	var err error
+	if upgradePolicyErr != nil {
+		err = errors.NewErrorFromHTTPStatusCode(response.Status(), "ocm client failed to create upgrade policy: %s", upgradePolicyErr)
+	}
+	return response.Body(), err
+}
+
+func (c client) GetUpgradePolicyState(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error) {
+	response, upgradePolicyErr := c.connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).
+		UpgradePolicies().
+		UpgradePolicy(upgradePolicyID).
+		State().
+		Get().
+		Send()
+
+	var err error
+	if upgradePolicyErr != nil {
+		err = errors.NewErrorFromHTTPStatusCode(response.Status(), "ocm client failed to get state of upgrade policy '%s': %s", upgradePolicyID, upgradePolicyErr)
+	}
+	return response.Body(), err
+}
+
 func (c client) GetSyncSet(clusterID string, syncSetID string) (*clustersmgmtv1.Syncset, error) {
 	clustersResource := c.connection.ClustersMgmt().V1().Clusters()
 	response, syncsetErr := clustersResource.Cluster(clusterID).
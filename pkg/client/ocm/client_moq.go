@@ -41,6 +41,9 @@ var _ Client = &ClientMock{}
 // 			CreateSyncSetFunc: func(clusterID string, syncset *clustersmgmtv1.Syncset) (*clustersmgmtv1.Syncset, error) {
 // 				panic("mock out the CreateSyncSet method")
 // 			},
+// 			CreateUpgradePolicyFunc: func(clusterID string, upgradePolicy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error) {
+// 				panic("mock out the CreateUpgradePolicy method")
+// 			},
 // 			DeleteClusterFunc: func(clusterID string) (int, error) {
 // 				panic("mock out the DeleteCluster method")
 // 			},
@@ -92,6 +95,9 @@ var _ Client = &ClientMock{}
 // 			GetSyncSetFunc: func(clusterID string, syncSetID string) (*clustersmgmtv1.Syncset, error) {
 // 				panic("mock out the GetSyncSet method")
 // 			},
+// 			GetUpgradePolicyStateFunc: func(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error) {
+// 				panic("mock out the GetUpgradePolicyState method")
+// 			},
 // 			ScaleDownComputeNodesFunc: func(clusterID string, decrement int) (*clustersmgmtv1.Cluster, error) {
 // 				panic("mock out the ScaleDownComputeNodes method")
 // 			},
@@ -135,6 +141,9 @@ type ClientMock struct {
 	// CreateSyncSetFunc mocks the CreateSyncSet method.
 	CreateSyncSetFunc func(clusterID string, syncset *clustersmgmtv1.Syncset) (*clustersmgmtv1.Syncset, error)
 
+	// CreateUpgradePolicyFunc mocks the CreateUpgradePolicy method.
+	CreateUpgradePolicyFunc func(clusterID string, upgradePolicy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error)
+
 	// DeleteClusterFunc mocks the DeleteCluster method.
 	DeleteClusterFunc func(clusterID string) (int, error)
 
@@ -186,6 +195,9 @@ type ClientMock struct {
 	// GetSyncSetFunc mocks the GetSyncSet method.
 	GetSyncSetFunc func(clusterID string, syncSetID string) (*clustersmgmtv1.Syncset, error)
 
+	// GetUpgradePolicyStateFunc mocks the GetUpgradePolicyState method.
+	GetUpgradePolicyStateFunc func(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error)
+
 	// ScaleDownComputeNodesFunc mocks the ScaleDownComputeNodes method.
 	ScaleDownComputeNodesFunc func(clusterID string, decrement int) (*clustersmgmtv1.Cluster, error)
 
@@ -246,6 +258,13 @@ type ClientMock struct {
 			// Syncset is the syncset argument value.
 			Syncset *clustersmgmtv1.Syncset
 		}
+		// CreateUpgradePolicy holds details about calls to the CreateUpgradePolicy method.
+		CreateUpgradePolicy []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// UpgradePolicy is the upgradePolicy argument value.
+			UpgradePolicy *clustersmgmtv1.UpgradePolicy
+		}
 		// DeleteCluster holds details about calls to the DeleteCluster method.
 		DeleteCluster []struct {
 			// ClusterID is the clusterID argument value.
@@ -339,6 +358,13 @@ type ClientMock struct {
 			// SyncSetID is the syncSetID argument value.
 			SyncSetID string
 		}
+		// GetUpgradePolicyState holds details about calls to the GetUpgradePolicyState method.
+		GetUpgradePolicyState []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// UpgradePolicyID is the upgradePolicyID argument value.
+			UpgradePolicyID string
+		}
 		// ScaleDownComputeNodes holds details about calls to the ScaleDownComputeNodes method.
 		ScaleDownComputeNodes []struct {
 			// ClusterID is the clusterID argument value.
@@ -386,6 +412,7 @@ type ClientMock struct {
 	lockCreateCluster                   sync.RWMutex
 	lockCreateIdentityProvider          sync.RWMutex
 	lockCreateSyncSet                   sync.RWMutex
+	lockCreateUpgradePolicy             sync.RWMutex
 	lockDeleteCluster                   sync.RWMutex
 	lockDeleteSubscription              sync.RWMutex
 	lockDeleteSyncSet                   sync.RWMutex
@@ -403,6 +430,7 @@ type ClientMock struct {
 	lockGetRegions                      sync.RWMutex
 	lockGetRequiresTermsAcceptance      sync.RWMutex
 	lockGetSyncSet                      sync.RWMutex
+	lockGetUpgradePolicyState           sync.RWMutex
 	lockScaleDownComputeNodes           sync.RWMutex
 	lockScaleUpComputeNodes             sync.RWMutex
 	lockSetComputeNodes                 sync.RWMutex
@@ -642,6 +670,41 @@ func (mock *ClientMock) CreateSyncSetCalls() []struct {
 	return calls
 }
 
+// CreateUpgradePolicy calls CreateUpgradePolicyFunc.
+func (mock *ClientMock) CreateUpgradePolicy(clusterID string, upgradePolicy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error) {
+	if mock.CreateUpgradePolicyFunc == nil {
+		panic("ClientMock.CreateUpgradePolicyFunc: method is nil but Client.CreateUpgradePolicy was just called")
+	}
+	callInfo := struct {
+		ClusterID     string
+		UpgradePolicy *clustersmgmtv1.UpgradePolicy
+	}{
+		ClusterID:     clusterID,
+		UpgradePolicy: upgradePolicy,
+	}
+	mock.lockCreateUpgradePolicy.Lock()
+	mock.calls.CreateUpgradePolicy = append(mock.calls.CreateUpgradePolicy, callInfo)
+	mock.lockCreateUpgradePolicy.Unlock()
+	return mock.CreateUpgradePolicyFunc(clusterID, upgradePolicy)
+}
+
+// CreateUpgradePolicyCalls gets all the calls that were made to CreateUpgradePolicy.
+// Check the length with:
+//     len(mockedClient.CreateUpgradePolicyCalls())
+func (mock *ClientMock) CreateUpgradePolicyCalls() []struct {
+	ClusterID     string
+	UpgradePolicy *clustersmgmtv1.UpgradePolicy
+} {
+	var calls []struct {
+		ClusterID     string
+		UpgradePolicy *clustersmgmtv1.UpgradePolicy
+	}
+	mock.lockCreateUpgradePolicy.RLock()
+	calls = mock.calls.CreateUpgradePolicy
+	mock.lockCreateUpgradePolicy.RUnlock()
+	return calls
+}
+
 // DeleteCluster calls DeleteClusterFunc.
 func (mock *ClientMock) DeleteCluster(clusterID string) (int, error) {
 	if mock.DeleteClusterFunc == nil {
@@ -1184,6 +1247,41 @@ func (mock *ClientMock) GetSyncSetCalls() []struct {
 	return calls
 }
 
+// GetUpgradePolicyState calls GetUpgradePolicyStateFunc.
+func (mock *ClientMock) GetUpgradePolicyState(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error) {
+	if mock.GetUpgradePolicyStateFunc == nil {
+		panic("ClientMock.GetUpgradePolicyStateFunc: method is nil but Client.GetUpgradePolicyState was just called")
+	}
+	callInfo := struct {
+		ClusterID       string
+		UpgradePolicyID string
+	}{
+		ClusterID:       clusterID,
+		UpgradePolicyID: upgradePolicyID,
+	}
+	mock.lockGetUpgradePolicyState.Lock()
+	mock.calls.GetUpgradePolicyState = append(mock.calls.GetUpgradePolicyState, callInfo)
+	mock.lockGetUpgradePolicyState.Unlock()
+	return mock.GetUpgradePolicyStateFunc(clusterID, upgradePolicyID)
+}
+
+// GetUpgradePolicyStateCalls gets all the calls that were made to GetUpgradePolicyState.
+// Check the length with:
+//     len(mockedClient.GetUpgradePolicyStateCalls())
+func (mock *ClientMock) GetUpgradePolicyStateCalls() []struct {
+	ClusterID       string
+	UpgradePolicyID string
+} {
+	var calls []struct {
+		ClusterID       string
+		UpgradePolicyID string
+	}
+	mock.lockGetUpgradePolicyState.RLock()
+	calls = mock.calls.GetUpgradePolicyState
+	mock.lockGetUpgradePolicyState.RUnlock()
+	return calls
+}
+
 // ScaleDownComputeNodes calls ScaleDownComputeNodesFunc.
 func (mock *ClientMock) ScaleDownComputeNodes(clusterID string, decrement int) (*clustersmgmtv1.Cluster, error) {
 	if mock.ScaleDownComputeNodesFunc == nil {
@@ -0,0 +1,34 @@
+package ocm
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/healthcheck"
+)
+
+var _ healthcheck.Check = &HealthCheck{}
+
+// HealthCheck checks that the connection to OCM can be authenticated
+type HealthCheck struct {
+	client ClusterManagementClient
+}
+
+func NewHealthCheck(client ClusterManagementClient) *HealthCheck {
+	return &HealthCheck{
+		client: client,
+	}
+}
+
+func (h *HealthCheck) Name() string {
+	return "ocm"
+}
+
+func (h *HealthCheck) Check(ctx context.Context) error {
+	// there is no connection when OCM is mocked
+	connection := h.client.Connection()
+	if connection == nil {
+		return nil
+	}
+	_, _, err := connection.TokensContext(ctx)
+	return err
+}
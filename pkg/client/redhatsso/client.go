@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
 	"github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
 	serviceaccountsclient "github.com/redhat-developer/app-services-sdk-go/serviceaccounts/apiv1internal/client"
@@ -69,8 +70,9 @@ func (c *rhSSOClient) getConfiguration(accessToken string) *serviceaccountsclien
 				"Authorization": fmt.Sprintf("Bearer %s", accessToken),
 				"Content-Type":  "application/json",
 			},
-			UserAgent: "OpenAPI-Generator/1.0.0/go",
-			Debug:     false,
+			UserAgent:  "OpenAPI-Generator/1.0.0/go",
+			Debug:      false,
+			HTTPClient: &http.Client{Transport: tracing.NewTransport(nil)},
 			Servers: serviceaccountsclient.ServerConfigurations{
 				{
 					URL: c.realmConfig.APIEndpointURI,
@@ -98,7 +100,7 @@ func (c *rhSSOClient) GetToken() (string, error) {
 		return cachedToken, nil
 	}
 
-	client := &http.Client{}
+	client := &http.Client{Transport: tracing.NewTransport(nil)}
 	parameters := url.Values{}
 	parameters.Set("grant_type", "client_credentials")
 	parameters.Set("client_id", c.realmConfig.ClientID)
@@ -0,0 +1,19 @@
+package config
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/spf13/cobra"
+)
+
+// config sub-command handles the configuration of the service
+func NewConfigCommand(env *environments.Env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the kas-fleet-manager configuration",
+		Long:  "Manage the Kafka Service Fleet Manager configuration",
+	}
+	cmd.AddCommand(
+		NewValidateCommand(env),
+	)
+	return cmd
+}
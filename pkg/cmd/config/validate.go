@@ -0,0 +1,46 @@
+package config
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+)
+
+// NewValidateCommand creates a command loading and cross-checking the configuration of the service without
+// connecting to the database or any external service. A JSON report is printed and the command exits with a
+// non-zero status when the configuration is invalid.
+func NewValidateCommand(env *environments.Env) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the kas-fleet-manager configuration",
+		Long:  "Load and validate the configuration files and flags of the Kafka Service Fleet Manager, without connecting to the database or any external service, and print a JSON report",
+		Run: func(cmd *cobra.Command, args []string) {
+			valid, err := validateConfig(env, cmd.OutOrStdout())
+			if err != nil {
+				glog.Fatalf("Unable to validate the configuration: %s", err.Error())
+			}
+			if !valid {
+				os.Exit(1)
+			}
+		},
+	}
+}
+
+// validateConfig prints the validation report of the configuration of the environment and returns whether it is valid
+func validateConfig(env *environments.Env, out io.Writer) (bool, error) {
+	report, err := env.ValidateConfig()
+	if err != nil {
+		return false, err
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return false, err
+	}
+	return report.Valid, nil
+}
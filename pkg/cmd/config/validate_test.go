@@ -0,0 +1,74 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/goava/di"
+	. "github.com/onsi/gomega"
+)
+
+type fakeValidator struct {
+	err error
+}
+
+func (f *fakeValidator) Validate(env *environments.Env) error {
+	return f.err
+}
+
+func Test_validateConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		envName   string
+		validator *fakeValidator
+		wantValid bool
+		wantErr   bool
+	}{
+		{
+			name:      "should print a valid report when every check passes",
+			envName:   environments.TestingEnv,
+			validator: &fakeValidator{},
+			wantValid: true,
+		},
+		{
+			name:      "should print an invalid report when a check fails",
+			envName:   environments.TestingEnv,
+			validator: &fakeValidator{err: errors.New("invalid configuration")},
+			wantValid: false,
+		},
+		{
+			name:      "should return an error when the environment is not supported",
+			envName:   "unknown",
+			validator: &fakeValidator{},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			env, err := environments.New(tt.envName,
+				di.ProvideValue(tt.validator, di.As(new(environments.ConfigValidator))),
+				di.ProvideValue(environments.SimpleEnvLoader{}, di.As(new(environments.EnvLoader)), di.Tags{"env": environments.TestingEnv}),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			out := &bytes.Buffer{}
+			valid, err := validateConfig(env, out)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(valid).To(Equal(tt.wantValid))
+			if tt.wantErr {
+				Expect(out.Len()).To(BeZero())
+				return
+			}
+
+			report := environments.ConfigValidationReport{}
+			Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+			Expect(report.Environment).To(Equal(tt.envName))
+			Expect(report.Valid).To(Equal(tt.wantValid))
+			Expect(report.Checks).To(HaveLen(2))
+		})
+	}
+}
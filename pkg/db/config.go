@@ -2,9 +2,14 @@ package db
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 
+	"github.com/golang/glog"
 	"github.com/spf13/pflag"
 )
 
@@ -26,6 +31,11 @@ type DatabaseConfig struct {
 	NameFile           string `json:"name_file"`
 	UsernameFile       string `json:"username_file"`
 	PasswordFile       string `json:"password_file"`
+
+	// ReplicaHosts are the read replicas of the database, as host or host:port. The port of the primary is used when omitted.
+	ReplicaHosts               []string      `json:"replica_hosts"`
+	ReplicaHostsFile           string        `json:"replica_hosts_file"`
+	ReplicaHealthCheckInterval time.Duration `json:"replica_health_check_interval"`
 }
 
 func NewDatabaseConfig() *DatabaseConfig {
@@ -41,6 +51,8 @@ func NewDatabaseConfig() *DatabaseConfig {
 		PasswordFile:       "secrets/db.password",
 		NameFile:           "secrets/db.name",
 		DatabaseCaCertFile: "secrets/db.ca_cert",
+
+		ReplicaHealthCheckInterval: 10 * time.Second,
 	}
 }
 
@@ -54,6 +66,8 @@ func (c *DatabaseConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.SSLMode, "db-sslmode", c.SSLMode, "Database ssl mode (disable | require | verify-ca | verify-full)")
 	fs.BoolVar(&c.Debug, "enable-db-debug", c.Debug, " framework's debug mode")
 	fs.IntVar(&c.MaxOpenConnections, "db-max-open-connections", c.MaxOpenConnections, "Maximum open DB connections for this instance")
+	fs.StringVar(&c.ReplicaHostsFile, "db-replica-hosts-file", c.ReplicaHostsFile, "Database read replica hosts file, containing a comma separated list of host or host:port")
+	fs.DurationVar(&c.ReplicaHealthCheckInterval, "db-replica-health-check-interval", c.ReplicaHealthCheckInterval, "Interval between the health checks of the database read replicas")
 }
 
 func (c *DatabaseConfig) ReadFiles() error {
@@ -79,31 +93,79 @@ func (c *DatabaseConfig) ReadFiles() error {
 	}
 
 	err = shared.ReadFileValueString(c.NameFile, &c.Name)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// The replica hosts file is optional: the database has no read replica when it does not exist or is empty
+	var replicaHosts string
+	err = shared.ReadFileValueString(c.ReplicaHostsFile, &replicaHosts)
+	if err != nil {
+		if os.IsNotExist(err) {
+			glog.V(10).Infof("Specified database replica hosts file '%s' does not exist. Proceeding without read replicas", c.ReplicaHostsFile)
+		} else {
+			return err
+		}
+	}
+	for _, host := range strings.Split(replicaHosts, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			c.ReplicaHosts = append(c.ReplicaHosts, host)
+		}
+	}
+	return nil
 }
 
 func (c *DatabaseConfig) ConnectionString() string {
-	if c.SSLMode != "disable" {
-		return fmt.Sprintf(
-			"host=%s port=%d user=%s password='%s' dbname=%s sslmode=%s sslrootcert=%s",
-			c.Host, c.Port, c.Username, c.Password, c.Name, c.SSLMode, c.DatabaseCaCertFile,
-		)
-	}
-	return fmt.Sprintf(
-		"host=%s port=%d user=%s password='%s' dbname=%s sslmode=%s",
-		c.Host, c.Port, c.Username, c.Password, c.Name, c.SSLMode,
-	)
+	return c.connectionString(c.Host, c.Port, false)
 }
 
 func (c *DatabaseConfig) LogSafeConnectionString() string {
+	return c.connectionString(c.Host, c.Port, true)
+}
+
+// ReplicaConnectionString returns the connection string of the given read replica host
+func (c *DatabaseConfig) ReplicaConnectionString(replicaHost string) (string, error) {
+	host, port, err := c.splitReplicaHost(replicaHost)
+	if err != nil {
+		return "", err
+	}
+	return c.connectionString(host, port, false), nil
+}
+
+// LogSafeReplicaConnectionString returns the connection string of the given read replica host without its secrets
+func (c *DatabaseConfig) LogSafeReplicaConnectionString(replicaHost string) string {
+	host, port, err := c.splitReplicaHost(replicaHost)
+	if err != nil {
+		return replicaHost
+	}
+	return c.connectionString(host, port, true)
+}
+
+func (c *DatabaseConfig) splitReplicaHost(replicaHost string) (string, int, error) {
+	i := strings.LastIndex(replicaHost, ":")
+	if i < 0 {
+		return replicaHost, c.Port, nil
+	}
+	port, err := strconv.Atoi(replicaHost[i+1:])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid port in database replica host %q: %v", replicaHost, err)
+	}
+	return replicaHost[:i], port, nil
+}
+
+func (c *DatabaseConfig) connectionString(host string, port int, logSafe bool) string {
+	password, caCertFile := c.Password, c.DatabaseCaCertFile
+	if logSafe {
+		password, caCertFile = "<REDACTED>", "<REDACTED>"
+	}
 	if c.SSLMode != "disable" {
 		return fmt.Sprintf(
-			"host=%s port=%d user=%s password='<REDACTED>' dbname=%s sslmode=%s sslrootcert=<REDACTED>",
-			c.Host, c.Port, c.Username, c.Name, c.SSLMode,
+			"host=%s port=%d user=%s password='%s' dbname=%s sslmode=%s sslrootcert=%s",
+			host, port, c.Username, password, c.Name, c.SSLMode, caCertFile,
 		)
 	}
 	return fmt.Sprintf(
-		"host=%s port=%d user=%s password='<REDACTED>' dbname=%s sslmode=%s",
-		c.Host, c.Port, c.Username, c.Name, c.SSLMode,
+		"host=%s port=%d user=%s password='%s' dbname=%s sslmode=%s",
+		host, port, c.Username, password, c.Name, c.SSLMode,
 	)
 }
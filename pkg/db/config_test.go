@@ -0,0 +1,63 @@
+package db
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDatabaseConfig_ReadFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "db-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeFile := func(name, content string) string {
+		file := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(file, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+
+	portFile := writeFile("db.port", "5432")
+
+	tests := []struct {
+		name             string
+		replicaHostsFile string
+		wantReplicaHosts []string
+		wantErr          bool
+	}{
+		{
+			name:             "should read the replica hosts",
+			replicaHostsFile: writeFile("db.replica_hosts", "replica-1, replica-2:5433\n"),
+			wantReplicaHosts: []string{"replica-1", "replica-2:5433"},
+		},
+		{
+			name:             "should have no replica when the replica hosts file is empty",
+			replicaHostsFile: writeFile("db.empty_replica_hosts", ""),
+		},
+		{
+			name:             "should have no replica when the replica hosts file does not exist",
+			replicaHostsFile: filepath.Join(dir, "db.missing_replica_hosts"),
+		},
+		{
+			name:             "should fail when the replica hosts file cannot be read",
+			replicaHostsFile: dir,
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			c := &DatabaseConfig{PortFile: portFile, ReplicaHostsFile: tt.replicaHostsFile}
+			err := c.ReadFiles()
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(c.ReplicaHosts).To(Equal(tt.wantReplicaHosts))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"github.com/golang/glog"
@@ -13,13 +14,17 @@ import (
 type ConnectionFactory struct {
 	Config *DatabaseConfig
 	DB     *gorm.DB
+	// replicas are the read replicas of the database, nil when none is configured
+	replicas *replicaSet
 }
 
-var gormConfig *gorm.Config = &gorm.Config{
-	PrepareStmt:       true,
-	AllowGlobalUpdate: false, // change it to true to allow updates without the WHERE clause
-	QueryFields:       true,
-	Logger:            customLoggerWithMetricsCollector{},
+func newGormConfig(target string) *gorm.Config {
+	return &gorm.Config{
+		PrepareStmt:       true,
+		AllowGlobalUpdate: false, // change it to true to allow updates without the WHERE clause
+		QueryFields:       true,
+		Logger:            customLoggerWithMetricsCollector{target: target},
+	}
 }
 
 // NewConnectionFactory will initialize a singleton ConnectionFactory as needed and return the same instance.
@@ -31,7 +36,7 @@ func NewConnectionFactory(config *DatabaseConfig) (*ConnectionFactory, func()) {
 	// refer to https://gorm.io/docs/gorm_config.html
 
 	if config.Dialect == "postgres" {
-		db, err = gorm.Open(postgres.Open(config.ConnectionString()), gormConfig)
+		db, err = gorm.Open(postgres.Open(config.ConnectionString()), newGormConfig(targetPrimary))
 	} else {
 		// TODO what other dialects do we support?
 		panic(fmt.Sprintf("Unsupported DB dialect: %s", config.Dialect))
@@ -45,6 +50,9 @@ func NewConnectionFactory(config *DatabaseConfig) (*ConnectionFactory, func()) {
 			err.Error(),
 		))
 	}
+	if err := registerTracingCallbacks(db); err != nil {
+		panic(fmt.Errorf("Unable to register the tracing callbacks: %s", err))
+	}
 	sqlDB, sqlDBErr := db.DB()
 	if sqlDBErr != nil {
 		panic(fmt.Errorf("Unexpected connection error: %s", sqlDBErr))
@@ -52,6 +60,9 @@ func NewConnectionFactory(config *DatabaseConfig) (*ConnectionFactory, func()) {
 
 	sqlDB.SetMaxOpenConns(config.MaxOpenConnections)
 	dbFactory := &ConnectionFactory{Config: config, DB: db}
+	if len(config.ReplicaHosts) > 0 {
+		dbFactory.replicas = newReplicaSet(config)
+	}
 	cleanup := func() {
 		if err := dbFactory.close(); err != nil {
 			glog.Fatalf("Unable to close db connection: %s", err.Error())
@@ -79,7 +90,7 @@ func NewMockConnectionFactory(dbConfig *DatabaseConfig) *ConnectionFactory {
 	if err != nil {
 		panic(err)
 	}
-	connectionFactory := &ConnectionFactory{dbConfig, mocketDB}
+	connectionFactory := &ConnectionFactory{Config: dbConfig, DB: mocketDB}
 	return connectionFactory
 }
 
@@ -91,6 +102,28 @@ func (f *ConnectionFactory) New() *gorm.DB {
 	return f.DB
 }
 
+// ReadOnly returns a connection to a healthy read replica of the database. Services opt in to it for queries that
+// can tolerate the replication lag, e.g. admin lists and metrics. The primary is returned instead when no replica is
+// configured or healthy, and for contexts that must read their own writes (see WithPrimary).
+// The statements run with the returned connection are traced as children of the span of the context.
+func (f *ConnectionFactory) ReadOnly(ctx context.Context) *gorm.DB {
+	return f.readOnly(ctx).WithContext(ctx)
+}
+
+func (f *ConnectionFactory) readOnly(ctx context.Context) *gorm.DB {
+	if f.replicas == nil || requiresPrimary(ctx) {
+		return f.New()
+	}
+	db := f.replicas.pick()
+	if db == nil {
+		return f.New()
+	}
+	if f.Config.Debug {
+		return db.Debug()
+	}
+	return db
+}
+
 // Checks to ensure a connection is present
 func (f *ConnectionFactory) CheckConnection() error {
 	return f.DB.Exec("SELECT 1").Error
@@ -100,6 +133,9 @@ func (f *ConnectionFactory) CheckConnection() error {
 // THIS MUST **NOT** BE CALLED UNTIL THE SERVER/PROCESS IS EXITING!!
 // This should only ever be called once for the entire duration of the application and only at the end.
 func (f *ConnectionFactory) close() error {
+	if f.replicas != nil {
+		f.replicas.close()
+	}
 	sqlDB, sqlDBErr := f.DB.DB()
 	if sqlDBErr != nil {
 		return sqlDBErr
@@ -7,12 +7,14 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"gorm.io/gorm"
 )
 
 type contextKey int
 
 const (
 	transactionKey contextKey = iota
+	primaryKey
 )
 
 // NewContext returns a new context with transaction stored in it.
@@ -32,8 +34,23 @@ func (c *ConnectionFactory) NewContext(ctx context.Context) (context.Context, er
 }
 
 // TxContext creates a new transaction context from context.Background()
+// Read only queries run with this context are sent to the primary so that they read the writes of the transaction.
 func (c *ConnectionFactory) TxContext() (ctx context.Context, err error) {
-	return c.NewContext(context.Background())
+	return c.NewContext(WithPrimary(context.Background()))
+}
+
+// WithPrimary returns a context for which ConnectionFactory.ReadOnly returns the primary rather than a read replica,
+// so that the queries run with it read their own writes regardless of the replication lag
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey, true)
+}
+
+func requiresPrimary(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	primary, _ := ctx.Value(primaryKey).(bool)
+	return primary
 }
 
 // Resolve resolves the current transaction according to the rollback flag.
@@ -98,6 +115,33 @@ func FromContext(ctx context.Context) (*sql.Tx, error) {
 	return transaction.tx, nil
 }
 
+// ForContext returns a connection running its statements in the transaction stored in the context, e.g. by the
+// TransactionMiddleware, so that they are committed or rolled back with the transaction of the request. A new
+// connection is returned when the context holds no transaction, or a resolved one.
+func (c *ConnectionFactory) ForContext(ctx context.Context) *gorm.DB {
+	dbConn := c.New().WithContext(ctx)
+	transaction, ok := ctx.Value(transactionKey).(*txFactory)
+	if !ok || transaction.resolved {
+		return dbConn
+	}
+	dbConn.Statement.ConnPool = transaction.tx
+	return dbConn
+}
+
+// AdvisoryLock takes a transaction level advisory lock on the given key using the transaction stored in the context.
+// The lock is held until the transaction is resolved so that concurrent requests locking the same key are serialised.
+func AdvisoryLock(ctx context.Context, key string) error {
+	tx, err := FromContext(ctx)
+	if err != nil {
+		return err
+	}
+	rows, err := tx.QueryContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", key)
+	if err != nil {
+		return fmt.Errorf("Could not take advisory lock %s: %v", key, err)
+	}
+	return rows.Close()
+}
+
 // MarkForRollback flags the transaction stored in the context for rollback and logs whatever error caused the rollback
 func MarkForRollback(ctx context.Context, err error) {
 	ulog := logger.NewUHCLogger(ctx)
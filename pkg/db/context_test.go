@@ -0,0 +1,35 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_ConnectionFactory_ForContext(t *testing.T) {
+	RegisterTestingT(t)
+	connectionFactory := NewMockConnectionFactory(nil)
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery("select txid_current()").WithReply([]map[string]interface{}{{"txid_current": 1}})
+
+	// without transaction the statements run on the database
+	dbConn := connectionFactory.ForContext(context.Background())
+	Expect(dbConn.Statement.ConnPool).To(Equal(connectionFactory.New().Statement.ConnPool))
+
+	ctx, err := connectionFactory.NewContext(context.Background())
+	Expect(err).ToNot(HaveOccurred())
+	tx, err := FromContext(ctx)
+	Expect(err).ToNot(HaveOccurred())
+
+	// the statements run in the transaction of the context, the connection of the factory is left untouched
+	dbConn = connectionFactory.ForContext(ctx)
+	Expect(dbConn.Statement.ConnPool).To(BeIdenticalTo(tx))
+	Expect(dbConn.Statement.Context).To(Equal(ctx))
+	Expect(connectionFactory.New().Statement.ConnPool).ToNot(BeIdenticalTo(tx))
+
+	// the statements do not run in the transaction once it is resolved
+	Expect(Resolve(ctx)).To(Succeed())
+	Expect(connectionFactory.ForContext(ctx).Statement.ConnPool).ToNot(BeIdenticalTo(tx))
+}
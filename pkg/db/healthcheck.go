@@ -0,0 +1,32 @@
+package db
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/healthcheck"
+)
+
+var _ healthcheck.Check = &HealthCheck{}
+
+// HealthCheck checks the connection to the database
+type HealthCheck struct {
+	connectionFactory *ConnectionFactory
+}
+
+func NewHealthCheck(connectionFactory *ConnectionFactory) *HealthCheck {
+	return &HealthCheck{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (h *HealthCheck) Name() string {
+	return "database"
+}
+
+func (h *HealthCheck) Check(ctx context.Context) error {
+	sqlDB, err := h.connectionFactory.DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
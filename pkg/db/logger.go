@@ -10,6 +10,8 @@ import (
 )
 
 type customLoggerWithMetricsCollector struct {
+	// target is the database the queries are sent to, i.e. "primary" or "replica"
+	target string
 }
 
 // LogMode sets the log level
@@ -41,6 +43,6 @@ func (l customLoggerWithMetricsCollector) Trace(ctx context.Context, begin time.
 	sql, _ := fc()
 	sql = strings.TrimLeft(sql, " ")
 	tokens := strings.Split(sql, " ")
-	metrics.IncreaseDatabaseQueryCount(status, tokens[0])
-	metrics.UpdateDatabaseQueryDurationMetric(status, tokens[0], elapsed)
+	metrics.IncreaseDatabaseQueryCount(status, tokens[0], l.target)
+	metrics.UpdateDatabaseQueryDurationMetric(status, tokens[0], l.target, elapsed)
 }
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+const (
+	targetPrimary = "primary"
+	targetReplica = "replica"
+)
+
+type replica struct {
+	host    string
+	db      *gorm.DB
+	healthy int32
+}
+
+func (r *replica) isHealthy() bool {
+	return atomic.LoadInt32(&r.healthy) == 1
+}
+
+// checkHealth pings the replica and updates its health, returning whether it is healthy
+func (r *replica) checkHealth(timeout time.Duration) bool {
+	err := ping(r.db, timeout)
+	var healthy int32
+	if err == nil {
+		healthy = 1
+	}
+	if previous := atomic.SwapInt32(&r.healthy, healthy); previous != healthy {
+		if err != nil {
+			glog.Warningf("Database replica %s is unhealthy, falling back to the other replicas or the primary: %v", r.host, err)
+		} else {
+			glog.Infof("Database replica %s is healthy", r.host)
+		}
+	}
+	return err == nil
+}
+
+func ping(db *gorm.DB, timeout time.Duration) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return sqlDB.PingContext(ctx)
+}
+
+// replicaSet balances the read only queries across the healthy read replicas of the database
+type replicaSet struct {
+	replicas []*replica
+	next     uint32
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// newReplicaSet opens a connection to each of the configured replicas and starts checking their health periodically.
+// A replica that cannot be reached is not used until a health check succeeds, it does not prevent the service from starting.
+func newReplicaSet(config *DatabaseConfig) *replicaSet {
+	s := &replicaSet{stop: make(chan struct{})}
+	for _, host := range config.ReplicaHosts {
+		connectionString, err := config.ReplicaConnectionString(host)
+		if err != nil {
+			glog.Errorf("Ignoring database replica %s: %v", host, err)
+			continue
+		}
+		gormConfig := newGormConfig(targetReplica)
+		// connect on the first health check rather than failing here when the replica is unavailable
+		gormConfig.DisableAutomaticPing = true
+		db, err := gorm.Open(postgres.Open(connectionString), gormConfig)
+		if err != nil {
+			glog.Errorf("Ignoring database replica %s with connection string %s: %v", host, config.LogSafeReplicaConnectionString(host), err)
+			continue
+		}
+		if err := registerTracingCallbacks(db); err != nil {
+			glog.Errorf("Ignoring database replica %s: unable to register the tracing callbacks: %v", host, err)
+			continue
+		}
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.SetMaxOpenConns(config.MaxOpenConnections)
+		}
+		s.replicas = append(s.replicas, &replica{host: host, db: db})
+	}
+
+	interval := config.ReplicaHealthCheckInterval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	s.checkHealth(interval)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.checkHealth(interval)
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+	return s
+}
+
+func (s *replicaSet) checkHealth(timeout time.Duration) {
+	for _, r := range s.replicas {
+		r.checkHealth(timeout)
+	}
+}
+
+// pick returns the connection of the next healthy replica in a round robin fashion, or nil if none is healthy
+func (s *replicaSet) pick() *gorm.DB {
+	n := len(s.replicas)
+	if n == 0 {
+		return nil
+	}
+	start := atomic.AddUint32(&s.next, 1)
+	for i := 0; i < n; i++ {
+		r := s.replicas[(start+uint32(i))%uint32(n)]
+		if r.isHealthy() {
+			return r.db
+		}
+	}
+	return nil
+}
+
+func (s *replicaSet) close() {
+	close(s.stop)
+	s.wg.Wait()
+	for _, r := range s.replicas {
+		if sqlDB, err := r.db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}
+}
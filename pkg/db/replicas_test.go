@@ -0,0 +1,114 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+)
+
+func Test_ConnectionFactory_ReadOnly(t *testing.T) {
+	primary := NewMockConnectionFactory(nil)
+	replicaDB := primary.DB.Session(&gorm.Session{})
+
+	tests := []struct {
+		name           string
+		replicas       *replicaSet
+		ctx            context.Context
+		wantReplicaUse bool
+	}{
+		{
+			name:           "should use the primary when no replica is configured",
+			ctx:            context.Background(),
+			wantReplicaUse: false,
+		},
+		{
+			name:           "should use a healthy replica",
+			replicas:       &replicaSet{replicas: []*replica{{host: "replica", db: replicaDB, healthy: 1}}},
+			ctx:            context.Background(),
+			wantReplicaUse: true,
+		},
+		{
+			name:           "should fall back to the primary when no replica is healthy",
+			replicas:       &replicaSet{replicas: []*replica{{host: "replica", db: replicaDB, healthy: 0}}},
+			ctx:            context.Background(),
+			wantReplicaUse: false,
+		},
+		{
+			name:           "should use the primary for contexts that must read their own writes",
+			replicas:       &replicaSet{replicas: []*replica{{host: "replica", db: replicaDB, healthy: 1}}},
+			ctx:            WithPrimary(context.Background()),
+			wantReplicaUse: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			factory := &ConnectionFactory{Config: &DatabaseConfig{}, DB: primary.DB, replicas: tt.replicas}
+			if tt.wantReplicaUse {
+				Expect(factory.readOnly(tt.ctx)).To(BeIdenticalTo(replicaDB))
+			} else {
+				Expect(factory.readOnly(tt.ctx)).To(BeIdenticalTo(primary.DB))
+			}
+			Expect(factory.ReadOnly(tt.ctx).Statement.Context).To(Equal(tt.ctx))
+		})
+	}
+}
+
+func Test_replicaSet_pick(t *testing.T) {
+	RegisterTestingT(t)
+	primary := NewMockConnectionFactory(nil)
+	first := &replica{host: "first", db: primary.DB.Session(&gorm.Session{}), healthy: 1}
+	second := &replica{host: "second", db: primary.DB.Session(&gorm.Session{}), healthy: 0}
+	third := &replica{host: "third", db: primary.DB.Session(&gorm.Session{}), healthy: 1}
+	set := &replicaSet{replicas: []*replica{first, second, third}}
+
+	picked := map[*gorm.DB]int{}
+	for i := 0; i < 6; i++ {
+		picked[set.pick()]++
+	}
+	Expect(picked).To(HaveLen(2), "the unhealthy replica should be skipped")
+	Expect(picked[first.db]).To(BeNumerically(">", 0))
+	Expect(picked[third.db]).To(BeNumerically(">", 0))
+	Expect(picked[second.db]).To(BeZero())
+
+	Expect((&replicaSet{}).pick()).To(BeNil())
+}
+
+func Test_DatabaseConfig_ReplicaConnectionString(t *testing.T) {
+	config := &DatabaseConfig{Port: 5432, Username: "user", Password: "secret", Name: "db", SSLMode: "disable"}
+
+	tests := []struct {
+		name    string
+		host    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "should use the port of the primary when omitted",
+			host: "replica",
+			want: "host=replica port=5432 user=user password='secret' dbname=db sslmode=disable",
+		},
+		{
+			name: "should use the port of the replica host",
+			host: "replica:5433",
+			want: "host=replica port=5433 user=user password='secret' dbname=db sslmode=disable",
+		},
+		{
+			name:    "should return an error when the port is invalid",
+			host:    "replica:port",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			got, err := config.ReplicaConnectionString(tt.host)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(got).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const tracingSpanKey = "tracing:span"
+
+// registerTracingCallbacks registers gorm callbacks starting a span for each statement.
+// The spans are children of the span stored in the statement context, e.g. the transaction span of a request when the
+// query is run using ConnectionFactory.ReadOnly(ctx) or db.WithContext(ctx). Statements run without a span in their context
+// are not traced, so that they do not each start a trace of their own.
+func registerTracingCallbacks(db *gorm.DB) error {
+	callbacks := db.Callback()
+	registrations := []error{
+		callbacks.Create().Before("gorm:create").Register("tracing:before_create", startStatementSpan("create")),
+		callbacks.Create().After("gorm:create").Register("tracing:after_create", endStatementSpan),
+		callbacks.Query().Before("gorm:query").Register("tracing:before_query", startStatementSpan("query")),
+		callbacks.Query().After("gorm:query").Register("tracing:after_query", endStatementSpan),
+		callbacks.Update().Before("gorm:update").Register("tracing:before_update", startStatementSpan("update")),
+		callbacks.Update().After("gorm:update").Register("tracing:after_update", endStatementSpan),
+		callbacks.Delete().Before("gorm:delete").Register("tracing:before_delete", startStatementSpan("delete")),
+		callbacks.Delete().After("gorm:delete").Register("tracing:after_delete", endStatementSpan),
+		callbacks.Row().Before("gorm:row").Register("tracing:before_row", startStatementSpan("row")),
+		callbacks.Row().After("gorm:row").Register("tracing:after_row", endStatementSpan),
+		callbacks.Raw().Before("gorm:raw").Register("tracing:before_raw", startStatementSpan("raw")),
+		callbacks.Raw().After("gorm:raw").Register("tracing:after_raw", endStatementSpan),
+	}
+	for _, err := range registrations {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func startStatementSpan(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Statement.Context == nil || !trace.SpanContextFromContext(db.Statement.Context).IsValid() {
+			return
+		}
+		ctx, span := tracing.StartSpan(db.Statement.Context, "db."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBOperationKey.String(operation)),
+		)
+		db.Statement.Context = ctx
+		db.InstanceSet(tracingSpanKey, span)
+	}
+}
+
+func endStatementSpan(db *gorm.DB) {
+	value, ok := db.InstanceGet(tracingSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	span.SetAttributes(
+		semconv.DBSQLTableKey.String(db.Statement.Table),
+		semconv.DBStatementKey.String(db.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", db.RowsAffected),
+	)
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		tracing.RecordError(span, db.Error)
+	}
+}
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type tracedRecord struct {
+	ID string
+}
+
+func Test_registerTracingCallbacks(t *testing.T) {
+	RegisterTestingT(t)
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	connectionFactory := NewMockConnectionFactory(nil)
+	Expect(registerTracingCallbacks(connectionFactory.DB)).To(Succeed())
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery("select txid_current()").WithReply([]map[string]interface{}{{"txid_current": 1}})
+	mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "traced_records"`).WithReply([]map[string]interface{}{{"id": "record-id"}})
+
+	handler := TransactionMiddleware(connectionFactory)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var records []tracedRecord
+		if err := connectionFactory.New().WithContext(r.Context()).Find(&records).Error; err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		// statements run without a span in their context are not traced
+		if err := connectionFactory.New().Find(&records).Error; err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	ctx, requestSpan := tracing.StartSpan(context.Background(), "request")
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	requestSpan.End()
+	Expect(rec.Code).To(Equal(http.StatusOK))
+
+	var transactionSpan sdktrace.ReadOnlySpan
+	var statementSpans []sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		switch span.Name() {
+		case "db.transaction":
+			transactionSpan = span
+		case "db.query":
+			statementSpans = append(statementSpans, span)
+		}
+	}
+	Expect(transactionSpan).ToNot(BeNil())
+	Expect(statementSpans).To(HaveLen(1))
+	Expect(statementSpans[0].SpanContext().TraceID()).To(Equal(requestSpan.SpanContext().TraceID()))
+	Expect(statementSpans[0].Parent().SpanID()).To(Equal(transactionSpan.SpanContext().SpanID()))
+}
@@ -10,6 +10,7 @@ import (
 
 	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
 )
 
 // TransactionMiddleware creates a new HTTP middleware that begins a database transaction
@@ -22,9 +23,18 @@ func TransactionMiddleware(db *ConnectionFactory) func(next http.Handler) http.H
 
 func transactionMiddleware(db *ConnectionFactory, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracing.StartSpan(r.Context(), "db.transaction")
+		defer span.End()
+
+		// requests that may write to the database read from the primary, so that they read their own writes
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			ctx = WithPrimary(ctx)
+		}
+
 		// Create a new Context with the transaction stored in it.
-		ctx, err := db.NewContext(r.Context())
+		ctx, err := db.NewContext(ctx)
 		if err != nil {
+			tracing.RecordError(span, err)
 			ulog := logger.NewUHCLogger(ctx)
 			ulog.Error(errors.Wrap(err, "Could not create transaction"))
 			// use default error to avoid exposing internals to users
@@ -48,6 +58,7 @@ func transactionMiddleware(db *ConnectionFactory, next http.Handler) http.Handle
 		defer func() {
 			err := Resolve(r.Context())
 			if err != nil {
+				tracing.RecordError(span, err)
 				ulog := logger.NewUHCLogger(ctx)
 				ulog.Error(err)
 			}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Test_TransactionMiddleware_Tracing(t *testing.T) {
+	RegisterTestingT(t)
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	connectionFactory := NewMockConnectionFactory(nil)
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery("select txid_current()").WithReply([]map[string]interface{}{{"txid_current": 1}})
+
+	var handlerSpan trace.Span
+	handler := TransactionMiddleware(connectionFactory)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, handlerSpan = tracing.StartSpan(r.Context(), "handler")
+		handlerSpan.End()
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	ctx, requestSpan := tracing.StartSpan(context.Background(), "request")
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	requestSpan.End()
+	Expect(rec.Code).To(Equal(http.StatusOK))
+
+	// the transaction span is a child of the request span, and the spans of the handler are children of the transaction span
+	var transactionSpan sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Name() == "db.transaction" {
+			transactionSpan = span
+		}
+	}
+	Expect(transactionSpan).ToNot(BeNil())
+	Expect(transactionSpan.SpanContext().TraceID()).To(Equal(requestSpan.SpanContext().TraceID()))
+	Expect(transactionSpan.Parent().SpanID()).To(Equal(requestSpan.SpanContext().SpanID()))
+	Expect(handlerSpan.SpanContext().TraceID()).To(Equal(requestSpan.SpanContext().TraceID()))
+	Expect(handlerSpan.(sdktrace.ReadOnlySpan).Parent().SpanID()).To(Equal(transactionSpan.SpanContext().SpanID()))
+}
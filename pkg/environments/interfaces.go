@@ -16,6 +16,12 @@ type ServiceValidator interface {
 	Validate(env *Env) error
 }
 
+// ConfigValidator values cross-check configuration that is only validated by the `config validate` command, as
+// failing these checks must not prevent the service from starting
+type ConfigValidator interface {
+	Validate(env *Env) error
+}
+
 // BootService are services that get started on application boot.
 type BootService interface {
 	Start()
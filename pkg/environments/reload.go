@@ -0,0 +1,224 @@
+package environments
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/fsnotify/fsnotify"
+	"github.com/goava/di"
+	"github.com/golang/glog"
+)
+
+// Reloadable is implemented by the ConfigModule values whose configuration files can be reloaded while the service is
+// running. The readers of a Reloadable configuration must use its accessors to get a consistent snapshot of it.
+type Reloadable interface {
+	// WatchedFiles returns the configuration files that trigger a reload when their content changes
+	WatchedFiles() []string
+	// Reload reads the watched files into a new snapshot of the configuration, validates it and atomically replaces the
+	// current snapshot with it. The current snapshot must be kept when an error is returned.
+	Reload() error
+}
+
+// ConfigReloadStatus is the state of the configuration loaded from the files of a Reloadable
+type ConfigReloadStatus struct {
+	Name string
+	// Files are the absolute paths of the watched files
+	Files []string
+	// Checksum is the sha256 checksum of the content of the watched files currently loaded
+	Checksum string
+	LoadedAt time.Time
+	// LastReloadAttempt is the time of the last reload, nil if the configuration has not been reloaded since startup
+	LastReloadAttempt *time.Time
+	// LastReloadError is the error of the last reload, the configuration loaded previously being kept in that case
+	LastReloadError error
+}
+
+type reloadEntry struct {
+	reloadable Reloadable
+	status     ConfigReloadStatus
+}
+
+// ConfigReloader watches the files of the Reloadable configurations and reloads them when their content changes
+type ConfigReloader struct {
+	config  *ConfigReloadConfig
+	entries []*reloadEntry
+	mutex   sync.RWMutex
+	watcher *fsnotify.Watcher
+	stop    chan struct{}
+	wg      sync.WaitGroup
+}
+
+type reloaderInjections struct {
+	di.Inject
+	Config      *ConfigReloadConfig
+	Reloadables []Reloadable `optional:"true"`
+}
+
+// NewConfigReloader creates a ConfigReloader for the Reloadable configurations. It must be created once the
+// configuration files have been read so that the checksums of the loaded files can be computed.
+func NewConfigReloader(in reloaderInjections) *ConfigReloader {
+	r := &ConfigReloader{config: in.Config}
+	now := time.Now()
+	for _, reloadable := range in.Reloadables {
+		files := watchedFiles(reloadable)
+		r.entries = append(r.entries, &reloadEntry{
+			reloadable: reloadable,
+			status: ConfigReloadStatus{
+				Name:     strings.TrimPrefix(fmt.Sprintf("%T", reloadable), "*"),
+				Files:    files,
+				Checksum: checksum(files),
+				LoadedAt: now,
+			},
+		})
+	}
+	return r
+}
+
+var _ BootService = &ConfigReloader{}
+
+func (r *ConfigReloader) Start() {
+	if !r.config.Enabled || len(r.entries) == 0 {
+		glog.Infof("Configuration reload is disabled")
+		return
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		glog.Errorf("Unable to watch the configuration files, configuration reload is disabled: %v", err)
+		return
+	}
+	// the directories are watched rather than the files themselves as the files can be replaced, i.e. by an editor or
+	// when a mounted Kubernetes ConfigMap is updated
+	dirs := map[string]bool{}
+	for _, entry := range r.entries {
+		for _, file := range entry.status.Files {
+			dir := filepath.Dir(file)
+			if dirs[dir] {
+				continue
+			}
+			if err := watcher.Add(dir); err != nil {
+				glog.Errorf("Unable to watch the configuration directory %s: %v", dir, err)
+				continue
+			}
+			dirs[dir] = true
+		}
+	}
+
+	r.watcher = watcher
+	r.stop = make(chan struct{})
+	r.wg.Add(1)
+	go r.watch()
+	glog.Infof("Watching %d configuration directories for changes", len(dirs))
+}
+
+func (r *ConfigReloader) Stop() {
+	if r.watcher == nil {
+		return
+	}
+	close(r.stop)
+	r.wg.Wait()
+	_ = r.watcher.Close()
+}
+
+func (r *ConfigReloader) watch() {
+	defer r.wg.Done()
+	// events usually come in bursts when a file is written, the reload happens once the files are no longer changing
+	var debounce <-chan time.Time
+	for {
+		select {
+		case _, ok := <-r.watcher.Events:
+			if !ok {
+				return
+			}
+			debounce = time.After(r.config.Debounce)
+		case err, ok := <-r.watcher.Errors:
+			if !ok {
+				return
+			}
+			glog.Warningf("Error while watching the configuration files: %v", err)
+		case <-debounce:
+			debounce = nil
+			r.ReloadChanged()
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// ReloadChanged reloads the configurations whose files have changed since they were last loaded
+func (r *ConfigReloader) ReloadChanged() {
+	for _, entry := range r.entries {
+		sum := checksum(entry.status.Files)
+		r.mutex.RLock()
+		unchanged := sum == entry.status.Checksum
+		r.mutex.RUnlock()
+		if unchanged {
+			continue
+		}
+
+		now := time.Now()
+		err := entry.reloadable.Reload()
+
+		r.mutex.Lock()
+		entry.status.LastReloadAttempt = &now
+		entry.status.LastReloadError = err
+		if err == nil {
+			entry.status.Checksum = sum
+			entry.status.LoadedAt = now
+		}
+		r.mutex.Unlock()
+
+		if err != nil {
+			glog.Errorf("Unable to reload the configuration %s, keeping the configuration loaded at %s: %v", entry.status.Name, entry.status.LoadedAt.Format(time.RFC3339), err)
+			metrics.IncreaseConfigReloadCount(entry.status.Name, metrics.ConfigReloadFailure)
+			continue
+		}
+		glog.Infof("Reloaded the configuration %s with checksum %s", entry.status.Name, sum)
+		metrics.IncreaseConfigReloadCount(entry.status.Name, metrics.ConfigReloadSuccess)
+		metrics.UpdateConfigReloadTimestamp(entry.status.Name, now)
+	}
+}
+
+// Statuses returns the state of the configurations loaded from the files of the Reloadable values
+func (r *ConfigReloader) Statuses() []ConfigReloadStatus {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	statuses := make([]ConfigReloadStatus, 0, len(r.entries))
+	for _, entry := range r.entries {
+		statuses = append(statuses, entry.status)
+	}
+	return statuses
+}
+
+func watchedFiles(reloadable Reloadable) []string {
+	var files []string
+	for _, file := range reloadable.WatchedFiles() {
+		if path := shared.BuildFullFilePath(file); path != "" {
+			files = append(files, path)
+		}
+	}
+	return files
+}
+
+// checksum returns the checksum of the content of the files. Files that cannot be read are part of the checksum so
+// that their removal is detected.
+func checksum(files []string) string {
+	hash := sha256.New()
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			content = []byte(err.Error())
+		}
+		_, _ = fmt.Fprintf(hash, "%s\x00%d\x00", file, len(content))
+		_, _ = hash.Write(content)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
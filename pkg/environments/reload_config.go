@@ -0,0 +1,28 @@
+package environments
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type ConfigReloadConfig struct {
+	Enabled  bool          `json:"enabled"`
+	Debounce time.Duration `json:"debounce"`
+}
+
+func NewConfigReloadConfig() *ConfigReloadConfig {
+	return &ConfigReloadConfig{
+		Enabled:  false,
+		Debounce: 2 * time.Second,
+	}
+}
+
+func (c *ConfigReloadConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.Enabled, "enable-config-reload", c.Enabled, "Enable the reload of the configuration files that support it when their content changes, without restarting the service")
+	fs.DurationVar(&c.Debounce, "config-reload-debounce", c.Debounce, "Time to wait after the last change of a configuration file before reloading it")
+}
+
+func (c *ConfigReloadConfig) ReadFiles() error {
+	return nil
+}
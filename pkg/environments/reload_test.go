@@ -0,0 +1,93 @@
+package environments
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+type fakeReloadable struct {
+	file    string
+	reloads int
+	err     error
+}
+
+func (f *fakeReloadable) WatchedFiles() []string {
+	return []string{f.file}
+}
+
+func (f *fakeReloadable) Reload() error {
+	f.reloads++
+	return f.err
+}
+
+func Test_ConfigReloader_ReloadChanged(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir, err := ioutil.TempDir("", "config-reload")
+	Expect(err).ToNot(HaveOccurred())
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "config.yaml")
+	Expect(ioutil.WriteFile(file, []byte("a: 1"), 0600)).To(Succeed())
+
+	reloadable := &fakeReloadable{file: file}
+	reloader := NewConfigReloader(reloaderInjections{Config: NewConfigReloadConfig(), Reloadables: []Reloadable{reloadable}})
+	loaded := reloader.Statuses()[0]
+	Expect(loaded.Name).To(Equal("environments.fakeReloadable"))
+	Expect(loaded.Files).To(Equal([]string{file}))
+	Expect(loaded.Checksum).ToNot(BeEmpty())
+
+	reloader.ReloadChanged()
+	Expect(reloadable.reloads).To(Equal(0), "unchanged files should not be reloaded")
+
+	Expect(ioutil.WriteFile(file, []byte("a: 2"), 0600)).To(Succeed())
+	reloadable.err = errors.New("invalid configuration")
+	reloader.ReloadChanged()
+	Expect(reloadable.reloads).To(Equal(1))
+	failed := reloader.Statuses()[0]
+	Expect(failed.Checksum).To(Equal(loaded.Checksum), "the checksum of the configuration loaded previously should be kept")
+	Expect(failed.LastReloadAttempt).ToNot(BeNil())
+	Expect(failed.LastReloadError).To(MatchError("invalid configuration"))
+
+	reloadable.err = nil
+	reloader.ReloadChanged()
+	Expect(reloadable.reloads).To(Equal(2), "a failed reload should be retried")
+	reloaded := reloader.Statuses()[0]
+	Expect(reloaded.Checksum).ToNot(Equal(loaded.Checksum))
+	Expect(reloaded.LastReloadError).To(BeNil())
+	Expect(reloaded.LoadedAt).To(Equal(*reloaded.LastReloadAttempt))
+
+	Expect(os.Remove(file)).To(Succeed())
+	reloader.ReloadChanged()
+	Expect(reloadable.reloads).To(Equal(3), "the removal of a file should be detected")
+}
+
+func Test_ConfigReloader_Start(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir, err := ioutil.TempDir("", "config-reload")
+	Expect(err).ToNot(HaveOccurred())
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "config.yaml")
+	Expect(ioutil.WriteFile(file, []byte("a: 1"), 0600)).To(Succeed())
+
+	reloadable := &fakeReloadable{file: file}
+	config := &ConfigReloadConfig{Enabled: true, Debounce: 10 * time.Millisecond}
+	reloader := NewConfigReloader(reloaderInjections{Config: config, Reloadables: []Reloadable{reloadable}})
+	reloader.Start()
+	defer reloader.Stop()
+
+	// replace the file the way a Kubernetes ConfigMap update or an editor does
+	tmp := filepath.Join(dir, "config.yaml.tmp")
+	Expect(ioutil.WriteFile(tmp, []byte("a: 2"), 0600)).To(Succeed())
+	Expect(os.Rename(tmp, file)).To(Succeed())
+
+	Eventually(func() *time.Time {
+		return reloader.Statuses()[0].LastReloadAttempt
+	}, 5*time.Second, 10*time.Millisecond).ShouldNot(BeNil())
+}
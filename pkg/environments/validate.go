@@ -0,0 +1,102 @@
+package environments
+
+import (
+	goerrors "errors"
+	"fmt"
+
+	"github.com/goava/di"
+)
+
+const (
+	ConfigCheckStatusOK      = "ok"
+	ConfigCheckStatusFailed  = "failed"
+	ConfigCheckStatusSkipped = "skipped"
+
+	// ConfigCheckStageReadFiles is the stage loading the configuration files of a ConfigModule
+	ConfigCheckStageReadFiles = "read_files"
+	// ConfigCheckStageModifyConfiguration is the stage applying the configuration changes of the named environment
+	ConfigCheckStageModifyConfiguration = "modify_configuration"
+	// ConfigCheckStageValidate is the stage running the cross-checks of a ServiceValidator or ConfigValidator
+	ConfigCheckStageValidate = "validate"
+)
+
+// ConfigCheck is the result of a single configuration check
+type ConfigCheck struct {
+	Name   string `json:"name"`
+	Stage  string `json:"stage"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ConfigValidationReport is the result of the validation of the configuration of an environment
+type ConfigValidationReport struct {
+	Environment string        `json:"environment"`
+	Valid       bool          `json:"valid"`
+	Checks      []ConfigCheck `json:"checks"`
+}
+
+func (r *ConfigValidationReport) add(name string, stage string, err error) {
+	check := ConfigCheck{Name: name, Stage: stage, Status: ConfigCheckStatusOK}
+	if err != nil {
+		check.Status = ConfigCheckStatusFailed
+		check.Error = err.Error()
+		r.Valid = false
+	}
+	r.Checks = append(r.Checks, check)
+}
+
+func (r *ConfigValidationReport) skip(name string, stage string) {
+	r.Checks = append(r.Checks, ConfigCheck{Name: name, Stage: stage, Status: ConfigCheckStatusSkipped})
+}
+
+// ValidateConfig loads and validates the configuration of the environment the same way CreateServices does, without
+// creating the services. This means that no connection is made to the database or any external service.
+//
+// Unlike CreateServices, it does not stop at the first error: all the ConfigModule.ReadFiles functions are called and
+// the result of each of them is reported. The EnvLoader.ModifyConfiguration function, the ServiceValidator.Validate
+// functions and the ConfigValidator.Validate functions found in the Env.ConfigContainer are then called, unless loading
+// the configuration files failed, in which case they are reported as skipped.
+func (env *Env) ValidateConfig() (*ConfigValidationReport, error) {
+	report := &ConfigValidationReport{Environment: env.Name, Valid: true}
+
+	modules := []ConfigModule{}
+	if err := env.ConfigContainer.Resolve(&modules); err != nil && !goerrors.Is(err, di.ErrTypeNotExists) {
+		return nil, err
+	}
+	for i := range modules {
+		report.add(fmt.Sprintf("%T", modules[i]), ConfigCheckStageReadFiles, modules[i].ReadFiles())
+	}
+
+	var namedEnv EnvLoader
+	if err := env.ConfigContainer.Resolve(&namedEnv, di.Tags{"env": env.Name}); err != nil {
+		return nil, fmt.Errorf("unsupported environment %q", env.Name)
+	}
+
+	var serviceValidators []ServiceValidator
+	if err := env.ConfigContainer.Resolve(&serviceValidators); err != nil && !goerrors.Is(err, di.ErrTypeNotExists) {
+		return nil, err
+	}
+	var configValidators []ConfigValidator
+	if err := env.ConfigContainer.Resolve(&configValidators); err != nil && !goerrors.Is(err, di.ErrTypeNotExists) {
+		return nil, err
+	}
+	validators := []ConfigValidator{}
+	for _, validator := range serviceValidators {
+		validators = append(validators, validator)
+	}
+	validators = append(validators, configValidators...)
+
+	if !report.Valid {
+		report.skip(fmt.Sprintf("%T", namedEnv), ConfigCheckStageModifyConfiguration)
+		for _, validator := range validators {
+			report.skip(fmt.Sprintf("%T", validator), ConfigCheckStageValidate)
+		}
+		return report, nil
+	}
+
+	report.add(fmt.Sprintf("%T", namedEnv), ConfigCheckStageModifyConfiguration, namedEnv.ModifyConfiguration(env))
+	for _, validator := range validators {
+		report.add(fmt.Sprintf("%T", validator), ConfigCheckStageValidate, validator.Validate(env))
+	}
+	return report, nil
+}
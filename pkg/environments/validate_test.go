@@ -0,0 +1,140 @@
+package environments
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goava/di"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+)
+
+type fakeConfigModule struct {
+	readFilesErr error
+}
+
+func (f *fakeConfigModule) AddFlags(fs *pflag.FlagSet) {}
+
+func (f *fakeConfigModule) ReadFiles() error {
+	return f.readFilesErr
+}
+
+type fakeServiceValidator struct {
+	err error
+}
+
+func (f *fakeServiceValidator) Validate(env *Env) error {
+	return f.err
+}
+
+type fakeConfigValidator struct {
+	err error
+}
+
+func (f *fakeConfigValidator) Validate(env *Env) error {
+	return f.err
+}
+
+func newValidateTestEnv(name string, module *fakeConfigModule, serviceValidator *fakeServiceValidator, configValidator *fakeConfigValidator) (*Env, error) {
+	return New(name,
+		di.ProvideValue(module, di.As(new(ConfigModule))),
+		di.ProvideValue(serviceValidator, di.As(new(ServiceValidator))),
+		di.ProvideValue(configValidator, di.As(new(ConfigValidator))),
+		di.ProvideValue(SimpleEnvLoader{}, di.As(new(EnvLoader)), di.Tags{"env": TestingEnv}),
+		di.Provide(Func(func() di.Option { return di.Options() })),
+	)
+}
+
+func Test_Env_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name             string
+		envName          string
+		module           *fakeConfigModule
+		serviceValidator *fakeServiceValidator
+		configValidator  *fakeConfigValidator
+		want             *ConfigValidationReport
+		wantErr          bool
+	}{
+		{
+			name:             "should report every check as ok when the configuration is valid",
+			envName:          TestingEnv,
+			module:           &fakeConfigModule{},
+			serviceValidator: &fakeServiceValidator{},
+			configValidator:  &fakeConfigValidator{},
+			want: &ConfigValidationReport{
+				Environment: TestingEnv,
+				Valid:       true,
+				Checks: []ConfigCheck{
+					{Name: "*environments.fakeConfigModule", Stage: ConfigCheckStageReadFiles, Status: ConfigCheckStatusOK},
+					{Name: "environments.SimpleEnvLoader", Stage: ConfigCheckStageModifyConfiguration, Status: ConfigCheckStatusOK},
+					{Name: "*environments.fakeServiceValidator", Stage: ConfigCheckStageValidate, Status: ConfigCheckStatusOK},
+					{Name: "*environments.fakeConfigValidator", Stage: ConfigCheckStageValidate, Status: ConfigCheckStatusOK},
+				},
+			},
+		},
+		{
+			name:             "should skip the validators when the configuration files cannot be read",
+			envName:          TestingEnv,
+			module:           &fakeConfigModule{readFilesErr: errors.New("missing file")},
+			serviceValidator: &fakeServiceValidator{},
+			configValidator:  &fakeConfigValidator{},
+			want: &ConfigValidationReport{
+				Environment: TestingEnv,
+				Valid:       false,
+				Checks: []ConfigCheck{
+					{Name: "*environments.fakeConfigModule", Stage: ConfigCheckStageReadFiles, Status: ConfigCheckStatusFailed, Error: "missing file"},
+					{Name: "environments.SimpleEnvLoader", Stage: ConfigCheckStageModifyConfiguration, Status: ConfigCheckStatusSkipped},
+					{Name: "*environments.fakeServiceValidator", Stage: ConfigCheckStageValidate, Status: ConfigCheckStatusSkipped},
+					{Name: "*environments.fakeConfigValidator", Stage: ConfigCheckStageValidate, Status: ConfigCheckStatusSkipped},
+				},
+			},
+		},
+		{
+			name:             "should report every failed validator",
+			envName:          TestingEnv,
+			module:           &fakeConfigModule{},
+			serviceValidator: &fakeServiceValidator{err: errors.New("invalid service configuration")},
+			configValidator:  &fakeConfigValidator{err: errors.New("invalid configuration")},
+			want: &ConfigValidationReport{
+				Environment: TestingEnv,
+				Valid:       false,
+				Checks: []ConfigCheck{
+					{Name: "*environments.fakeConfigModule", Stage: ConfigCheckStageReadFiles, Status: ConfigCheckStatusOK},
+					{Name: "environments.SimpleEnvLoader", Stage: ConfigCheckStageModifyConfiguration, Status: ConfigCheckStatusOK},
+					{Name: "*environments.fakeServiceValidator", Stage: ConfigCheckStageValidate, Status: ConfigCheckStatusFailed, Error: "invalid service configuration"},
+					{Name: "*environments.fakeConfigValidator", Stage: ConfigCheckStageValidate, Status: ConfigCheckStatusFailed, Error: "invalid configuration"},
+				},
+			},
+		},
+		{
+			name:             "should return an error for an unsupported environment",
+			envName:          "unknown",
+			module:           &fakeConfigModule{},
+			serviceValidator: &fakeServiceValidator{},
+			configValidator:  &fakeConfigValidator{},
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			env, err := newValidateTestEnv(tt.envName, tt.module, tt.serviceValidator, tt.configValidator)
+			Expect(err).ToNot(HaveOccurred())
+
+			report, err := env.ValidateConfig()
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(report).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_Env_CreateServices_SkipsConfigValidators(t *testing.T) {
+	RegisterTestingT(t)
+	env, err := newValidateTestEnv(TestingEnv, &fakeConfigModule{}, &fakeServiceValidator{}, &fakeConfigValidator{err: errors.New("invalid configuration")})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(env.CreateServices()).To(Succeed(), "a failed ConfigValidator should not prevent the service from starting")
+
+	env, err = newValidateTestEnv(TestingEnv, &fakeConfigModule{}, &fakeServiceValidator{err: errors.New("invalid service configuration")}, &fakeConfigValidator{})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(env.CreateServices()).To(MatchError("invalid service configuration"))
+}
@@ -184,6 +184,14 @@ const (
 	ErrorInstanceTypeNotSupported       ServiceErrorCode = 41
 	ErrorInstanceTypeNotSupportedReason string           = "Instance Type not supported"
 
+	// Idempotency key reused with a different request
+	ErrorIdempotencyKeyMismatch       ServiceErrorCode = 42
+	ErrorIdempotencyKeyMismatchReason string           = "Idempotency key has already been used with a different request"
+
+	// Precondition of a conditional request failed
+	ErrorPreconditionFailed       ServiceErrorCode = 43
+	ErrorPreconditionFailedReason string           = "Precondition failed"
+
 	// Too Many requests error. Used by rate limiting
 	ErrorTooManyRequests       ServiceErrorCode = 429
 	ErrorTooManyRequestsReason string           = "Too Many requests"
@@ -257,6 +265,8 @@ func Errors() ServiceErrors {
 		ServiceError{ErrorMalformedServiceAccountDesc, ErrorMalformedServiceAccountDescReason, http.StatusBadRequest, nil},
 		ServiceError{ErrorMalformedServiceAccountId, ErrorMalformedServiceAccountIdReason, http.StatusBadRequest, nil},
 		ServiceError{ErrorMaxLimitForServiceAccountsReached, ErrorMaxLimitForServiceAccountsReachedReason, http.StatusForbidden, nil},
+		ServiceError{ErrorIdempotencyKeyMismatch, ErrorIdempotencyKeyMismatchReason, http.StatusUnprocessableEntity, nil},
+		ServiceError{ErrorPreconditionFailed, ErrorPreconditionFailedReason, http.StatusPreconditionFailed, nil},
 	}
 }
 
@@ -509,6 +519,14 @@ func Conflict(reason string, values ...interface{}) *ServiceError {
 	return New(ErrorConflict, reason, values...)
 }
 
+func PreconditionFailed(reason string, values ...interface{}) *ServiceError {
+	return New(ErrorPreconditionFailed, reason, values...)
+}
+
+func TooManyRequests(reason string, values ...interface{}) *ServiceError {
+	return New(ErrorTooManyRequests, reason, values...)
+}
+
 func Validation(reason string, values ...interface{}) *ServiceError {
 	return New(ErrorValidation, reason, values...)
 }
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+type ConfigsHandler struct {
+	configReloader *environments.ConfigReloader
+}
+
+func NewConfigsHandler(configReloader *environments.ConfigReloader) *ConfigsHandler {
+	return &ConfigsHandler{
+		configReloader: configReloader,
+	}
+}
+
+func PresentConfigStatus(status environments.ConfigReloadStatus) api.ConfigStatus {
+	res := api.ConfigStatus{
+		Kind:              "Config",
+		Name:              status.Name,
+		Files:             status.Files,
+		Checksum:          status.Checksum,
+		LoadedAt:          status.LoadedAt,
+		LastReloadAttempt: status.LastReloadAttempt,
+	}
+	if res.Files == nil {
+		res.Files = []string{}
+	}
+	if status.LastReloadError != nil {
+		res.LastReloadError = status.LastReloadError.Error()
+	}
+	return res
+}
+
+// List returns the checksum of the configuration files loaded by the instance serving the request, for each of the
+// configurations that can be reloaded
+func (h *ConfigsHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			statuses := h.configReloader.Statuses()
+			configList := api.ConfigStatusList{
+				Kind:  "ConfigList",
+				Total: int32(len(statuses)),
+				Items: []api.ConfigStatus{},
+			}
+			for _, status := range statuses {
+				configList.Items = append(configList.Items, PresentConfigStatus(status))
+			}
+			return configList, nil
+		},
+	}
+
+	HandleList(w, r, cfg)
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+const (
+	ETagHeader    = "ETag"
+	IfMatchHeader = "If-Match"
+)
+
+// VersionETag returns the entity tag of a resource that has a monotonically increasing version
+func VersionETag(version int64) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// TimestampETag returns the entity tag of a resource without a version, derived from when it was last updated.
+// The timestamp is truncated to microseconds, the precision in which it is stored in the database.
+func TimestampETag(updatedAt time.Time) string {
+	return fmt.Sprintf(`"%d"`, updatedAt.UnixNano()/int64(time.Microsecond))
+}
+
+// SetETag sets the ETag header of the response, it must be called before the response is written
+func SetETag(w http.ResponseWriter, etag string) {
+	w.Header().Set(ETagHeader, etag)
+}
+
+// ValidateIfMatch checks the If-Match header of the request against the current entity tag of the resource returned by
+// getETag. To prevent two concurrent requests from both matching the same entity tag, the check takes a lock on the
+// resource in the transaction of the request, which is held until the request completes. The lock key is the resource
+// type and id, e.g. "connectors/<id>". Requests without an If-Match header are not checked.
+func ValidateIfMatch(r *http.Request, lockKey string, getETag func() (string, *errors.ServiceError)) Validate {
+	return func() *errors.ServiceError {
+		ifMatch := r.Header.Get(IfMatchHeader)
+		if ifMatch == "" {
+			return nil
+		}
+
+		if err := db.AdvisoryLock(r.Context(), lockKey); err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to check the %s header", IfMatchHeader)
+		}
+
+		etag, err := getETag()
+		if err != nil {
+			return err
+		}
+		for _, candidate := range strings.Split(ifMatch, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || candidate == etag {
+				return nil
+			}
+		}
+		return errors.PreconditionFailed("%s header %s does not match the current entity tag %s of the resource", IfMatchHeader, ifMatch, etag)
+	}
+}
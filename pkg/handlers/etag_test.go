@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func TestTimestampETag(t *testing.T) {
+	RegisterTestingT(t)
+	updatedAt := time.Date(2022, 4, 1, 10, 0, 0, 123456789, time.UTC)
+	// the nanoseconds are not stored in the database so they must not change the entity tag
+	Expect(TimestampETag(updatedAt)).To(Equal(TimestampETag(updatedAt.Truncate(time.Microsecond))))
+	Expect(TimestampETag(updatedAt)).NotTo(Equal(TimestampETag(updatedAt.Add(time.Microsecond))))
+}
+
+func TestValidateIfMatch(t *testing.T) {
+	tests := []struct {
+		name            string
+		ifMatch         string
+		withTransaction bool
+		wantErrCode     errors.ServiceErrorCode
+		wantLock        bool
+	}{
+		{
+			name: "should not check requests without If-Match header",
+		},
+		{
+			name:            "should succeed when the entity tag matches",
+			ifMatch:         `"1", "2"`,
+			withTransaction: true,
+			wantLock:        true,
+		},
+		{
+			name:            "should succeed when If-Match is a wildcard",
+			ifMatch:         "*",
+			withTransaction: true,
+			wantLock:        true,
+		},
+		{
+			name:            "should fail with precondition failed when the entity tag does not match",
+			ifMatch:         `"1"`,
+			withTransaction: true,
+			wantErrCode:     errors.ErrorPreconditionFailed,
+			wantLock:        true,
+		},
+		{
+			name:        "should fail when the request has no transaction",
+			ifMatch:     `"2"`,
+			wantErrCode: errors.ErrorGeneral,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := httptest.NewRequest(http.MethodPatch, "/connectors/123", nil)
+			if tt.ifMatch != "" {
+				req.Header.Set(IfMatchHeader, tt.ifMatch)
+			}
+			if tt.withTransaction {
+				connectionFactory := db.NewMockConnectionFactory(nil)
+				mocket.Catcher.Reset().NewMock().WithQuery("select txid_current()").WithReply([]map[string]interface{}{{"txid_current": 1}})
+				ctx, err := connectionFactory.NewContext(req.Context())
+				Expect(err).NotTo(HaveOccurred())
+				req = req.WithContext(ctx)
+			}
+			mocket.Catcher.NewMock().WithQuery("pg_advisory_xact_lock")
+
+			getETagCalls := 0
+			err := ValidateIfMatch(req, "connectors/123", func() (string, *errors.ServiceError) {
+				getETagCalls++
+				return VersionETag(2), nil
+			})()
+
+			if tt.wantErrCode != 0 {
+				Expect(err).NotTo(BeNil())
+				Expect(err.Code).To(Equal(tt.wantErrCode))
+			} else {
+				Expect(err).To(BeNil())
+			}
+			if tt.wantLock {
+				Expect(getETagCalls).To(Equal(1))
+			} else {
+				Expect(getETagCalls).To(Equal(0))
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 )
 
@@ -20,11 +21,13 @@ import (
 //   Validate is a list of Validation function that run in order, returning fast on the first error.
 //   Action is the specific logic a handler must take (e.g, find an object, save an object)
 //   ErrorHandler is the way errors are returned to the client
+//   IdempotencyService enables the Idempotency-Key header support of Handle when set
 type HandlerConfig struct {
-	MarshalInto  interface{}
-	Validate     []Validate
-	Action       HttpAction
-	ErrorHandler ErrorHandlerFunc
+	MarshalInto        interface{}
+	Validate           []Validate
+	Action             HttpAction
+	ErrorHandler       ErrorHandlerFunc
+	IdempotencyService idempotency.IdempotencyService
 }
 
 type EventStream struct {
@@ -58,6 +61,16 @@ func Handle(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig, httpStat
 		cfg.ErrorHandler = shared.HandleError
 	}
 
+	idempotentReq, replayed, idempotencyErr := startIdempotentRequest(w, r, cfg)
+	if idempotencyErr != nil {
+		errorHandler(r, w, cfg, idempotencyErr)
+		return
+	}
+	if replayed {
+		success(r)
+		return
+	}
+
 	if cfg.MarshalInto != nil {
 
 		err := json.NewDecoder(r.Body).Decode(&cfg.MarshalInto)
@@ -72,6 +85,7 @@ func Handle(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig, httpStat
 		//err = json.Unmarshal(bytes, &cfg.MarshalInto)
 
 		if err != nil {
+			idempotentReq.release(r)
 			errorHandler(r, w, cfg, errors.MalformedRequest("Invalid request format: %s", err))
 			return
 		}
@@ -80,6 +94,7 @@ func Handle(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig, httpStat
 	for _, v := range cfg.Validate {
 		err := v()
 		if err != nil {
+			idempotentReq.release(r)
 			errorHandler(r, w, cfg, err)
 			return
 		}
@@ -89,8 +104,10 @@ func Handle(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig, httpStat
 
 	switch {
 	case serviceErr != nil:
+		idempotentReq.release(r)
 		errorHandler(r, w, cfg, serviceErr)
 	default:
+		idempotentReq.complete(r, httpStatus, result)
 		shared.WriteJSONResponse(w, httpStatus, result)
 		success(r)
 	}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header clients can set so that retries of a create request
+	// return the response of the original request instead of creating the resource again
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on the responses that are replayed from a previous request
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+type idempotentRequest struct {
+	service   idempotency.IdempotencyService
+	key       string
+	principal string
+}
+
+// startIdempotentRequest reserves the Idempotency-Key of the request. It returns a nil idempotentRequest when the
+// handler does not support idempotency keys or when the client did not send one. When the key has already been
+// used for the same request, the stored response is written and replayed is set to true.
+func startIdempotentRequest(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig) (req *idempotentRequest, replayed bool, serviceErr *errors.ServiceError) {
+	key := r.Header.Get(IdempotencyKeyHeader)
+	if cfg.IdempotencyService == nil || key == "" {
+		return nil, false, nil
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, false, errors.BadRequest("%s header must not be longer than %d characters", IdempotencyKeyHeader, maxIdempotencyKeyLength)
+	}
+
+	claims, err := auth.GetClaimsFromContext(r.Context())
+	if err != nil {
+		return nil, false, errors.Unauthenticated("user not authenticated")
+	}
+	principal := auth.GetUsernameFromClaims(claims)
+
+	// the body is read here to compute the request hash, so it has to be restored for it to be unmarshalled
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, false, errors.MalformedRequest("Unable to read request body: %s", err)
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	requestHash := hashRequest(r, body)
+	existing, serviceErr := cfg.IdempotencyService.Reserve(r.Context(), key, principal, requestHash)
+	if serviceErr != nil {
+		return nil, false, serviceErr
+	}
+	if existing == nil {
+		return &idempotentRequest{
+			service:   cfg.IdempotencyService,
+			key:       key,
+			principal: principal,
+		}, false, nil
+	}
+
+	if existing.RequestHash != requestHash {
+		return nil, false, errors.New(errors.ErrorIdempotencyKeyMismatch, "%s '%s' has already been used with a different request", IdempotencyKeyHeader, key)
+	}
+	if !existing.IsCompleted() {
+		return nil, false, errors.Conflict("a request with %s '%s' is still being processed", IdempotencyKeyHeader, key)
+	}
+
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	shared.WriteJSONResponse(w, existing.ResponseCode, existing.ResponseBody)
+	return nil, true, nil
+}
+
+// complete records the response of the request so that it can be replayed
+func (i *idempotentRequest) complete(r *http.Request, httpStatus int, result interface{}) {
+	if i == nil {
+		return
+	}
+	body, err := json.Marshal(result)
+	if err != nil {
+		i.release(r)
+		return
+	}
+	if serviceErr := i.service.Complete(r.Context(), i.key, i.principal, httpStatus, body); serviceErr != nil {
+		ulog := logger.NewUHCLogger(r.Context())
+		ulog.Error(serviceErr)
+	}
+}
+
+// release frees the key so that a failed request can be retried with it
+func (i *idempotentRequest) release(r *http.Request) {
+	if i == nil {
+		return
+	}
+	if serviceErr := i.service.Release(r.Context(), i.key, i.principal); serviceErr != nil {
+		ulog := logger.NewUHCLogger(r.Context())
+		ulog.Error(serviceErr)
+	}
+}
+
+func hashRequest(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/gomega"
+)
+
+func TestHandle_IdempotencyKey(t *testing.T) {
+	const requestBody = `{"name":"test"}`
+	tests := []struct {
+		name               string
+		idempotencyKey     string
+		requestBody        string
+		reserve            func(ctx context.Context, key string, principal string, requestHash string) (*api.IdempotencyKey, *errors.ServiceError)
+		actionErr          *errors.ServiceError
+		wantCode           int
+		wantBody           string
+		wantActionCalls    int
+		wantCompleteCalls  int
+		wantReleaseCalls   int
+		wantReplayedHeader string
+	}{
+		{
+			name:            "should run the action without reserving a key when the header is not set",
+			requestBody:     requestBody,
+			wantCode:        http.StatusAccepted,
+			wantActionCalls: 1,
+		},
+		{
+			name:           "should record the response when the key has not been used yet",
+			idempotencyKey: "key-1",
+			requestBody:    requestBody,
+			reserve: func(ctx context.Context, key string, principal string, requestHash string) (*api.IdempotencyKey, *errors.ServiceError) {
+				return nil, nil
+			},
+			wantCode:          http.StatusAccepted,
+			wantActionCalls:   1,
+			wantCompleteCalls: 1,
+		},
+		{
+			name:           "should release the key when the action fails",
+			idempotencyKey: "key-1",
+			requestBody:    requestBody,
+			reserve: func(ctx context.Context, key string, principal string, requestHash string) (*api.IdempotencyKey, *errors.ServiceError) {
+				return nil, nil
+			},
+			actionErr:        errors.GeneralError("failed"),
+			wantCode:         http.StatusInternalServerError,
+			wantActionCalls:  1,
+			wantReleaseCalls: 1,
+		},
+		{
+			name:           "should replay the stored response when the key has already been used for the same request",
+			idempotencyKey: "key-1",
+			requestBody:    requestBody,
+			reserve: func(ctx context.Context, key string, principal string, requestHash string) (*api.IdempotencyKey, *errors.ServiceError) {
+				return &api.IdempotencyKey{
+					Key:          key,
+					Principal:    principal,
+					RequestHash:  requestHash,
+					ResponseCode: http.StatusAccepted,
+					ResponseBody: api.JSON(`{"id":"stored"}`),
+				}, nil
+			},
+			wantCode:           http.StatusAccepted,
+			wantBody:           `{"id":"stored"}`,
+			wantReplayedHeader: "true",
+		},
+		{
+			name:           "should reject a different request sent with the same key",
+			idempotencyKey: "key-1",
+			requestBody:    `{"name":"other"}`,
+			reserve: func(ctx context.Context, key string, principal string, requestHash string) (*api.IdempotencyKey, *errors.ServiceError) {
+				return &api.IdempotencyKey{
+					Key:          key,
+					Principal:    principal,
+					RequestHash:  "another-hash",
+					ResponseCode: http.StatusAccepted,
+				}, nil
+			},
+			wantCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "should return a conflict when the original request is still being processed",
+			idempotencyKey: "key-1",
+			requestBody:    requestBody,
+			reserve: func(ctx context.Context, key string, principal string, requestHash string) (*api.IdempotencyKey, *errors.ServiceError) {
+				return &api.IdempotencyKey{
+					Key:         key,
+					Principal:   principal,
+					RequestHash: requestHash,
+				}, nil
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name:           "should reject keys that are too long",
+			idempotencyKey: strings.Repeat("k", maxIdempotencyKeyLength+1),
+			requestBody:    requestBody,
+			wantCode:       http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			idempotencyService := &idempotency.IdempotencyServiceMock{
+				ReserveFunc: tt.reserve,
+				CompleteFunc: func(ctx context.Context, key string, principal string, responseCode int, responseBody []byte) *errors.ServiceError {
+					return nil
+				},
+				ReleaseFunc: func(ctx context.Context, key string, principal string) *errors.ServiceError {
+					return nil
+				},
+			}
+
+			var payload map[string]string
+			actionCalls := 0
+			cfg := &HandlerConfig{
+				MarshalInto: &payload,
+				Action: func() (interface{}, *errors.ServiceError) {
+					actionCalls++
+					if tt.actionErr != nil {
+						return nil, tt.actionErr
+					}
+					return payload, nil
+				},
+				IdempotencyService: idempotencyService,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/kafkas", strings.NewReader(tt.requestBody))
+			if tt.idempotencyKey != "" {
+				req.Header.Set(IdempotencyKeyHeader, tt.idempotencyKey)
+			}
+			token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"username": "test-user"})
+			req = req.WithContext(auth.SetTokenInContext(req.Context(), token))
+			rr := httptest.NewRecorder()
+
+			Handle(rr, req, cfg, http.StatusAccepted)
+
+			Expect(rr.Code).To(Equal(tt.wantCode))
+			Expect(actionCalls).To(Equal(tt.wantActionCalls))
+			Expect(idempotencyService.CompleteCalls()).To(HaveLen(tt.wantCompleteCalls))
+			Expect(idempotencyService.ReleaseCalls()).To(HaveLen(tt.wantReleaseCalls))
+			// the keys are stored in the transaction of the request held by its context
+			for _, reserveCall := range idempotencyService.ReserveCalls() {
+				Expect(reserveCall.Ctx).To(BeIdenticalTo(req.Context()))
+			}
+			Expect(rr.Header().Get(IdempotentReplayedHeader)).To(Equal(tt.wantReplayedHeader))
+			if tt.wantBody != "" {
+				Expect(rr.Body.String()).To(MatchJSON(tt.wantBody))
+			}
+			if tt.wantCompleteCalls > 0 {
+				completeCall := idempotencyService.CompleteCalls()[0]
+				Expect(completeCall.Principal).To(Equal("test-user"))
+				Expect(completeCall.ResponseCode).To(Equal(http.StatusAccepted))
+				Expect(completeCall.ResponseBody).To(MatchJSON(tt.requestBody))
+			}
+		})
+	}
+}
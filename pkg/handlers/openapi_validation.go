@@ -0,0 +1,426 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const (
+	openAPISchemasRef    = "#/components/schemas/"
+	jsonSchemaDefinition = "#/definitions/"
+	defaultMediaType     = "application/json"
+)
+
+var openAPIMethods = []string{
+	http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete,
+	http.MethodOptions, http.MethodHead, http.MethodPatch, http.MethodTrace,
+}
+
+// OpenAPIValidationMiddleware validates the path parameters, the query parameters and the body of the requests
+// against the operations of an OpenAPI document, before they reach the handlers. Requests that don't match any
+// operation of the document are left to the handlers.
+type OpenAPIValidationMiddleware struct {
+	operations []*openAPIOperation
+}
+
+type openAPIOperation struct {
+	method       string
+	path         string
+	segments     []string
+	parameters   []*openAPIParameter
+	bodyRequired bool
+	// bodies are the schemas of the request body, by media type
+	bodies map[string]*gojsonschema.Schema
+}
+
+type openAPIParameter struct {
+	name     string
+	in       string
+	required bool
+	explode  bool
+	// schemaType and itemsType are used to convert the raw values of the parameter before validating them
+	schemaType string
+	itemsType  string
+	schema     *gojsonschema.Schema
+}
+
+// NewOpenAPIValidationMiddleware creates the middleware validating the requests against the operations of
+// openAPIDefinitions, the JSON OpenAPI document returned by shared.LoadOpenAPISpec
+func NewOpenAPIValidationMiddleware(openAPIDefinitions []byte) (*OpenAPIValidationMiddleware, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(openAPIDefinitions, &doc); err != nil {
+		return nil, pkgerrors.Wrap(err, "unable to parse the OpenAPI document")
+	}
+
+	// the schemas of the components become the definitions of a JSON schema, that each schema of the operations
+	// is compiled with
+	definitions := map[string]interface{}{}
+	if schemas, ok := lookupRef(doc, strings.TrimSuffix(openAPISchemasRef, "/")).(map[string]interface{}); ok {
+		for name, schema := range schemas {
+			definitions[name] = toJSONSchema(schema)
+		}
+	}
+	compile := func(schema interface{}) (*gojsonschema.Schema, error) {
+		return gojsonschema.NewSchema(gojsonschema.NewGoLoader(map[string]interface{}{
+			"definitions": definitions,
+			"allOf":       []interface{}{toJSONSchema(schema)},
+		}))
+	}
+
+	middleware := &OpenAPIValidationMiddleware{}
+	paths, _ := doc["paths"].(map[string]interface{})
+	for path, item := range paths {
+		pathItem, _ := resolveRef(doc, item).(map[string]interface{})
+		for _, method := range openAPIMethods {
+			op, ok := pathItem[strings.ToLower(method)].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			operation, err := newOpenAPIOperation(doc, method, path, pathItem, op, compile)
+			if err != nil {
+				return nil, pkgerrors.Wrapf(err, "invalid operation %s %s", method, path)
+			}
+			middleware.operations = append(middleware.operations, operation)
+		}
+	}
+	sort.Slice(middleware.operations, func(i, j int) bool {
+		return middleware.operations[i].path+middleware.operations[i].method < middleware.operations[j].path+middleware.operations[j].method
+	})
+
+	return middleware, nil
+}
+
+func newOpenAPIOperation(doc map[string]interface{}, method string, path string, pathItem map[string]interface{}, op map[string]interface{}, compile func(interface{}) (*gojsonschema.Schema, error)) (*openAPIOperation, error) {
+	operation := &openAPIOperation{
+		method:   method,
+		path:     path,
+		segments: splitPath(path),
+		bodies:   map[string]*gojsonschema.Schema{},
+	}
+
+	// parameters of the operation override the ones of the path with the same name and location
+	parameters := map[string]map[string]interface{}{}
+	for _, params := range []interface{}{pathItem["parameters"], op["parameters"]} {
+		list, _ := params.([]interface{})
+		for _, p := range list {
+			if param, ok := resolveRef(doc, p).(map[string]interface{}); ok {
+				parameters[fmt.Sprintf("%v.%v", param["in"], param["name"])] = param
+			}
+		}
+	}
+	for _, param := range parameters {
+		in, _ := param["in"].(string)
+		if in != "path" && in != "query" {
+			continue
+		}
+		name, _ := param["name"].(string)
+		required, _ := param["required"].(bool)
+		explode, ok := param["explode"].(bool)
+		if !ok {
+			explode = true
+		}
+		parameter := &openAPIParameter{
+			name:     name,
+			in:       in,
+			required: required || in == "path",
+			explode:  explode,
+		}
+		if schema, ok := resolveRef(doc, param["schema"]).(map[string]interface{}); ok {
+			parameter.schemaType, _ = schema["type"].(string)
+			if items, ok := resolveRef(doc, schema["items"]).(map[string]interface{}); ok {
+				parameter.itemsType, _ = items["type"].(string)
+			}
+			compiled, err := compile(schema)
+			if err != nil {
+				return nil, pkgerrors.Wrapf(err, "invalid schema of parameter '%s'", name)
+			}
+			parameter.schema = compiled
+		}
+		operation.parameters = append(operation.parameters, parameter)
+	}
+	sort.Slice(operation.parameters, func(i, j int) bool {
+		return operation.parameters[i].in+operation.parameters[i].name < operation.parameters[j].in+operation.parameters[j].name
+	})
+
+	if requestBody, ok := resolveRef(doc, op["requestBody"]).(map[string]interface{}); ok {
+		operation.bodyRequired, _ = requestBody["required"].(bool)
+		content, _ := requestBody["content"].(map[string]interface{})
+		for mediaType, c := range content {
+			mediaTypeContent, _ := c.(map[string]interface{})
+			if schema, ok := mediaTypeContent["schema"]; ok {
+				compiled, err := compile(schema)
+				if err != nil {
+					return nil, pkgerrors.Wrapf(err, "invalid schema of request body '%s'", mediaType)
+				}
+				operation.bodies[mediaType] = compiled
+			}
+		}
+	}
+
+	return operation, nil
+}
+
+// Middleware handler to validate the requests against the operations of the OpenAPI document
+func (middleware *OpenAPIValidationMiddleware) Validate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		operation, pathParams := middleware.findOperation(r)
+		if operation != nil {
+			if err := operation.validate(r, pathParams); err != nil {
+				shared.HandleError(r, w, err)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// findOperation returns the operation matching the method and path of the request and the values of its path
+// parameters. When several operations match, the one with the most literal path segments wins, e.g.
+// /kafka_connector_namespaces/eval over /kafka_connector_namespaces/{connector_namespace_id}.
+func (middleware *OpenAPIValidationMiddleware) findOperation(r *http.Request) (*openAPIOperation, map[string]string) {
+	segments := splitPath(r.URL.Path)
+
+	var found *openAPIOperation
+	var foundParams map[string]string
+	foundLiterals := -1
+	for _, operation := range middleware.operations {
+		if operation.method != r.Method || len(operation.segments) != len(segments) {
+			continue
+		}
+		params := map[string]string{}
+		literals := 0
+		for i, segment := range operation.segments {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				params[strings.Trim(segment, "{}")] = segments[i]
+			} else if segment == segments[i] {
+				literals++
+			} else {
+				literals = -1
+				break
+			}
+		}
+		if literals > foundLiterals {
+			found, foundParams, foundLiterals = operation, params, literals
+		}
+	}
+	return found, foundParams
+}
+
+func (operation *openAPIOperation) validate(r *http.Request, pathParams map[string]string) *errors.ServiceError {
+	var violations []string
+
+	query := r.URL.Query()
+	for _, parameter := range operation.parameters {
+		var values []string
+		if parameter.in == "path" {
+			values = []string{pathParams[parameter.name]}
+		} else if v, ok := query[parameter.name]; ok {
+			values = v
+		}
+		violations = append(violations, parameter.validate(values)...)
+	}
+
+	if len(operation.bodies) > 0 {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return errors.MalformedRequest("Unable to read request body: %s", err)
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		if len(bytes.TrimSpace(body)) == 0 {
+			if operation.bodyRequired {
+				violations = append(violations, "body: is required")
+			}
+		} else if schema := operation.bodySchema(r.Header.Get("Content-Type")); schema != nil {
+			var document interface{}
+			if err := json.Unmarshal(body, &document); err != nil {
+				return errors.MalformedRequest("Invalid request format: %s", err)
+			}
+			result, err := schema.Validate(gojsonschema.NewGoLoader(document))
+			if err != nil {
+				return errors.MalformedRequest("Invalid request format: %s", err)
+			}
+			violations = append(violations, resultViolations("body", result)...)
+		}
+	}
+
+	if len(violations) > 0 {
+		return errors.Validation("request does not conform to the OpenAPI specification: %s", strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// bodySchema returns the schema of the body for the given content type. Bodies of other media types than the
+// ones of the operation are validated as JSON, which is what the handlers decode them as.
+func (operation *openAPIOperation) bodySchema(contentType string) *gojsonschema.Schema {
+	mediaType := defaultMediaType
+	if contentType != "" {
+		if parsed, _, err := mime.ParseMediaType(contentType); err == nil {
+			mediaType = parsed
+		}
+	}
+	if schema, ok := operation.bodies[mediaType]; ok {
+		return schema
+	}
+	return operation.bodies[defaultMediaType]
+}
+
+func (parameter *openAPIParameter) validate(values []string) []string {
+	field := fmt.Sprintf("%s.%s", parameter.in, parameter.name)
+	if len(values) == 0 {
+		if parameter.required {
+			return []string{fmt.Sprintf("%s: is required", field)}
+		}
+		return nil
+	}
+	if parameter.schema == nil {
+		return nil
+	}
+
+	var value interface{}
+	if parameter.schemaType == "array" {
+		if !parameter.explode {
+			values = strings.Split(values[0], ",")
+		}
+		items := make([]interface{}, 0, len(values))
+		for _, v := range values {
+			item, err := convertParameterValue(v, parameter.itemsType)
+			if err != nil {
+				return []string{fmt.Sprintf("%s: '%s' is not a valid %s", field, v, parameter.itemsType)}
+			}
+			items = append(items, item)
+		}
+		value = items
+	} else {
+		v, err := convertParameterValue(values[0], parameter.schemaType)
+		if err != nil {
+			return []string{fmt.Sprintf("%s: '%s' is not a valid %s", field, values[0], parameter.schemaType)}
+		}
+		value = v
+	}
+
+	result, err := parameter.schema.Validate(gojsonschema.NewGoLoader(value))
+	if err != nil {
+		return []string{fmt.Sprintf("%s: %v", field, err)}
+	}
+	return resultViolations(field, result)
+}
+
+func convertParameterValue(value string, schemaType string) (interface{}, error) {
+	switch schemaType {
+	case "integer":
+		return strconv.ParseInt(value, 10, 64)
+	case "number":
+		return strconv.ParseFloat(value, 64)
+	case "boolean":
+		return strconv.ParseBool(value)
+	default:
+		return value, nil
+	}
+}
+
+// resultViolations describes the errors of a validation result, prefixing their field paths with field
+func resultViolations(field string, result *gojsonschema.Result) []string {
+	var violations []string
+	for _, e := range result.Errors() {
+		// the sub schemas of the allOf wrapping each schema repeat the errors of the schema
+		if e.Type() == "number_all_of" {
+			continue
+		}
+		path := field
+		if f := e.Field(); f != gojsonschema.STRING_ROOT_SCHEMA_PROPERTY {
+			path = fmt.Sprintf("%s.%s", field, f)
+		}
+		// required errors are reported on the object missing the property
+		if property, ok := e.Details()["property"].(string); ok && e.Type() == "required" {
+			violations = append(violations, fmt.Sprintf("%s.%s: is required", path, property))
+			continue
+		}
+		violations = append(violations, fmt.Sprintf("%s: %s", path, e.Description()))
+	}
+	return violations
+}
+
+// toJSONSchema converts an OpenAPI schema to a JSON schema: references to the schemas of the components become
+// references to the definitions, nullable schemas also accept null and examples are dropped
+func toJSONSchema(node interface{}) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(n))
+		for key, value := range n {
+			switch key {
+			case "example", "examples":
+				continue
+			case "$ref":
+				if ref, ok := value.(string); ok && strings.HasPrefix(ref, openAPISchemasRef) {
+					value = jsonSchemaDefinition + strings.TrimPrefix(ref, openAPISchemasRef)
+				}
+				converted[key] = value
+			default:
+				converted[key] = toJSONSchema(value)
+			}
+		}
+		if nullable, _ := n["nullable"].(bool); nullable {
+			if t, ok := converted["type"].(string); ok {
+				converted["type"] = []interface{}{t, "null"}
+			}
+			if enum, ok := converted["enum"].([]interface{}); ok {
+				converted["enum"] = append(enum, nil)
+			}
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(n))
+		for i, value := range n {
+			converted[i] = toJSONSchema(value)
+		}
+		return converted
+	default:
+		return node
+	}
+}
+
+// resolveRef returns the node referenced by a '$ref' local to the document, or the node itself
+func resolveRef(doc map[string]interface{}, node interface{}) interface{} {
+	if m, ok := node.(map[string]interface{}); ok {
+		if ref, ok := m["$ref"].(string); ok {
+			return lookupRef(doc, ref)
+		}
+	}
+	return node
+}
+
+func lookupRef(doc map[string]interface{}, ref string) interface{} {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil
+	}
+	var node interface{} = doc
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		m, ok := node.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		node = m[token]
+	}
+	return node
+}
+
+func splitPath(path string) []string {
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return strings.Split(path, "/")
+}
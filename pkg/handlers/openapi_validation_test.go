@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/gomega"
+)
+
+const testOpenAPISpec = `
+openapi: 3.0.0
+paths:
+  /api/test/v1/things:
+    post:
+      parameters:
+        - in: query
+          name: async
+          required: true
+          schema:
+            type: boolean
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/ThingRequest'
+    get:
+      parameters:
+        - $ref: '#/components/parameters/size'
+        - in: query
+          name: filters
+          schema:
+            type: array
+            items:
+              type: string
+              enum: [a, b]
+  /api/test/v1/things/{id}:
+    parameters:
+      - in: path
+        name: id
+        schema:
+          type: string
+          pattern: '^[a-z0-9]+$'
+    get:
+      responses: {}
+  /api/test/v1/things/search:
+    get:
+      responses: {}
+components:
+  parameters:
+    size:
+      in: query
+      name: size
+      schema:
+        type: integer
+        minimum: 1
+        maximum: 100
+  schemas:
+    ThingRequest:
+      type: object
+      required:
+        - name
+      properties:
+        name:
+          type: string
+          maxLength: 8
+        spec:
+          $ref: '#/components/schemas/ThingSpec'
+        enabled:
+          type: boolean
+          nullable: true
+      example:
+        $ref: '#/components/examples/ThingExample'
+    ThingSpec:
+      type: object
+      required:
+        - replicas
+      properties:
+        replicas:
+          type: integer
+          minimum: 1
+`
+
+func TestOpenAPIValidationMiddleware_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		url           string
+		contentType   string
+		body          string
+		wantErrCode   errors.ServiceErrorCode
+		wantViolation []string
+	}{
+		{
+			name:   "should accept a valid request",
+			method: http.MethodPost,
+			url:    "/api/test/v1/things?async=true",
+			body:   `{"name": "thing", "spec": {"replicas": 1}, "enabled": null}`,
+		},
+		{
+			name:          "should reject a request without a required query parameter",
+			method:        http.MethodPost,
+			url:           "/api/test/v1/things",
+			body:          `{"name": "thing"}`,
+			wantErrCode:   errors.ErrorValidation,
+			wantViolation: []string{"query.async: is required"},
+		},
+		{
+			name:          "should reject a query parameter of the wrong type",
+			method:        http.MethodPost,
+			url:           "/api/test/v1/things?async=maybe",
+			body:          `{"name": "thing"}`,
+			wantErrCode:   errors.ErrorValidation,
+			wantViolation: []string{"query.async: 'maybe' is not a valid boolean"},
+		},
+		{
+			name:          "should reject a request without its required body",
+			method:        http.MethodPost,
+			url:           "/api/test/v1/things?async=true",
+			wantErrCode:   errors.ErrorValidation,
+			wantViolation: []string{"body: is required"},
+		},
+		{
+			name:        "should reject a body that is not JSON",
+			method:      http.MethodPost,
+			url:         "/api/test/v1/things?async=true",
+			body:        `{"name":`,
+			wantErrCode: errors.ErrorMalformedRequest,
+		},
+		{
+			name:          "should reject a body that does not conform to its schema with the paths of the fields",
+			method:        http.MethodPost,
+			url:           "/api/test/v1/things?async=true",
+			contentType:   "application/json; charset=utf-8",
+			body:          `{"name": "a-very-long-name", "spec": {}, "enabled": "yes"}`,
+			wantErrCode:   errors.ErrorValidation,
+			wantViolation: []string{"body.name: String length must be less than or equal to 8", "body.spec.replicas: is required", "body.enabled: Invalid type"},
+		},
+		{
+			name:   "should accept valid query parameters",
+			method: http.MethodGet,
+			url:    "/api/test/v1/things?size=10&filters=a&filters=b",
+		},
+		{
+			name:          "should reject a query parameter out of its range",
+			method:        http.MethodGet,
+			url:           "/api/test/v1/things?size=1000",
+			wantErrCode:   errors.ErrorValidation,
+			wantViolation: []string{"query.size: Must be less than or equal to 100"},
+		},
+		{
+			name:          "should reject an array query parameter with an invalid item",
+			method:        http.MethodGet,
+			url:           "/api/test/v1/things?filters=a&filters=c",
+			wantErrCode:   errors.ErrorValidation,
+			wantViolation: []string{"query.filters.1: 1 must be one of the following"},
+		},
+		{
+			name:          "should reject a path parameter that does not conform to its schema",
+			method:        http.MethodGet,
+			url:           "/api/test/v1/things/NOT_VALID",
+			wantErrCode:   errors.ErrorValidation,
+			wantViolation: []string{"path.id: Does not match pattern"},
+		},
+		{
+			name:   "should prefer the operations with literal path segments",
+			method: http.MethodGet,
+			url:    "/api/test/v1/things/search",
+		},
+		{
+			name:   "should not validate requests that don't match any operation",
+			method: http.MethodDelete,
+			url:    "/api/test/v1/things/NOT_VALID",
+		},
+	}
+
+	spec, err := yaml.YAMLToJSON([]byte(testOpenAPISpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	middleware, err := NewOpenAPIValidationMiddleware(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			var handledBody string
+			handler := middleware.Validate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				handledBody = string(body)
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(tt.method, tt.url, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			if tt.wantErrCode == 0 {
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				// the body must still be readable by the handler
+				Expect(handledBody).To(Equal(tt.body))
+				return
+			}
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring(errors.CodeStr(tt.wantErrCode)))
+			for _, violation := range tt.wantViolation {
+				Expect(recorder.Body.String()).To(ContainSubstring(violation))
+			}
+		})
+	}
+}
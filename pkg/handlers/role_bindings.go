@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
+	"github.com/gorilla/mux"
+)
+
+// RoleBindingsHandler serves the role bindings and the permissions of the public APIs of the kafka and connector services
+type RoleBindingsHandler struct {
+	roleBindingService rbac.RoleBindingService
+	basePath           string
+}
+
+// NewRoleBindingsHandler returns the handler of the role bindings of the API served under the given base path, e.g.
+// /api/kafkas_mgmt/v1
+func NewRoleBindingsHandler(roleBindingService rbac.RoleBindingService, basePath string) *RoleBindingsHandler {
+	return &RoleBindingsHandler{
+		roleBindingService: roleBindingService,
+		basePath:           strings.TrimSuffix(basePath, "/"),
+	}
+}
+
+// ConvertRoleBindingRequest returns the role binding of the organisation requested by the given user
+func ConvertRoleBindingRequest(request api.RoleBindingRequest, organisationId string, createdBy string) *api.RoleBinding {
+	return &api.RoleBinding{
+		OrganisationId: organisationId,
+		Subject:        request.Subject,
+		SubjectType:    request.SubjectType,
+		Role:           request.Role,
+		CreatedBy:      createdBy,
+	}
+}
+
+// PresentRoleBinding returns the response of the role binding, its href being relative to the given base path
+func PresentRoleBinding(roleBinding *api.RoleBinding, basePath string) api.RoleBindingResponse {
+	return api.RoleBindingResponse{
+		Id:             roleBinding.ID,
+		Kind:           "RoleBinding",
+		Href:           fmt.Sprintf("%s/role_bindings/%s", basePath, roleBinding.ID),
+		OrganisationId: roleBinding.OrganisationId,
+		Subject:        roleBinding.Subject,
+		SubjectType:    roleBinding.SubjectType,
+		Role:           roleBinding.Role,
+		CreatedBy:      roleBinding.CreatedBy,
+		CreatedAt:      roleBinding.CreatedAt,
+	}
+}
+
+// PresentPermissions presents the role of the subject and the permissions it grants
+func PresentPermissions(subject string, organisationId string, role rbac.Role) api.Permissions {
+	permissions := api.Permissions{
+		Kind:           "Permissions",
+		Subject:        subject,
+		OrganisationId: organisationId,
+		Role:           string(role),
+		Grants:         []api.PermissionGrant{},
+	}
+	for _, grant := range role.Grants() {
+		permissions.Grants = append(permissions.Grants, api.PermissionGrant{
+			ResourceType: string(grant.ResourceType),
+			Permission:   string(grant.Permission),
+			Scope:        string(grant.Scope),
+		})
+	}
+	return permissions
+}
+
+func (h *RoleBindingsHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			claims, err := auth.GetClaimsFromContext(ctx)
+			if err != nil {
+				return nil, errors.Unauthenticated("user not authenticated")
+			}
+			if svcErr := rbac.AuthorizeAny(ctx, rbac.ResourceRoleBinding, rbac.PermissionRead); svcErr != nil {
+				return nil, svcErr
+			}
+
+			roleBindings, svcErr := h.roleBindingService.List(auth.GetOrgIdFromClaims(claims))
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			roleBindingList := api.RoleBindingResponseList{
+				Kind:  "RoleBindingList",
+				Total: int32(len(roleBindings)),
+				Items: []api.RoleBindingResponse{},
+			}
+			for _, roleBinding := range roleBindings {
+				roleBindingList.Items = append(roleBindingList.Items, PresentRoleBinding(roleBinding, h.basePath))
+			}
+			return roleBindingList, nil
+		},
+	}
+
+	HandleList(w, r, cfg)
+}
+
+func (h *RoleBindingsHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var roleBindingRequest api.RoleBindingRequest
+	cfg := &HandlerConfig{
+		MarshalInto: &roleBindingRequest,
+		Validate: []Validate{
+			ValidateMinLength(&roleBindingRequest.Subject, "subject", 1),
+			ValidateRoleBinding(&roleBindingRequest.SubjectType, &roleBindingRequest.Role),
+			func() *errors.ServiceError {
+				return rbac.AuthorizeAny(r.Context(), rbac.ResourceRoleBinding, rbac.PermissionCreate)
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := auth.GetClaimsFromContext(r.Context())
+			if err != nil {
+				return nil, errors.Unauthenticated("user not authenticated")
+			}
+			orgId := auth.GetOrgIdFromClaims(claims)
+			if orgId == "" {
+				return nil, errors.Forbidden("role bindings can only be created by the members of an organisation")
+			}
+
+			roleBinding := ConvertRoleBindingRequest(roleBindingRequest, orgId, auth.GetUsernameFromClaims(claims))
+			if svcErr := h.roleBindingService.Create(roleBinding); svcErr != nil {
+				return nil, svcErr
+			}
+			return PresentRoleBinding(roleBinding, h.basePath), nil
+		},
+	}
+
+	Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h *RoleBindingsHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	cfg := &HandlerConfig{
+		Validate: []Validate{
+			ValidateMinLength(&id, "id", 1),
+			func() *errors.ServiceError {
+				return rbac.AuthorizeAny(r.Context(), rbac.ResourceRoleBinding, rbac.PermissionDelete)
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := auth.GetClaimsFromContext(r.Context())
+			if err != nil {
+				return nil, errors.Unauthenticated("user not authenticated")
+			}
+			return nil, h.roleBindingService.Delete(auth.GetOrgIdFromClaims(claims), id)
+		},
+	}
+
+	HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// GetPermissions returns the role of the principal of the request and the permissions granted to it
+func (h *RoleBindingsHandler) GetPermissions(w http.ResponseWriter, r *http.Request) {
+	cfg := &HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			claims, err := auth.GetClaimsFromContext(ctx)
+			if err != nil {
+				return nil, errors.Unauthenticated("user not authenticated")
+			}
+			return PresentPermissions(auth.GetUsernameFromClaims(claims), auth.GetOrgIdFromClaims(claims), rbac.GetRoleFromContext(ctx)), nil
+		},
+	}
+
+	HandleGet(w, r, cfg)
+}
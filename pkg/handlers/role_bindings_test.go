@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+)
+
+var (
+	roleBindingsAdmin  = jwt.MapClaims{"username": "org-admin", "org_id": "org-id", "is_org_admin": true}
+	roleBindingsEditor = jwt.MapClaims{"username": "editor", "org_id": "org-id"}
+)
+
+const roleBindingsBasePath = "/api/kafkas_mgmt/v1"
+
+func buildRoleBindingsRequest(method string, body io.Reader, claims jwt.MapClaims) *http.Request {
+	ctx := auth.SetTokenInContext(context.Background(), &jwt.Token{Claims: claims})
+	ctx = rbac.SetRoleContext(ctx, rbac.DefaultRole(claims))
+	req := httptest.NewRequest(method, roleBindingsBasePath+"/role_bindings", body).WithContext(ctx)
+	return mux.SetURLVars(req, map[string]string{"id": "role-binding-id"})
+}
+
+func Test_RoleBindingsHandler_List(t *testing.T) {
+	tests := []struct {
+		name     string
+		basePath string
+		wantHref string
+	}{
+		{
+			name:     "should list the role bindings of the kafka API",
+			basePath: "/api/kafkas_mgmt/v1",
+			wantHref: "/api/kafkas_mgmt/v1/role_bindings/role-binding-id",
+		},
+		{
+			name:     "should list the role bindings of the connector API",
+			basePath: "/api/connector_mgmt/v1/",
+			wantHref: "/api/connector_mgmt/v1/role_bindings/role-binding-id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			service := &rbac.RoleBindingServiceMock{
+				ListFunc: func(orgId string) (api.RoleBindingList, *errors.ServiceError) {
+					Expect(orgId).To(Equal("org-id"))
+					return api.RoleBindingList{
+						{Meta: api.Meta{ID: "role-binding-id"}, OrganisationId: orgId, Subject: "editor", SubjectType: string(rbac.SubjectTypeUser), Role: string(rbac.RoleEditor)},
+					}, nil
+				},
+			}
+
+			rw := httptest.NewRecorder()
+			NewRoleBindingsHandler(service, tt.basePath).List(rw, buildRoleBindingsRequest(http.MethodGet, nil, roleBindingsEditor))
+			Expect(rw.Code).To(Equal(http.StatusOK))
+
+			var list api.RoleBindingResponseList
+			Expect(json.Unmarshal(rw.Body.Bytes(), &list)).To(Succeed())
+			Expect(list.Kind).To(Equal("RoleBindingList"))
+			Expect(list.Total).To(Equal(int32(1)))
+			Expect(list.Items).To(HaveLen(1))
+			Expect(list.Items[0].Kind).To(Equal("RoleBinding"))
+			Expect(list.Items[0].Href).To(Equal(tt.wantHref))
+			Expect(list.Items[0].Role).To(Equal(string(rbac.RoleEditor)))
+		})
+	}
+}
+
+func Test_RoleBindingsHandler_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		claims     jwt.MapClaims
+		body       string
+		createErr  *errors.ServiceError
+		wantStatus int
+		wantCreate bool
+	}{
+		{
+			name:       "should bind the role when the user is an administrator of the organisation",
+			claims:     roleBindingsAdmin,
+			body:       `{"subject": "editor", "subject_type": "user", "role": "editor"}`,
+			wantStatus: http.StatusCreated,
+			wantCreate: true,
+		},
+		{
+			name:       "should deny editors to bind roles",
+			claims:     roleBindingsEditor,
+			body:       `{"subject": "other-member", "subject_type": "user", "role": "admin"}`,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "should reject an unknown role",
+			claims:     roleBindingsAdmin,
+			body:       `{"subject": "editor", "subject_type": "user", "role": "owner"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "should reject an unknown subject type",
+			claims:     roleBindingsAdmin,
+			body:       `{"subject": "editor", "subject_type": "group", "role": "viewer"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "should return a conflict when the subject is already bound to a role",
+			claims:     roleBindingsAdmin,
+			body:       `{"subject": "editor", "subject_type": "user", "role": "viewer"}`,
+			createErr:  errors.Conflict("Subject 'editor' is already bound to the role 'editor'"),
+			wantStatus: http.StatusConflict,
+			wantCreate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			created := false
+			service := &rbac.RoleBindingServiceMock{
+				CreateFunc: func(roleBinding *api.RoleBinding) *errors.ServiceError {
+					created = true
+					Expect(roleBinding.OrganisationId).To(Equal("org-id"))
+					Expect(roleBinding.CreatedBy).To(Equal("org-admin"))
+					roleBinding.ID = "role-binding-id"
+					return tt.createErr
+				},
+			}
+
+			rw := httptest.NewRecorder()
+			NewRoleBindingsHandler(service, roleBindingsBasePath).Create(rw, buildRoleBindingsRequest(http.MethodPost, strings.NewReader(tt.body), tt.claims))
+			Expect(rw.Code).To(Equal(tt.wantStatus), rw.Body.String())
+			Expect(created).To(Equal(tt.wantCreate))
+			if tt.wantStatus == http.StatusCreated {
+				var roleBinding api.RoleBindingResponse
+				Expect(json.Unmarshal(rw.Body.Bytes(), &roleBinding)).To(Succeed())
+				Expect(roleBinding.Id).To(Equal("role-binding-id"))
+				Expect(roleBinding.Subject).To(Equal("editor"))
+				Expect(roleBinding.Href).To(Equal("/api/kafkas_mgmt/v1/role_bindings/role-binding-id"))
+			}
+		})
+	}
+}
+
+func Test_RoleBindingsHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name       string
+		claims     jwt.MapClaims
+		deleteErr  *errors.ServiceError
+		wantStatus int
+	}{
+		{
+			name:       "should delete the role binding when the user is an administrator of the organisation",
+			claims:     roleBindingsAdmin,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "should deny editors to delete role bindings",
+			claims:     roleBindingsEditor,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "should return not found when the organisation has no role binding with the id",
+			claims:     roleBindingsAdmin,
+			deleteErr:  errors.NotFound("RoleBinding with id='role-binding-id' not found"),
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			service := &rbac.RoleBindingServiceMock{
+				DeleteFunc: func(orgId string, id string) *errors.ServiceError {
+					Expect(orgId).To(Equal("org-id"))
+					Expect(id).To(Equal("role-binding-id"))
+					return tt.deleteErr
+				},
+			}
+
+			rw := httptest.NewRecorder()
+			NewRoleBindingsHandler(service, roleBindingsBasePath).Delete(rw, buildRoleBindingsRequest(http.MethodDelete, nil, tt.claims))
+			Expect(rw.Code).To(Equal(tt.wantStatus))
+		})
+	}
+}
+
+func Test_RoleBindingsHandler_GetPermissions(t *testing.T) {
+	RegisterTestingT(t)
+	rw := httptest.NewRecorder()
+	NewRoleBindingsHandler(&rbac.RoleBindingServiceMock{}, roleBindingsBasePath).GetPermissions(rw, buildRoleBindingsRequest(http.MethodGet, nil, roleBindingsEditor))
+	Expect(rw.Code).To(Equal(http.StatusOK))
+
+	var permissions api.Permissions
+	Expect(json.Unmarshal(rw.Body.Bytes(), &permissions)).To(Succeed())
+	Expect(permissions.Kind).To(Equal("Permissions"))
+	Expect(permissions.Subject).To(Equal("editor"))
+	Expect(permissions.OrganisationId).To(Equal("org-id"))
+	Expect(permissions.Role).To(Equal(string(rbac.RoleEditor)))
+	Expect(permissions.Grants).To(ContainElement(api.PermissionGrant{
+		ResourceType: string(rbac.ResourceKafka), Permission: string(rbac.PermissionUpdate), Scope: string(rbac.ScopeOwned),
+	}))
+	Expect(permissions.Grants).ToNot(ContainElement(api.PermissionGrant{
+		ResourceType: string(rbac.ResourceRoleBinding), Permission: string(rbac.PermissionCreate), Scope: string(rbac.ScopeOrganisation),
+	}))
+}
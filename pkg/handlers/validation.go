@@ -10,6 +10,7 @@ import (
 	"strconv"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
 )
 
 var (
@@ -114,6 +115,19 @@ func ValidateMinLength(value *string, field string, min int) Validate {
 	}
 }
 
+// ValidateRoleBinding validates the subject type and the role of a role binding request
+func ValidateRoleBinding(subjectType *string, role *string) Validate {
+	return func() *errors.ServiceError {
+		if !rbac.SubjectType(*subjectType).IsValid() {
+			return errors.BadRequest("subject_type must be one of %q or %q", rbac.SubjectTypeUser, rbac.SubjectTypeServiceAccount)
+		}
+		if !rbac.Role(*role).IsValid() {
+			return errors.BadRequest("role must be one of %v", rbac.Roles)
+		}
+		return nil
+	}
+}
+
 func ValidateJsonSchema(schemaName string, schemaLoader gojsonschema.JSONLoader, documentName string, documentLoader gojsonschema.JSONLoader) *errors.ServiceError {
 	schema, err := gojsonschema.NewSchema(schemaLoader)
 	if err != nil {
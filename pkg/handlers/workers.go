@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/gorilla/mux"
+)
+
+type WorkersHandler struct {
+	leaderElectionManager *workers.LeaderElectionManager
+	signalBus             signalbus.SignalBus
+}
+
+func NewWorkersHandler(leaderElectionManager *workers.LeaderElectionManager, signalBus signalbus.SignalBus) *WorkersHandler {
+	return &WorkersHandler{
+		leaderElectionManager: leaderElectionManager,
+		signalBus:             signalBus,
+	}
+}
+
+func PresentWorkerStatus(status workers.WorkerStatus) api.WorkerStatus {
+	res := api.WorkerStatus{
+		Kind:         "Worker",
+		ID:           status.ID,
+		Type:         status.WorkerType,
+		Running:      status.Running,
+		Leader:       status.Leader,
+		LeaseExpires: status.LeaseExpires,
+		LastRunStart: status.LastRunStart,
+		LastRunEnd:   status.LastRunEnd,
+		LastErrors:   []string{},
+	}
+	if status.LastRunEnd != nil {
+		res.LastRunDuration = status.LastRunDuration.String()
+	}
+	for _, err := range status.LastErrors {
+		res.LastErrors = append(res.LastErrors, err.Error())
+	}
+	return res
+}
+
+func (h *WorkersHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			statuses, err := h.leaderElectionManager.GetWorkerStatuses()
+			if err != nil {
+				return nil, errors.GeneralError("unable to list workers: %s", err.Error())
+			}
+
+			workerList := api.WorkerStatusList{
+				Kind:  "WorkerList",
+				Total: int32(len(statuses)),
+				Items: []api.WorkerStatus{},
+			}
+			for _, status := range statuses {
+				workerList.Items = append(workerList.Items, PresentWorkerStatus(status))
+			}
+
+			return workerList, nil
+		},
+	}
+
+	HandleList(w, r, cfg)
+}
+
+// Reconcile triggers an immediate reconcile loop of the worker of the given type on whichever instance holds its leader lease
+func (h *WorkersHandler) Reconcile(w http.ResponseWriter, r *http.Request) {
+	workerType := mux.Vars(r)["type"]
+	cfg := &HandlerConfig{
+		Validate: []Validate{
+			func() *errors.ServiceError {
+				if !h.leaderElectionManager.HasWorkerType(workerType) {
+					return errors.NotFound("worker type '%s' not found", workerType)
+				}
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			h.signalBus.Notify("reconcile:" + workerType)
+			return nil, nil
+		},
+	}
+
+	Handle(w, r, cfg, http.StatusAccepted)
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func buildWorkersHandler() (*WorkersHandler, signalbus.SignalBus) {
+	worker := &workers.WorkerMock{
+		GetIDFunc: func() string {
+			return "000-001"
+		},
+		GetWorkerTypeFunc: func() string {
+			return "cluster"
+		},
+		IsRunningFunc: func() bool {
+			return true
+		},
+	}
+	signalBus := signalbus.NewSignalBus()
+	leaderElectionManager := workers.NewLeaderElectionManager([]workers.Worker{worker}, db.NewMockConnectionFactory(nil), &workers.ReconcilerConfig{})
+	return NewWorkersHandler(leaderElectionManager, signalBus), signalBus
+}
+
+func TestWorkersHandler_List(t *testing.T) {
+	expires := time.Now().Add(time.Hour)
+	tests := []struct {
+		name       string
+		setupFn    func()
+		wantCode   int
+		wantLeader string
+	}{
+		{
+			name: "should return the registered workers with their leader",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery("SELECT * FROM leader_leases where deleted_at is null").
+					WithReply([]map[string]interface{}{
+						{"leader": "000-002", "lease_type": "cluster", "expires": expires},
+					})
+			},
+			wantCode:   http.StatusOK,
+			wantLeader: "000-002",
+		},
+		{
+			name: "should return an error when the leases cannot be read",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("SELECT").WithQueryException()
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			handler, _ := buildWorkersHandler()
+			tt.setupFn()
+
+			rw := httptest.NewRecorder()
+			handler.List(rw, httptest.NewRequest(http.MethodGet, "/api/kafkas_mgmt/v1/admin/workers", nil))
+
+			Expect(rw.Code).To(Equal(tt.wantCode))
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			var workerList api.WorkerStatusList
+			Expect(json.Unmarshal(rw.Body.Bytes(), &workerList)).To(Succeed())
+			Expect(workerList.Kind).To(Equal("WorkerList"))
+			Expect(workerList.Items).To(HaveLen(1))
+			Expect(workerList.Items[0].Type).To(Equal("cluster"))
+			Expect(workerList.Items[0].Running).To(BeTrue())
+			Expect(workerList.Items[0].Leader).To(Equal(tt.wantLeader))
+		})
+	}
+}
+
+func TestWorkersHandler_Reconcile(t *testing.T) {
+	tests := []struct {
+		name         string
+		workerType   string
+		wantCode     int
+		wantSignaled bool
+	}{
+		{
+			name:         "should signal the reconcile of a registered worker type",
+			workerType:   "cluster",
+			wantCode:     http.StatusAccepted,
+			wantSignaled: true,
+		},
+		{
+			name:       "should return not found for an unknown worker type",
+			workerType: "unknown",
+			wantCode:   http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			handler, signalBus := buildWorkersHandler()
+			sub := signalBus.Subscribe("reconcile:cluster")
+			defer sub.Close()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/kafkas_mgmt/v1/admin/workers/"+tt.workerType+"/reconcile", nil)
+			req = mux.SetURLVars(req, map[string]string{"type": tt.workerType})
+			rw := httptest.NewRecorder()
+			handler.Reconcile(rw, req)
+
+			Expect(rw.Code).To(Equal(tt.wantCode))
+			Expect(sub.IsSignaled()).To(Equal(tt.wantSignaled))
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// The healthcheck package checks the dependencies of the service for the readiness and liveness endpoints of the
+// health check server.
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+)
+
+const (
+	StatusOK     = "ok"
+	StatusFailed = "failed"
+)
+
+// Check checks a dependency of the service
+type Check interface {
+	// Name identifies the check in the reports
+	Name() string
+	// Check returns an error when the dependency can't be used
+	Check(ctx context.Context) error
+}
+
+// ReadinessCheck is a check that must pass for the service to receive traffic
+type ReadinessCheck interface {
+	Check
+}
+
+// LivenessCheck is a check that must pass for the service to keep running. It should only fail when restarting the
+// service fixes the failure.
+type LivenessCheck interface {
+	Check
+}
+
+type Result struct {
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	Duration  string    `json:"duration"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]Result `json:"checks"`
+}
+
+// Checker runs checks concurrently and caches their report for a while, so that frequent probes from several
+// sources don't overload the dependencies
+type Checker struct {
+	checks    []Check
+	timeout   time.Duration
+	ttl       time.Duration
+	mutex     sync.Mutex
+	report    *Report
+	expiresAt time.Time
+	now       func() time.Time
+}
+
+// NewChecker creates a checker of the given checks. Each check fails when it doesn't complete within timeout and
+// reports are cached for ttl.
+func NewChecker(checks []Check, timeout time.Duration, ttl time.Duration) *Checker {
+	return &Checker{
+		checks:  checks,
+		timeout: timeout,
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+// Report returns the cached report, or runs the checks when it has expired
+func (c *Checker) Report() Report {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.report != nil && c.now().Before(c.expiresAt) {
+		return *c.report
+	}
+	report := c.run()
+	c.report = &report
+	c.expiresAt = c.now().Add(c.ttl)
+	return report
+}
+
+func (c *Checker) run() Report {
+	// checks don't use the context of the request, as their results are shared by the following requests
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	results := make([]Result, len(c.checks))
+	var wg sync.WaitGroup
+	for i := range c.checks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			start := c.now()
+			err := c.runCheck(ctx, c.checks[i])
+			results[i] = Result{
+				Status:    StatusOK,
+				Duration:  c.now().Sub(start).String(),
+				CheckedAt: start,
+			}
+			if err != nil {
+				results[i].Status = StatusFailed
+				results[i].Error = err.Error()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	report := Report{
+		Status: StatusOK,
+		Checks: make(map[string]Result, len(c.checks)),
+	}
+	for i, check := range c.checks {
+		report.Checks[check.Name()] = results[i]
+		if results[i].Status != StatusOK {
+			report.Status = StatusFailed
+		}
+	}
+	return report
+}
+
+// runCheck stops waiting for checks that don't return when their context is done
+func (c *Checker) runCheck(ctx context.Context, check Check) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- check.Check(ctx)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("check did not complete within %s", c.timeout)
+	}
+}
+
+// ServeHTTP writes the report of the checks, with a 503 status code when one of them failed
+func (c *Checker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	report := c.Report()
+	code := http.StatusOK
+	if report.Status != StatusOK {
+		code = http.StatusServiceUnavailable
+	}
+	shared.WriteJSONResponse(w, code, report)
+}
@@ -0,0 +1,100 @@
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+type testCheck struct {
+	name  string
+	err   error
+	block bool
+	calls int
+}
+
+func (c *testCheck) Name() string {
+	return c.name
+}
+
+func (c *testCheck) Check(ctx context.Context) error {
+	c.calls++
+	if c.block {
+		time.Sleep(time.Second)
+	}
+	return c.err
+}
+
+func TestChecker_ServeHTTP(t *testing.T) {
+	tests := []struct {
+		name       string
+		checks     []Check
+		wantCode   int
+		wantStatus map[string]string
+	}{
+		{
+			name:     "should succeed when there are no checks",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:       "should succeed when all the checks pass",
+			checks:     []Check{&testCheck{name: "database"}, &testCheck{name: "sso"}},
+			wantCode:   http.StatusOK,
+			wantStatus: map[string]string{"database": StatusOK, "sso": StatusOK},
+		},
+		{
+			name:       "should fail when a check fails",
+			checks:     []Check{&testCheck{name: "database"}, &testCheck{name: "sso", err: fmt.Errorf("connection refused")}},
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: map[string]string{"database": StatusOK, "sso": StatusFailed},
+		},
+		{
+			name:       "should fail when a check does not complete in time",
+			checks:     []Check{&testCheck{name: "database", block: true}},
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: map[string]string{"database": StatusFailed},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			checker := NewChecker(tt.checks, 100*time.Millisecond, time.Minute)
+			recorder := httptest.NewRecorder()
+			checker.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/healthcheck/ready", nil))
+
+			Expect(recorder.Code).To(Equal(tt.wantCode))
+			var report Report
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &report)).To(Succeed())
+			Expect(report.Checks).To(HaveLen(len(tt.wantStatus)))
+			for name, status := range tt.wantStatus {
+				Expect(report.Checks[name].Status).To(Equal(status))
+				Expect(report.Checks[name].Error == "").To(Equal(status == StatusOK))
+			}
+		})
+	}
+}
+
+func TestChecker_Report_Cache(t *testing.T) {
+	RegisterTestingT(t)
+
+	now := time.Now()
+	check := &testCheck{name: "database"}
+	checker := NewChecker([]Check{check}, time.Second, 10*time.Second)
+	checker.now = func() time.Time { return now }
+
+	checker.Report()
+	check.err = fmt.Errorf("connection refused")
+	Expect(checker.Report().Status).To(Equal(StatusOK))
+	Expect(check.calls).To(Equal(1))
+
+	now = now.Add(10 * time.Second)
+	Expect(checker.Report().Status).To(Equal(StatusFailed))
+	Expect(check.calls).To(Equal(2))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/golang/glog"
 	"github.com/openshift-online/ocm-sdk-go/authentication"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type LoggerKeys string
@@ -87,6 +88,13 @@ type logger struct {
 	sentryHub *sentry.Hub
 }
 
+// NewEventLogger creates a new logger whose messages are prefixed with the given event type, like the messages logged
+// while serving the API requests are prefixed with the type of their route. It is used for the events that are not
+// triggered by an API request e.g. the events detected by the workers.
+func NewEventLogger(eventType string) UHCLogger {
+	return NewUHCLogger(context.WithValue(context.Background(), ActionKey, eventType))
+}
+
 // NewUHCLogger creates a new logger instance with a default verbosity of 1
 func NewUHCLogger(ctx context.Context) UHCLogger {
 	logger := &logger{
@@ -134,6 +142,10 @@ func (l *logger) prepareLogPrefix(format string, args ...interface{}) string {
 		prefix = strings.Join([]string{prefix, "opid='", opid, "' "}, "")
 	}
 
+	if spanContext := trace.SpanContextFromContext(l.context); spanContext.HasTraceID() {
+		prefix = strings.Join([]string{prefix, "trace_id='", spanContext.TraceID().String(), "' "}, "")
+	}
+
 	return prefix + orig
 }
 
@@ -52,18 +52,33 @@ const (
 
 	KafkaPerClusterCount = "kafka_per_cluster_count"
 
+	// KafkaCapacity - metric name for the capacity reported by the kas-fleetshard-operator for each Kafka instance
+	KafkaCapacity = "kafka_capacity"
+
+	// KafkaHealth - metric name for the health verdict of each Kafka instance
+	KafkaHealth = "kafka_health"
+	// KafkaHealthSignal - metric name for the signals contributing to the health verdict of each Kafka instance
+	KafkaHealthSignal = "kafka_health_signal"
+
 	LeaderWorker = "leader_worker"
 
 	// ObservatoriumRequestCount - metric name for the number of observatorium requests sent
 	ObservatoriumRequestCount = "observatorium_request_count"
 	// ObservatoriumRequestDuration - metric name for observatorium request duration in seconds
 	ObservatoriumRequestDuration = "observatorium_request_duration"
+	// ObservatoriumMetricsCacheRequestCount - metric name for the number of kafka metrics queries answered with or without the cache
+	ObservatoriumMetricsCacheRequestCount = "observatorium_metrics_cache_request_count"
 
 	// DatabaseQueryCount - metric name for the number of database query sent
 	DatabaseQueryCount = "database_query_count"
 	// DatabaseQueryDuration - metric name for database query duration in milliseconds
 	DatabaseQueryDuration = "database_query_duration"
 
+	// ConfigReloadCount - metric name for the number of configuration reloads
+	ConfigReloadCount = "config_reload_count"
+	// ConfigReloadLastSuccessTimestamp - metric name for the time of the last successful configuration reload
+	ConfigReloadLastSuccessTimestamp = "config_reload_last_success_timestamp_seconds"
+
 	// ClusterStatusMaxCapacity - metric name for the maximum kafka instance capacity
 	ClusterStatusCapacityMax = "cluster_status_capacity_max"
 
@@ -73,17 +88,57 @@ const (
 	// ClusterStatusCapacityAvailable - metric name for the number of available instances
 	ClusterStatusCapacityAvailable = "cluster_status_capacity_available"
 
+	// ClusterStatusReportAge - metric name for the time since the kas-fleetshard-operator last reported the status of each cluster
+	ClusterStatusReportAge = "cluster_status_report_age_in_seconds"
+	// ClusterUnresponsiveCount - metric name for the number of times each cluster became unresponsive
+	ClusterUnresponsiveCount = "cluster_unresponsive_count"
+
 	LabelStatusCode = "code"
 	LabelMethod     = "method"
 	LabelPath       = "path"
 
 	LabelDatabaseQueryStatus = "status"
 	LabelDatabaseQueryType   = "query"
+	LabelDatabaseTarget      = "target"
 	LabelRegion              = "region"
 	LabelInstanceType        = "instance_type"
 	LabelCloudProvider       = "cloud_provider"
+	LabelConfig              = "config"
+	LabelCapacity            = "capacity"
+	LabelCacheResult         = "result"
+	LabelHealthSignal        = "signal"
+
+	// ConfigReloadSuccess - status of a configuration reload that replaced the loaded configuration
+	ConfigReloadSuccess = "success"
+	// ConfigReloadFailure - status of a configuration reload that kept the loaded configuration
+	ConfigReloadFailure = "failure"
+
+	// MetricsCacheHit - result of a kafka metrics query answered from the cache or by a concurrent identical query
+	MetricsCacheHit = "hit"
+	// MetricsCacheMiss - result of a kafka metrics query sent to Observatorium
+	MetricsCacheMiss = "miss"
+
+	// KafkaCapacityIngressEgressThroughput - capacity label value for the ingress/egress throughput in bytes per second
+	KafkaCapacityIngressEgressThroughput = "ingress_egress_throughput_bytes_per_second"
+	// KafkaCapacityMaxConnections - capacity label value for the maximum number of connections
+	KafkaCapacityMaxConnections = "max_connections"
+	// KafkaCapacityMaxConnectionAttempts - capacity label value for the maximum number of connection attempts per second
+	KafkaCapacityMaxConnectionAttempts = "max_connection_attempts_per_second"
+	// KafkaCapacityMaxPartitions - capacity label value for the maximum number of partitions
+	KafkaCapacityMaxPartitions = "max_partitions"
+	// KafkaCapacityMaxDataRetentionSize - capacity label value for the maximum data retention size in bytes
+	KafkaCapacityMaxDataRetentionSize = "max_data_retention_bytes"
 )
 
+// KafkaCapacityTypes - the values of the capacity label of the kafka capacity metric
+var KafkaCapacityTypes = []string{
+	KafkaCapacityIngressEgressThroughput,
+	KafkaCapacityMaxConnections,
+	KafkaCapacityMaxConnectionAttempts,
+	KafkaCapacityMaxPartitions,
+	KafkaCapacityMaxDataRetentionSize,
+}
+
 // JobType metric to capture
 type JobType string
 
@@ -121,6 +176,23 @@ var KafkaPerClusterCountMetricsLabels = []string{
 	LabelClusterExternalID,
 }
 
+var kafkaCapacityMetricsLabels = []string{
+	LabelID,
+	LabelClusterID,
+	LabelCapacity,
+}
+
+var kafkaHealthMetricsLabels = []string{
+	LabelID,
+	LabelClusterID,
+}
+
+var kafkaHealthSignalMetricsLabels = []string{
+	LabelID,
+	LabelClusterID,
+	LabelHealthSignal,
+}
+
 // ClusterOperationsCountMetricsLabels - is the slice of labels to add to Kafka operations count metrics
 var ClusterOperationsCountMetricsLabels = []string{
 	labelOperation,
@@ -149,6 +221,20 @@ var observatoriumRequestMetricsLabels = []string{
 var DatabaseMetricsLabels = []string{
 	LabelDatabaseQueryStatus,
 	LabelDatabaseQueryType,
+	LabelDatabaseTarget,
+}
+
+var configReloadCountMetricsLabels = []string{
+	LabelConfig,
+	LabelStatus,
+}
+
+var configReloadTimestampMetricsLabels = []string{
+	LabelConfig,
+}
+
+var clusterHeartbeatMetricsLabels = []string{
+	LabelClusterID,
 }
 
 var clusterStatusCapacityLabels = []string{
@@ -335,6 +421,38 @@ func UpdateKafkaPerClusterCountMetric(clusterId string, clusterExternalID string
 	kafkaPerClusterCountMetric.With(labels).Set(float64(count))
 }
 
+// create a new GaugeVec for the time since the last status report of each cluster
+var clusterStatusReportAgeMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: KasFleetManager,
+		Name:      ClusterStatusReportAge,
+		Help:      "the time in seconds since the kas-fleetshard-operator last reported the status of a data plane cluster",
+	},
+	clusterHeartbeatMetricsLabels)
+
+// UpdateClusterStatusReportAgeMetric sets the time since the last status report of the cluster
+func UpdateClusterStatusReportAgeMetric(clusterId string, age time.Duration) {
+	clusterStatusReportAgeMetric.With(prometheus.Labels{
+		LabelClusterID: clusterId,
+	}).Set(age.Seconds())
+}
+
+// create a new CounterVec for the number of times each cluster became unresponsive
+var clusterUnresponsiveCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: KasFleetManager,
+		Name:      ClusterUnresponsiveCount,
+		Help:      "number of times the kas-fleetshard-operator of a data plane cluster stopped reporting the cluster status",
+	},
+	clusterHeartbeatMetricsLabels)
+
+// IncreaseClusterUnresponsiveCountMetric - increase counter for clusterUnresponsiveCountMetric
+func IncreaseClusterUnresponsiveCountMetric(clusterId string) {
+	clusterUnresponsiveCountMetric.With(prometheus.Labels{
+		LabelClusterID: clusterId,
+	}).Inc()
+}
+
 // #### Metrics for Dataplane clusters - End ####
 
 // #### Metrics for Kafkas - Start ####
@@ -459,6 +577,88 @@ func IncreaseKafkaTotalOperationsCountMetric(operation constants2.KafkaOperation
 	kafkaOperationsTotalCountMetric.With(labels).Inc()
 }
 
+// create a new GaugeVec for the capacity reported for each kafka
+var kafkaCapacityMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: KasFleetManager,
+		Name:      KafkaCapacity,
+		Help:      "the capacity of a Kafka instance as reported by the kas-fleetshard-operator, partitioned by capacity type",
+	},
+	kafkaCapacityMetricsLabels,
+)
+
+// UpdateKafkaCapacityMetric sets the given capacity of the kafka, capacity is one of KafkaCapacityTypes
+func UpdateKafkaCapacityMetric(kafkaId string, clusterId string, capacity string, value float64) {
+	labels := prometheus.Labels{
+		LabelID:        kafkaId,
+		LabelClusterID: clusterId,
+		LabelCapacity:  capacity,
+	}
+	kafkaCapacityMetric.With(labels).Set(value)
+}
+
+// DeleteKafkaCapacityMetric removes the capacity of the kafka so that the capacity of deleted kafkas is no longer scraped
+func DeleteKafkaCapacityMetric(kafkaId string, clusterId string) {
+	for _, capacity := range KafkaCapacityTypes {
+		kafkaCapacityMetric.Delete(prometheus.Labels{
+			LabelID:        kafkaId,
+			LabelClusterID: clusterId,
+			LabelCapacity:  capacity,
+		})
+	}
+}
+
+// create a new GaugeVec for the health verdict of each kafka
+var kafkaHealthMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: KasFleetManager,
+		Name:      KafkaHealth,
+		Help:      "the health verdict of a ready Kafka instance: 0 healthy, 1 degraded, 2 unavailable",
+	},
+	kafkaHealthMetricsLabels,
+)
+
+// create a new GaugeVec for the signals contributing to the health verdict of each kafka
+var kafkaHealthSignalMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: KasFleetManager,
+		Name:      KafkaHealthSignal,
+		Help:      "the status of a signal contributing to the health verdict of a ready Kafka instance: -1 unknown, 0 healthy, 1 degraded, 2 unavailable",
+	},
+	kafkaHealthSignalMetricsLabels,
+)
+
+// UpdateKafkaHealthMetric sets the health verdict of the kafka and the status of each of its signals
+func UpdateKafkaHealthMetric(kafkaId string, clusterId string, verdict float64, signals map[string]float64) {
+	kafkaHealthMetric.With(prometheus.Labels{
+		LabelID:        kafkaId,
+		LabelClusterID: clusterId,
+	}).Set(verdict)
+	for signal, value := range signals {
+		kafkaHealthSignalMetric.With(prometheus.Labels{
+			LabelID:           kafkaId,
+			LabelClusterID:    clusterId,
+			LabelHealthSignal: signal,
+		}).Set(value)
+	}
+}
+
+// DeleteKafkaHealthMetric removes the health of the kafka so that the health of the kafkas that are no longer ready is
+// no longer scraped
+func DeleteKafkaHealthMetric(kafkaId string, clusterId string, signals []string) {
+	kafkaHealthMetric.Delete(prometheus.Labels{
+		LabelID:        kafkaId,
+		LabelClusterID: clusterId,
+	})
+	for _, signal := range signals {
+		kafkaHealthSignalMetric.Delete(prometheus.Labels{
+			LabelID:           kafkaId,
+			LabelClusterID:    clusterId,
+			LabelHealthSignal: signal,
+		})
+	}
+}
+
 // #### Metrics for Kafkas - End ####
 
 // #### Metrics for Reconcilers - Start ####
@@ -598,12 +798,26 @@ func UpdateObservatoriumRequestDurationMetric(code int, path, method string, ela
 	observatoriumRequestDurationMetric.With(labels).Observe(elapsed.Seconds())
 }
 
+// register observatorium metrics cache request count metric
+//	  observatorium_metrics_cache_request_count - Number of kafka metrics queries partitioned by cache result (hit or miss)
+var observatoriumMetricsCacheRequestCountMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: KasFleetManager,
+	Name:      ObservatoriumMetricsCacheRequestCount,
+	Help:      "number of kafka metrics queries answered from the cache (hit) or sent to Observatorium (miss). The queries waiting for the result of a concurrent identical query are counted as hits.",
+}, []string{LabelCacheResult})
+
+// Increase the observatorium metrics cache request count metric with the following labels:
+// 	- result: MetricsCacheHit or MetricsCacheMiss
+func IncreaseObservatoriumMetricsCacheRequestCount(result string) {
+	observatoriumMetricsCacheRequestCountMetric.With(prometheus.Labels{LabelCacheResult: result}).Inc()
+}
+
 // #### Metrics for Observatorium - End ####
 
 // #### Metrics for Database ####
 
 // register database query count metric
-//	  database_query_count - Number of Database query sent partitioned by status, and sql query type
+//	  database_query_count - Number of Database query sent partitioned by status, sql query type and target database
 var databaseRequestCountMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
 	Subsystem: KasFleetManager,
 	Name:      DatabaseQueryCount,
@@ -613,15 +827,17 @@ var databaseRequestCountMetric = prometheus.NewCounterVec(prometheus.CounterOpts
 // Increase the database query count metric with the following labels:
 // 	- status: (i.e. "success" or "failure")
 // 	- queryType: (i.e. "SELECT", "UPDATE", "INSERT", "DELETE")
-func IncreaseDatabaseQueryCount(status string, queryType string) {
+// 	- target: (i.e. "primary" or "replica")
+func IncreaseDatabaseQueryCount(status string, queryType string, target string) {
 	labels := prometheus.Labels{
 		LabelDatabaseQueryStatus: status,
 		LabelDatabaseQueryType:   queryType,
+		LabelDatabaseTarget:      target,
 	}
 	databaseRequestCountMetric.With(labels).Inc()
 }
 
-// register database query duration metric. Each metric is partitioned by status, query type
+// register database query duration metric. Each metric is partitioned by status, query type and target database
 //	 database_query_duration_sum - Total time to send requests to Database in milliseconds.
 //	 database_query_duration_count - Total number of database query measured.
 //	 database_query_duration_bucket - Number of Database queries organized in buckets.
@@ -666,16 +882,57 @@ var databaseQueryDurationMetric = prometheus.NewHistogramVec(
 // Update the observatorium request duration metric with the following labels:
 // 	- status: (i.e. "success" or "failure")
 // 	- queryType: (i.e. "SELECT", "UPDATE", "INSERT", "DELETE")
-func UpdateDatabaseQueryDurationMetric(status string, queryType string, elapsed time.Duration) {
+// 	- target: (i.e. "primary" or "replica")
+func UpdateDatabaseQueryDurationMetric(status string, queryType string, target string, elapsed time.Duration) {
 	labels := prometheus.Labels{
 		LabelDatabaseQueryStatus: status,
 		LabelDatabaseQueryType:   queryType,
+		LabelDatabaseTarget:      target,
 	}
 	databaseQueryDurationMetric.With(labels).Observe(float64(elapsed.Milliseconds()))
 }
 
 // #### Metrics for Database - End ####
 
+// #### Metrics for Configuration reloads ####
+
+// register configuration reload count metric
+//	  config_reload_count - Number of configuration reloads partitioned by configuration and status
+var configReloadCountMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: KasFleetManager,
+	Name:      ConfigReloadCount,
+	Help:      "number of reloads of the configuration files. A failed reload keeps the configuration loaded previously.",
+}, configReloadCountMetricsLabels)
+
+// IncreaseConfigReloadCount increases the configuration reload count metric with the following labels:
+// 	- config: the name of the reloaded configuration (i.e. "acl.AccessControlListConfig")
+// 	- status: (i.e. "success" or "failure")
+func IncreaseConfigReloadCount(config string, status string) {
+	labels := prometheus.Labels{
+		LabelConfig: config,
+		LabelStatus: status,
+	}
+	configReloadCountMetric.With(labels).Inc()
+}
+
+// register configuration reload timestamp metric
+//	  config_reload_last_success_timestamp_seconds - Time of the last successful reload partitioned by configuration
+var configReloadTimestampMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: KasFleetManager,
+	Name:      ConfigReloadLastSuccessTimestamp,
+	Help:      "unix time of the last successful reload of the configuration files.",
+}, configReloadTimestampMetricsLabels)
+
+// UpdateConfigReloadTimestamp sets the time of the last successful reload of the given configuration
+func UpdateConfigReloadTimestamp(config string, reloadedAt time.Time) {
+	labels := prometheus.Labels{
+		LabelConfig: config,
+	}
+	configReloadTimestampMetric.With(labels).Set(float64(reloadedAt.Unix()))
+}
+
+// #### Metrics for Configuration reloads - End ####
+
 // register the metric(s)
 func init() {
 	// metrics for data plane clusters
@@ -688,6 +945,8 @@ func init() {
 	prometheus.MustRegister(clusterStatusCapacityMaxMetric)
 	prometheus.MustRegister(clusterStatusCapacityUsedMetric)
 	prometheus.MustRegister(clusterStatusCapacityAvailableMetric)
+	prometheus.MustRegister(clusterStatusReportAgeMetric)
+	prometheus.MustRegister(clusterUnresponsiveCountMetric)
 
 	// metrics for Kafkas
 	prometheus.MustRegister(requestKafkaCreationDurationMetric)
@@ -695,6 +954,9 @@ func init() {
 	prometheus.MustRegister(kafkaOperationsTotalCountMetric)
 	prometheus.MustRegister(kafkaStatusSinceCreatedMetric)
 	prometheus.MustRegister(KafkaStatusCountMetric)
+	prometheus.MustRegister(kafkaCapacityMetric)
+	prometheus.MustRegister(kafkaHealthMetric)
+	prometheus.MustRegister(kafkaHealthSignalMetric)
 
 	// metrics for reconcilers
 	prometheus.MustRegister(reconcilerDurationMetric)
@@ -706,10 +968,15 @@ func init() {
 	// metrics for observatorium
 	prometheus.MustRegister(observatoriumRequestCountMetric)
 	prometheus.MustRegister(observatoriumRequestDurationMetric)
+	prometheus.MustRegister(observatoriumMetricsCacheRequestCountMetric)
 
 	// metrics for database
 	prometheus.MustRegister(databaseRequestCountMetric)
 	prometheus.MustRegister(databaseQueryDurationMetric)
+
+	// metrics for configuration reloads
+	prometheus.MustRegister(configReloadCountMetric)
+	prometheus.MustRegister(configReloadTimestampMetric)
 }
 
 // ResetMetricsForKafkaManagers will reset the metrics for the KafkaManager background reconciler
@@ -717,6 +984,8 @@ func init() {
 func ResetMetricsForKafkaManagers() {
 	kafkaStatusSinceCreatedMetric.Reset()
 	KafkaStatusCountMetric.Reset()
+	kafkaHealthMetric.Reset()
+	kafkaHealthSignalMetric.Reset()
 }
 
 // ResetMetricsForClusterManagers will reset the metrics for the ClusterManager background reconciler
@@ -728,6 +997,7 @@ func ResetMetricsForClusterManagers() {
 	clusterStatusCapacityMaxMetric.Reset()
 	clusterStatusCapacityUsedMetric.Reset()
 	clusterStatusCapacityAvailableMetric.Reset()
+	clusterStatusReportAgeMetric.Reset()
 }
 
 // ResetMetricsForReconcilers will reset the metrics related to the reconcilers
@@ -744,6 +1014,7 @@ func ResetMetricsForReconcilers() {
 func ResetMetricsForObservatorium() {
 	observatoriumRequestCountMetric.Reset()
 	observatoriumRequestDurationMetric.Reset()
+	observatoriumMetricsCacheRequestCountMetric.Reset()
 }
 
 // Reset the metrics we have defined. It is mainly used for testing.
@@ -757,12 +1028,17 @@ func Reset() {
 	clusterStatusCapacityMaxMetric.Reset()
 	clusterStatusCapacityUsedMetric.Reset()
 	clusterStatusCapacityAvailableMetric.Reset()
+	clusterStatusReportAgeMetric.Reset()
+	clusterUnresponsiveCountMetric.Reset()
 
 	requestKafkaCreationDurationMetric.Reset()
 	kafkaOperationsSuccessCountMetric.Reset()
 	kafkaOperationsTotalCountMetric.Reset()
 	kafkaStatusSinceCreatedMetric.Reset()
 	KafkaStatusCountMetric.Reset()
+	kafkaCapacityMetric.Reset()
+	kafkaHealthMetric.Reset()
+	kafkaHealthSignalMetric.Reset()
 
 	reconcilerDurationMetric.Reset()
 	reconcilerSuccessCountMetric.Reset()
@@ -774,4 +1050,7 @@ func Reset() {
 
 	databaseRequestCountMetric.Reset()
 	databaseQueryDurationMetric.Reset()
+
+	configReloadCountMetric.Reset()
+	configReloadTimestampMetric.Reset()
 }
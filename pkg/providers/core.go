@@ -6,19 +6,25 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/cmd/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/cmd/migrate"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/cmd/serve"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/healthcheck"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/quota_management"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/ratelimit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/rbac"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sentry"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 	"github.com/goava/di"
 )
@@ -35,20 +41,26 @@ func CoreConfigProviders() di.Option {
 		di.Provide(server.NewServerConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(ocm.NewOCMConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(keycloak.NewKeycloakConfig, di.As(new(environments.ConfigModule))),
-		di.Provide(acl.NewAccessControlListConfig, di.As(new(environments.ConfigModule))),
-		di.Provide(quota_management.NewQuotaManagementListConfig, di.As(new(environments.ConfigModule))),
+		di.Provide(acl.NewAccessControlListConfig, di.As(new(environments.ConfigModule)), di.As(new(environments.Reloadable))),
+		di.Provide(quota_management.NewQuotaManagementListConfig, di.As(new(environments.ConfigModule)), di.As(new(environments.ConfigValidator)), di.As(new(environments.Reloadable))),
 		di.Provide(server.NewMetricsConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(workers.NewReconcilerConfig, di.As(new(environments.ConfigModule))),
+		di.Provide(environments.NewConfigReloadConfig, di.As(new(environments.ConfigModule))),
 
 		// Add common CLI sub commands
 		di.Provide(serve.NewServeCommand),
 		di.Provide(migrate.NewMigrateCommand),
+		di.Provide(config.NewConfigCommand),
 
 		// Add other core config providers..
 		sentry.ConfigProviders(),
 		signalbus.ConfigProviders(),
 		authorization.ConfigProviders(),
 		account.ConfigProviders(),
+		idempotency.ConfigProviders(),
+		ratelimit.ConfigProviders(),
+		tracing.ConfigProviders(),
+		rbac.ConfigProviders(),
 
 		di.Provide(environments.Func(ServiceProviders)),
 	)
@@ -78,9 +90,12 @@ func ServiceProviders() di.Option {
 		}),
 
 		di.Provide(aws.NewDefaultClientFactory, di.As(new(aws.ClientFactory))),
+		di.Provide(aws.NewDefaultEKSClientFactory, di.As(new(aws.EKSClientFactory))),
 
 		di.Provide(acl.NewAccessControlListMiddleware),
 		di.Provide(handlers.NewErrorsHandler),
+		di.Provide(handlers.NewWorkersHandler),
+		di.Provide(handlers.NewConfigsHandler),
 		di.Provide(func(c *keycloak.KeycloakConfig) sso.KafkaKeycloakService {
 			return sso.NewKeycloakServiceBuilder().
 				WithConfiguration(c).
@@ -97,6 +112,11 @@ func ServiceProviders() di.Option {
 		di.Provide(server.NewAPIServer, di.As(new(environments.BootService))),
 		di.Provide(server.NewMetricsServer, di.As(new(environments.BootService))),
 		di.Provide(server.NewHealthCheckServer, di.As(new(environments.BootService))),
+		di.Provide(db.NewHealthCheck, di.As(new(healthcheck.ReadinessCheck))),
+		di.Provide(sso.NewHealthCheck, di.As(new(healthcheck.ReadinessCheck))),
+		di.Provide(ocm.NewHealthCheck, di.As(new(healthcheck.ReadinessCheck))),
+		di.Provide(server.NewAPIServerHealthCheck, di.As(new(healthcheck.LivenessCheck))),
 		di.Provide(workers.NewLeaderElectionManager, di.As(new(environments.BootService))),
+		di.Provide(environments.NewConfigReloader, di.As(new(environments.BootService))),
 	)
 }
@@ -1,17 +1,22 @@
 package quota_management
 
 import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/spf13/pflag"
 	"gopkg.in/yaml.v2"
 	"os"
+	"sync"
 )
 
 type QuotaManagementListConfig struct {
 	QuotaList                  RegisteredUsersListConfiguration
 	QuotaListConfigFile        string
 	EnableInstanceLimitControl bool
+	mutex                      sync.RWMutex
 }
 
 func NewQuotaManagementListConfig() *QuotaManagementListConfig {
@@ -41,15 +46,80 @@ func (c *QuotaManagementListConfig) ReadFiles() error {
 	return err
 }
 
+var _ environments.ConfigValidator = &QuotaManagementListConfig{}
+
+// Validate ensures that organisations and accounts are listed only once, as only the first entry would be used otherwise
+func (c *QuotaManagementListConfig) Validate(env *environments.Env) error {
+	return validateQuotaList(c.GetQuotaList())
+}
+
+func validateQuotaList(quotaList RegisteredUsersListConfiguration) error {
+	organisations := map[string]bool{}
+	for _, org := range quotaList.Organisations {
+		if organisations[org.Id] {
+			return fmt.Errorf("organisation '%s' is listed more than once in the quota management list", org.Id)
+		}
+		organisations[org.Id] = true
+		if err := validateAccountList(org.RegisteredUsers); err != nil {
+			return fmt.Errorf("invalid registered users of organisation '%s': %v", org.Id, err)
+		}
+	}
+	if err := validateAccountList(quotaList.ServiceAccounts); err != nil {
+		return fmt.Errorf("invalid registered service accounts: %v", err)
+	}
+	return nil
+}
+
+func validateAccountList(accounts AccountList) error {
+	usernames := map[string]bool{}
+	for _, account := range accounts {
+		if usernames[account.Username] {
+			return fmt.Errorf("account '%s' is listed more than once", account.Username)
+		}
+		usernames[account.Username] = true
+	}
+	return nil
+}
+
 func (c *QuotaManagementListConfig) GetAllowedAccountByUsernameAndOrgId(username string, orgId string) (Account, bool) {
 	var user Account
 	var found bool
-	org, _ := c.QuotaList.Organisations.GetById(orgId)
+	quotaList := c.GetQuotaList()
+	org, _ := quotaList.Organisations.GetById(orgId)
 	user, found = org.RegisteredUsers.GetByUsername(username)
 	if found {
 		return user, found
 	}
-	return c.QuotaList.ServiceAccounts.GetByUsername(username)
+	return quotaList.ServiceAccounts.GetByUsername(username)
+}
+
+// GetQuotaList returns the snapshot of the quota list currently loaded
+func (c *QuotaManagementListConfig) GetQuotaList() RegisteredUsersListConfiguration {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.QuotaList
+}
+
+var _ environments.Reloadable = &QuotaManagementListConfig{}
+
+func (c *QuotaManagementListConfig) WatchedFiles() []string {
+	return []string{c.QuotaListConfigFile}
+}
+
+// Reload replaces the quota list with the content of the configuration file. Unlike at startup, a missing file is an
+// error as it most likely is a mistake, and the quota list loaded previously is kept.
+func (c *QuotaManagementListConfig) Reload() error {
+	var quotaList RegisteredUsersListConfiguration
+	if err := readQuotaManagementListConfigFile(c.QuotaListConfigFile, &quotaList); err != nil {
+		return err
+	}
+	if err := validateQuotaList(quotaList); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.QuotaList = quotaList
+	return nil
 }
 
 // Read the contents of file into the quota list config
@@ -1,8 +1,12 @@
 package quota_management
 
 import (
-	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+
+	. "github.com/onsi/gomega"
 )
 
 func Test_OrganisationList_GetById(t *testing.T) {
@@ -330,3 +334,83 @@ func Test_AllowedAccount_GetMaxAllowedInstances(t *testing.T) {
 		})
 	}
 }
+
+func Test_QuotaManagementListConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		quotaList RegisteredUsersListConfiguration
+		wantErr   bool
+	}{
+		{
+			name: "should succeed when organisations and accounts are listed once",
+			quotaList: RegisteredUsersListConfiguration{
+				Organisations: OrganisationList{
+					{Id: "org-1", RegisteredUsers: AccountList{{Username: "user-1"}, {Username: "user-2"}}},
+					{Id: "org-2", RegisteredUsers: AccountList{{Username: "user-1"}}},
+				},
+				ServiceAccounts: AccountList{{Username: "service-account-1"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "should fail when an organisation is listed more than once",
+			quotaList: RegisteredUsersListConfiguration{
+				Organisations: OrganisationList{{Id: "org-1"}, {Id: "org-1"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should fail when a user is registered more than once in an organisation",
+			quotaList: RegisteredUsersListConfiguration{
+				Organisations: OrganisationList{
+					{Id: "org-1", RegisteredUsers: AccountList{{Username: "user-1"}, {Username: "user-1"}}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should fail when a service account is listed more than once",
+			quotaList: RegisteredUsersListConfiguration{
+				ServiceAccounts: AccountList{{Username: "service-account-1"}, {Username: "service-account-1"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			RegisterTestingT(t)
+			config := &QuotaManagementListConfig{QuotaList: tt.quotaList}
+			Expect(config.Validate(nil) != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_QuotaManagementListConfig_Reload(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir, err := ioutil.TempDir("", "quota-management-list")
+	Expect(err).ToNot(HaveOccurred())
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "quota-management-list-configuration.yaml")
+	config := &QuotaManagementListConfig{QuotaListConfigFile: file}
+
+	Expect(ioutil.WriteFile(file, []byte("registered_users_per_organisation:\n  - id: org-1\n"), 0600)).To(Succeed())
+	Expect(config.Reload()).To(Succeed())
+	_, found := config.GetQuotaList().Organisations.GetById("org-1")
+	Expect(found).To(BeTrue())
+
+	Expect(ioutil.WriteFile(file, []byte("registered_users_per_organisation:\n  - id: org-2\n  - id: org-2\n"), 0600)).To(Succeed())
+	Expect(config.Reload()).ToNot(Succeed())
+	_, found = config.GetQuotaList().Organisations.GetById("org-1")
+	Expect(found).To(BeTrue(), "the quota list loaded previously should be kept when the new one is invalid")
+
+	Expect(os.Remove(file)).To(Succeed())
+	Expect(config.Reload()).ToNot(Succeed())
+	_, found = config.GetQuotaList().Organisations.GetById("org-1")
+	Expect(found).To(BeTrue(), "the quota list loaded previously should be kept when the file is removed")
+}
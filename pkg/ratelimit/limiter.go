@@ -0,0 +1,48 @@
+// The ratelimit package limits the rate of the API requests of each organisation with token buckets.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+//go:generate moq -out limiter_moq.go . Limiter
+type Limiter interface {
+	// Allow takes a token from the bucket of key. When the bucket is empty, the request is not allowed and the time
+	// until the next token is available is returned.
+	Allow(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+}
+
+// bucket is the state of a token bucket, shared by the limiter backends
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+func newBucket(limit Limit, now time.Time) *bucket {
+	return &bucket{
+		tokens:    float64(limit.Burst),
+		updatedAt: now,
+	}
+}
+
+// take refills the bucket with the tokens accumulated since its last update, then takes a token from it
+func (b *bucket) take(limit Limit, now time.Time) (bool, time.Duration) {
+	if elapsed := now.Sub(b.updatedAt); elapsed > 0 {
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed.Seconds()*limit.RequestsPerSecond)
+		b.updatedAt = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) / limit.RequestsPerSecond * float64(time.Second))
+}
+
+// full tells whether the bucket has been refilled completely at the given time, in which case it is the same as a
+// new bucket
+func (b *bucket) full(limit Limit, now time.Time) bool {
+	return b.tokens+now.Sub(b.updatedAt).Seconds()*limit.RequestsPerSecond >= float64(limit.Burst)
+}
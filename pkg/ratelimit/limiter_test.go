@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_bucket_take(t *testing.T) {
+	now := time.Now()
+	limit := Limit{RequestsPerSecond: 2, Burst: 3}
+
+	tests := []struct {
+		name           string
+		bucket         *bucket
+		now            time.Time
+		wantAllowed    bool
+		wantRetryAfter time.Duration
+		wantTokens     float64
+	}{
+		{
+			name:        "should allow the request when the bucket is full",
+			bucket:      newBucket(limit, now),
+			now:         now,
+			wantAllowed: true,
+			wantTokens:  2,
+		},
+		{
+			name:           "should not allow the request when the bucket is empty",
+			bucket:         &bucket{tokens: 0, updatedAt: now},
+			now:            now,
+			wantAllowed:    false,
+			wantRetryAfter: 500 * time.Millisecond,
+			wantTokens:     0,
+		},
+		{
+			name:        "should refill the bucket with the tokens accumulated since its last update",
+			bucket:      &bucket{tokens: 0, updatedAt: now},
+			now:         now.Add(time.Second),
+			wantAllowed: true,
+			wantTokens:  1,
+		},
+		{
+			name:        "should not refill the bucket over its burst",
+			bucket:      &bucket{tokens: 1, updatedAt: now},
+			now:         now.Add(time.Minute),
+			wantAllowed: true,
+			wantTokens:  2,
+		},
+		{
+			name:           "should return the time until the next token is available",
+			bucket:         &bucket{tokens: 0.5, updatedAt: now},
+			now:            now,
+			wantAllowed:    false,
+			wantRetryAfter: 250 * time.Millisecond,
+			wantTokens:     0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			allowed, retryAfter := tt.bucket.take(limit, tt.now)
+			Expect(allowed).To(Equal(tt.wantAllowed))
+			Expect(retryAfter).To(Equal(tt.wantRetryAfter))
+			Expect(tt.bucket.tokens).To(BeNumerically("~", tt.wantTokens, 0.0001))
+		})
+	}
+}
+
+func Test_memoryLimiter_Allow(t *testing.T) {
+	RegisterTestingT(t)
+
+	now := time.Now()
+	limiter := NewMemoryLimiter().(*memoryLimiter)
+	limiter.now = func() time.Time { return now }
+	limiter.lastCleanup = now
+	limit := Limit{RequestsPerSecond: 1, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		allowed, _, err := limiter.Allow(context.Background(), "metrics/org:1", limit)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+	}
+	allowed, retryAfter, err := limiter.Allow(context.Background(), "metrics/org:1", limit)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(allowed).To(BeFalse())
+	Expect(retryAfter).To(Equal(time.Second))
+
+	// buckets are independent
+	allowed, _, err = limiter.Allow(context.Background(), "metrics/org:2", limit)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(allowed).To(BeTrue())
+
+	// full buckets are removed on cleanup
+	now = now.Add(memoryLimiterCleanupInterval)
+	allowed, _, err = limiter.Allow(context.Background(), "metrics/org:1", limit)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(allowed).To(BeTrue())
+	Expect(limiter.buckets).To(HaveLen(1))
+	Expect(limiter.buckets).To(HaveKey("metrics/org:1"))
+}
@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const memoryLimiterCleanupInterval = time.Minute
+
+type memoryBucket struct {
+	*bucket
+	limit Limit
+}
+
+// memoryLimiter keeps the buckets in memory, so the limits apply to each replica of the service
+type memoryLimiter struct {
+	mutex       sync.Mutex
+	buckets     map[string]*memoryBucket
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+var _ Limiter = &memoryLimiter{}
+
+func NewMemoryLimiter() Limiter {
+	return &memoryLimiter{
+		buckets:     map[string]*memoryBucket{},
+		lastCleanup: time.Now(),
+		now:         time.Now,
+	}
+}
+
+func (l *memoryLimiter) Allow(_ context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	l.cleanup(now)
+
+	b, ok := l.buckets[key]
+	if !ok || b.limit != limit {
+		b = &memoryBucket{bucket: newBucket(limit, now), limit: limit}
+		l.buckets[key] = b
+	}
+	allowed, retryAfter := b.take(limit, now)
+	return allowed, retryAfter, nil
+}
+
+// cleanup removes the buckets that have been refilled completely, as they would be recreated identically
+func (l *memoryLimiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < memoryLimiterCleanupInterval {
+		return
+	}
+	for key, b := range l.buckets {
+		if b.full(b.limit, now) {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastCleanup = now
+}
@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// postgresLimiter keeps the buckets in the rate_limit_buckets table, so the limits are shared by all the replicas of
+// the service. Buckets are locked while they are updated and the time of the database is used to refill them, so
+// that the replicas agree on the state of a bucket.
+type postgresLimiter struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ Limiter = &postgresLimiter{}
+
+func NewPostgresLimiter(connectionFactory *db.ConnectionFactory) Limiter {
+	return &postgresLimiter{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (l *postgresLimiter) Allow(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	var allowed bool
+	var retryAfter time.Duration
+
+	err := l.connectionFactory.New().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("INSERT INTO rate_limit_buckets (key, tokens, updated_at) VALUES (?, ?, now()) ON CONFLICT (key) DO NOTHING", key, float64(limit.Burst)).Error; err != nil {
+			return errors.Wrap(err, "failed to create rate limit bucket")
+		}
+
+		var row struct {
+			Tokens    float64
+			UpdatedAt time.Time
+			Now       time.Time
+		}
+		if err := tx.Raw("SELECT tokens, updated_at, now() AS now FROM rate_limit_buckets WHERE key = ? FOR UPDATE", key).Scan(&row).Error; err != nil {
+			return errors.Wrap(err, "failed to get rate limit bucket")
+		}
+
+		b := &bucket{tokens: row.Tokens, updatedAt: row.UpdatedAt}
+		allowed, retryAfter = b.take(limit, row.Now)
+
+		if err := tx.Exec("UPDATE rate_limit_buckets SET tokens = ?, updated_at = ? WHERE key = ?", b.tokens, b.updatedAt, key).Error; err != nil {
+			return errors.Wrap(err, "failed to update rate limit bucket")
+		}
+		return nil
+	})
+	if err != nil {
+		return false, 0, err
+	}
+	return allowed, retryAfter, nil
+}
@@ -0,0 +1,29 @@
+package ratelimit
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/goava/di"
+)
+
+func ConfigProviders() di.Option {
+	return di.Options(
+		di.Provide(NewRateLimitConfig, di.As(new(environments.ConfigModule))),
+		di.Provide(environments.Func(ServiceProviders)),
+	)
+}
+
+func ServiceProviders() di.Option {
+	return di.Options(
+		di.Provide(NewLimiter),
+		di.Provide(NewRateLimitMiddleware),
+	)
+}
+
+// NewLimiter creates the limiter of the configured backend
+func NewLimiter(rateLimitConfig *RateLimitConfig, connectionFactory *db.ConnectionFactory) Limiter {
+	if rateLimitConfig.Backend == PostgresBackend {
+		return NewPostgresLimiter(connectionFactory)
+	}
+	return NewMemoryLimiter()
+}
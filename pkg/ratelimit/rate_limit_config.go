@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	MemoryBackend   = "memory"
+	PostgresBackend = "postgres"
+)
+
+// Limit is the rate of a token bucket: it is refilled with RequestsPerSecond tokens every second and holds up to
+// Burst tokens
+type Limit struct {
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	Burst             int     `yaml:"burst"`
+}
+
+func (l Limit) validate() error {
+	if l.RequestsPerSecond <= 0 {
+		return fmt.Errorf("requests_per_second must be greater than 0")
+	}
+	if l.Burst < 1 {
+		return fmt.Errorf("burst must be greater than 0")
+	}
+	return nil
+}
+
+// RouteGroup is a set of routes sharing the same limit. Routes are identified by the event type of their route name,
+// e.g. 'get-metrics'
+type RouteGroup struct {
+	Name   string   `yaml:"name"`
+	Routes []string `yaml:"routes"`
+	Limit  `yaml:",inline"`
+}
+
+type RateLimits struct {
+	// Default is the limit of the routes not in any group. Those routes are not limited when it is not set.
+	Default *Limit       `yaml:"default"`
+	Groups  []RouteGroup `yaml:"groups"`
+}
+
+// LimitFor returns the group and the limit of the given route, or a nil limit when the route is not limited
+func (l *RateLimits) LimitFor(route string) (string, *Limit) {
+	for i := range l.Groups {
+		if shared.Contains(l.Groups[i].Routes, route) {
+			return l.Groups[i].Name, &l.Groups[i].Limit
+		}
+	}
+	return "default", l.Default
+}
+
+func (l *RateLimits) validate() error {
+	if l.Default != nil {
+		if err := l.Default.validate(); err != nil {
+			return fmt.Errorf("invalid default limit: %w", err)
+		}
+	}
+	for _, group := range l.Groups {
+		if group.Name == "" {
+			return fmt.Errorf("route groups must have a name")
+		}
+		if err := group.validate(); err != nil {
+			return fmt.Errorf("invalid limit for route group '%s': %w", group.Name, err)
+		}
+	}
+	return nil
+}
+
+type RateLimitConfig struct {
+	Enabled    bool
+	Backend    string
+	ConfigFile string
+	Limits     RateLimits
+}
+
+func NewRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Enabled:    false,
+		Backend:    MemoryBackend,
+		ConfigFile: "config/rate-limit-configuration.yaml",
+	}
+}
+
+func (c *RateLimitConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.Enabled, "enable-rate-limit", c.Enabled, "Enable rate limiting of the API requests per organisation")
+	fs.StringVar(&c.Backend, "rate-limit-backend", c.Backend, fmt.Sprintf("Backend storing the rate limit counters: '%s' for limits per replica or '%s' for limits shared by all the replicas", MemoryBackend, PostgresBackend))
+	fs.StringVar(&c.ConfigFile, "rate-limit-config-file", c.ConfigFile, "Rate limit configuration file")
+}
+
+func (c *RateLimitConfig) ReadFiles() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Backend != MemoryBackend && c.Backend != PostgresBackend {
+		return fmt.Errorf("invalid rate limit backend '%s', supported backends are: %s, %s", c.Backend, MemoryBackend, PostgresBackend)
+	}
+	if err := readRateLimitConfigFile(c.ConfigFile, &c.Limits); err != nil {
+		return err
+	}
+	return c.Limits.validate()
+}
+
+// Read the contents of file into the rate limits config
+func readRateLimitConfigFile(file string, val *RateLimits) error {
+	fileContents, err := shared.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	return yaml.UnmarshalStrict([]byte(fileContents), val)
+}
@@ -0,0 +1,91 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+)
+
+type RateLimitMiddleware struct {
+	rateLimitConfig *RateLimitConfig
+	limiter         Limiter
+}
+
+func NewRateLimitMiddleware(rateLimitConfig *RateLimitConfig, limiter Limiter) *RateLimitMiddleware {
+	middleware := RateLimitMiddleware{
+		rateLimitConfig: rateLimitConfig,
+		limiter:         limiter,
+	}
+	return &middleware
+}
+
+// Middleware handler to limit the rate of the requests of each organisation, per route group. Requests without an
+// organisation are limited per service account client id (e.g. the one of an agent cluster) or per user.
+// Requests that are not authenticated are left to the authentication middlewares.
+func (middleware *RateLimitMiddleware) RateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !middleware.rateLimitConfig.Enabled {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		claims, err := auth.GetClaimsFromContext(r.Context())
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		subject := rateLimitSubject(claims)
+		if subject == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var route string
+		if currentRoute := mux.CurrentRoute(r); currentRoute != nil {
+			route = logger.NewLogEventFromString(currentRoute.GetName()).Type
+		}
+		group, limit := middleware.rateLimitConfig.Limits.LimitFor(route)
+		if limit == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		allowed, retryAfter, err := middleware.limiter.Allow(r.Context(), fmt.Sprintf("%s/%s", group, subject), *limit)
+		if err != nil {
+			// don't fail the request because the limits can't be checked
+			glog.Errorf("unable to check rate limit of '%s' for route group '%s': %v", subject, group, err)
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !allowed {
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(seconds))
+			shared.HandleError(r, w, errors.TooManyRequests("Rate limit exceeded, retry after %d seconds", seconds))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func rateLimitSubject(claims jwt.MapClaims) string {
+	if orgId := auth.GetOrgIdFromClaims(claims); orgId != "" {
+		return "org:" + orgId
+	}
+	if clientId := auth.GetClientIdFromClaims(claims); clientId != "" {
+		return "client:" + clientId
+	}
+	if username := auth.GetUsernameFromClaims(claims); username != "" {
+		return "user:" + username
+	}
+	return ""
+}
@@ -0,0 +1,163 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+)
+
+func TestRateLimitMiddleware_RateLimit(t *testing.T) {
+	limits := RateLimits{
+		Default: &Limit{RequestsPerSecond: 10, Burst: 20},
+		Groups: []RouteGroup{
+			{
+				Name:   "metrics",
+				Routes: []string{"get-metrics"},
+				Limit:  Limit{RequestsPerSecond: 1, Burst: 5},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		enabled        bool
+		limits         RateLimits
+		claims         jwt.MapClaims
+		route          string
+		allowed        bool
+		retryAfter     time.Duration
+		allowErr       error
+		wantCode       int
+		wantKey        string
+		wantRetryAfter string
+	}{
+		{
+			name:     "should not limit requests when rate limiting is disabled",
+			enabled:  false,
+			limits:   limits,
+			claims:   jwt.MapClaims{"org_id": "org-1"},
+			route:    "get-metrics",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "should not limit requests without claims",
+			enabled:  true,
+			limits:   limits,
+			route:    "get-metrics",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "should limit requests per organisation and route group",
+			enabled:  true,
+			limits:   limits,
+			claims:   jwt.MapClaims{"org_id": "org-1", "username": "user-1"},
+			route:    "get-metrics",
+			allowed:  true,
+			wantCode: http.StatusOK,
+			wantKey:  "metrics/org:org-1",
+		},
+		{
+			name:     "should limit requests of routes not in a group with the default limit",
+			enabled:  true,
+			limits:   limits,
+			claims:   jwt.MapClaims{"org_id": "org-1"},
+			route:    "list-kafka",
+			allowed:  true,
+			wantCode: http.StatusOK,
+			wantKey:  "default/org:org-1",
+		},
+		{
+			name:     "should limit requests without organisation per client id",
+			enabled:  true,
+			limits:   limits,
+			claims:   jwt.MapClaims{"clientId": "kas-fleetshard-agent-cluster-1"},
+			route:    "list-dataplane-kafkas",
+			allowed:  true,
+			wantCode: http.StatusOK,
+			wantKey:  "default/client:kas-fleetshard-agent-cluster-1",
+		},
+		{
+			name:     "should limit requests without organisation per user",
+			enabled:  true,
+			limits:   limits,
+			claims:   jwt.MapClaims{"username": "user-1"},
+			route:    "list-kafka",
+			allowed:  true,
+			wantCode: http.StatusOK,
+			wantKey:  "default/user:user-1",
+		},
+		{
+			name:     "should not limit routes not in a group when there is no default limit",
+			enabled:  true,
+			limits:   RateLimits{Groups: limits.Groups},
+			claims:   jwt.MapClaims{"org_id": "org-1"},
+			route:    "list-kafka",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:           "should reject requests over the limit with a retry after header",
+			enabled:        true,
+			limits:         limits,
+			claims:         jwt.MapClaims{"org_id": "org-1"},
+			route:          "get-metrics",
+			allowed:        false,
+			retryAfter:     1500 * time.Millisecond,
+			wantCode:       http.StatusTooManyRequests,
+			wantKey:        "metrics/org:org-1",
+			wantRetryAfter: "2",
+		},
+		{
+			name:     "should not fail requests when the limit can't be checked",
+			enabled:  true,
+			limits:   limits,
+			claims:   jwt.MapClaims{"org_id": "org-1"},
+			route:    "get-metrics",
+			allowErr: fmt.Errorf("connection refused"),
+			wantCode: http.StatusOK,
+			wantKey:  "metrics/org:org-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			limiter := &LimiterMock{
+				AllowFunc: func(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+					return tt.allowed, tt.retryAfter, tt.allowErr
+				},
+			}
+			middleware := NewRateLimitMiddleware(&RateLimitConfig{Enabled: tt.enabled, Limits: tt.limits}, limiter)
+
+			router := mux.NewRouter()
+			router.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}).Name(logger.NewLogEvent(tt.route, "test route").ToString())
+			router.Use(middleware.RateLimit)
+
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+			if tt.claims != nil {
+				req = req.WithContext(auth.SetTokenInContext(req.Context(), &jwt.Token{Claims: tt.claims}))
+			}
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Result().StatusCode).To(Equal(tt.wantCode))
+			Expect(recorder.Result().Header.Get("Retry-After")).To(Equal(tt.wantRetryAfter))
+			if tt.wantKey == "" {
+				Expect(limiter.AllowCalls()).To(BeEmpty())
+			} else {
+				Expect(limiter.AllowCalls()).To(HaveLen(1))
+				Expect(limiter.AllowCalls()[0].Key).To(Equal(tt.wantKey))
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+type contextKey string
+
+const contextRole contextKey = "rbac-role"
+
+// SetRoleContext sets the role of the principal of the request in the context
+func SetRoleContext(ctx context.Context, role Role) context.Context {
+	return context.WithValue(ctx, contextRole, role)
+}
+
+// GetRoleFromContext returns the role of the principal of the request, resolved by the RoleMiddleware. The default
+// role given by the claims of the principal is returned when the role has not been resolved.
+func GetRoleFromContext(ctx context.Context) Role {
+	if role, ok := ctx.Value(contextRole).(Role); ok {
+		return role
+	}
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return RoleViewer
+	}
+	return DefaultRole(claims)
+}
+
+// DefaultRole returns the role of the principals that are not bound to any role. Organisation administrators are
+// always administrators, the other members of the organisation are editors.
+func DefaultRole(claims jwt.MapClaims) Role {
+	if auth.GetIsOrgAdminFromClaims(claims) {
+		return RoleAdmin
+	}
+	return RoleEditor
+}
+
+// Resource is a resource of an organisation that permissions are evaluated on
+type Resource struct {
+	Type           ResourceType
+	OrganisationId string
+	// Owner is the username of the owner of the resource, empty for the resources not owned by a user
+	Owner string
+}
+
+// Evaluate returns true when the principal of the request has the permission on the resource. Principals that are
+// not members of an organisation only have permissions on the resources they own, outside of any organisation.
+func Evaluate(ctx context.Context, permission Permission, resource Resource) bool {
+	if auth.GetIsAdminFromContext(ctx) {
+		return true
+	}
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return false
+	}
+
+	username := auth.GetUsernameFromClaims(claims)
+	orgId := auth.GetOrgIdFromClaims(claims)
+	isOwner := resource.Owner != "" && resource.Owner == username
+	if orgId == "" {
+		return resource.OrganisationId == "" && (isOwner || permission == PermissionCreate)
+	}
+	if resource.OrganisationId != orgId {
+		return false
+	}
+
+	scope, ok := GetRoleFromContext(ctx).Scope(resource.Type, permission)
+	return ok && (scope == ScopeOrganisation || isOwner)
+}
+
+// EvaluateAny returns true when the principal of the request has the permission on at least some of the resources of
+// the type in its organisation, i.e. the resources it owns. It is used when the resource is not known yet.
+func EvaluateAny(ctx context.Context, resourceType ResourceType, permission Permission) bool {
+	if auth.GetIsAdminFromContext(ctx) {
+		return true
+	}
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return false
+	}
+	if auth.GetOrgIdFromClaims(claims) == "" {
+		return true
+	}
+	_, ok := GetRoleFromContext(ctx).Scope(resourceType, permission)
+	return ok
+}
+
+// HasOrganisationScope returns true when the principal of the request has the permission on all the resources of the
+// type in its organisation
+func HasOrganisationScope(ctx context.Context, resourceType ResourceType, permission Permission) bool {
+	if auth.GetIsAdminFromContext(ctx) {
+		return true
+	}
+	scope, ok := GetRoleFromContext(ctx).Scope(resourceType, permission)
+	return ok && scope == ScopeOrganisation
+}
+
+// Authorize returns a forbidden error when the principal of the request does not have the permission on the resource
+func Authorize(ctx context.Context, permission Permission, resource Resource) *errors.ServiceError {
+	if !Evaluate(ctx, permission, resource) {
+		return errors.Forbidden("User not authorized to %s this %s", permission, resource.Type)
+	}
+	return nil
+}
+
+// AuthorizeAny returns a forbidden error when the principal of the request does not have the permission on any of the
+// resources of the type
+func AuthorizeAny(ctx context.Context, resourceType ResourceType, permission Permission) *errors.ServiceError {
+	if !EvaluateAny(ctx, resourceType, permission) {
+		return errors.Forbidden("User not authorized to %s %s resources", permission, resourceType)
+	}
+	return nil
+}
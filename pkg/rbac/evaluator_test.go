@@ -0,0 +1,125 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/gomega"
+)
+
+func contextWithClaims(claims jwt.MapClaims) context.Context {
+	return auth.SetTokenInContext(context.Background(), &jwt.Token{Claims: claims})
+}
+
+func Test_Evaluate(t *testing.T) {
+	t.Parallel()
+	member := jwt.MapClaims{"username": "member", "org_id": "org-1"}
+	orgAdmin := jwt.MapClaims{"username": "org-admin", "org_id": "org-1", "is_org_admin": true}
+	individual := jwt.MapClaims{"username": "individual"}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		permission Permission
+		resource   Resource
+		want       bool
+	}{
+		{
+			name:       "allow editors to update the resources they own",
+			ctx:        contextWithClaims(member),
+			permission: PermissionUpdate,
+			resource:   Resource{Type: ResourceKafka, OrganisationId: "org-1", Owner: "member"},
+			want:       true,
+		},
+		{
+			name:       "deny editors to update the resources owned by other members",
+			ctx:        contextWithClaims(member),
+			permission: PermissionUpdate,
+			resource:   Resource{Type: ResourceKafka, OrganisationId: "org-1", Owner: "other-member"},
+			want:       false,
+		},
+		{
+			name:       "deny viewers to update the resources they own",
+			ctx:        SetRoleContext(contextWithClaims(member), RoleViewer),
+			permission: PermissionUpdate,
+			resource:   Resource{Type: ResourceKafka, OrganisationId: "org-1", Owner: "member"},
+			want:       false,
+		},
+		{
+			name:       "allow members bound to the admin role to update the resources of other members",
+			ctx:        SetRoleContext(contextWithClaims(member), RoleAdmin),
+			permission: PermissionUpdate,
+			resource:   Resource{Type: ResourceKafka, OrganisationId: "org-1", Owner: "other-member"},
+			want:       true,
+		},
+		{
+			name:       "allow organisation administrators to delete the resources of other members",
+			ctx:        contextWithClaims(orgAdmin),
+			permission: PermissionDelete,
+			resource:   Resource{Type: ResourceServiceAccount, OrganisationId: "org-1", Owner: "other-member"},
+			want:       true,
+		},
+		{
+			name:       "deny organisation administrators to read the resources of other organisations",
+			ctx:        contextWithClaims(orgAdmin),
+			permission: PermissionRead,
+			resource:   Resource{Type: ResourceKafka, OrganisationId: "org-2", Owner: "other-member"},
+			want:       false,
+		},
+		{
+			name:       "allow users without organisation to delete the resources they own",
+			ctx:        contextWithClaims(individual),
+			permission: PermissionDelete,
+			resource:   Resource{Type: ResourceConnector, Owner: "individual"},
+			want:       true,
+		},
+		{
+			name:       "deny users without organisation to read the resources of an organisation",
+			ctx:        contextWithClaims(individual),
+			permission: PermissionRead,
+			resource:   Resource{Type: ResourceConnector, OrganisationId: "org-1", Owner: "individual"},
+			want:       false,
+		},
+		{
+			name:       "allow fleet manager administrators",
+			ctx:        auth.SetIsAdminContext(context.Background(), true),
+			permission: PermissionDelete,
+			resource:   Resource{Type: ResourceKafka, OrganisationId: "org-1", Owner: "member"},
+			want:       true,
+		},
+		{
+			name:       "deny unauthenticated requests",
+			ctx:        context.Background(),
+			permission: PermissionRead,
+			resource:   Resource{Type: ResourceKafka, OrganisationId: "org-1", Owner: "member"},
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			RegisterTestingT(t)
+			Expect(Evaluate(tt.ctx, tt.permission, tt.resource)).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_AuthorizeAny(t *testing.T) {
+	RegisterTestingT(t)
+	member := contextWithClaims(jwt.MapClaims{"username": "member", "org_id": "org-1"})
+	Expect(AuthorizeAny(member, ResourceKafka, PermissionCreate)).To(BeNil())
+	Expect(AuthorizeAny(member, ResourceRoleBinding, PermissionCreate)).ToNot(BeNil())
+	Expect(AuthorizeAny(SetRoleContext(member, RoleViewer), ResourceKafka, PermissionCreate)).ToNot(BeNil())
+	Expect(AuthorizeAny(SetRoleContext(member, RoleAdmin), ResourceRoleBinding, PermissionDelete)).To(BeNil())
+}
+
+func Test_HasOrganisationScope(t *testing.T) {
+	RegisterTestingT(t)
+	member := contextWithClaims(jwt.MapClaims{"username": "member", "org_id": "org-1"})
+	Expect(HasOrganisationScope(member, ResourceKafka, PermissionDelete)).To(BeFalse())
+	Expect(HasOrganisationScope(member, ResourceKafka, PermissionRead)).To(BeTrue())
+	Expect(HasOrganisationScope(SetRoleContext(member, RoleAdmin), ResourceKafka, PermissionDelete)).To(BeTrue())
+}
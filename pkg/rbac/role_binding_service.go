@@ -0,0 +1,121 @@
+package rbac
+
+import (
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+)
+
+//go:generate moq -out role_binding_service_moq.go . RoleBindingService
+type RoleBindingService interface {
+	// List returns the role bindings of the organisation
+	List(orgId string) (api.RoleBindingList, *errors.ServiceError)
+	// Get returns the role binding of the organisation with the given id
+	Get(orgId string, id string) (*api.RoleBinding, *errors.ServiceError)
+	// FindBySubjects returns the role binding of the organisation bound to the first of the subjects that is bound to a
+	// role, nil if there is none. The subjects only match the role bindings of their type.
+	FindBySubjects(orgId string, subjects []Subject) (*api.RoleBinding, *errors.ServiceError)
+	// Create binds a role to a subject of the organisation. A subject can only be bound to a single role.
+	Create(roleBinding *api.RoleBinding) *errors.ServiceError
+	Delete(orgId string, id string) *errors.ServiceError
+}
+
+// Subject is a user or a service account roles can be bound to
+type Subject struct {
+	Name string
+	Type SubjectType
+}
+
+var _ RoleBindingService = &roleBindingService{}
+
+type roleBindingService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewRoleBindingService(connectionFactory *db.ConnectionFactory) RoleBindingService {
+	return &roleBindingService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *roleBindingService) List(orgId string) (api.RoleBindingList, *errors.ServiceError) {
+	dbConn := s.connectionFactory.New()
+	var roleBindings api.RoleBindingList
+	if err := dbConn.Where("organisation_id = ?", orgId).Order("created_at").Find(&roleBindings).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list role bindings")
+	}
+	return roleBindings, nil
+}
+
+func (s *roleBindingService) Get(orgId string, id string) (*api.RoleBinding, *errors.ServiceError) {
+	dbConn := s.connectionFactory.New()
+	var roleBinding api.RoleBinding
+	if err := dbConn.Where("organisation_id = ? AND id = ?", orgId, id).First(&roleBinding).Error; err != nil {
+		return nil, services.HandleGetError("RoleBinding", "id", id, err)
+	}
+	return &roleBinding, nil
+}
+
+func (s *roleBindingService) FindBySubjects(orgId string, subjects []Subject) (*api.RoleBinding, *errors.ServiceError) {
+	if len(subjects) == 0 {
+		return nil, nil
+	}
+	conditions := make([]string, 0, len(subjects))
+	args := make([]interface{}, 0, 2*len(subjects))
+	for _, subject := range subjects {
+		conditions = append(conditions, "(subject = ? AND subject_type = ?)")
+		args = append(args, subject.Name, string(subject.Type))
+	}
+
+	dbConn := s.connectionFactory.New()
+	var roleBindings api.RoleBindingList
+	if err := dbConn.Where("organisation_id = ?", orgId).
+		Where(strings.Join(conditions, " OR "), args...).
+		Find(&roleBindings).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to find role binding")
+	}
+
+	// several subjects may be bound to a role, e.g. both the username and the client id of a service account: the
+	// binding of the first subject wins so that the role does not depend on the order the rows are returned in
+	for _, subject := range subjects {
+		for _, roleBinding := range roleBindings {
+			if roleBinding.Subject == subject.Name && roleBinding.SubjectType == string(subject.Type) {
+				return roleBinding, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (s *roleBindingService) Create(roleBinding *api.RoleBinding) *errors.ServiceError {
+	// a subject can only be bound to a single role whatever its type, as enforced by the unique index of the table
+	dbConn := s.connectionFactory.New()
+	var existing api.RoleBindingList
+	if err := dbConn.Where("organisation_id = ? AND subject = ?", roleBinding.OrganisationId, roleBinding.Subject).Find(&existing).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to find role binding")
+	}
+	if len(existing) > 0 {
+		return errors.Conflict("Subject '%s' is already bound to the role '%s'", roleBinding.Subject, existing[0].Role)
+	}
+
+	roleBinding.ID = api.NewID()
+	if err := dbConn.Create(roleBinding).Error; err != nil {
+		return services.HandleCreateError("RoleBinding", err)
+	}
+	return nil
+}
+
+func (s *roleBindingService) Delete(orgId string, id string) *errors.ServiceError {
+	dbConn := s.connectionFactory.New()
+	result := dbConn.Where("organisation_id = ? AND id = ?", orgId, id).Delete(&api.RoleBinding{})
+	if result.Error != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, result.Error, "failed to delete role binding")
+	}
+	if result.RowsAffected == 0 {
+		return errors.NotFound("RoleBinding with id='%s' not found", id)
+	}
+	return nil
+}
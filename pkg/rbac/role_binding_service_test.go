@@ -0,0 +1,318 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+var roleBindingRow = map[string]interface{}{
+	"id":              "role-binding-id",
+	"organisation_id": "org-id",
+	"subject":         "user",
+	"subject_type":    string(SubjectTypeUser),
+	"role":            string(RoleEditor),
+	"created_by":      "admin",
+}
+
+func Test_roleBindingService_List(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "should return the role bindings of the organisation",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "role_bindings" WHERE (organisation_id = $1)`).
+					WithArgs("org-id").
+					WithReply([]map[string]interface{}{roleBindingRow})
+			},
+			want: 1,
+		},
+		{
+			name: "should return an error when the role bindings cannot be listed",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "role_bindings"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			s := NewRoleBindingService(db.NewMockConnectionFactory(nil))
+			tt.setupFn()
+
+			roleBindings, err := s.List("org-id")
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(roleBindings).To(HaveLen(tt.want))
+		})
+	}
+}
+
+func Test_roleBindingService_Get(t *testing.T) {
+	tests := []struct {
+		name     string
+		setupFn  func()
+		wantCode errors.ServiceErrorCode
+	}{
+		{
+			name: "should return the role binding of the organisation",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "role_bindings" WHERE (organisation_id = $1 AND id = $2)`).
+					WithArgs("org-id", "role-binding-id").
+					WithReply([]map[string]interface{}{roleBindingRow})
+			},
+		},
+		{
+			name: "should return not found when the organisation has no role binding with the id",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantCode: errors.ErrorNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			s := NewRoleBindingService(db.NewMockConnectionFactory(nil))
+			tt.setupFn()
+
+			roleBinding, err := s.Get("org-id", "role-binding-id")
+			if tt.wantCode != 0 {
+				Expect(err).ToNot(BeNil())
+				Expect(err.Code).To(Equal(tt.wantCode))
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(roleBinding.ID).To(Equal("role-binding-id"))
+			Expect(roleBinding.Role).To(Equal(string(RoleEditor)))
+		})
+	}
+}
+
+func Test_roleBindingService_FindBySubjects(t *testing.T) {
+	serviceAccountRow := map[string]interface{}{
+		"id":              "client-role-binding-id",
+		"organisation_id": "org-id",
+		"subject":         "client-id",
+		"subject_type":    string(SubjectTypeServiceAccount),
+		"role":            string(RoleViewer),
+		"created_by":      "admin",
+	}
+	serviceAccountUserRow := map[string]interface{}{
+		"id":              "user-role-binding-id",
+		"organisation_id": "org-id",
+		"subject":         "service-account-user",
+		"subject_type":    string(SubjectTypeServiceAccount),
+		"role":            string(RoleAdmin),
+		"created_by":      "admin",
+	}
+	serviceAccountSubjects := []Subject{
+		{Name: "service-account-user", Type: SubjectTypeServiceAccount},
+		{Name: "client-id", Type: SubjectTypeServiceAccount},
+	}
+
+	tests := []struct {
+		name     string
+		subjects []Subject
+		setupFn  func()
+		wantID   string
+		wantErr  bool
+	}{
+		{
+			name:     "should return the role binding of the subject with its type",
+			subjects: []Subject{{Name: "user", Type: SubjectTypeUser}},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "role_bindings" WHERE (organisation_id = $1) AND ((subject = $2 AND subject_type = $3))`).
+					WithArgs("org-id", "user", string(SubjectTypeUser)).
+					WithReply([]map[string]interface{}{roleBindingRow})
+			},
+			wantID: "role-binding-id",
+		},
+		{
+			name:     "should return the role binding of the first subject when several subjects are bound to a role",
+			subjects: serviceAccountSubjects,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "role_bindings" WHERE (organisation_id = $1) AND ((subject = $2 AND subject_type = $3) OR (subject = $4 AND subject_type = $5))`).
+					WithArgs("org-id", "service-account-user", string(SubjectTypeServiceAccount), "client-id", string(SubjectTypeServiceAccount)).
+					WithReply([]map[string]interface{}{serviceAccountRow, serviceAccountUserRow})
+			},
+			wantID: "user-role-binding-id",
+		},
+		{
+			name:     "should return the role binding of the next subject when the first one is not bound to a role",
+			subjects: serviceAccountSubjects,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "role_bindings"`).
+					WithReply([]map[string]interface{}{serviceAccountRow})
+			},
+			wantID: "client-role-binding-id",
+		},
+		{
+			name:     "should ignore the role bindings of another subject type",
+			subjects: []Subject{{Name: "user", Type: SubjectTypeServiceAccount}},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "role_bindings"`).
+					WithReply([]map[string]interface{}{roleBindingRow})
+			},
+		},
+		{
+			name:     "should return nil when none of the subjects is bound to a role",
+			subjects: serviceAccountSubjects,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+		},
+		{
+			name:     "should return an error when the role bindings cannot be queried",
+			subjects: serviceAccountSubjects,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "role_bindings"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			s := NewRoleBindingService(db.NewMockConnectionFactory(nil))
+			tt.setupFn()
+
+			roleBinding, err := s.FindBySubjects("org-id", tt.subjects)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			if tt.wantID == "" {
+				Expect(roleBinding).To(BeNil())
+				return
+			}
+			Expect(roleBinding).ToNot(BeNil())
+			Expect(roleBinding.ID).To(Equal(tt.wantID))
+		})
+	}
+}
+
+func Test_roleBindingService_Create(t *testing.T) {
+	tests := []struct {
+		name     string
+		setupFn  func()
+		wantCode errors.ServiceErrorCode
+	}{
+		{
+			name: "should create the role binding when the subject is not bound to a role",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "role_bindings"`)
+			},
+		},
+		{
+			name: "should return a conflict when the subject is already bound to a role",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "role_bindings" WHERE (organisation_id = $1 AND subject = $2)`).
+					WithArgs("org-id", "user").
+					WithReply([]map[string]interface{}{roleBindingRow})
+			},
+			wantCode: errors.ErrorConflict,
+		},
+		{
+			name: "should return an error when the role binding cannot be inserted",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "role_bindings"`).WithExecException()
+			},
+			wantCode: errors.ErrorGeneral,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			s := NewRoleBindingService(db.NewMockConnectionFactory(nil))
+			tt.setupFn()
+
+			roleBinding := &api.RoleBinding{
+				OrganisationId: "org-id",
+				Subject:        "user",
+				SubjectType:    string(SubjectTypeUser),
+				Role:           string(RoleViewer),
+			}
+			err := s.Create(roleBinding)
+			if tt.wantCode != 0 {
+				Expect(err).ToNot(BeNil())
+				Expect(err.Code).To(Equal(tt.wantCode))
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(roleBinding.ID).ToNot(BeEmpty())
+		})
+	}
+}
+
+func Test_roleBindingService_Delete(t *testing.T) {
+	tests := []struct {
+		name     string
+		setupFn  func()
+		wantCode errors.ServiceErrorCode
+	}{
+		{
+			name: "should delete the role binding of the organisation",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`UPDATE "role_bindings" SET "deleted_at"=$1 WHERE (organisation_id = $2 AND id = $3)`).
+					WithRowsNum(1)
+			},
+		},
+		{
+			name: "should return not found when the organisation has no role binding with the id",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "role_bindings"`).WithRowsNum(0)
+			},
+			wantCode: errors.ErrorNotFound,
+		},
+		{
+			name: "should return an error when the role binding cannot be deleted",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "role_bindings"`).WithExecException()
+			},
+			wantCode: errors.ErrorGeneral,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			s := NewRoleBindingService(db.NewMockConnectionFactory(nil))
+			tt.setupFn()
+
+			err := s.Delete("org-id", "role-binding-id")
+			if tt.wantCode != 0 {
+				Expect(err).ToNot(BeNil())
+				Expect(err.Code).To(Equal(tt.wantCode))
+				return
+			}
+			Expect(err).To(BeNil())
+		})
+	}
+}
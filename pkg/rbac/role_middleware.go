@@ -0,0 +1,62 @@
+package rbac
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+type RoleMiddleware struct {
+	roleBindingService RoleBindingService
+}
+
+func NewRoleMiddleware(roleBindingService RoleBindingService) *RoleMiddleware {
+	return &RoleMiddleware{
+		roleBindingService: roleBindingService,
+	}
+}
+
+// ResolveRole is the middleware handler setting the role of the principal of the request in the context, so that the
+// policy evaluator can authorize the actions of the handlers. Organisation administrators are always administrators,
+// the role of the other members of an organisation is given by the role binding of their username, or of the client id
+// of service accounts when their username is not bound to a role.
+func (m *RoleMiddleware) ResolveRole(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, err := auth.GetClaimsFromContext(ctx)
+		if err != nil {
+			// leave the authentication errors to the other middlewares and the handlers
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		role := DefaultRole(claims)
+		orgId := auth.GetOrgIdFromClaims(claims)
+		if orgId != "" && role != RoleAdmin {
+			roleBinding, svcErr := m.roleBindingService.FindBySubjects(orgId, requestSubjects(claims))
+			if svcErr != nil {
+				shared.HandleError(r, w, svcErr)
+				return
+			}
+			if roleBinding != nil {
+				role = Role(roleBinding.Role)
+			}
+		}
+
+		*r = *r.WithContext(SetRoleContext(ctx, role))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requestSubjects returns the subjects the principal of the request may be bound as, in the order their role bindings
+// take precedence. Tokens with a client id are the tokens of service accounts.
+func requestSubjects(claims jwt.MapClaims) []Subject {
+	username := auth.GetUsernameFromClaims(claims)
+	clientId := auth.GetClientIdFromClaims(claims)
+	if clientId == "" {
+		return []Subject{{Name: username, Type: SubjectTypeUser}}
+	}
+	return []Subject{{Name: username, Type: SubjectTypeServiceAccount}, {Name: clientId, Type: SubjectTypeServiceAccount}}
+}
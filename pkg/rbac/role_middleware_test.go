@@ -0,0 +1,105 @@
+package rbac
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/gomega"
+)
+
+func Test_RoleMiddleware_ResolveRole(t *testing.T) {
+	tests := []struct {
+		name         string
+		claims       jwt.MapClaims
+		roleBinding  *api.RoleBinding
+		findErr      *errors.ServiceError
+		wantRole     Role
+		wantStatus   int
+		wantLookedUp bool
+		wantSubjects []Subject
+	}{
+		{
+			name:         "resolve the default role when the user is not bound to any role",
+			claims:       jwt.MapClaims{"username": "member", "org_id": "org-1"},
+			wantRole:     RoleEditor,
+			wantStatus:   http.StatusOK,
+			wantLookedUp: true,
+			wantSubjects: []Subject{{Name: "member", Type: SubjectTypeUser}},
+		},
+		{
+			name:         "look up the role bindings of the service account user and client id of service account tokens",
+			claims:       jwt.MapClaims{"username": "service-account-member", "clientId": "member-client", "org_id": "org-1"},
+			roleBinding:  &api.RoleBinding{Subject: "member-client", SubjectType: string(SubjectTypeServiceAccount), Role: string(RoleAdmin)},
+			wantRole:     RoleAdmin,
+			wantStatus:   http.StatusOK,
+			wantLookedUp: true,
+			wantSubjects: []Subject{
+				{Name: "service-account-member", Type: SubjectTypeServiceAccount},
+				{Name: "member-client", Type: SubjectTypeServiceAccount},
+			},
+		},
+		{
+			name:         "resolve the role bound to the user",
+			claims:       jwt.MapClaims{"username": "member", "org_id": "org-1"},
+			roleBinding:  &api.RoleBinding{Subject: "member", Role: string(RoleViewer)},
+			wantRole:     RoleViewer,
+			wantStatus:   http.StatusOK,
+			wantLookedUp: true,
+		},
+		{
+			name:         "always resolve the admin role for organisation administrators",
+			claims:       jwt.MapClaims{"username": "org-admin", "org_id": "org-1", "is_org_admin": true},
+			roleBinding:  &api.RoleBinding{Subject: "org-admin", Role: string(RoleViewer)},
+			wantRole:     RoleAdmin,
+			wantStatus:   http.StatusOK,
+			wantLookedUp: false,
+		},
+		{
+			name:         "do not look up the role bindings of users without organisation",
+			claims:       jwt.MapClaims{"username": "individual"},
+			wantRole:     RoleEditor,
+			wantStatus:   http.StatusOK,
+			wantLookedUp: false,
+		},
+		{
+			name:         "return an error when the role bindings cannot be retrieved",
+			claims:       jwt.MapClaims{"username": "member", "org_id": "org-1"},
+			findErr:      errors.GeneralError("db error"),
+			wantStatus:   http.StatusInternalServerError,
+			wantLookedUp: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			roleBindingService := &RoleBindingServiceMock{
+				FindBySubjectsFunc: func(orgId string, subjects []Subject) (*api.RoleBinding, *errors.ServiceError) {
+					return tt.roleBinding, tt.findErr
+				},
+			}
+			var resolvedRole Role
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				resolvedRole = GetRoleFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/kafkas_mgmt/v1/kafkas", nil)
+			req = req.WithContext(auth.SetTokenInContext(req.Context(), &jwt.Token{Claims: tt.claims}))
+			rr := httptest.NewRecorder()
+			NewRoleMiddleware(roleBindingService).ResolveRole(next).ServeHTTP(rr, req)
+
+			Expect(rr.Code).To(Equal(tt.wantStatus))
+			Expect(resolvedRole).To(Equal(tt.wantRole))
+			Expect(len(roleBindingService.FindBySubjectsCalls()) > 0).To(Equal(tt.wantLookedUp))
+			if tt.wantSubjects != nil {
+				Expect(roleBindingService.FindBySubjectsCalls()[0].Subjects).To(Equal(tt.wantSubjects))
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// The rbac package implements the role based access control of the resources of an organisation. The members of an
+// organisation are given a role, either through a RoleBinding or by default, and the permissions of the role are
+// evaluated against the resources of the organisation by the policy evaluator.
+package rbac
+
+// Role is the role of a user or a service account within its organisation
+type Role string
+
+const (
+	// RoleViewer can read the resources of the organisation
+	RoleViewer Role = "viewer"
+	// RoleEditor can read the resources of the organisation, create resources and modify the resources it owns
+	RoleEditor Role = "editor"
+	// RoleAdmin can read and modify all the resources of the organisation and manage the role bindings
+	RoleAdmin Role = "admin"
+)
+
+// Roles are the roles that can be bound to the members of an organisation
+var Roles = []Role{RoleViewer, RoleEditor, RoleAdmin}
+
+// IsValid returns true when the role is one of the Roles
+func (r Role) IsValid() bool {
+	for _, role := range Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Permission is an action on a type of resource
+type Permission string
+
+const (
+	PermissionRead   Permission = "read"
+	PermissionCreate Permission = "create"
+	PermissionUpdate Permission = "update"
+	PermissionDelete Permission = "delete"
+)
+
+var permissions = []Permission{PermissionRead, PermissionCreate, PermissionUpdate, PermissionDelete}
+
+// ResourceType is the type of the resources permissions are evaluated on
+type ResourceType string
+
+const (
+	ResourceKafka              ResourceType = "kafka"
+	ResourceServiceAccount     ResourceType = "service_account"
+	ResourceConnector          ResourceType = "connector"
+	ResourceConnectorCluster   ResourceType = "connector_cluster"
+	ResourceConnectorNamespace ResourceType = "connector_namespace"
+	ResourceRoleBinding        ResourceType = "role_binding"
+)
+
+var resourceTypes = []ResourceType{
+	ResourceKafka, ResourceServiceAccount, ResourceConnector, ResourceConnectorCluster, ResourceConnectorNamespace, ResourceRoleBinding,
+}
+
+// SubjectType is the type of the principal a role is bound to
+type SubjectType string
+
+const (
+	SubjectTypeUser           SubjectType = "user"
+	SubjectTypeServiceAccount SubjectType = "service_account"
+)
+
+// IsValid returns true when the subject type is either SubjectTypeUser or SubjectTypeServiceAccount
+func (t SubjectType) IsValid() bool {
+	return t == SubjectTypeUser || t == SubjectTypeServiceAccount
+}
+
+// Scope defines the resources of the organisation a permission is granted on
+type Scope string
+
+const (
+	// ScopeOrganisation grants the permission on all the resources of the organisation
+	ScopeOrganisation Scope = "organisation"
+	// ScopeOwned grants the permission on the resources owned by the principal only
+	ScopeOwned Scope = "owned"
+)
+
+// Grant is a permission granted to a role on a type of resource
+type Grant struct {
+	ResourceType ResourceType
+	Permission   Permission
+	Scope        Scope
+}
+
+// Scope returns the scope of the permission granted to the role on the type of resource, false if the permission is
+// not granted
+func (r Role) Scope(resourceType ResourceType, permission Permission) (Scope, bool) {
+	switch {
+	case r == RoleAdmin:
+		return ScopeOrganisation, true
+	case permission == PermissionRead:
+		return ScopeOrganisation, true
+	case r == RoleViewer || resourceType == ResourceRoleBinding:
+		return "", false
+	case permission == PermissionCreate:
+		return ScopeOrganisation, true
+	default:
+		return ScopeOwned, true
+	}
+}
+
+// Grants returns all the permissions granted to the role
+func (r Role) Grants() []Grant {
+	var grants []Grant
+	for _, resourceType := range resourceTypes {
+		for _, permission := range permissions {
+			if scope, ok := r.Scope(resourceType, permission); ok {
+				grants = append(grants, Grant{ResourceType: resourceType, Permission: permission, Scope: scope})
+			}
+		}
+	}
+	return grants
+}
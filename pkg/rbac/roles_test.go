@@ -0,0 +1,96 @@
+package rbac
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_Role_Scope(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		role         Role
+		resourceType ResourceType
+		permission   Permission
+		wantScope    Scope
+		wantGranted  bool
+	}{
+		{
+			name:         "grant the read permission on all the resources of the organisation to viewers",
+			role:         RoleViewer,
+			resourceType: ResourceKafka,
+			permission:   PermissionRead,
+			wantScope:    ScopeOrganisation,
+			wantGranted:  true,
+		},
+		{
+			name:         "do not grant the create permission to viewers",
+			role:         RoleViewer,
+			resourceType: ResourceKafka,
+			permission:   PermissionCreate,
+			wantGranted:  false,
+		},
+		{
+			name:         "grant the create permission to editors",
+			role:         RoleEditor,
+			resourceType: ResourceConnector,
+			permission:   PermissionCreate,
+			wantScope:    ScopeOrganisation,
+			wantGranted:  true,
+		},
+		{
+			name:         "grant the delete permission on the resources they own to editors",
+			role:         RoleEditor,
+			resourceType: ResourceServiceAccount,
+			permission:   PermissionDelete,
+			wantScope:    ScopeOwned,
+			wantGranted:  true,
+		},
+		{
+			name:         "do not grant the role bindings management to editors",
+			role:         RoleEditor,
+			resourceType: ResourceRoleBinding,
+			permission:   PermissionCreate,
+			wantGranted:  false,
+		},
+		{
+			name:         "grant the update permission on all the resources of the organisation to admins",
+			role:         RoleAdmin,
+			resourceType: ResourceKafka,
+			permission:   PermissionUpdate,
+			wantScope:    ScopeOrganisation,
+			wantGranted:  true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			RegisterTestingT(t)
+			scope, granted := tt.role.Scope(tt.resourceType, tt.permission)
+			Expect(granted).To(Equal(tt.wantGranted))
+			Expect(scope).To(Equal(tt.wantScope))
+		})
+	}
+}
+
+func Test_Role_Grants(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(RoleViewer.Grants()).To(HaveLen(len(resourceTypes)), "viewers should only be granted the read permission")
+	Expect(RoleAdmin.Grants()).To(HaveLen(len(resourceTypes) * len(permissions)))
+	Expect(RoleEditor.Grants()).To(ContainElement(Grant{ResourceType: ResourceKafka, Permission: PermissionDelete, Scope: ScopeOwned}))
+	for _, grant := range RoleEditor.Grants() {
+		if grant.ResourceType == ResourceRoleBinding {
+			Expect(grant.Permission).To(Equal(PermissionRead), "editors should not manage the role bindings")
+		}
+	}
+}
+
+func Test_Role_IsValid(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(RoleEditor.IsValid()).To(BeTrue())
+	Expect(Role("owner").IsValid()).To(BeFalse())
+	Expect(SubjectTypeServiceAccount.IsValid()).To(BeTrue())
+	Expect(SubjectType("group").IsValid()).To(BeFalse())
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server/logging"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sentry"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
 	"github.com/goava/di"
 
 	"github.com/openshift-online/ocm-sdk-go/authentication"
@@ -80,6 +81,9 @@ func NewAPIServer(options ServerOptions) *ApiServer {
 	// Operation ID middleware sets a relatively unique operation ID in the context of each request for debugging purposes
 	mainRouter.Use(logger.OperationIDMiddleware)
 
+	// Tracing middleware starts a span for each request, continuing the trace propagated by the caller if any
+	mainRouter.Use(tracing.Middleware)
+
 	// Request logging middleware logs pertinent information about the request and response
 	mainRouter.Use(logging.RequestLoggingMiddleware)
 
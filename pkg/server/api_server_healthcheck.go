@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/healthcheck"
+)
+
+var _ healthcheck.Check = &APIServerHealthCheck{}
+
+// APIServerHealthCheck checks that the API server still answers requests. Any response is accepted, whatever its
+// status code, as the check only detects a server that stopped serving requests.
+type APIServerHealthCheck struct {
+	url    string
+	client *http.Client
+}
+
+func NewAPIServerHealthCheck(serverConfig *ServerConfig) *APIServerHealthCheck {
+	scheme := "http"
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if serverConfig.EnableHTTPS {
+		scheme = "https"
+		// the server is reached through the loopback interface, which its certificate is not issued for
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // #nosec G402
+	}
+	return &APIServerHealthCheck{
+		url:    fmt.Sprintf("%s://%s/", scheme, loopbackAddress(serverConfig.BindAddress)),
+		client: &http.Client{Transport: transport},
+	}
+}
+
+func (h *APIServerHealthCheck) Name() string {
+	return "api_server"
+}
+
+func (h *APIServerHealthCheck) Check(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// loopbackAddress replaces the host of a bind address listening on all the interfaces by localhost
+func loopbackAddress(bindAddress string) string {
+	host, port, err := net.SplitHostPort(bindAddress)
+	if err != nil {
+		return bindAddress
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestAPIServerHealthCheck_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		closed  bool
+		wantErr bool
+	}{
+		{
+			name: "should pass when the server answers with any status code",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+		},
+		{
+			name: "should fail when the server does not answer in time",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				<-r.Context().Done()
+			},
+			wantErr: true,
+		},
+		{
+			name:    "should fail when the server is not listening",
+			handler: func(w http.ResponseWriter, r *http.Request) {},
+			closed:  true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+			if tt.closed {
+				server.Close()
+			}
+
+			check := NewAPIServerHealthCheck(&ServerConfig{BindAddress: strings.TrimPrefix(server.URL, "http://")})
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			err := check.Check(ctx)
+			Expect(err != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_loopbackAddress(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(loopbackAddress(":8000")).To(Equal("localhost:8000"))
+	Expect(loopbackAddress("0.0.0.0:8000")).To(Equal("localhost:8000"))
+	Expect(loopbackAddress("[::]:8000")).To(Equal("localhost:8000"))
+	Expect(loopbackAddress("127.0.0.1:8000")).To(Equal("127.0.0.1:8000"))
+	Expect(loopbackAddress("api.example.com:443")).To(Equal("api.example.com:443"))
+}
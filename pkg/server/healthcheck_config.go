@@ -1,24 +1,32 @@
 package server
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 )
 
 type HealthCheckConfig struct {
-	BindAddress string `json:"bind_address"`
-	EnableHTTPS bool   `json:"enable_https"`
+	BindAddress   string        `json:"bind_address"`
+	EnableHTTPS   bool          `json:"enable_https"`
+	CheckTimeout  time.Duration `json:"check_timeout"`
+	CheckCacheTTL time.Duration `json:"check_cache_ttl"`
 }
 
 func NewHealthCheckConfig() *HealthCheckConfig {
 	return &HealthCheckConfig{
-		BindAddress: "localhost:8083",
-		EnableHTTPS: false,
+		BindAddress:   "localhost:8083",
+		EnableHTTPS:   false,
+		CheckTimeout:  5 * time.Second,
+		CheckCacheTTL: 10 * time.Second,
 	}
 }
 
 func (c *HealthCheckConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.BindAddress, "health-check-server-bindaddress", c.BindAddress, "Health check server bind address")
 	fs.BoolVar(&c.EnableHTTPS, "enable-health-check-https", c.EnableHTTPS, "Enable HTTPS for health check server")
+	fs.DurationVar(&c.CheckTimeout, "health-check-timeout", c.CheckTimeout, "Time after which a readiness or liveness check of a dependency fails")
+	fs.DurationVar(&c.CheckCacheTTL, "health-check-cache-ttl", c.CheckCacheTTL, "Time the results of the readiness and liveness checks are cached for")
 }
 
 func (c *HealthCheckConfig) ReadFiles() error {
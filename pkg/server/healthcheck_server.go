@@ -3,12 +3,14 @@ package server
 import (
 	"context"
 	"fmt"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/healthcheck"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sentry"
 	"net"
 	"net/http"
 	"time"
 
 	health "github.com/docker/go-healthcheck"
+	"github.com/goava/di"
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 )
@@ -26,7 +28,14 @@ type HealthCheckServer struct {
 	healthCheckConfig *HealthCheckConfig
 }
 
-func NewHealthCheckServer(healthCheckConfig *HealthCheckConfig, serverConfig *ServerConfig, sentryConfig *sentry.Config) *HealthCheckServer {
+// HealthChecks are the checks of the dependencies of the service provided by the modules
+type HealthChecks struct {
+	di.Inject
+	Readiness []healthcheck.ReadinessCheck `optional:"true"`
+	Liveness  []healthcheck.LivenessCheck  `optional:"true"`
+}
+
+func NewHealthCheckServer(healthCheckConfig *HealthCheckConfig, serverConfig *ServerConfig, sentryConfig *sentry.Config, healthChecks HealthChecks) *HealthCheckServer {
 	router := mux.NewRouter()
 	health.DefaultRegistry = health.NewRegistry()
 	health.Register("maintenance_status", updater)
@@ -34,6 +43,18 @@ func NewHealthCheckServer(healthCheckConfig *HealthCheckConfig, serverConfig *Se
 	router.HandleFunc("/healthcheck/down", downHandler).Methods(http.MethodPost)
 	router.HandleFunc("/healthcheck/up", upHandler).Methods(http.MethodPost)
 
+	// the service is not ready while in maintenance mode, so that it stops receiving traffic
+	readinessChecks := []healthcheck.Check{&maintenanceCheck{}}
+	for _, check := range healthChecks.Readiness {
+		readinessChecks = append(readinessChecks, check)
+	}
+	var livenessChecks []healthcheck.Check
+	for _, check := range healthChecks.Liveness {
+		livenessChecks = append(livenessChecks, check)
+	}
+	router.Handle("/healthcheck/ready", healthcheck.NewChecker(readinessChecks, healthCheckConfig.CheckTimeout, healthCheckConfig.CheckCacheTTL)).Methods(http.MethodGet)
+	router.Handle("/healthcheck/live", healthcheck.NewChecker(livenessChecks, healthCheckConfig.CheckTimeout, healthCheckConfig.CheckCacheTTL)).Methods(http.MethodGet)
+
 	srv := &http.Server{
 		Handler: router,
 		Addr:    healthCheckConfig.BindAddress,
@@ -95,3 +116,13 @@ func upHandler(w http.ResponseWriter, r *http.Request) {
 func downHandler(w http.ResponseWriter, r *http.Request) {
 	updater.Update(fmt.Errorf("maintenance mode"))
 }
+
+type maintenanceCheck struct{}
+
+func (c *maintenanceCheck) Name() string {
+	return "maintenance_status"
+}
+
+func (c *maintenanceCheck) Check(ctx context.Context) error {
+	return updater.Check()
+}
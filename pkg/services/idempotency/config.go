@@ -0,0 +1,25 @@
+package idempotency
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type IdempotencyConfig struct {
+	KeyTTL time.Duration `json:"idempotency_key_ttl"`
+}
+
+func NewIdempotencyConfig() *IdempotencyConfig {
+	return &IdempotencyConfig{
+		KeyTTL: 24 * time.Hour,
+	}
+}
+
+func (c *IdempotencyConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.KeyTTL, "idempotency-key-ttl", c.KeyTTL, "The time the response of a request sent with an Idempotency-Key header is kept to be replayed on retries.")
+}
+
+func (c *IdempotencyConfig) ReadFiles() error {
+	return nil
+}
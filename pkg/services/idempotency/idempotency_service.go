@@ -0,0 +1,92 @@
+// The idempotency package stores the responses of requests sent with an Idempotency-Key header so that
+// client retries can be answered with the original response.
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"gorm.io/gorm/clause"
+)
+
+// IdempotencyService stores the keys in the transaction of the request held by the context, so that they are committed
+// or rolled back with the resources the request creates.
+//go:generate moq -out idempotency_service_moq.go . IdempotencyService
+type IdempotencyService interface {
+	// Reserve claims the key for the given principal. If the key is already in use and has not expired,
+	// nothing is reserved and the existing record is returned instead.
+	Reserve(ctx context.Context, key string, principal string, requestHash string) (*api.IdempotencyKey, *errors.ServiceError)
+	// Complete records the response of the request that reserved the key
+	Complete(ctx context.Context, key string, principal string, responseCode int, responseBody []byte) *errors.ServiceError
+	// Release removes a reservation so that the request can be retried with the same key
+	Release(ctx context.Context, key string, principal string) *errors.ServiceError
+}
+
+var _ IdempotencyService = &idempotencyService{}
+
+type idempotencyService struct {
+	connectionFactory *db.ConnectionFactory
+	config            *IdempotencyConfig
+}
+
+func NewIdempotencyService(connectionFactory *db.ConnectionFactory, config *IdempotencyConfig) IdempotencyService {
+	return &idempotencyService{
+		connectionFactory: connectionFactory,
+		config:            config,
+	}
+}
+
+func (s *idempotencyService) Reserve(ctx context.Context, key string, principal string, requestHash string) (*api.IdempotencyKey, *errors.ServiceError) {
+	dbConn := s.connectionFactory.ForContext(ctx)
+	now := time.Now()
+
+	// expired keys can be reused, so clean them up before trying to claim the key
+	if err := dbConn.Where("expires_at < ?", now).Delete(&api.IdempotencyKey{}).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete expired idempotency keys")
+	}
+
+	reservation := &api.IdempotencyKey{
+		Key:         key,
+		Principal:   principal,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.config.KeyTTL),
+	}
+	result := dbConn.Clauses(clause.OnConflict{DoNothing: true}).Create(reservation)
+	if result.Error != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, result.Error, "failed to reserve idempotency key")
+	}
+	if result.RowsAffected > 0 {
+		return nil, nil
+	}
+
+	var existing api.IdempotencyKey
+	if err := dbConn.Where("key = ? AND principal = ?", key, principal).First(&existing).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get idempotency key")
+	}
+	return &existing, nil
+}
+
+func (s *idempotencyService) Complete(ctx context.Context, key string, principal string, responseCode int, responseBody []byte) *errors.ServiceError {
+	dbConn := s.connectionFactory.ForContext(ctx)
+	if err := dbConn.Model(&api.IdempotencyKey{}).
+		Where("key = ? AND principal = ?", key, principal).
+		Updates(map[string]interface{}{
+			"response_code": responseCode,
+			"response_body": api.JSON(responseBody),
+		}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to record the response of idempotency key")
+	}
+	return nil
+}
+
+func (s *idempotencyService) Release(ctx context.Context, key string, principal string) *errors.ServiceError {
+	dbConn := s.connectionFactory.ForContext(ctx)
+	if err := dbConn.Where("key = ? AND principal = ?", key, principal).Delete(&api.IdempotencyKey{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to release idempotency key")
+	}
+	return nil
+}
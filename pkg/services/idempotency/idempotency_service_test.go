@@ -0,0 +1,130 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_idempotencyService_Reserve(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr bool
+	}{
+		{
+			name: "should reserve a key that is not in use",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`DELETE FROM "idempotency_keys" WHERE expires_at <`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "idempotency_keys"`).WithRowsNum(1)
+			},
+		},
+		{
+			name: "should return an error when the expired keys cannot be deleted",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`DELETE FROM "idempotency_keys"`).WithExecException()
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the key cannot be reserved",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`DELETE FROM "idempotency_keys" WHERE expires_at <`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "idempotency_keys"`).WithExecException()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			s := NewIdempotencyService(db.NewMockConnectionFactory(nil), NewIdempotencyConfig())
+			tt.setupFn()
+
+			existing, err := s.Reserve(context.Background(), "key", "principal", "hash")
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(existing).To(BeNil())
+		})
+	}
+}
+
+func Test_idempotencyService_Complete(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr bool
+	}{
+		{
+			name: "should record the response of the key",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`UPDATE "idempotency_keys" SET "response_body"=$1,"response_code"=$2 WHERE key = $3 AND principal = $4`).
+					WithRowsNum(1)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return an error when the response cannot be recorded",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "idempotency_keys"`).WithExecException()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			s := NewIdempotencyService(db.NewMockConnectionFactory(nil), NewIdempotencyConfig())
+			tt.setupFn()
+
+			err := s.Complete(context.Background(), "key", "principal", 201, []byte(`{"id":"test"}`))
+			Expect(err != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_idempotencyService_Release(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr bool
+	}{
+		{
+			name: "should delete the reservation of the key",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`DELETE FROM "idempotency_keys" WHERE key = $1 AND principal = $2`).
+					WithArgs("key", "principal").
+					WithRowsNum(1)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return an error when the reservation cannot be deleted",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`DELETE FROM "idempotency_keys"`).WithExecException()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			s := NewIdempotencyService(db.NewMockConnectionFactory(nil), NewIdempotencyConfig())
+			tt.setupFn()
+
+			err := s.Release(context.Background(), "key", "principal")
+			Expect(err != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// cursor is the position of the last item of a page: the value of the ordering column and the id of the item
+type cursor struct {
+	Value json.RawMessage `json:"v"`
+	ID    string          `json:"id"`
+}
+
+// KeysetPaging lists resources by position instead of by page number. The items are ordered by a column and by
+// their id, and each page starts right after the (column, id) values stored in the cursor of the previous page.
+// Unlike offset paging, the cost of a page does not grow with its position and the pages don't shift when new
+// items are inserted.
+type KeysetPaging struct {
+	table  string
+	column string
+	desc   bool
+	size   int
+	value  interface{}
+	id     string
+	schema *schema.Schema
+}
+
+// NewKeysetPaging creates the keyset paging of the given list arguments. model is the type of the items the page
+// is read into, the resource being listed being stored in table. The items are ordered by the only order by argument, which must be one of columns, or by
+// defaultColumn when there is none.
+func NewKeysetPaging(model interface{}, table string, listArgs *ListArguments, defaultColumn string, columns ...string) (*KeysetPaging, error) {
+	s, err := schema.Parse(model, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse the model")
+	}
+
+	k := &KeysetPaging{
+		table:  table,
+		column: defaultColumn,
+		size:   listArgs.Size,
+		schema: s,
+	}
+
+	if len(listArgs.OrderBy) > 1 {
+		return nil, errors.Errorf("only one order by field is supported when paging with a cursor")
+	}
+	if len(listArgs.OrderBy) == 1 {
+		keywords := strings.Fields(strings.ToLower(listArgs.OrderBy[0]))
+		if len(keywords) == 0 || len(keywords) > 2 {
+			return nil, errors.Errorf("invalid order by clause '%s'", listArgs.OrderBy[0])
+		}
+		k.column = keywords[0]
+		k.desc = len(keywords) == 2 && keywords[1] == "desc"
+	}
+	if k.column != defaultColumn && !shared.Contains(columns, k.column) {
+		return nil, errors.Errorf("order by field '%s' is not supported when paging with a cursor, supported fields are: %s", k.column, strings.Join(append([]string{defaultColumn}, columns...), ", "))
+	}
+
+	field := s.LookUpField(k.column)
+	if field == nil {
+		return nil, errors.Errorf("unknown order by field '%s'", k.column)
+	}
+
+	if listArgs.Cursor != "" {
+		data, err := base64.RawURLEncoding.DecodeString(listArgs.Cursor)
+		if err != nil {
+			return nil, errors.Errorf("invalid cursor")
+		}
+		var c cursor
+		if err := json.Unmarshal(data, &c); err != nil || c.ID == "" {
+			return nil, errors.Errorf("invalid cursor")
+		}
+		value := reflect.New(field.FieldType)
+		if err := json.Unmarshal(c.Value, value.Interface()); err != nil {
+			return nil, errors.Errorf("invalid cursor for order by field '%s'", k.column)
+		}
+		k.value = value.Elem().Interface()
+		k.id = c.ID
+	}
+
+	return k, nil
+}
+
+// Apply orders the query and selects the items of the page. One more item than the page size is selected, so that
+// Page can tell if there is a next page.
+func (k *KeysetPaging) Apply(dbConn *gorm.DB) *gorm.DB {
+	column := fmt.Sprintf("%s.%s", k.table, k.column)
+	id := fmt.Sprintf("%s.id", k.table)
+	direction, op := "ASC", ">"
+	if k.desc {
+		direction, op = "DESC", "<"
+	}
+
+	if k.id != "" {
+		dbConn = dbConn.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", column, id, op), k.value, k.id)
+	}
+	return dbConn.
+		Order(fmt.Sprintf("%s %s", column, direction)).
+		Order(fmt.Sprintf("%s %s", id, direction)).
+		Limit(k.size + 1)
+}
+
+// Page removes the extra item selected by Apply from items, which must be a pointer to the slice of results.
+// It returns the cursor of the next page, or an empty string when this is the last page.
+func (k *KeysetPaging) Page(items interface{}) (string, error) {
+	list := reflect.ValueOf(items).Elem()
+	itemType := list.Type().Elem()
+	for itemType.Kind() == reflect.Ptr {
+		itemType = itemType.Elem()
+	}
+	if itemType != k.schema.ModelType {
+		return "", errors.Errorf("unable to page items of type %s with the keyset paging of %s", itemType, k.schema.ModelType)
+	}
+	if list.Len() <= k.size {
+		return "", nil
+	}
+	list.Set(list.Slice(0, k.size))
+	if k.size == 0 {
+		return "", nil
+	}
+
+	last := reflect.Indirect(list.Index(k.size - 1))
+	value, _ := k.schema.LookUpField(k.column).ValueOf(last)
+	id, _ := k.schema.LookUpField("id").ValueOf(last)
+
+	rawValue, err := json.Marshal(value)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to create the cursor")
+	}
+	data, err := json.Marshal(cursor{Value: rawValue, ID: fmt.Sprintf("%v", id)})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to create the cursor")
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
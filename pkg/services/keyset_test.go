@@ -0,0 +1,205 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+)
+
+type keysetTestResource struct {
+	db.Model
+	Name    string
+	Version int64
+}
+
+// KeysetTestModel is embedded by keysetTestResourceWithStatus, gorm only parses the exported embedded structs
+type KeysetTestModel struct {
+	db.Model
+	Name string
+}
+
+// keysetTestResourceWithStatus embeds the resource, like the resources read with columns of joined tables
+type keysetTestResourceWithStatus struct {
+	Status string
+	KeysetTestModel
+}
+
+func Test_NewKeysetPaging(t *testing.T) {
+	tests := []struct {
+		name        string
+		listArgs    *ListArguments
+		wantErr     bool
+		wantColumn  string
+		wantDesc    bool
+		wantCursorV interface{}
+	}{
+		{
+			name:       "should order by the default column",
+			listArgs:   &ListArguments{Size: 10, CursorPaging: true},
+			wantColumn: "name",
+		},
+		{
+			name:       "should order by the order by argument",
+			listArgs:   &ListArguments{Size: 10, CursorPaging: true, OrderBy: []string{"created_at  DESC"}},
+			wantColumn: "created_at",
+			wantDesc:   true,
+		},
+		{
+			name:     "should fail when ordering by an unsupported column",
+			listArgs: &ListArguments{Size: 10, CursorPaging: true, OrderBy: []string{"version"}},
+			wantErr:  true,
+		},
+		{
+			name:     "should fail when ordering by more than one column",
+			listArgs: &ListArguments{Size: 10, CursorPaging: true, OrderBy: []string{"name", "created_at"}},
+			wantErr:  true,
+		},
+		{
+			name:     "should fail when the cursor is not valid",
+			listArgs: &ListArguments{Size: 10, CursorPaging: true, Cursor: "not-a-cursor"},
+			wantErr:  true,
+		},
+		{
+			name: "should fail when the cursor value does not match the order by column",
+			listArgs: &ListArguments{
+				Size:         10,
+				CursorPaging: true,
+				OrderBy:      []string{"created_at"},
+				// {"v":"name-1","id":"1"}
+				Cursor: "eyJ2IjoibmFtZS0xIiwiaWQiOiIxIn0",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			keyset, err := NewKeysetPaging(&keysetTestResource{}, "resources", tt.listArgs, "name", "created_at")
+			Expect(err != nil).To(Equal(tt.wantErr))
+			if err == nil {
+				Expect(keyset.column).To(Equal(tt.wantColumn))
+				Expect(keyset.desc).To(Equal(tt.wantDesc))
+			}
+		})
+	}
+}
+
+func Test_KeysetPaging_Page(t *testing.T) {
+	RegisterTestingT(t)
+
+	createdAt := time.Date(2022, 4, 1, 10, 0, 0, 123456000, time.UTC)
+	items := []*keysetTestResource{
+		{Model: db.Model{ID: "1", CreatedAt: createdAt.Add(-time.Hour)}},
+		{Model: db.Model{ID: "2", CreatedAt: createdAt}},
+		{Model: db.Model{ID: "3", CreatedAt: createdAt.Add(time.Hour)}},
+	}
+	listArgs := &ListArguments{Size: 2, CursorPaging: true, OrderBy: []string{"created_at"}}
+
+	keyset, err := NewKeysetPaging(&keysetTestResource{}, "resources", listArgs, "name", "created_at")
+	Expect(err).NotTo(HaveOccurred())
+	nextCursor, err := keyset.Page(&items)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(items).To(HaveLen(2))
+	Expect(nextCursor).NotTo(BeEmpty())
+
+	// the cursor points to the last item of the page
+	listArgs.Cursor = nextCursor
+	next, err := NewKeysetPaging(&keysetTestResource{}, "resources", listArgs, "name", "created_at")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(next.id).To(Equal("2"))
+	Expect(next.value).To(BeTemporally("==", createdAt))
+
+	// there is no next page when all the items fit in the page
+	lastPage := items[:1]
+	nextCursor, err = next.Page(&lastPage)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(nextCursor).To(BeEmpty())
+	Expect(lastPage).To(HaveLen(1))
+}
+
+func Test_KeysetPaging_PageEmbeddedResource(t *testing.T) {
+	RegisterTestingT(t)
+
+	items := []*keysetTestResourceWithStatus{
+		{Status: "ready", KeysetTestModel: KeysetTestModel{Model: db.Model{ID: "1"}, Name: "a"}},
+		{Status: "ready", KeysetTestModel: KeysetTestModel{Model: db.Model{ID: "2"}, Name: "b"}},
+	}
+	listArgs := &ListArguments{Size: 1, CursorPaging: true}
+
+	keyset, err := NewKeysetPaging(&keysetTestResourceWithStatus{}, "resources", listArgs, "name")
+	Expect(err).NotTo(HaveOccurred())
+	nextCursor, err := keyset.Page(&items)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(items).To(HaveLen(1))
+
+	listArgs.Cursor = nextCursor
+	next, err := NewKeysetPaging(&keysetTestResourceWithStatus{}, "resources", listArgs, "name")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(next.id).To(Equal("1"))
+	Expect(next.value).To(Equal("a"))
+}
+
+func Test_KeysetPaging_PageOtherType(t *testing.T) {
+	RegisterTestingT(t)
+
+	items := []*keysetTestResourceWithStatus{
+		{KeysetTestModel: KeysetTestModel{Model: db.Model{ID: "1"}}},
+		{KeysetTestModel: KeysetTestModel{Model: db.Model{ID: "2"}}},
+	}
+	keyset, err := NewKeysetPaging(&keysetTestResource{}, "resources", &ListArguments{Size: 1, CursorPaging: true}, "name")
+	Expect(err).NotTo(HaveOccurred())
+	_, err = keyset.Page(&items)
+	Expect(err).To(HaveOccurred())
+}
+
+func Test_KeysetPaging_Apply(t *testing.T) {
+	tests := []struct {
+		name     string
+		listArgs *ListArguments
+		wantSQL  string
+	}{
+		{
+			name:     "should order by the column and id when there is no cursor",
+			listArgs: &ListArguments{Size: 10, CursorPaging: true},
+			wantSQL:  `SELECT * FROM "keyset_test_resources" WHERE "keyset_test_resources"."deleted_at" IS NULL ORDER BY resources.name ASC,resources.id ASC LIMIT 11`,
+		},
+		{
+			name: "should select the items after the cursor",
+			listArgs: &ListArguments{
+				Size:         10,
+				CursorPaging: true,
+				// {"v":"name-1","id":"1"}
+				Cursor: "eyJ2IjoibmFtZS0xIiwiaWQiOiIxIn0",
+			},
+			wantSQL: `SELECT * FROM "keyset_test_resources" WHERE (resources.name, resources.id) > ($1, $2) AND "keyset_test_resources"."deleted_at" IS NULL ORDER BY resources.name ASC,resources.id ASC LIMIT 11`,
+		},
+		{
+			name: "should select the items before the cursor when ordering in descending order",
+			listArgs: &ListArguments{
+				Size:         10,
+				CursorPaging: true,
+				OrderBy:      []string{"name desc"},
+				// {"v":"name-1","id":"1"}
+				Cursor: "eyJ2IjoibmFtZS0xIiwiaWQiOiIxIn0",
+			},
+			wantSQL: `SELECT * FROM "keyset_test_resources" WHERE (resources.name, resources.id) < ($1, $2) AND "keyset_test_resources"."deleted_at" IS NULL ORDER BY resources.name DESC,resources.id DESC LIMIT 11`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			keyset, err := NewKeysetPaging(&keysetTestResource{}, "resources", tt.listArgs, "name")
+			Expect(err).NotTo(HaveOccurred())
+
+			dbConn := db.NewMockConnectionFactory(nil).New().Session(&gorm.Session{DryRun: true})
+			var resources []keysetTestResource
+			stmt := keyset.Apply(dbConn).Find(&resources).Statement
+			Expect(stmt.SQL.String()).To(Equal(tt.wantSQL))
+		})
+	}
+}
@@ -3,30 +3,53 @@ package services
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
-var validColumns = []string{"region", "name", "cloud_provider", "status", "owner"}
+// timestampColumns are the only columns that can be compared with the <, <=, > and >= operators.
+// Their values must be in RFC3339 format.
+var timestampColumns = []string{"created_at", "updated_at"}
 
 const (
-	BraceTokenFamily       = "BRACE"
-	OpTokenFamily          = "OP"
-	LogicalOpTokenFamily   = "LOGICAL"
-	ColumnTokenFamily      = "COLUMN"
-	ValueTokenFamily       = "VALUE"
-	QuotedValueTokenFamily = "QUOTED"
-
-	OpenBrace   = "OPEN_BRACE"
-	ClosedBrace = "CLOSED_BRACE"
-	Column      = "COLUMN"
-	Value       = "VALUE"
-	QuotedValue = "QUOTED_VALUE"
-	Eq          = "EQ"
-	NotEq       = "NOT_EQ"
-	LikeState   = "LIKE"
-	AndState    = "AND"
-	OrState     = "OR"
+	BraceTokenFamily           = "BRACE"
+	OpTokenFamily              = "OP"
+	ComparisonOpTokenFamily    = "COMPARISON_OP"
+	LogicalOpTokenFamily       = "LOGICAL"
+	ColumnTokenFamily          = "COLUMN"
+	ValueTokenFamily           = "VALUE"
+	QuotedValueTokenFamily     = "QUOTED"
+	ListBraceTokenFamily       = "LIST_BRACE"
+	ListSeparatorTokenFamily   = "LIST_SEPARATOR"
+	ListValueTokenFamily       = "LIST_VALUE"
+	ListQuotedValueTokenFamily = "LIST_QUOTED"
+
+	OpenBrace       = "OPEN_BRACE"
+	ClosedBrace     = "CLOSED_BRACE"
+	Column          = "COLUMN"
+	Value           = "VALUE"
+	QuotedValue     = "QUOTED_VALUE"
+	Eq              = "EQ"
+	NotEq           = "NOT_EQ"
+	LikeState       = "LIKE"
+	ILikeState      = "ILIKE"
+	LessThan        = "LT"
+	LessOrEqual     = "LTE"
+	GreaterThan     = "GT"
+	GreaterOrEqual  = "GTE"
+	InState         = "IN"
+	NotInState      = "NOT_IN"
+	IsState         = "IS"
+	IsNotState      = "IS_NOT"
+	NullState       = "NULL"
+	OpenList        = "OPEN_LIST"
+	ClosedList      = "CLOSED_LIST"
+	ListSeparator   = "LIST_SEPARATOR"
+	ListValue       = "LIST_VALUE"
+	ListQuotedValue = "LIST_QUOTED_VALUE"
+	AndState        = "AND"
+	OrState         = "OR"
 )
 const MaximumComplexity = 10
 
@@ -51,29 +74,62 @@ var _ QueryParser = &queryParser{}
 // initStateMachine
 // This will be our grammar (each Token will eat the spaces after the Token itself):
 // Tokens:
-// OPEN_BRACE       = (
-// CLOSED_BRACE     = )
-// COLUMN -         = [A-Za-z][A-Za-z0-9_]*
-// VALUE            = [^ ^(^)]+
-// QUOTED_VALUE     = `'([^']|\\')*'`
-// EQ               = =
-// NOT_EQ           = <>
-// LIKE             = [Ll][Ii][Kk][Ee]
-// AND              = [Aa][Nn][Dd]
-// OR               = [Oo][Rr]
+// OPEN_BRACE        = (
+// CLOSED_BRACE      = )
+// COLUMN -          = [A-Za-z][A-Za-z0-9_]*
+// VALUE             = [^ ^(^)]+
+// QUOTED_VALUE      = `'([^']|\\')*'`
+// EQ                = =
+// NOT_EQ            = <>
+// LIKE              = [Ll][Ii][Kk][Ee]
+// ILIKE             = [Ii][Ll][Ii][Kk][Ee]
+// LT                = <
+// LTE               = <=
+// GT                = >
+// GTE               = >=
+// IN                = [Ii][Nn]
+// NOT_IN            = [Nn][Oo][Tt]
+// IS                = [Ii][Ss]
+// IS_NOT            = [Nn][Oo][Tt]
+// NULL              = [Nn][Uu][Ll][Ll]
+// OPEN_LIST         = (
+// CLOSED_LIST       = )
+// LIST_SEPARATOR    = ,
+// LIST_VALUE        = [^ ^(^)^,]+
+// LIST_QUOTED_VALUE = `'([^']|\\')*'`
+// AND               = [Aa][Nn][Dd]
+// OR                = [Oo][Rr]
 //
 // VALID TRANSITIONS:
-// START        -> COLUMN | OPEN_BRACE
-// OPEN_BRACE   -> OPEN_BRACE | COLUMN
-// COLUMN       -> EQ | NOT_EQ | LIKE
-// EQ           -> VALUE | QUOTED_VALUE
-// NOT_EQ       -> VALUE | QUOTED_VALUE
-// LIKE         -> VALUE | QUOTED_VALUE
-// VALUE        -> OR | AND | CLOSED_BRACE | [END]
-// QUOTED_VALUE -> OR | AND | CLOSED_BRACE | [END]
-// CLOSED_BRACE -> OR | AND | CLOSED_BRACE | [END]
-// AND          -> COLUMN | OPEN_BRACE
-// OR           -> COLUMN | OPEN_BRACE
+// START             -> COLUMN | OPEN_BRACE
+// OPEN_BRACE        -> OPEN_BRACE | COLUMN
+// COLUMN            -> EQ | NOT_EQ | LIKE | ILIKE | LT | LTE | GT | GTE | IN | NOT_IN | IS
+// EQ                -> VALUE | QUOTED_VALUE
+// NOT_EQ            -> VALUE | QUOTED_VALUE
+// LIKE              -> VALUE | QUOTED_VALUE
+// ILIKE             -> VALUE | QUOTED_VALUE
+// LT                -> VALUE | QUOTED_VALUE
+// LTE               -> VALUE | QUOTED_VALUE
+// GT                -> VALUE | QUOTED_VALUE
+// GTE               -> VALUE | QUOTED_VALUE
+// NOT_IN            -> IN
+// IN                -> OPEN_LIST
+// OPEN_LIST         -> LIST_VALUE | LIST_QUOTED_VALUE
+// LIST_VALUE        -> LIST_SEPARATOR | CLOSED_LIST
+// LIST_QUOTED_VALUE -> LIST_SEPARATOR | CLOSED_LIST
+// LIST_SEPARATOR    -> LIST_VALUE | LIST_QUOTED_VALUE
+// IS                -> IS_NOT | NULL
+// IS_NOT            -> NULL
+// VALUE             -> OR | AND | CLOSED_BRACE | [END]
+// QUOTED_VALUE      -> OR | AND | CLOSED_BRACE | [END]
+// CLOSED_LIST       -> OR | AND | CLOSED_BRACE | [END]
+// NULL              -> OR | AND | CLOSED_BRACE | [END]
+// CLOSED_BRACE      -> OR | AND | CLOSED_BRACE | [END]
+// AND               -> COLUMN | OPEN_BRACE
+// OR                -> COLUMN | OPEN_BRACE
+//
+// The LT, LTE, GT and GTE operators can only be used with the created_at and updated_at columns and their
+// value must be a timestamp in RFC3339 format.
 func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 
 	// counts the number of joins
@@ -103,6 +159,32 @@ func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 		return nil
 	}
 
+	unquote := func(value string) string {
+		// unescape
+		tmp := strings.ReplaceAll(value, `\'`, "'")
+		// remove quotes:
+		if len(tmp) > 1 {
+			tmp = string([]rune(tmp)[1 : len(tmp)-1])
+		}
+		return tmp
+	}
+
+	// the last column and whether it is being compared with a comparison operator
+	lastColumn := ""
+	comparing := false
+	addValue := func(value string) error {
+		if !comparing {
+			p.dbqry.Values = append(p.dbqry.Values, value)
+			return nil
+		}
+		timestamp, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return errors.Errorf("invalid value '%s' for column '%s': expected a timestamp in RFC3339 format", value, lastColumn)
+		}
+		p.dbqry.Values = append(p.dbqry.Values, timestamp)
+		return nil
+	}
+
 	onNewToken := func(token *ParsedToken) error {
 		switch token.family {
 		case BraceTokenFamily:
@@ -113,17 +195,34 @@ func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 			return nil
 		case ValueTokenFamily:
 			p.dbqry.Query += " ?"
-			p.dbqry.Values = append(p.dbqry.Values, token.value)
-			return nil
+			return addValue(token.value)
 		case QuotedValueTokenFamily:
 			p.dbqry.Query += " ?"
-			// unescape
-			tmp := strings.ReplaceAll(token.value, `\'`, "'")
-			// remove quotes:
-			if len(tmp) > 1 {
-				tmp = string([]rune(tmp)[1 : len(tmp)-1])
+			return addValue(unquote(token.value))
+		case ListBraceTokenFamily:
+			if token.value == "(" {
+				p.dbqry.Query += " ("
+			} else {
+				p.dbqry.Query += ")"
 			}
-			p.dbqry.Values = append(p.dbqry.Values, tmp)
+			return nil
+		case ListSeparatorTokenFamily:
+			p.dbqry.Query += ", "
+			return nil
+		case ListValueTokenFamily:
+			p.dbqry.Query += "?"
+			p.dbqry.Values = append(p.dbqry.Values, token.value)
+			return nil
+		case ListQuotedValueTokenFamily:
+			p.dbqry.Query += "?"
+			p.dbqry.Values = append(p.dbqry.Values, unquote(token.value))
+			return nil
+		case ComparisonOpTokenFamily:
+			if !contains(timestampColumns, lastColumn) {
+				return errors.Errorf("operator '%s' is only supported for columns: %s", token.value, strings.Join(timestampColumns, ", "))
+			}
+			comparing = true
+			p.dbqry.Query += " " + token.value
 			return nil
 		case LogicalOpTokenFamily:
 			complexity++
@@ -135,10 +234,13 @@ func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 		case ColumnTokenFamily:
 			// we want column names to be lowercase
 			columnName := strings.ToLower(token.value)
-			if !contains(p.dbqry.ValidColumns, columnName) {
+			qualifiedColumnName, ok := qualifiedColumn(p.dbqry.ValidColumns, columnName)
+			if !ok {
 				return fmt.Errorf("invalid column name: '%s'", token.value)
 			}
-			p.dbqry.Query += columnName
+			lastColumn = columnName
+			comparing = false
+			p.dbqry.Query += qualifiedColumnName
 			return nil
 		default:
 			p.dbqry.Query += " " + token.value
@@ -146,6 +248,10 @@ func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 		}
 	}
 
+	valueTransitions := []string{QuotedValue, Value}
+	listValueTransitions := []string{ListQuotedValue, ListValue}
+	afterValueTransitions := []string{OrState, AndState, ClosedBrace, EndState}
+
 	grammar := Grammar{
 		Tokens: []TokenDefinition{
 			{Name: OpenBrace, Family: BraceTokenFamily, AcceptPattern: `\(`},
@@ -156,19 +262,49 @@ func (p *queryParser) initStateMachine() (State, checkUnbalancedBraces) {
 			{Name: Eq, Family: OpTokenFamily, AcceptPattern: `=`},
 			{Name: NotEq, Family: OpTokenFamily, AcceptPattern: `<>`},
 			{Name: LikeState, Family: OpTokenFamily, AcceptPattern: `[Ll][Ii][Kk][Ee]`},
+			{Name: ILikeState, Family: OpTokenFamily, AcceptPattern: `[Ii][Ll][Ii][Kk][Ee]`},
+			{Name: LessThan, Family: ComparisonOpTokenFamily, AcceptPattern: `<`},
+			{Name: LessOrEqual, Family: ComparisonOpTokenFamily, AcceptPattern: `<=`},
+			{Name: GreaterThan, Family: ComparisonOpTokenFamily, AcceptPattern: `>`},
+			{Name: GreaterOrEqual, Family: ComparisonOpTokenFamily, AcceptPattern: `>=`},
+			{Name: InState, Family: OpTokenFamily, AcceptPattern: `[Ii][Nn]`},
+			{Name: NotInState, Family: OpTokenFamily, AcceptPattern: `[Nn][Oo][Tt]`},
+			{Name: IsState, Family: OpTokenFamily, AcceptPattern: `[Ii][Ss]`},
+			{Name: IsNotState, Family: OpTokenFamily, AcceptPattern: `[Nn][Oo][Tt]`},
+			{Name: NullState, Family: OpTokenFamily, AcceptPattern: `[Nn][Uu][Ll][Ll]`},
+			{Name: OpenList, Family: ListBraceTokenFamily, AcceptPattern: `\(`},
+			{Name: ClosedList, Family: ListBraceTokenFamily, AcceptPattern: `\)`},
+			{Name: ListSeparator, Family: ListSeparatorTokenFamily, AcceptPattern: `,`},
+			{Name: ListValue, Family: ListValueTokenFamily, AcceptPattern: `[^',][^ ^(^)^,]*`},
+			{Name: ListQuotedValue, Family: ListQuotedValueTokenFamily, AcceptPattern: `'([^']|\\')*'`},
 			{Name: AndState, Family: LogicalOpTokenFamily, AcceptPattern: `[Aa][Nn][Dd]`},
 			{Name: OrState, Family: LogicalOpTokenFamily, AcceptPattern: `[Oo][Rr]`},
 		},
 		Transitions: []TransitionDefinition{
 			{TokenName: StartState, ValidTransitions: []string{Column, OpenBrace}},
 			{TokenName: OpenBrace, ValidTransitions: []string{Column, OpenBrace}},
-			{TokenName: Column, ValidTransitions: []string{Eq, NotEq, LikeState}},
-			{TokenName: Eq, ValidTransitions: []string{QuotedValue, Value}},
-			{TokenName: NotEq, ValidTransitions: []string{QuotedValue, Value}},
-			{TokenName: LikeState, ValidTransitions: []string{QuotedValue, Value}},
-			{TokenName: QuotedValue, ValidTransitions: []string{OrState, AndState, ClosedBrace, EndState}},
-			{TokenName: Value, ValidTransitions: []string{OrState, AndState, ClosedBrace, EndState}},
-			{TokenName: ClosedBrace, ValidTransitions: []string{OrState, AndState, ClosedBrace, EndState}},
+			{TokenName: Column, ValidTransitions: []string{Eq, NotEq, LikeState, ILikeState, LessThan, LessOrEqual, GreaterThan, GreaterOrEqual, InState, NotInState, IsState}},
+			{TokenName: Eq, ValidTransitions: valueTransitions},
+			{TokenName: NotEq, ValidTransitions: valueTransitions},
+			{TokenName: LikeState, ValidTransitions: valueTransitions},
+			{TokenName: ILikeState, ValidTransitions: valueTransitions},
+			{TokenName: LessThan, ValidTransitions: valueTransitions},
+			{TokenName: LessOrEqual, ValidTransitions: valueTransitions},
+			{TokenName: GreaterThan, ValidTransitions: valueTransitions},
+			{TokenName: GreaterOrEqual, ValidTransitions: valueTransitions},
+			{TokenName: NotInState, ValidTransitions: []string{InState}},
+			{TokenName: InState, ValidTransitions: []string{OpenList}},
+			{TokenName: OpenList, ValidTransitions: listValueTransitions},
+			{TokenName: ListValue, ValidTransitions: []string{ListSeparator, ClosedList}},
+			{TokenName: ListQuotedValue, ValidTransitions: []string{ListSeparator, ClosedList}},
+			{TokenName: ListSeparator, ValidTransitions: listValueTransitions},
+			{TokenName: IsState, ValidTransitions: []string{IsNotState, NullState}},
+			{TokenName: IsNotState, ValidTransitions: []string{NullState}},
+			{TokenName: QuotedValue, ValidTransitions: afterValueTransitions},
+			{TokenName: Value, ValidTransitions: afterValueTransitions},
+			{TokenName: ClosedList, ValidTransitions: afterValueTransitions},
+			{TokenName: NullState, ValidTransitions: afterValueTransitions},
+			{TokenName: ClosedBrace, ValidTransitions: afterValueTransitions},
 			{TokenName: AndState, ValidTransitions: []string{Column, OpenBrace}},
 			{TokenName: OrState, ValidTransitions: []string{Column, OpenBrace}},
 		},
@@ -212,12 +348,20 @@ func (p *queryParser) Parse(sql string) (*DBQuery, error) {
 	return &p.dbqry, nil
 }
 
-func NewQueryParser(columns ...string) QueryParser {
-	query := DBQuery{}
-	if len(columns) == 0 {
-		query.ValidColumns = validColumns
-	} else {
-		query.ValidColumns = columns
+// qualifiedColumn returns the valid column searched with the given name. Valid columns can be qualified with their
+// table name, they are then searched with their unqualified name.
+func qualifiedColumn(validColumns []string, columnName string) (string, bool) {
+	for _, validColumn := range validColumns {
+		if validColumn == columnName || strings.HasSuffix(validColumn, "."+columnName) {
+			return validColumn, true
+		}
 	}
-	return &queryParser{dbqry: query}
+	return "", false
+}
+
+// NewQueryParser creates a parser that only accepts the given columns. Each resource passes its own allow-list
+// of the columns that can be searched. A column can be qualified with its table name, e.g. "connectors.created_at",
+// when it is ambiguous in the joined tables: it is searched as "created_at" and the qualified name is used in the query.
+func NewQueryParser(columns ...string) QueryParser {
+	return &queryParser{dbqry: DBQuery{ValidColumns: columns}}
 }
@@ -1,15 +1,20 @@
 package services
 
 import (
-	. "github.com/onsi/gomega"
 	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
 )
 
+var testColumns = []string{"region", "name", "cloud_provider", "status", "owner", "created_at", "updated_at"}
+
 func Test_QueryParser(t *testing.T) {
 
 	tests := []struct {
 		name      string
 		qry       string
+		columns   []string
 		outQry    string
 		outValues []interface{}
 		wantErr   bool
@@ -115,12 +120,131 @@ func Test_QueryParser(t *testing.T) {
 			qry:     "((cloud_provider = Value and name = value1) and (owner = value2 or region=b  ) or badcolumn=c or name=e and region LIKE '%test%'",
 			wantErr: true,
 		},
+		{
+			name:      "IN list",
+			qry:       "status IN (accepted, 'ready', provisioning)",
+			outQry:    "status IN (?, ?, ?)",
+			outValues: []interface{}{"accepted", "ready", "provisioning"},
+		},
+		{
+			name:      "NOT IN list with quoted value containing separators",
+			qry:       `status not in ('a, b','c\'d') and name = test`,
+			outQry:    "status not in (?, ?) and name = ?",
+			outValues: []interface{}{"a, b", "c'd", "test"},
+		},
+		{
+			name:      "IN list within braces",
+			qry:       "(region in (us-east-1,eu-west-1) or owner = a)",
+			outQry:    "(region in (?, ?) or owner = ?)",
+			outValues: []interface{}{"us-east-1", "eu-west-1", "a"},
+		},
+		{
+			name:      "Separator outside of an IN list is part of the value",
+			qry:       "name = a,b and owner in (c,d)",
+			outQry:    "name = ? and owner in (?, ?)",
+			outValues: []interface{}{"a,b", "c", "d"},
+		},
+		{
+			name:      "Separator after an IN list is part of the value",
+			qry:       "(owner in (c) or region = eu,us) and name like a,b%",
+			outQry:    "(owner in (?) or region = ?) and name like ?",
+			outValues: []interface{}{"c", "eu,us", "a,b%"},
+		},
+		{
+			name:    "Empty IN list",
+			qry:     "status in ()",
+			wantErr: true,
+		},
+		{
+			name:    "Unterminated IN list",
+			qry:     "status in (a, b",
+			wantErr: true,
+		},
+		{
+			name:    "IN without list",
+			qry:     "status in a",
+			wantErr: true,
+		},
+		{
+			name:    "Trailing separator in IN list",
+			qry:     "status in (a,)",
+			wantErr: true,
+		},
+		{
+			name:      "ILIKE",
+			qry:       "name ILIKE '%Test%'",
+			outQry:    "name ILIKE ?",
+			outValues: []interface{}{"%Test%"},
+		},
+		{
+			name:      "IS NULL and IS NOT NULL",
+			qry:       "owner is null or (region IS NOT NULL and name = a)",
+			outQry:    "owner is null or (region IS NOT NULL and name = ?)",
+			outValues: []interface{}{"a"},
+		},
+		{
+			name:    "IS without NULL",
+			qry:     "owner is a",
+			wantErr: true,
+		},
+		{
+			name:      "Comparison operators on timestamps",
+			qry:       "created_at >= 2022-01-01T00:00:00Z and updated_at < '2022-02-01T10:00:00+02:00'",
+			outQry:    "created_at >= ? and updated_at < ?",
+			outValues: []interface{}{time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 2, 1, 10, 0, 0, 0, time.FixedZone("", 2*60*60))},
+		},
+		{
+			name:      "Equality on timestamps is not converted",
+			qry:       "created_at > 2022-01-01T00:00:00Z and name = 2022-01-01T00:00:00Z",
+			outQry:    "created_at > ? and name = ?",
+			outValues: []interface{}{time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), "2022-01-01T00:00:00Z"},
+		},
+		{
+			name:    "Comparison with a value that is not RFC3339",
+			qry:     "created_at > yesterday",
+			wantErr: true,
+		},
+		{
+			name:    "Comparison on a column that is not a timestamp",
+			qry:     "name > a",
+			wantErr: true,
+		},
+		{
+			name:      "Custom columns",
+			qry:       "kafka_id = a and channel in (stable, beta)",
+			columns:   []string{"kafka_id", "channel"},
+			outQry:    "kafka_id = ? and channel in (?, ?)",
+			outValues: []interface{}{"a", "stable", "beta"},
+		},
+		{
+			name:      "Qualified columns are searched by their unqualified name",
+			qry:       "name = a and created_at > 2022-01-01T00:00:00Z",
+			columns:   []string{"name", "connectors.created_at"},
+			outQry:    "name = ? and connectors.created_at > ?",
+			outValues: []interface{}{"a", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:    "Qualified columns cannot be searched by their qualified name",
+			qry:     "connectors.created_at > 2022-01-01T00:00:00Z",
+			columns: []string{"connectors.created_at"},
+			wantErr: true,
+		},
+		{
+			name:    "Column not in custom columns",
+			qry:     "name = a",
+			columns: []string{"kafka_id", "channel"},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			RegisterTestingT(t)
-			qry, err := NewQueryParser().Parse(tt.qry)
+			columns := tt.columns
+			if columns == nil {
+				columns = testColumns
+			}
+			qry, err := NewQueryParser(columns...).Parse(tt.qry)
 
 			if err != nil && !tt.wantErr {
 				t.Errorf("QueryParser() error = %v, wantErr = %v", err, tt.wantErr)
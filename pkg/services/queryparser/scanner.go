@@ -1,12 +1,17 @@
 package services
 
-import "github.com/pkg/errors"
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
 
 const (
 	OP = iota
 	BRACE
 	LITERAL
 	QUOTED_LITERAL
+	SEPARATOR
 	NO_TOKEN
 )
 
@@ -40,6 +45,8 @@ func (s *scanner) Init(txt string) {
 
 	quoted := false
 	escaped := false
+	// listOpen is true between the braces of an IN list, the only place where ',' separates values
+	listOpen := false
 
 	sendCurrentTokens := func() {
 		res := ""
@@ -59,6 +66,18 @@ func (s *scanner) Init(txt string) {
 		currentTokenType = NO_TOKEN
 	}
 
+	addLiteral := func(i int, currentChar rune) {
+		if currentTokenType != NO_TOKEN && currentTokenType != LITERAL && currentTokenType != QUOTED_LITERAL {
+			sendCurrentTokens()
+		}
+		currentTokenType = LITERAL
+		tokens = append(tokens, Token{
+			TokenType: LITERAL,
+			Value:     string(currentChar),
+			Position:  i,
+		})
+	}
+
 	// extract all the tokens from the string
 	for i, currentChar := range txt {
 		switch currentChar {
@@ -77,11 +96,35 @@ func (s *scanner) Init(txt string) {
 		case ')':
 			// found closebrace Token
 			sendCurrentTokens()
+			if currentChar == '(' {
+				lastToken := len(s.tokens) - 1
+				listOpen = lastToken >= 0 && s.tokens[lastToken].TokenType == LITERAL && strings.EqualFold(s.tokens[lastToken].Value, "in")
+			} else {
+				listOpen = false
+			}
 			s.tokens = append(s.tokens, Token{
 				TokenType: BRACE,
 				Value:     string(currentChar),
 				Position:  i,
 			})
+		case ',':
+			if quoted {
+				tokens = append(tokens, Token{
+					TokenType: QUOTED_LITERAL,
+					Value:     ",",
+					Position:  i,
+				})
+			} else if listOpen {
+				// found list separator Token
+				sendCurrentTokens()
+				s.tokens = append(s.tokens, Token{
+					TokenType: SEPARATOR,
+					Value:     ",",
+					Position:  i,
+				})
+			} else {
+				addLiteral(i, currentChar)
+			}
 		case '=':
 			fallthrough
 		case '<':
@@ -141,15 +184,7 @@ func (s *scanner) Init(txt string) {
 			}
 			// none of the previous: LITERAL
 		default:
-			if currentTokenType != NO_TOKEN && currentTokenType != LITERAL && currentTokenType != QUOTED_LITERAL {
-				sendCurrentTokens()
-			}
-			currentTokenType = LITERAL
-			tokens = append(tokens, Token{
-				TokenType: LITERAL,
-				Value:     string(currentChar),
-				Position:  i,
-			})
+			addLiteral(i, currentChar)
 		}
 	}
 
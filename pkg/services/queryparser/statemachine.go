@@ -2,8 +2,10 @@ package services
 
 import (
 	"fmt"
-	"github.com/pkg/errors"
 	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
 )
 
 type State interface {
@@ -32,6 +34,8 @@ type state struct {
 	family    string
 	// acceptPattern - pattern used to decide if the current character can be accepted as part of this Token Value
 	acceptPattern string
+	// acceptRegex - acceptPattern compiled once when the state is built, accept is called for every candidate transition
+	acceptRegex *regexp.Regexp
 
 	// last - this is set to true if this Token can be the last Token (just before the EOF)
 	last bool
@@ -50,6 +54,7 @@ func NewStartState() State {
 	return &state{
 		tokenName:     "START",
 		acceptPattern: `^$`,
+		acceptRegex:   regexp.MustCompile(`^$`),
 	}
 }
 
@@ -61,8 +66,7 @@ func NewEndState() State {
 }
 
 func (s *state) accept(tok string) bool {
-	matched, _ := regexp.Match(s.acceptPattern, []byte(tok))
-	return matched
+	return s.acceptRegex.MatchString(tok)
 }
 
 func (s *state) parse(tok string) (State, error) {
@@ -82,14 +86,26 @@ func (s *state) parse(tok string) (State, error) {
 		}
 	}
 
-	return nil, errors.Errorf("Unexpected Token `%s`", tok)
+	return nil, errors.Errorf("Unexpected Token `%s`, expected one of: %s", tok, s.expected())
+}
+
+// expected - the names of the tokens that can follow this state, used to report parsing errors
+func (s *state) expected() string {
+	var names []string
+	for _, next := range s.next {
+		names = append(names, next.(*state).tokenName)
+	}
+	if s.last {
+		names = append(names, "END")
+	}
+	return strings.Join(names, ", ")
 }
 
 // eof - this function must be called when the whole string has been parsed to check if the current state is a valid eof state
 func (s *state) eof() error {
 	// EOF has been reached. Check if the current Token can be the last one
 	if !s.last {
-		return errors.Errorf(`EOF encountered while parsing string`)
+		return errors.Errorf("EOF encountered while parsing string, expected one of: %s", s.expected())
 	}
 
 	return nil
@@ -135,8 +151,11 @@ func (sb *stateBuilder) OnNewToken(handler func(token *ParsedToken) error) State
 	return sb
 }
 
+// Build - returns the built state. It panics if the accept pattern is not a valid regular expression, as grammars
+// are defined by the code.
 func (sb *stateBuilder) Build() State {
 	sb.s.acceptPattern = fmt.Sprintf(`^%s$`, sb.s.acceptPattern)
+	sb.s.acceptRegex = regexp.MustCompile(sb.s.acceptPattern)
 	return sb.s
 }
 
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+// buildTestStateMachine builds a state machine accepting lists of numbers separated by '+', e.g. "1 + 2 + 3"
+func buildTestStateMachine(onNewToken NewTokenHandler) State {
+	grammar := Grammar{
+		Tokens: []TokenDefinition{
+			{Name: "NUMBER", Family: "VALUE", AcceptPattern: `[0-9]+`},
+			{Name: "PLUS", Family: "OP", AcceptPattern: `\+`},
+		},
+		Transitions: []TransitionDefinition{
+			{TokenName: StartState, ValidTransitions: []string{"NUMBER"}},
+			{TokenName: "NUMBER", ValidTransitions: []string{"PLUS", EndState}},
+			{TokenName: "PLUS", ValidTransitions: []string{"NUMBER"}},
+		},
+	}
+	return NewStateMachineBuilder(&grammar).OnNewToken(onNewToken).Build()
+}
+
+func Test_StateMachine(t *testing.T) {
+	tests := []struct {
+		name       string
+		tokens     []string
+		handlerErr error
+		wantTokens []ParsedToken
+		wantErr    string
+	}{
+		{
+			name:   "should parse the tokens accepted by the grammar",
+			tokens: []string{"1", "+", "23"},
+			wantTokens: []ParsedToken{
+				{tokenName: "NUMBER", family: "VALUE", value: "1"},
+				{tokenName: "PLUS", family: "OP", value: "+"},
+				{tokenName: "NUMBER", family: "VALUE", value: "23"},
+			},
+		},
+		{
+			name:    "should only accept tokens fully matching the pattern",
+			tokens:  []string{"1a"},
+			wantErr: "Unexpected Token `1a`, expected one of: NUMBER",
+		},
+		{
+			name:    "should report the tokens expected after an unexpected token",
+			tokens:  []string{"1", "2"},
+			wantErr: "Unexpected Token `2`, expected one of: PLUS, END",
+		},
+		{
+			name:    "should report the tokens expected when the end is reached too early",
+			tokens:  []string{"1", "+"},
+			wantErr: "EOF encountered while parsing string, expected one of: NUMBER",
+		},
+		{
+			name:       "should return the error of the token handler",
+			tokens:     []string{"1"},
+			handlerErr: errors.New("handler error"),
+			wantErr:    "handler error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			var parsedTokens []ParsedToken
+			state := buildTestStateMachine(func(token *ParsedToken) error {
+				parsedTokens = append(parsedTokens, *token)
+				return tt.handlerErr
+			})
+
+			var err error
+			for _, token := range tt.tokens {
+				if state, err = state.parse(token); err != nil {
+					break
+				}
+			}
+			if err == nil {
+				err = state.eof()
+			}
+
+			if tt.wantErr != "" {
+				Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedTokens).To(Equal(tt.wantTokens))
+		})
+	}
+}
+
+func Test_StateBuilder_InvalidPattern(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(func() {
+		NewStateBuilder("INVALID").AcceptPattern(`[`).Build()
+	}).To(Panic())
+}
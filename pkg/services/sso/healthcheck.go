@@ -0,0 +1,33 @@
+package sso
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/healthcheck"
+)
+
+var _ healthcheck.Check = &HealthCheck{}
+
+// HealthCheck checks that an access token can be fetched from the sso provider, mas-sso or sso.redhat.com
+type HealthCheck struct {
+	service KafkaKeycloakService
+}
+
+func NewHealthCheck(service KafkaKeycloakService) *HealthCheck {
+	return &HealthCheck{
+		service: service,
+	}
+}
+
+func (h *HealthCheck) Name() string {
+	return "sso"
+}
+
+func (h *HealthCheck) Check(ctx context.Context) error {
+	proxy, ok := h.service.(*keycloakServiceProxy)
+	if !ok {
+		return nil
+	}
+	_, err := proxy.accessTokenProvider.GetToken()
+	return err
+}
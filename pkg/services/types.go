@@ -22,6 +22,10 @@ type ListArguments struct {
 	Preloads []string
 	Search   string
 	OrderBy  []string
+	// Cursor is the opaque position returned as next_cursor by the previous page. It is only used when
+	// CursorPaging is set, which happens when the cursor parameter is sent, even if empty.
+	Cursor       string
+	CursorPaging bool
 }
 
 // NewListArguments - Create ListArguments from url query parameters with sane defaults
@@ -45,6 +49,10 @@ func NewListArguments(params url.Values) *ListArguments {
 	if v := params.Get("search"); v != "" {
 		listArgs.Search = v
 	}
+	if _, ok := params["cursor"]; ok {
+		listArgs.CursorPaging = true
+		listArgs.Cursor = params.Get("cursor")
+	}
 	if v := params.Get("orderBy"); v != "" {
 		listArgs.OrderBy = strings.Split(v, ",")
 		// remove spaces
@@ -63,6 +71,10 @@ func (la *ListArguments) Validate() error {
 		return errors.Errorf("size must be equal or greater than 1")
 	}
 
+	if la.CursorPaging && len(la.OrderBy) > 1 {
+		return errors.Errorf("only one order by field is supported when paging with a cursor")
+	}
+
 	if len(la.OrderBy) > 0 {
 		space := regexp.MustCompile(`\s+`)
 		for _, orderByClause := range la.OrderBy {
@@ -83,3 +83,45 @@ func Test_ValidateOrderBy(t *testing.T) {
 		})
 	}
 }
+
+func Test_NewListArguments_Cursor(t *testing.T) {
+	tests := []struct {
+		name             string
+		params           map[string][]string
+		wantCursorPaging bool
+		wantCursor       string
+		wantErr          bool
+	}{
+		{
+			name:   "Page mode when the cursor is not set",
+			params: map[string][]string{"page": {"2"}},
+		},
+		{
+			name:             "Cursor mode when the cursor is empty",
+			params:           map[string][]string{"cursor": {""}},
+			wantCursorPaging: true,
+		},
+		{
+			name:             "Cursor mode with a cursor",
+			params:           map[string][]string{"cursor": {"abc"}},
+			wantCursorPaging: true,
+			wantCursor:       "abc",
+		},
+		{
+			name:             "Cursor mode with more than one order by field",
+			params:           map[string][]string{"cursor": {""}, "orderBy": {"name asc, created_at"}},
+			wantCursorPaging: true,
+			wantErr:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			la := NewListArguments(tt.params)
+			Expect(la.CursorPaging).To(Equal(tt.wantCursorPaging))
+			Expect(la.Cursor).To(Equal(tt.wantCursor))
+			Expect(la.Validate() != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AddAWSHandlers adds handlers to the AWS session handlers so that a client span is started for each AWS API call,
+// including its retries. Handlers are used rather than wrapping the HTTP transport as the AWS SDK requires a
+// *http.Transport to load custom CA bundles.
+func AddAWSHandlers(handlers *request.Handlers) {
+	handlers.Build.PushFrontNamed(request.NamedHandler{
+		Name: "tracing.StartSpan",
+		Fn: func(r *request.Request) {
+			ctx, _ := StartSpan(r.Context(), fmt.Sprintf("%s.%s", r.ClientInfo.ServiceName, r.Operation.Name),
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					semconv.RPCSystemKey.String("aws-api"),
+					semconv.RPCServiceKey.String(r.ClientInfo.ServiceName),
+					semconv.RPCMethodKey.String(r.Operation.Name),
+				),
+			)
+			r.SetContext(ctx)
+		},
+	})
+	handlers.Build.PushBackNamed(request.NamedHandler{
+		Name: "tracing.InjectContext",
+		Fn: func(r *request.Request) {
+			otel.GetTextMapPropagator().Inject(r.Context(), propagation.HeaderCarrier(r.HTTPRequest.Header))
+		},
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tracing.EndSpan",
+		Fn: func(r *request.Request) {
+			span := trace.SpanFromContext(r.Context())
+			defer span.End()
+			span.SetAttributes(attribute.Int("aws.retry_count", r.RetryCount))
+			if r.HTTPResponse != nil {
+				span.SetAttributes(semconv.HTTPStatusCodeKey.Int(r.HTTPResponse.StatusCode))
+			}
+			RecordError(span, r.Error)
+		},
+	})
+}
@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	// ExporterNone records spans, so that trace IDs are propagated and logged, but never exports them
+	ExporterNone = "none"
+	// ExporterOTLP exports spans to an OpenTelemetry collector using the OTLP/HTTP protocol
+	ExporterOTLP = "otlp"
+)
+
+type Config struct {
+	Exporter     string  `json:"exporter"`
+	OTLPEndpoint string  `json:"otlp_endpoint"`
+	OTLPInsecure bool    `json:"otlp_insecure"`
+	SampleRatio  float64 `json:"sample_ratio"`
+	ServiceName  string  `json:"service_name"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Exporter:     ExporterNone,
+		OTLPEndpoint: "localhost:4318",
+		OTLPInsecure: false,
+		SampleRatio:  1,
+		ServiceName:  "kas-fleet-manager",
+	}
+}
+
+func (c *Config) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.Exporter, "tracing-exporter", c.Exporter, fmt.Sprintf("Exporter used for the traces of the service (options: %s, %s)", ExporterNone, ExporterOTLP))
+	fs.StringVar(&c.OTLPEndpoint, "tracing-otlp-endpoint", c.OTLPEndpoint, "Host and port of the OpenTelemetry collector the traces are exported to")
+	fs.BoolVar(&c.OTLPInsecure, "tracing-otlp-insecure", c.OTLPInsecure, "Export the traces to the OpenTelemetry collector without TLS")
+	fs.Float64Var(&c.SampleRatio, "tracing-sample-ratio", c.SampleRatio, "Ratio of the traces started by the service that are sampled, between 0 and 1")
+	fs.StringVar(&c.ServiceName, "tracing-service-name", c.ServiceName, "Service name the traces are reported under")
+}
+
+func (c *Config) ReadFiles() error {
+	if c.Exporter != ExporterNone && c.Exporter != ExporterOTLP {
+		return fmt.Errorf("invalid tracing exporter %q, must be one of: %s, %s", c.Exporter, ExporterNone, ExporterOTLP)
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("invalid tracing sample ratio %v, must be between 0 and 1", c.SampleRatio)
+	}
+	return nil
+}
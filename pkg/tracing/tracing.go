@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/golang/glog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager"
+
+// shutdownTimeout is the time given to the exporter to flush the pending spans when the service stops
+const shutdownTimeout = 5 * time.Second
+
+// NewTracerProvider creates the tracer provider of the service according to the tracing configuration.
+// Spans are always recorded so that trace IDs are propagated to outbound requests and logs, even when they are not exported.
+func NewTracerProvider(envName environments.EnvName, c *Config) (*sdktrace.TracerProvider, func(), error) {
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(c.ServiceName),
+			semconv.DeploymentEnvironmentKey.String(string(envName)),
+		)),
+	}
+
+	if c.Exporter == ExporterOTLP {
+		exporterOptions := []otlptracehttp.Option{otlptracehttp.WithEndpoint(c.OTLPEndpoint)}
+		if c.OTLPInsecure {
+			exporterOptions = append(exporterOptions, otlptracehttp.WithInsecure())
+		}
+		// the exporter connects lazily, so that an unavailable collector does not prevent the service from starting
+		exporter := otlptracehttp.NewUnstarted(exporterOptions...)
+		if err := exporter.Start(context.Background()); err != nil {
+			return nil, nil, err
+		}
+		options = append(options, sdktrace.WithBatcher(exporter))
+	}
+
+	provider := sdktrace.NewTracerProvider(options...)
+	return provider, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := provider.Shutdown(ctx); err != nil {
+			glog.Errorf("Unable to shutdown the tracer provider: %s", err.Error())
+		}
+	}, nil
+}
+
+// Initialize registers the tracer provider of the service as the global one
+func Initialize(c *Config, provider *sdktrace.TracerProvider) {
+	glog.Infof("Tracing enabled with the %q exporter and a sample ratio of %v", c.Exporter, c.SampleRatio)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// StartSpan starts a span with the given name as a child of the span stored in the context, if any
+func StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// RecordError records the error on the span and marks the span as failed
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// TraceID returns the ID of the trace the span stored in the context belongs to, or an empty string when there is none
+func TraceID(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
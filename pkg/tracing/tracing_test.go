@@ -0,0 +1,167 @@
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupTestTracerProvider() *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return recorder
+}
+
+func attributeValue(attributes []attribute.KeyValue, key attribute.Key) attribute.Value {
+	for _, kv := range attributes {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func Test_Middleware(t *testing.T) {
+	const parentTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	tests := []struct {
+		name              string
+		status            int
+		traceparent       string
+		wantStatusCode    codes.Code
+		wantParentTraceID bool
+	}{
+		{
+			name:           "should start a new trace for a request without trace context",
+			status:         http.StatusOK,
+			wantStatusCode: codes.Unset,
+		},
+		{
+			name:              "should continue the trace propagated by the caller",
+			status:            http.StatusCreated,
+			traceparent:       "00-" + parentTraceID + "-00f067aa0ba902b7-01",
+			wantStatusCode:    codes.Unset,
+			wantParentTraceID: true,
+		},
+		{
+			name:           "should mark the span as failed on server errors",
+			status:         http.StatusInternalServerError,
+			wantStatusCode: codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			recorder := setupTestTracerProvider()
+
+			var handlerTraceID string
+			router := mux.NewRouter()
+			router.Use(Middleware)
+			router.HandleFunc("/api/kafkas_mgmt/v1/kafkas/{id}", func(w http.ResponseWriter, r *http.Request) {
+				handlerTraceID = TraceID(r.Context())
+				w.WriteHeader(tt.status)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/kafkas_mgmt/v1/kafkas/123", nil)
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			span := spans[0]
+			Expect(span.Name()).To(Equal("GET /api/kafkas_mgmt/v1/kafkas/{id}"))
+			Expect(span.SpanKind()).To(Equal(trace.SpanKindServer))
+			Expect(span.Status().Code).To(Equal(tt.wantStatusCode))
+			Expect(attributeValue(span.Attributes(), "http.status_code").AsInt64()).To(Equal(int64(tt.status)))
+			Expect(handlerTraceID).To(Equal(span.SpanContext().TraceID().String()))
+			if tt.wantParentTraceID {
+				Expect(handlerTraceID).To(Equal(parentTraceID))
+			}
+		})
+	}
+}
+
+func Test_Transport(t *testing.T) {
+	RegisterTestingT(t)
+	recorder := setupTestTracerProvider()
+
+	var receivedTraceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedTraceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, parent := StartSpan(httptest.NewRequest(http.MethodGet, "/", nil).Context(), "parent")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	Expect(err).ToNot(HaveOccurred())
+
+	client := &http.Client{Transport: NewTransport(nil)}
+	resp, err := client.Do(req)
+	Expect(err).ToNot(HaveOccurred())
+	_ = resp.Body.Close()
+	parent.End()
+
+	Expect(req.Header.Get("traceparent")).To(BeEmpty(), "the original request must not be modified")
+
+	spans := recorder.Ended()
+	Expect(spans).To(HaveLen(2))
+	span := spans[0]
+	Expect(span.SpanKind()).To(Equal(trace.SpanKindClient))
+	Expect(span.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+	Expect(span.Status().Code).To(Equal(codes.Error))
+	Expect(attributeValue(span.Attributes(), "http.status_code").AsInt64()).To(Equal(int64(http.StatusServiceUnavailable)))
+	Expect(receivedTraceparent).To(ContainSubstring(span.SpanContext().SpanID().String()))
+	Expect(receivedTraceparent).To(ContainSubstring(parent.SpanContext().TraceID().String()))
+}
+
+func Test_AddAWSHandlers(t *testing.T) {
+	RegisterTestingT(t)
+	recorder := setupTestTracerProvider()
+
+	var receivedTraceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedTraceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-east-1"),
+		MaxRetries:  aws.Int(0),
+	})
+	Expect(err).ToNot(HaveOccurred())
+	AddAWSHandlers(&sess.Handlers)
+
+	_, err = route53.New(sess).GetChange(&route53.GetChangeInput{Id: aws.String("change-id")})
+	Expect(err).To(HaveOccurred())
+
+	spans := recorder.Ended()
+	Expect(spans).To(HaveLen(1))
+	span := spans[0]
+	Expect(span.Name()).To(Equal("route53.GetChange"))
+	Expect(span.SpanKind()).To(Equal(trace.SpanKindClient))
+	Expect(span.Status().Code).To(Equal(codes.Error))
+	Expect(attributeValue(span.Attributes(), "http.status_code").AsInt64()).To(Equal(int64(http.StatusBadRequest)))
+	Expect(receivedTraceparent).To(ContainSubstring(span.SpanContext().TraceID().String()))
+}
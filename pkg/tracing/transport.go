@@ -0,0 +1,49 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewTransport wraps the given transport so that a client span is started for each outbound request and the
+// trace is propagated to the called service. The default transport is wrapped when the given one is nil.
+func NewTransport(wrapped http.RoundTripper) http.RoundTripper {
+	if wrapped == nil {
+		wrapped = http.DefaultTransport
+	}
+	return &transport{wrapped: wrapped}
+}
+
+type transport struct {
+	wrapped http.RoundTripper
+}
+
+func (t *transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx, span := StartSpan(request.Context(), fmt.Sprintf("HTTP %s %s", request.Method, request.URL.Host),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.HTTPClientAttributesFromHTTPRequest(request)...),
+	)
+	defer span.End()
+
+	// a round tripper must not modify the given request
+	request = request.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(request.Header))
+
+	response, err := t.wrapped.RoundTrip(request)
+	if err != nil {
+		RecordError(span, err)
+		return response, err
+	}
+
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(response.StatusCode))
+	if response.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(response.StatusCode))
+	}
+	return response, nil
+}
@@ -201,6 +201,49 @@ func (s *LeaderElectionManager) acquireLeaderLease(workerId string, workerType s
 	}, nil
 }
 
+// GetWorkerStatuses returns the leadership and reconcile state of every worker registered in this process
+func (s *LeaderElectionManager) GetWorkerStatuses() ([]WorkerStatus, error) {
+	var leaseList api.LeaderLeaseList
+	dbConn := s.connectionFactory.New()
+	if err := dbConn.Raw("SELECT * FROM leader_leases where deleted_at is null").Scan(&leaseList).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve leader leases")
+	}
+
+	leases := map[string]*api.LeaderLease{}
+	for _, lease := range leaseList {
+		leases[lease.LeaseType] = lease
+	}
+
+	var statuses []WorkerStatus
+	for _, worker := range s.workers {
+		status := WorkerStatus{
+			ID:         worker.GetID(),
+			WorkerType: worker.GetWorkerType(),
+			Running:    worker.IsRunning(),
+		}
+		if lease, ok := leases[worker.GetWorkerType()]; ok {
+			status.Leader = lease.Leader
+			status.LeaseExpires = lease.Expires
+		}
+		if recorder, ok := worker.(runStatusRecorder); ok {
+			status.RunStatus = recorder.GetRunStatus()
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// HasWorkerType returns true if a worker of the given type is registered in this process
+func (s *LeaderElectionManager) HasWorkerType(workerType string) bool {
+	for _, worker := range s.workers {
+		if worker.GetWorkerType() == workerType {
+			return true
+		}
+	}
+	return false
+}
+
 func isExpired(lease *api.LeaderLease) bool {
 	return lease.Leader == "" || time.Now().After(*lease.Expires)
 }
@@ -183,3 +183,85 @@ func TestLeaderElectionManager_acquireLeaderLease(t *testing.T) {
 		})
 	}
 }
+
+func TestLeaderElectionManager_GetWorkerStatuses(t *testing.T) {
+	expires := time.Now().Add(time.Hour)
+	worker := &WorkerMock{
+		GetIDFunc: func() string {
+			return "000-001"
+		},
+		GetWorkerTypeFunc: func() string {
+			return "cluster"
+		},
+		IsRunningFunc: func() bool {
+			return true
+		},
+	}
+
+	tests := []struct {
+		name    string
+		setupFn func()
+		want    []WorkerStatus
+		wantErr bool
+	}{
+		{
+			name: "failure listing leases table results in error",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("SELECT").WithQueryException()
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns the lease of each registered worker",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery("SELECT * FROM leader_leases where deleted_at is null").
+					WithReply([]map[string]interface{}{
+						{"leader": "000-001", "lease_type": "cluster", "expires": expires},
+						{"leader": "000-002", "lease_type": "kafka", "expires": expires},
+					})
+			},
+			want: []WorkerStatus{
+				{ID: "000-001", WorkerType: "cluster", Running: true, Leader: "000-001"},
+			},
+		},
+		{
+			name: "worker without a lease has no leader",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery("SELECT * FROM leader_leases where deleted_at is null").
+					WithReply([]map[string]interface{}{})
+			},
+			want: []WorkerStatus{
+				{ID: "000-001", WorkerType: "cluster", Running: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupFn()
+			s := &LeaderElectionManager{
+				workers:           []Worker{worker},
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			got, err := s.GetWorkerStatuses()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetWorkerStatuses() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("GetWorkerStatuses() got = %v, want %v", got, tt.want)
+				return
+			}
+			for i := range got {
+				if got[i].ID != tt.want[i].ID || got[i].WorkerType != tt.want[i].WorkerType ||
+					got[i].Running != tt.want[i].Running || got[i].Leader != tt.want[i].Leader {
+					t.Errorf("GetWorkerStatuses() got = %v, want %v", got[i], tt.want[i])
+				}
+				if got[i].Leader != "" && got[i].LeaseExpires == nil {
+					t.Errorf("GetWorkerStatuses() expected lease expiry to be set")
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package workers
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
@@ -10,6 +11,9 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/golang/glog"
 )
@@ -78,6 +82,12 @@ func (r *Reconciler) Start(worker Worker) {
 }
 
 func (r *Reconciler) runReconcile(worker Worker) {
+	// workers do not take a context, so the span measures the whole reconcile loop and tags its logs,
+	// but the requests done by the worker are not traced as its children
+	ctx, span := tracing.StartSpan(context.Background(), "reconcile "+worker.GetWorkerType(),
+		trace.WithAttributes(attribute.String("worker.type", worker.GetWorkerType()), attribute.String("worker.id", worker.GetID())))
+	defer span.End()
+
 	start := time.Now()
 	errors := worker.Reconcile()
 	if len(errors) == 0 {
@@ -86,9 +96,21 @@ func (r *Reconciler) runReconcile(worker Worker) {
 		metrics.IncreaseReconcilerFailureCount(worker.GetWorkerType())
 		metrics.IncreaseReconcilerErrorsCount(worker.GetWorkerType(), len(errors))
 	}
-	metrics.UpdateReconcilerDurationMetric(worker.GetWorkerType(), time.Since(start))
+	end := time.Now()
+	metrics.UpdateReconcilerDurationMetric(worker.GetWorkerType(), end.Sub(start))
+	if recorder, ok := worker.(runStatusRecorder); ok {
+		recorder.setRunStatus(RunStatus{
+			LastRunStart:    &start,
+			LastRunEnd:      &end,
+			LastRunDuration: end.Sub(start),
+			LastErrors:      errors,
+		})
+	}
+	span.SetAttributes(attribute.Int("reconcile.errors", len(errors)))
+	ulog := logger.NewUHCLogger(ctx)
 	for _, e := range errors {
-		logger.Logger.Error(e)
+		tracing.RecordError(span, e)
+		ulog.Error(e)
 	}
 }
 
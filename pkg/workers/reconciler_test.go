@@ -2,6 +2,7 @@ package workers
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -79,3 +80,48 @@ func TestReconciler_Wakeup(t *testing.T) {
 	// We can use a 0 timeout here because Wakeup will wait for the reconcile to occur first.
 	Expect(waitForReconcile(0)).Should(Equal(false))
 }
+
+type statusTestWorker struct {
+	BaseWorker
+	reconcileErrors []error
+}
+
+func (w *statusTestWorker) Start() {}
+
+func (w *statusTestWorker) Stop() {}
+
+func (w *statusTestWorker) Reconcile() []error {
+	return w.reconcileErrors
+}
+
+func TestReconciler_runReconcileRecordsRunStatus(t *testing.T) {
+	RegisterTestingT(t)
+	r := Reconciler{
+		SignalBus:        signalbus.NewSignalBus(),
+		ReconcilerConfig: NewReconcilerConfig(),
+	}
+	worker := &statusTestWorker{
+		BaseWorker: BaseWorker{
+			Id:         "test",
+			WorkerType: "test",
+		},
+		reconcileErrors: []error{errors.New("reconcile failed")},
+	}
+
+	Expect(worker.GetRunStatus().LastRunStart).To(BeNil())
+
+	before := time.Now()
+	r.runReconcile(worker)
+
+	status := worker.GetRunStatus()
+	Expect(status.LastRunStart).ToNot(BeNil())
+	Expect(status.LastRunEnd).ToNot(BeNil())
+	Expect(status.LastRunStart.Before(before)).To(BeFalse())
+	Expect(status.LastRunEnd.Before(*status.LastRunStart)).To(BeFalse())
+	Expect(status.LastRunDuration).To(Equal(status.LastRunEnd.Sub(*status.LastRunStart)))
+	Expect(status.LastErrors).To(Equal(worker.reconcileErrors))
+
+	worker.reconcileErrors = nil
+	r.runReconcile(worker)
+	Expect(worker.GetRunStatus().LastErrors).To(BeEmpty())
+}
@@ -26,6 +26,8 @@ type BaseWorker struct {
 	isRunning    bool
 	imStop       chan struct{}
 	syncTeardown sync.WaitGroup
+	runStatus    RunStatus
+	runStatusMux sync.RWMutex
 }
 
 func (b *BaseWorker) GetID() string {
@@ -52,6 +54,19 @@ func (b *BaseWorker) SetIsRunning(val bool) {
 	b.isRunning = val
 }
 
+// GetRunStatus returns the outcome of the last reconcile loop run by the worker
+func (b *BaseWorker) GetRunStatus() RunStatus {
+	b.runStatusMux.RLock()
+	defer b.runStatusMux.RUnlock()
+	return b.runStatus
+}
+
+func (b *BaseWorker) setRunStatus(status RunStatus) {
+	b.runStatusMux.Lock()
+	defer b.runStatusMux.Unlock()
+	b.runStatus = status
+}
+
 func (b *BaseWorker) StartWorker(w Worker) {
 	metrics.SetLeaderWorkerMetric(b.WorkerType, true)
 	b.Reconciler.Start(w)
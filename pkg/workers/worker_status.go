@@ -0,0 +1,30 @@
+package workers
+
+import (
+	"time"
+)
+
+// RunStatus describes the outcome of the last reconcile loop executed by a worker
+type RunStatus struct {
+	LastRunStart    *time.Time
+	LastRunEnd      *time.Time
+	LastRunDuration time.Duration
+	LastErrors      []error
+}
+
+// WorkerStatus is a snapshot of the leadership and reconcile state of a worker registered in this process.
+// The run details are only known to the process that ran the worker while it held the leader lease.
+type WorkerStatus struct {
+	RunStatus
+	ID           string
+	WorkerType   string
+	Running      bool
+	Leader       string
+	LeaseExpires *time.Time
+}
+
+// runStatusRecorder is implemented by workers keeping track of their reconcile loops, see BaseWorker
+type runStatusRecorder interface {
+	setRunStatus(status RunStatus)
+	GetRunStatus() RunStatus
+}